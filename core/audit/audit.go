@@ -0,0 +1,317 @@
+package audit
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"net/url"
+	"os"
+	"os/user"
+	"reflect"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// AuthMethod is how the actor of an audit log entry authenticated
+type AuthMethod string
+
+const (
+	// AuthMethodSession is a user logged in with a session cookie
+	AuthMethodSession AuthMethod = "session"
+	// AuthMethodToken is a user authenticated with an API token
+	AuthMethodToken AuthMethod = "token"
+	// AuthMethodCLI is an operator running a local command on the node's host
+	AuthMethodCLI AuthMethod = "cli"
+)
+
+// GetAuthMethod parses an AuthMethod from a string
+func GetAuthMethod(s string) (AuthMethod, error) {
+	switch m := AuthMethod(s); m {
+	case AuthMethodSession, AuthMethodToken, AuthMethodCLI:
+		return m, nil
+	default:
+		return "", errors.Errorf("invalid auth method %q, must be one of: session, token, cli", s)
+	}
+}
+
+// Actor is who performed an audited action
+type Actor struct {
+	// Name is the email of the user, or the OS user for local commands
+	Name       string
+	AuthMethod AuthMethod
+}
+
+// CLIActor returns the actor for local commands run by the current OS user
+func CLIActor() Actor {
+	name := "unknown"
+	if u, err := user.Current(); err == nil && u.Username != "" {
+		name = u.Username
+	}
+	if host, err := os.Hostname(); err == nil && host != "" {
+		name += "@" + host
+	}
+	return Actor{Name: name, AuthMethod: AuthMethodCLI}
+}
+
+// Action is a privileged operation that is recorded in the audit log
+type Action string
+
+const (
+	ActionUserCreated              Action = "user.created"
+	ActionUserRoleUpdated          Action = "user.role_updated"
+	ActionUserDeleted              Action = "user.deleted"
+	ActionUserPasswordUpdated      Action = "user.password_updated"
+	ActionAPITokenCreated          Action = "api_token.created"
+	ActionAPITokenDeleted          Action = "api_token.deleted"
+	ActionExternalInitiatorCreated Action = "external_initiator.created"
+	ActionExternalInitiatorDeleted Action = "external_initiator.deleted"
+	ActionBridgeCreated            Action = "bridge.created"
+	ActionBridgeUpdated            Action = "bridge.updated"
+	ActionBridgeDeleted            Action = "bridge.deleted"
+	ActionJobCreated               Action = "job.created"
+	ActionJobDeleted               Action = "job.deleted"
+	ActionJobPaused                Action = "job.paused"
+	ActionJobResumed               Action = "job.resumed"
+	ActionJobUpdated               Action = "job.updated"
+	ActionJobRolledBack            Action = "job.rolled_back"
+	ActionJobProposalApproved      Action = "job_proposal.approved"
+	ActionJobProposalCancelled     Action = "job_proposal.cancelled"
+	ActionJobProposalRejected      Action = "job_proposal.rejected"
+	ActionJobProposalSpecUpdated   Action = "job_proposal.spec_updated"
+	ActionFeedsManagerCreated      Action = "feeds_manager.created"
+	ActionFeedsManagerUpdated      Action = "feeds_manager.updated"
+	ActionKeyCreated               Action = "key.created"
+	ActionKeyUpdated               Action = "key.updated"
+	ActionKeyDeleted               Action = "key.deleted"
+	ActionKeyImported              Action = "key.imported"
+	ActionKeyExported              Action = "key.exported"
+	ActionChainCreated             Action = "chain.created"
+	ActionChainUpdated             Action = "chain.updated"
+	ActionChainDeleted             Action = "chain.deleted"
+	ActionNodeCreated              Action = "node.created"
+	ActionNodeDeleted              Action = "node.deleted"
+	ActionConfigUpdated            Action = "config.updated"
+	ActionLogConfigUpdated         Action = "log_config.updated"
+	ActionTransferCreated          Action = "transfer.created"
+	ActionTxsRebroadcast           Action = "txs.rebroadcast"
+	ActionTxsCancelled             Action = "txs.cancelled"
+	ActionTxsReplaced              Action = "txs.replaced"
+	ActionNonceSet                 Action = "nonce.set"
+	ActionKeyPoolCreated           Action = "key_pool.created"
+	ActionKeyPoolUpdated           Action = "key_pool.updated"
+	ActionKeyPoolDeleted           Action = "key_pool.deleted"
+)
+
+// Entry is a single record in the audit log
+type Entry struct {
+	ID         int64
+	Actor      string
+	AuthMethod AuthMethod
+	Action     Action
+	Target     string
+	Diff       Diff
+	CreatedAt  time.Time
+}
+
+// Filter narrows down the audit log entries that are listed. Zero values
+// match every entry.
+type Filter struct {
+	Actor      string
+	AuthMethod AuthMethod
+	Action     Action
+	Target     string
+	Since      *time.Time
+	Until      *time.Time
+}
+
+// Change is the value of a field before and after an action
+type Change struct {
+	Old interface{} `json:"old,omitempty"`
+	New interface{} `json:"new,omitempty"`
+}
+
+// Diff is the set of fields changed by an action, keyed by field name
+type Diff map[string]Change
+
+// Scan implements sql.Scanner
+func (d *Diff) Scan(value interface{}) error {
+	if value == nil {
+		*d = nil
+		return nil
+	}
+	b, ok := value.([]byte)
+	if !ok {
+		return errors.New("type assertion to []byte failed")
+	}
+
+	// Keep large numbers, like amounts in Wei, exact when reading them back
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	return dec.Decode(d)
+}
+
+// Value implements driver.Valuer
+func (d Diff) Value() (driver.Value, error) {
+	if d == nil {
+		return nil, nil
+	}
+	return json.Marshal(d)
+}
+
+// Redacted is the value stored in place of sensitive fields
+const Redacted = "[REDACTED]"
+
+var (
+	sensitiveFieldRegex = regexp.MustCompile(`(?i)(password|secret|token|private|mnemonic|keyjson|credential|authorization|cookie|salt)`)
+	// RPC providers often embed API keys in the path of their URLs, so only
+	// the hosts of RPC node URLs are recorded
+	rpcURLFieldRegex = regexp.MustCompile(`(?i)(^|\.)(ws|http)url$`)
+)
+
+// NewDiff returns the fields that differ between before and after, either of
+// which may be nil for creations and deletions. Both are compared by their
+// JSON encoding, nested objects are compared field by field, and values that
+// are not JSON objects are diffed as a single "value" field. The values of
+// sensitive fields, such as passwords, secrets and tokens, and the credentials
+// in URLs are redacted. Only the hosts of RPC node URLs are kept.
+func NewDiff(before, after interface{}) Diff {
+	prev, next := toFields(before), toFields(after)
+
+	keys := map[string]struct{}{}
+	for k := range prev {
+		keys[k] = struct{}{}
+	}
+	for k := range next {
+		keys[k] = struct{}{}
+	}
+
+	d := Diff{}
+	for k := range keys {
+		o, n := prev[k], next[k]
+		if reflect.DeepEqual(o, n) {
+			continue
+		}
+		d[k] = Change{Old: redact(k, o), New: redact(k, n)}
+	}
+	if len(d) == 0 {
+		return nil
+	}
+	return d
+}
+
+func toFields(v interface{}) map[string]interface{} {
+	if v == nil {
+		return nil
+	}
+	if rv := reflect.ValueOf(v); rv.Kind() == reflect.Ptr && rv.IsNil() {
+		return nil
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return map[string]interface{}{"value": Redacted}
+	}
+	// Decode numbers as json.Number, so that large amounts like Wei don't lose
+	// precision
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	var decoded interface{}
+	if err = dec.Decode(&decoded); err != nil {
+		return map[string]interface{}{"value": Redacted}
+	}
+	if fields, ok := decoded.(map[string]interface{}); ok {
+		flattened := map[string]interface{}{}
+		flatten("", fields, flattened)
+		return flattened
+	}
+	if decoded == nil {
+		return nil
+	}
+	return map[string]interface{}{"value": decoded}
+}
+
+// flatten copies nested objects into fields with dotted keys, e.g.
+// "config.EvmGasPriceDefault", so that only the nested values that changed
+// are recorded
+func flatten(prefix string, fields map[string]interface{}, into map[string]interface{}) {
+	for k, v := range fields {
+		if prefix != "" {
+			k = prefix + "." + k
+		}
+		if nested, ok := v.(map[string]interface{}); ok && len(nested) > 0 {
+			flatten(k, nested, into)
+			continue
+		}
+		into[k] = v
+	}
+}
+
+func redact(key string, v interface{}) interface{} {
+	if v == nil {
+		return nil
+	}
+	if sensitiveFieldRegex.MatchString(key) {
+		return Redacted
+	}
+	switch val := v.(type) {
+	case map[string]interface{}:
+		redacted := make(map[string]interface{}, len(val))
+		for k, nested := range val {
+			redacted[k] = redact(k, nested)
+		}
+		return redacted
+	case []interface{}:
+		redacted := make([]interface{}, len(val))
+		for i, nested := range val {
+			redacted[i] = redact(key, nested)
+		}
+		return redacted
+	case string:
+		if rpcURLFieldRegex.MatchString(key) {
+			return urlHost(val)
+		}
+		return redactURL(val)
+	default:
+		return v
+	}
+}
+
+// redactURL strips the password and the values of sensitive query parameters
+// from URLs, such as those of bridges. Other strings are returned unchanged.
+func redactURL(s string) string {
+	u, err := url.Parse(s)
+	if err != nil || u.Scheme == "" || u.Host == "" {
+		return s
+	}
+	if u.User != nil {
+		if _, ok := u.User.Password(); ok {
+			u.User = url.UserPassword(u.User.Username(), "redacted")
+		}
+	}
+	if u.RawQuery != "" {
+		query := u.Query()
+		for k := range query {
+			if sensitiveFieldRegex.MatchString(k) || strings.Contains(strings.ToLower(k), "key") {
+				query.Set(k, "redacted")
+			}
+		}
+		u.RawQuery = query.Encode()
+	}
+	return u.String()
+}
+
+func urlHost(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil || u.Host == "" {
+		return Redacted
+	}
+	return u.Scheme + "://" + u.Host
+}
+
+// KeyTarget is the target of actions on keys, made up of the key type and the
+// key's ID, e.g. "eth:0x..."
+func KeyTarget(keyType, id string) string {
+	return keyType + ":" + id
+}
@@ -0,0 +1,64 @@
+package audit
+
+import (
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+	"github.com/smartcontractkit/sqlx"
+)
+
+type Config interface {
+	pg.LogConfig
+	AuditLogForwardToLogger() bool
+}
+
+//go:generate mockery --name AuditLogger --output ./mocks/ --case=underscore
+
+// AuditLogger records privileged operator actions in the audit log
+type AuditLogger interface {
+	// Record appends an entry for an action that has already succeeded. A
+	// failure to record the entry is logged, but does not undo the action.
+	Record(actor Actor, action Action, target string, diff Diff)
+	// Entries returns the entries matching the filter, newest first, and the
+	// total number of matching entries
+	Entries(filter Filter, offset, limit int) ([]Entry, int, error)
+}
+
+type auditLogger struct {
+	orm     ORM
+	lggr    logger.Logger
+	forward bool
+}
+
+var _ AuditLogger = (*auditLogger)(nil)
+
+// NewAuditLogger returns an AuditLogger that writes to the database, and
+// forwards each entry to the logger as a structured log line if
+// AUDIT_LOG_FORWARD_TO_LOGGER is set
+func NewAuditLogger(db *sqlx.DB, lggr logger.Logger, cfg Config) AuditLogger {
+	return &auditLogger{
+		orm:     NewORM(db, lggr, cfg),
+		lggr:    lggr.Named("AuditLogger"),
+		forward: cfg.AuditLogForwardToLogger(),
+	}
+}
+
+func (a *auditLogger) Record(actor Actor, action Action, target string, diff Diff) {
+	entry := Entry{
+		Actor:      actor.Name,
+		AuthMethod: actor.AuthMethod,
+		Action:     action,
+		Target:     target,
+		Diff:       diff,
+	}
+	if err := a.orm.InsertEntry(&entry); err != nil {
+		a.lggr.Errorw("Failed to record audit log entry", "err", err, "actor", actor.Name, "authMethod", actor.AuthMethod, "action", action, "target", target)
+		return
+	}
+	if a.forward {
+		a.lggr.Infow("Audit log entry", "id", entry.ID, "actor", entry.Actor, "authMethod", entry.AuthMethod, "action", entry.Action, "target", entry.Target, "diff", entry.Diff, "createdAt", entry.CreatedAt)
+	}
+}
+
+func (a *auditLogger) Entries(filter Filter, offset, limit int) ([]Entry, int, error) {
+	return a.orm.Entries(filter, offset, limit)
+}
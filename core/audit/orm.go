@@ -0,0 +1,89 @@
+package audit
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+	"github.com/smartcontractkit/sqlx"
+)
+
+//go:generate mockery --name ORM --output ./mocks/ --case=underscore
+
+// ORM reads and appends to the audit log. Entries can never be updated or
+// deleted.
+type ORM interface {
+	InsertEntry(entry *Entry) error
+	Entries(filter Filter, offset, limit int) ([]Entry, int, error)
+}
+
+type orm struct {
+	q pg.Q
+}
+
+var _ ORM = (*orm)(nil)
+
+func NewORM(db *sqlx.DB, lggr logger.Logger, cfg pg.LogConfig) ORM {
+	namedLogger := lggr.Named("AuditORM")
+	return &orm{pg.NewQ(db, namedLogger, cfg)}
+}
+
+// InsertEntry appends the entry to the audit log, setting its ID and
+// creation time
+func (o *orm) InsertEntry(entry *Entry) error {
+	sql := `INSERT INTO audit_log_entries (actor, auth_method, action, target, diff, created_at)
+VALUES ($1, $2, $3, $4, $5, NOW()) RETURNING *`
+	return errors.Wrap(o.q.Get(entry, sql, entry.Actor, entry.AuthMethod, entry.Action, entry.Target, entry.Diff), "InsertEntry failed")
+}
+
+// Entries returns the entries matching the filter, newest first, and the
+// total number of matching entries
+func (o *orm) Entries(filter Filter, offset, limit int) (entries []Entry, count int, err error) {
+	where, args := filter.whereClause()
+	err = o.q.Transaction(func(tx pg.Queryer) error {
+		if err = tx.Get(&count, "SELECT COUNT(*) FROM audit_log_entries"+where, args...); err != nil {
+			return errors.Wrap(err, "Entries failed to get count")
+		}
+		sql := fmt.Sprintf(`SELECT * FROM audit_log_entries%s ORDER BY created_at DESC, id DESC LIMIT $%d OFFSET $%d`, where, len(args)+1, len(args)+2)
+		if err = tx.Select(&entries, sql, append(args, limit, offset)...); err != nil {
+			return errors.Wrap(err, "Entries failed to load audit_log_entries")
+		}
+		return nil
+	}, pg.OptReadOnlyTx())
+
+	return
+}
+
+func (f Filter) whereClause() (string, []interface{}) {
+	var conds []string
+	var args []interface{}
+	add := func(cond string, arg interface{}) {
+		args = append(args, arg)
+		conds = append(conds, fmt.Sprintf(cond, len(args)))
+	}
+	if f.Actor != "" {
+		add("lower(actor) = lower($%d)", f.Actor)
+	}
+	if f.AuthMethod != "" {
+		add("auth_method = $%d", f.AuthMethod)
+	}
+	if f.Action != "" {
+		add("action = $%d", f.Action)
+	}
+	if f.Target != "" {
+		add("target = $%d", f.Target)
+	}
+	if f.Since != nil {
+		add("created_at >= $%d", *f.Since)
+	}
+	if f.Until != nil {
+		add("created_at < $%d", *f.Until)
+	}
+	if len(conds) == 0 {
+		return "", nil
+	}
+	return " WHERE " + strings.Join(conds, " AND "), args
+}
@@ -0,0 +1,126 @@
+package audit_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/smartcontractkit/sqlx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/audit"
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+func setupORM(t *testing.T) (*sqlx.DB, audit.ORM) {
+	t.Helper()
+
+	cfg := cltest.NewTestGeneralConfig(t)
+	db := pgtest.NewSqlxDB(t)
+	orm := audit.NewORM(db, logger.TestLogger(t), cfg)
+
+	return db, orm
+}
+
+func mustInsertEntry(t *testing.T, orm audit.ORM, actor string, method audit.AuthMethod, action audit.Action, target string) audit.Entry {
+	t.Helper()
+
+	entry := audit.Entry{
+		Actor:      actor,
+		AuthMethod: method,
+		Action:     action,
+		Target:     target,
+	}
+	require.NoError(t, orm.InsertEntry(&entry))
+
+	return entry
+}
+
+func TestORM_InsertEntry(t *testing.T) {
+	t.Parallel()
+
+	_, orm := setupORM(t)
+
+	entry := audit.Entry{
+		Actor:      "admin@chain.link",
+		AuthMethod: audit.AuthMethodSession,
+		Action:     audit.ActionBridgeUpdated,
+		Target:     "bridge1",
+		Diff:       audit.NewDiff(map[string]interface{}{"confirmations": 1}, map[string]interface{}{"confirmations": 2}),
+	}
+	require.NoError(t, orm.InsertEntry(&entry))
+	assert.NotZero(t, entry.ID)
+	assert.False(t, entry.CreatedAt.IsZero())
+
+	entries, count, err := orm.Entries(audit.Filter{}, 0, 10)
+	require.NoError(t, err)
+	require.Equal(t, 1, count)
+	require.Len(t, entries, 1)
+	assert.Equal(t, entry.ID, entries[0].ID)
+	assert.Equal(t, entry.Diff, entries[0].Diff)
+}
+
+func TestORM_Entries(t *testing.T) {
+	t.Parallel()
+
+	_, orm := setupORM(t)
+
+	e1 := mustInsertEntry(t, orm, "admin@chain.link", audit.AuthMethodSession, audit.ActionBridgeCreated, "bridge1")
+	e2 := mustInsertEntry(t, orm, "Admin@chain.link", audit.AuthMethodToken, audit.ActionBridgeDeleted, "bridge1")
+	e3 := mustInsertEntry(t, orm, "root@node", audit.AuthMethodCLI, audit.ActionNonceSet, "eth:0xabc")
+
+	ids := func(entries []audit.Entry) []int64 {
+		var ids []int64
+		for _, e := range entries {
+			ids = append(ids, e.ID)
+		}
+		return ids
+	}
+	future := time.Now().Add(time.Hour)
+
+	tests := []struct {
+		name   string
+		filter audit.Filter
+		want   []int64
+	}{
+		{"all, newest first", audit.Filter{}, []int64{e3.ID, e2.ID, e1.ID}},
+		{"actor is case insensitive", audit.Filter{Actor: "admin@chain.link"}, []int64{e2.ID, e1.ID}},
+		{"auth method", audit.Filter{AuthMethod: audit.AuthMethodCLI}, []int64{e3.ID}},
+		{"action", audit.Filter{Action: audit.ActionBridgeDeleted}, []int64{e2.ID}},
+		{"target", audit.Filter{Target: "bridge1"}, []int64{e2.ID, e1.ID}},
+		{"combined", audit.Filter{Actor: "admin@chain.link", Action: audit.ActionBridgeCreated}, []int64{e1.ID}},
+		{"since", audit.Filter{Since: &future}, nil},
+		{"until", audit.Filter{Until: &future}, []int64{e3.ID, e2.ID, e1.ID}},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			entries, count, err := orm.Entries(tt.filter, 0, 10)
+			require.NoError(t, err)
+			assert.Equal(t, len(tt.want), count)
+			assert.Equal(t, tt.want, ids(entries))
+		})
+	}
+
+	t.Run("paging", func(t *testing.T) {
+		entries, count, err := orm.Entries(audit.Filter{}, 1, 1)
+		require.NoError(t, err)
+		assert.Equal(t, 3, count)
+		assert.Equal(t, []int64{e2.ID}, ids(entries))
+	})
+}
+
+func TestORM_EntriesAreAppendOnly(t *testing.T) {
+	t.Parallel()
+
+	db, orm := setupORM(t)
+
+	entry := mustInsertEntry(t, orm, "admin@chain.link", audit.AuthMethodSession, audit.ActionUserDeleted, "oncall@chain.link")
+
+	_, err := db.Exec(`UPDATE audit_log_entries SET actor = 'someone@else' WHERE id = $1`, entry.ID)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "audit log entries cannot be updated or deleted")
+}
@@ -0,0 +1,308 @@
+package bridges
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+// DefaultBreakerCooldown is used for bridges with a circuit breaker but no
+// cooldown
+const DefaultBreakerCooldown = 30 * time.Second
+
+// ErrCircuitOpen is returned for requests to a bridge while its circuit
+// breaker is open
+var ErrCircuitOpen = errors.New("circuit breaker is open")
+
+var (
+	promBreakerState = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "bridge_circuit_breaker_state",
+		Help: "State of the bridge's circuit breaker (0 = closed, 1 = half open, 2 = open)",
+	},
+		[]string{"bridge"},
+	)
+	promBreakerTransitions = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "bridge_circuit_breaker_transitions_total",
+		Help: "Number of times the bridge's circuit breaker has changed state",
+	},
+		[]string{"bridge", "state"},
+	)
+	promRequestsRejected = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "bridge_requests_rejected_total",
+		Help: "Number of requests to the bridge that failed fast because its circuit breaker was open",
+	},
+		[]string{"bridge"},
+	)
+	promRequestsInFlight = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "bridge_requests_in_flight",
+		Help: "Number of requests currently in flight to the bridge",
+	},
+		[]string{"bridge"},
+	)
+)
+
+// BreakerState is the state of a bridge's circuit breaker
+type BreakerState string
+
+const (
+	// BreakerStateClosed lets all requests through
+	BreakerStateClosed BreakerState = "closed"
+	// BreakerStateOpen fails all requests fast until the cooldown has passed
+	BreakerStateOpen BreakerState = "open"
+	// BreakerStateHalfOpen lets a single request through to probe the bridge
+	BreakerStateHalfOpen BreakerState = "half_open"
+)
+
+func (s BreakerState) promValue() float64 {
+	switch s {
+	case BreakerStateHalfOpen:
+		return 1
+	case BreakerStateOpen:
+		return 2
+	default:
+		return 0
+	}
+}
+
+// RequestOutcome is reported back to Breakers when a request finishes
+type RequestOutcome int
+
+const (
+	// RequestSucceeded means the bridge responded
+	RequestSucceeded RequestOutcome = iota
+	// RequestFailed means the bridge did not respond, timed out, or responded
+	// with a server error
+	RequestFailed
+	// RequestAbandoned means the caller gave up on the request, which says
+	// nothing about the health of the bridge
+	RequestAbandoned
+)
+
+// BreakerStatus is a snapshot of a bridge's circuit breaker
+type BreakerStatus struct {
+	Name                TaskType
+	State               BreakerState
+	ConsecutiveFailures uint32
+	// OpenedAt is set while the breaker is open or half open
+	OpenedAt *time.Time
+	InFlight int
+}
+
+//go:generate mockery --name Breakers --output ./mocks --case=underscore
+
+// Breakers tracks the health of each bridge across all the runs that call it,
+// limiting concurrent requests and failing requests fast when a bridge keeps
+// failing.
+type Breakers interface {
+	// Acquire waits until a request to the bridge may be made, according to
+	// its RequestPolicy. It returns ErrCircuitOpen without waiting if the
+	// bridge's circuit breaker is open. The returned function must be called
+	// exactly once with the outcome of the request.
+	Acquire(ctx context.Context, bt BridgeType) (release func(RequestOutcome), err error)
+	// Status returns the current state of the bridge's circuit breaker.
+	Status(name TaskType) BreakerStatus
+}
+
+type breakers struct {
+	lggr logger.Logger
+	now  func() time.Time
+
+	mu       sync.Mutex
+	breakers map[TaskType]*breaker
+}
+
+var _ Breakers = (*breakers)(nil)
+
+// NewBreakers returns an empty set of circuit breakers. Breakers are created
+// on demand, closed.
+func NewBreakers(lggr logger.Logger) Breakers {
+	return newBreakers(lggr, time.Now)
+}
+
+func newBreakers(lggr logger.Logger, now func() time.Time) *breakers {
+	return &breakers{
+		lggr:     lggr.Named("BridgeBreakers"),
+		now:      now,
+		breakers: make(map[TaskType]*breaker),
+	}
+}
+
+func (bs *breakers) get(name TaskType) *breaker {
+	bs.mu.Lock()
+	defer bs.mu.Unlock()
+	b, exists := bs.breakers[name]
+	if !exists {
+		b = &breaker{
+			name:     name,
+			lggr:     bs.lggr.With("bridge", name),
+			now:      bs.now,
+			state:    BreakerStateClosed,
+			released: make(chan struct{}),
+		}
+		bs.breakers[name] = b
+	}
+	return b
+}
+
+func (bs *breakers) Acquire(ctx context.Context, bt BridgeType) (func(RequestOutcome), error) {
+	return bs.get(bt.Name).acquire(ctx, bt.RequestPolicy)
+}
+
+func (bs *breakers) Status(name TaskType) BreakerStatus {
+	bs.mu.Lock()
+	b, exists := bs.breakers[name]
+	bs.mu.Unlock()
+	if !exists {
+		return BreakerStatus{Name: name, State: BreakerStateClosed}
+	}
+	return b.status()
+}
+
+type breaker struct {
+	name TaskType
+	lggr logger.Logger
+	now  func() time.Time
+
+	mu       sync.Mutex
+	state    BreakerState
+	failures uint32
+	openedAt time.Time
+	// probing is set while the single request allowed through in the half
+	// open state is in flight
+	probing  bool
+	inFlight int
+	// released is closed and replaced every time a request finishes, to wake
+	// up requests waiting for a free slot
+	released chan struct{}
+}
+
+func (b *breaker) acquire(ctx context.Context, policy RequestPolicy) (func(RequestOutcome), error) {
+	for {
+		b.mu.Lock()
+		if err := b.admit(policy); err != nil {
+			b.mu.Unlock()
+			promRequestsRejected.WithLabelValues(b.name.String()).Inc()
+			return nil, err
+		}
+		if policy.MaxConcurrency == 0 || b.inFlight < int(policy.MaxConcurrency) {
+			probe := b.state == BreakerStateHalfOpen
+			if probe {
+				b.probing = true
+			}
+			b.inFlight++
+			promRequestsInFlight.WithLabelValues(b.name.String()).Set(float64(b.inFlight))
+			b.mu.Unlock()
+
+			var once sync.Once
+			return func(outcome RequestOutcome) {
+				once.Do(func() { b.release(policy, probe, outcome) })
+			}, nil
+		}
+		released := b.released
+		b.mu.Unlock()
+
+		select {
+		case <-released:
+		case <-ctx.Done():
+			return nil, errors.Wrap(ctx.Err(), "timed out waiting for a free request slot")
+		}
+	}
+}
+
+// admit returns ErrCircuitOpen if the request must fail fast
+func (b *breaker) admit(policy RequestPolicy) error {
+	if policy.BreakerFailureThreshold == 0 {
+		// The breaker may have been disabled since it opened
+		if b.state != BreakerStateClosed {
+			b.transition(BreakerStateClosed)
+		}
+		return nil
+	}
+	switch b.state {
+	case BreakerStateOpen:
+		if b.now().Sub(b.openedAt) < policy.Cooldown() {
+			return ErrCircuitOpen
+		}
+		b.transition(BreakerStateHalfOpen)
+	case BreakerStateHalfOpen:
+		if b.probing {
+			return ErrCircuitOpen
+		}
+	}
+	return nil
+}
+
+func (b *breaker) release(policy RequestPolicy, probe bool, outcome RequestOutcome) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.inFlight--
+	promRequestsInFlight.WithLabelValues(b.name.String()).Set(float64(b.inFlight))
+	close(b.released)
+	b.released = make(chan struct{})
+
+	if probe {
+		b.probing = false
+	}
+	switch outcome {
+	case RequestSucceeded:
+		b.failures = 0
+		// Only the probe closes the breaker: stragglers that were let through
+		// before it opened say little about the bridge's current health
+		if probe && b.state == BreakerStateHalfOpen {
+			b.transition(BreakerStateClosed)
+		}
+	case RequestFailed:
+		b.failures++
+		if policy.BreakerFailureThreshold == 0 {
+			return
+		}
+		if (probe && b.state == BreakerStateHalfOpen) ||
+			(b.state == BreakerStateClosed && b.failures >= policy.BreakerFailureThreshold) {
+			b.transition(BreakerStateOpen)
+		}
+	case RequestAbandoned:
+	}
+}
+
+func (b *breaker) transition(state BreakerState) {
+	switch state {
+	case BreakerStateOpen:
+		b.openedAt = b.now()
+		b.lggr.Warnw("Bridge circuit breaker opened, requests will fail fast", "consecutiveFailures", b.failures)
+	case BreakerStateHalfOpen:
+		b.lggr.Infow("Bridge circuit breaker half open, probing bridge")
+	case BreakerStateClosed:
+		b.failures = 0
+		if b.state != BreakerStateClosed {
+			b.lggr.Infow("Bridge circuit breaker closed")
+		}
+	}
+	b.state = state
+	promBreakerState.WithLabelValues(b.name.String()).Set(state.promValue())
+	promBreakerTransitions.WithLabelValues(b.name.String(), string(state)).Inc()
+}
+
+// status reports an open breaker whose cooldown has passed as open, since it
+// only transitions to half open when the next request arrives
+func (b *breaker) status() BreakerStatus {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	status := BreakerStatus{
+		Name:                b.name,
+		State:               b.state,
+		ConsecutiveFailures: b.failures,
+		InFlight:            b.inFlight,
+	}
+	if b.state != BreakerStateClosed {
+		openedAt := b.openedAt
+		status.OpenedAt = &openedAt
+	}
+	return status
+}
@@ -0,0 +1,197 @@
+package bridges_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/bridges"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+)
+
+type testClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func (c *testClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *testClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+}
+
+func request(t *testing.T, bs bridges.Breakers, bt bridges.BridgeType, outcome bridges.RequestOutcome) error {
+	release, err := bs.Acquire(context.Background(), bt)
+	if err != nil {
+		return err
+	}
+	release(outcome)
+	return nil
+}
+
+func TestBreakers_CircuitBreaker(t *testing.T) {
+	t.Parallel()
+
+	clock := &testClock{now: time.Unix(1000, 0)}
+	bs := bridges.NewBreakersWithClock(logger.TestLogger(t), clock.Now)
+	bt := bridges.BridgeType{
+		Name: "adapter",
+		RequestPolicy: bridges.RequestPolicy{
+			BreakerFailureThreshold: 3,
+			BreakerCooldown:         models.Interval(time.Minute),
+		},
+	}
+
+	status := bs.Status(bt.Name)
+	assert.Equal(t, bridges.BreakerStateClosed, status.State)
+	assert.Nil(t, status.OpenedAt)
+
+	// A success resets the count of consecutive failures
+	require.NoError(t, request(t, bs, bt, bridges.RequestFailed))
+	require.NoError(t, request(t, bs, bt, bridges.RequestFailed))
+	require.NoError(t, request(t, bs, bt, bridges.RequestSucceeded))
+	assert.Equal(t, uint32(0), bs.Status(bt.Name).ConsecutiveFailures)
+
+	// Abandoned requests don't count either way
+	require.NoError(t, request(t, bs, bt, bridges.RequestFailed))
+	require.NoError(t, request(t, bs, bt, bridges.RequestFailed))
+	require.NoError(t, request(t, bs, bt, bridges.RequestAbandoned))
+	assert.Equal(t, uint32(2), bs.Status(bt.Name).ConsecutiveFailures)
+	assert.Equal(t, bridges.BreakerStateClosed, bs.Status(bt.Name).State)
+
+	require.NoError(t, request(t, bs, bt, bridges.RequestFailed))
+	status = bs.Status(bt.Name)
+	assert.Equal(t, bridges.BreakerStateOpen, status.State)
+	require.NotNil(t, status.OpenedAt)
+	assert.Equal(t, clock.Now(), *status.OpenedAt)
+
+	// Requests fail fast until the cooldown has passed
+	require.Equal(t, bridges.ErrCircuitOpen, request(t, bs, bt, bridges.RequestSucceeded))
+	clock.Advance(time.Minute - time.Second)
+	require.Equal(t, bridges.ErrCircuitOpen, request(t, bs, bt, bridges.RequestSucceeded))
+	clock.Advance(time.Second)
+
+	// A single probe is let through, which reopens the breaker if it fails
+	release, err := bs.Acquire(context.Background(), bt)
+	require.NoError(t, err)
+	assert.Equal(t, bridges.BreakerStateHalfOpen, bs.Status(bt.Name).State)
+	require.Equal(t, bridges.ErrCircuitOpen, request(t, bs, bt, bridges.RequestSucceeded))
+	release(bridges.RequestFailed)
+	status = bs.Status(bt.Name)
+	assert.Equal(t, bridges.BreakerStateOpen, status.State)
+	assert.Equal(t, clock.Now(), *status.OpenedAt)
+
+	// A successful probe closes it
+	clock.Advance(time.Minute)
+	require.NoError(t, request(t, bs, bt, bridges.RequestSucceeded))
+	status = bs.Status(bt.Name)
+	assert.Equal(t, bridges.BreakerStateClosed, status.State)
+	assert.Equal(t, uint32(0), status.ConsecutiveFailures)
+	assert.Nil(t, status.OpenedAt)
+
+	// An abandoned probe lets another one through
+	for i := 0; i < 3; i++ {
+		require.NoError(t, request(t, bs, bt, bridges.RequestFailed))
+	}
+	clock.Advance(time.Minute)
+	require.NoError(t, request(t, bs, bt, bridges.RequestAbandoned))
+	assert.Equal(t, bridges.BreakerStateHalfOpen, bs.Status(bt.Name).State)
+	require.NoError(t, request(t, bs, bt, bridges.RequestSucceeded))
+	assert.Equal(t, bridges.BreakerStateClosed, bs.Status(bt.Name).State)
+}
+
+func TestBreakers_CircuitBreakerDisabled(t *testing.T) {
+	t.Parallel()
+
+	clock := &testClock{now: time.Unix(1000, 0)}
+	bs := bridges.NewBreakersWithClock(logger.TestLogger(t), clock.Now)
+	bt := bridges.BridgeType{Name: "adapter"}
+
+	for i := 0; i < 100; i++ {
+		require.NoError(t, request(t, bs, bt, bridges.RequestFailed))
+	}
+	status := bs.Status(bt.Name)
+	assert.Equal(t, bridges.BreakerStateClosed, status.State)
+	assert.Equal(t, uint32(100), status.ConsecutiveFailures)
+
+	// Enabling the breaker takes effect on the next failure
+	bt.BreakerFailureThreshold = 5
+	require.NoError(t, request(t, bs, bt, bridges.RequestFailed))
+	assert.Equal(t, bridges.BreakerStateOpen, bs.Status(bt.Name).State)
+
+	// The default cooldown applies
+	clock.Advance(bridges.DefaultBreakerCooldown - time.Second)
+	require.Equal(t, bridges.ErrCircuitOpen, request(t, bs, bt, bridges.RequestSucceeded))
+
+	// Disabling the breaker closes it
+	bt.BreakerFailureThreshold = 0
+	require.NoError(t, request(t, bs, bt, bridges.RequestSucceeded))
+	assert.Equal(t, bridges.BreakerStateClosed, bs.Status(bt.Name).State)
+}
+
+func TestBreakers_MaxConcurrency(t *testing.T) {
+	t.Parallel()
+
+	bs := bridges.NewBreakers(logger.TestLogger(t))
+	bt := bridges.BridgeType{
+		Name:          "adapter",
+		RequestPolicy: bridges.RequestPolicy{MaxConcurrency: 2},
+	}
+
+	release1, err := bs.Acquire(context.Background(), bt)
+	require.NoError(t, err)
+	release2, err := bs.Acquire(context.Background(), bt)
+	require.NoError(t, err)
+	assert.Equal(t, 2, bs.Status(bt.Name).InFlight)
+
+	// Other bridges are not affected
+	releaseOther, err := bs.Acquire(context.Background(), bridges.BridgeType{Name: "other", RequestPolicy: bt.RequestPolicy})
+	require.NoError(t, err)
+	releaseOther(bridges.RequestSucceeded)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err = bs.Acquire(ctx, bt)
+	require.Equal(t, context.DeadlineExceeded, errors.Cause(err))
+
+	acquired := make(chan func(bridges.RequestOutcome))
+	go func() {
+		release, err := bs.Acquire(context.Background(), bt)
+		assert.NoError(t, err)
+		acquired <- release
+	}()
+
+	select {
+	case <-acquired:
+		t.Fatal("request acquired a slot while the bridge was at max concurrency")
+	case <-time.After(10 * time.Millisecond):
+	}
+
+	release1(bridges.RequestSucceeded)
+	// Releasing twice has no effect
+	release1(bridges.RequestSucceeded)
+
+	var release3 func(bridges.RequestOutcome)
+	select {
+	case release3 = <-acquired:
+	case <-time.After(time.Second):
+		t.Fatal("request did not acquire a slot after one was released")
+	}
+	assert.Equal(t, 2, bs.Status(bt.Name).InFlight)
+
+	release2(bridges.RequestSucceeded)
+	release3(bridges.RequestSucceeded)
+	assert.Equal(t, 0, bs.Status(bt.Name).InFlight)
+}
@@ -10,6 +10,9 @@ import (
 	"strings"
 	"time"
 
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+
 	"github.com/smartcontractkit/chainlink/core/assets"
 	"github.com/smartcontractkit/chainlink/core/store/models"
 	"github.com/smartcontractkit/chainlink/core/utils"
@@ -21,6 +24,7 @@ type BridgeTypeRequest struct {
 	URL                    models.WebURL `json:"url"`
 	Confirmations          uint32        `json:"confirmations"`
 	MinimumContractPayment *assets.Link  `json:"minimumContractPayment"`
+	RequestPolicy
 }
 
 // GetID returns the ID of this structure for jsonapi serialization.
@@ -48,6 +52,7 @@ type BridgeTypeAuthentication struct {
 	IncomingToken          string
 	OutgoingToken          string
 	MinimumContractPayment *assets.Link
+	RequestPolicy
 }
 
 // BridgeType is used for external adapters and has fields for
@@ -60,8 +65,105 @@ type BridgeType struct {
 	Salt                   string
 	OutgoingToken          string
 	MinimumContractPayment *assets.Link
-	CreatedAt              time.Time
-	UpdatedAt              time.Time
+	RequestPolicy
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// MaxRetries is the largest number of retries a bridge can be configured with
+const MaxRetries = 10
+
+// RequestPolicy controls how the bridge task calls a bridge. The zero value
+// makes a single attempt with the node's default HTTP timeout, with no limit
+// on concurrency and no circuit breaker.
+type RequestPolicy struct {
+	// RetryOnStatusCodes lists the error status codes that are worth retrying.
+	// Connection errors and timeouts are always retried.
+	RetryOnStatusCodes pq.Int32Array `json:"retryOnStatusCodes"`
+	// Retries is the number of times a failed request is retried within a
+	// single task run.
+	Retries uint32 `json:"retries"`
+	// AttemptTimeout bounds each attempt. Zero uses DEFAULT_HTTP_TIMEOUT.
+	AttemptTimeout models.Interval `json:"attemptTimeout"`
+	// MaxConcurrency bounds the number of requests in flight to the bridge
+	// across all runs. Zero is unlimited.
+	MaxConcurrency uint32 `json:"maxConcurrency"`
+	// BreakerFailureThreshold is the number of consecutive failed requests
+	// that opens the circuit breaker. Zero disables the breaker.
+	BreakerFailureThreshold uint32 `json:"breakerFailureThreshold"`
+	// BreakerCooldown is how long the breaker stays open before letting a
+	// single request through to probe the bridge. Zero uses
+	// DefaultBreakerCooldown.
+	BreakerCooldown models.Interval `json:"breakerCooldown"`
+	// CacheTTL is how long a response is reused for identical requests to
+	// the bridge. Zero disables the response cache.
+	CacheTTL models.Interval `json:"cacheTTL"`
+	// CacheMaxStale is how long past its TTL a cached response may still be
+	// used when the bridge fails or its circuit breaker is open.
+	CacheMaxStale models.Interval `json:"cacheMaxStale"`
+}
+
+// Validate checks the policy's values are within range
+func (p RequestPolicy) Validate() error {
+	for _, code := range p.RetryOnStatusCodes {
+		if code < 400 || code > 599 {
+			return errors.Errorf("retryOnStatusCodes: %d is not an HTTP error status code", code)
+		}
+	}
+	if p.Retries > MaxRetries {
+		return errors.Errorf("retries must be at most %d", MaxRetries)
+	}
+	if p.AttemptTimeout.Duration() < 0 {
+		return errors.New("attemptTimeout must not be negative")
+	}
+	if p.BreakerCooldown.Duration() < 0 {
+		return errors.New("breakerCooldown must not be negative")
+	}
+	if p.CacheTTL.Duration() < 0 {
+		return errors.New("cacheTTL must not be negative")
+	}
+	if p.CacheMaxStale.Duration() < 0 {
+		return errors.New("cacheMaxStale must not be negative")
+	}
+	if p.CacheTTL.IsZero() && !p.CacheMaxStale.IsZero() {
+		return errors.New("cacheMaxStale requires a cacheTTL")
+	}
+	return nil
+}
+
+// ShouldRetry returns true if a request that failed with statusCode is worth
+// retrying. A zero status code means no response was received.
+func (p RequestPolicy) ShouldRetry(statusCode int) bool {
+	if statusCode == 0 {
+		return true
+	}
+	for _, code := range p.RetryOnStatusCodes {
+		if int(code) == statusCode {
+			return true
+		}
+	}
+	return false
+}
+
+// CachesResponses returns true if the bridge's responses are cached
+func (p RequestPolicy) CachesResponses() bool {
+	return p.CacheTTL.Duration() > 0
+}
+
+// Cooldown returns the time the circuit breaker stays open
+func (p RequestPolicy) Cooldown() time.Duration {
+	if p.BreakerCooldown.IsZero() {
+		return DefaultBreakerCooldown
+	}
+	return p.BreakerCooldown.Duration()
+}
+
+func (p RequestPolicy) normalized() RequestPolicy {
+	// The column is NOT NULL, so a nil array must be stored as an empty one
+	if p.RetryOnStatusCodes == nil {
+		p.RetryOnStatusCodes = pq.Int32Array{}
+	}
+	return p
 }
 
 // NewBridgeType returns a bridge bridge type authentication (with plaintext
@@ -84,6 +186,7 @@ func NewBridgeType(btr *BridgeTypeRequest) (*BridgeTypeAuthentication,
 			IncomingToken:          incomingToken,
 			OutgoingToken:          outgoingToken,
 			MinimumContractPayment: btr.MinimumContractPayment,
+			RequestPolicy:          btr.RequestPolicy.normalized(),
 		}, &BridgeType{
 			Name:                   btr.Name,
 			URL:                    btr.URL,
@@ -92,6 +195,7 @@ func NewBridgeType(btr *BridgeTypeRequest) (*BridgeTypeAuthentication,
 			Salt:                   salt,
 			OutgoingToken:          outgoingToken,
 			MinimumContractPayment: btr.MinimumContractPayment,
+			RequestPolicy:          btr.RequestPolicy.normalized(),
 		}, nil
 }
 
@@ -2,9 +2,13 @@ package bridges_test
 
 import (
 	"testing"
+	"time"
+
+	"github.com/lib/pq"
 
 	"github.com/smartcontractkit/chainlink/core/bridges"
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/store/models"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -36,3 +40,58 @@ func TestBridgeType_Authenticate(t *testing.T) {
 		})
 	}
 }
+
+func TestRequestPolicy_Validate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		policy bridges.RequestPolicy
+		err    string
+	}{
+		{"zero value", bridges.RequestPolicy{}, ""},
+		{"valid", bridges.RequestPolicy{
+			RetryOnStatusCodes:      pq.Int32Array{408, 429, 500, 599},
+			Retries:                 bridges.MaxRetries,
+			AttemptTimeout:          models.Interval(time.Second),
+			MaxConcurrency:          100,
+			BreakerFailureThreshold: 5,
+			BreakerCooldown:         models.Interval(time.Minute),
+			CacheTTL:                models.Interval(5 * time.Second),
+			CacheMaxStale:           models.Interval(time.Minute),
+		}, ""},
+		{"success status code", bridges.RequestPolicy{RetryOnStatusCodes: pq.Int32Array{503, 200}}, "retryOnStatusCodes: 200 is not an HTTP error status code"},
+		{"invalid status code", bridges.RequestPolicy{RetryOnStatusCodes: pq.Int32Array{600}}, "retryOnStatusCodes: 600 is not an HTTP error status code"},
+		{"too many retries", bridges.RequestPolicy{Retries: bridges.MaxRetries + 1}, "retries must be at most 10"},
+		{"negative attempt timeout", bridges.RequestPolicy{AttemptTimeout: models.Interval(-time.Second)}, "attemptTimeout must not be negative"},
+		{"negative cooldown", bridges.RequestPolicy{BreakerCooldown: models.Interval(-time.Second)}, "breakerCooldown must not be negative"},
+		{"negative cache TTL", bridges.RequestPolicy{CacheTTL: models.Interval(-time.Second)}, "cacheTTL must not be negative"},
+		{"negative max stale", bridges.RequestPolicy{CacheTTL: models.Interval(time.Second), CacheMaxStale: models.Interval(-time.Second)}, "cacheMaxStale must not be negative"},
+		{"max stale without TTL", bridges.RequestPolicy{CacheMaxStale: models.Interval(time.Second)}, "cacheMaxStale requires a cacheTTL"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.policy.Validate()
+			if test.err == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, test.err)
+			}
+		})
+	}
+}
+
+func TestRequestPolicy_ShouldRetry(t *testing.T) {
+	t.Parallel()
+
+	policy := bridges.RequestPolicy{RetryOnStatusCodes: pq.Int32Array{429, 503}}
+	assert.True(t, policy.ShouldRetry(0))
+	assert.True(t, policy.ShouldRetry(429))
+	assert.True(t, policy.ShouldRetry(503))
+	assert.False(t, policy.ShouldRetry(400))
+	assert.False(t, policy.ShouldRetry(500))
+
+	assert.True(t, bridges.RequestPolicy{}.ShouldRetry(0))
+	assert.False(t, bridges.RequestPolicy{}.ShouldRetry(503))
+}
@@ -0,0 +1,191 @@
+package bridges
+
+import (
+	"crypto/sha256"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// MaxCachedResponses bounds the number of responses cached for each bridge.
+// Responses to new requests are not cached while a bridge's cache is full.
+const MaxCachedResponses = 1000
+
+var (
+	promCacheHits = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "bridge_cache_hits_total",
+		Help: "Number of bridge requests answered from the response cache",
+	},
+		[]string{"bridge"},
+	)
+	promCacheMisses = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "bridge_cache_misses_total",
+		Help: "Number of bridge requests that had no fresh response in the cache",
+	},
+		[]string{"bridge"},
+	)
+	promCacheStaleHits = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "bridge_cache_stale_hits_total",
+		Help: "Number of failed bridge requests answered with a stale response from the cache",
+	},
+		[]string{"bridge"},
+	)
+	promCacheEntries = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "bridge_cache_entries",
+		Help: "Number of responses currently cached for the bridge",
+	},
+		[]string{"bridge"},
+	)
+)
+
+// CacheStatus records where the response to a bridge request came from
+type CacheStatus string
+
+const (
+	// CacheHit means a fresh cached response was used
+	CacheHit CacheStatus = "hit"
+	// CacheMiss means the bridge was called
+	CacheMiss CacheStatus = "miss"
+	// CacheStale means the bridge failed and a stale cached response was used
+	// instead
+	CacheStale CacheStatus = "stale"
+)
+
+//go:generate mockery --name ResponseCache --output ./mocks --case=underscore
+
+// ResponseCache holds recent responses from bridges with a CacheTTL, keyed by
+// the bridge's URL and a hash of the request body, so that identical requests
+// made by different runs only call the bridge once.
+type ResponseCache interface {
+	// Get returns the cached response to the request if it is younger than
+	// the bridge's CacheTTL.
+	Get(bt BridgeType, requestBody []byte) (response []byte, ok bool)
+	// GetStale returns the cached response to the request if it is younger
+	// than the bridge's CacheTTL plus CacheMaxStale. It is used when the
+	// bridge could not be called.
+	GetStale(bt BridgeType, requestBody []byte) (response []byte, ok bool)
+	// Set caches a successful response to the request.
+	Set(bt BridgeType, requestBody []byte, response []byte)
+	// Flush drops the responses cached for the bridge and returns how many
+	// there were.
+	Flush(name TaskType) int
+	// FlushAll drops the responses cached for all bridges and returns how
+	// many there were.
+	FlushAll() int
+}
+
+type cacheKey struct {
+	url      string
+	bodyHash [sha256.Size]byte
+}
+
+type cacheEntry struct {
+	response []byte
+	storedAt time.Time
+}
+
+type responseCache struct {
+	now func() time.Time
+
+	mu      sync.RWMutex
+	entries map[TaskType]map[cacheKey]cacheEntry
+}
+
+var _ ResponseCache = (*responseCache)(nil)
+
+// NewResponseCache returns an empty in-memory response cache
+func NewResponseCache() ResponseCache {
+	return newResponseCache(time.Now)
+}
+
+func newResponseCache(now func() time.Time) *responseCache {
+	return &responseCache{
+		now:     now,
+		entries: make(map[TaskType]map[cacheKey]cacheEntry),
+	}
+}
+
+func newCacheKey(bt BridgeType, requestBody []byte) cacheKey {
+	return cacheKey{url: bt.URL.String(), bodyHash: sha256.Sum256(requestBody)}
+}
+
+func (c *responseCache) get(bt BridgeType, requestBody []byte, maxAge time.Duration) ([]byte, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	entry, exists := c.entries[bt.Name][newCacheKey(bt, requestBody)]
+	if !exists || c.now().Sub(entry.storedAt) >= maxAge {
+		return nil, false
+	}
+	return entry.response, true
+}
+
+func (c *responseCache) Get(bt BridgeType, requestBody []byte) ([]byte, bool) {
+	response, ok := c.get(bt, requestBody, bt.CacheTTL.Duration())
+	if ok {
+		promCacheHits.WithLabelValues(bt.Name.String()).Inc()
+	} else {
+		promCacheMisses.WithLabelValues(bt.Name.String()).Inc()
+	}
+	return response, ok
+}
+
+func (c *responseCache) GetStale(bt BridgeType, requestBody []byte) ([]byte, bool) {
+	response, ok := c.get(bt, requestBody, bt.CacheTTL.Duration()+bt.CacheMaxStale.Duration())
+	if ok {
+		promCacheStaleHits.WithLabelValues(bt.Name.String()).Inc()
+	}
+	return response, ok
+}
+
+func (c *responseCache) Set(bt BridgeType, requestBody []byte, response []byte) {
+	if !bt.CachesResponses() {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entries, exists := c.entries[bt.Name]
+	if !exists {
+		entries = make(map[cacheKey]cacheEntry)
+		c.entries[bt.Name] = entries
+	}
+	// Drop the entries that can no longer be used, even when stale
+	now := c.now()
+	maxAge := bt.CacheTTL.Duration() + bt.CacheMaxStale.Duration()
+	for key, entry := range entries {
+		if now.Sub(entry.storedAt) >= maxAge {
+			delete(entries, key)
+		}
+	}
+
+	key := newCacheKey(bt, requestBody)
+	if _, exists := entries[key]; exists || len(entries) < MaxCachedResponses {
+		entries[key] = cacheEntry{response: response, storedAt: now}
+	}
+	promCacheEntries.WithLabelValues(bt.Name.String()).Set(float64(len(entries)))
+}
+
+func (c *responseCache) Flush(name TaskType) int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.flush(name)
+}
+
+func (c *responseCache) FlushAll() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var flushed int
+	for name := range c.entries {
+		flushed += c.flush(name)
+	}
+	return flushed
+}
+
+func (c *responseCache) flush(name TaskType) int {
+	flushed := len(c.entries[name])
+	delete(c.entries, name)
+	promCacheEntries.WithLabelValues(name.String()).Set(0)
+	return flushed
+}
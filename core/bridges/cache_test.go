@@ -0,0 +1,118 @@
+package bridges_test
+
+import (
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/bridges"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+)
+
+func cachedBridge(t *testing.T, name, rawURL string) bridges.BridgeType {
+	u, err := url.Parse(rawURL)
+	require.NoError(t, err)
+	return bridges.BridgeType{
+		Name: bridges.MustNewTaskType(name),
+		URL:  models.WebURL(*u),
+		RequestPolicy: bridges.RequestPolicy{
+			CacheTTL:      models.Interval(10 * time.Second),
+			CacheMaxStale: models.Interval(time.Minute),
+		},
+	}
+}
+
+func TestResponseCache(t *testing.T) {
+	t.Parallel()
+
+	clock := &testClock{now: time.Unix(1000, 0)}
+	cache := bridges.NewResponseCacheWithClock(clock.Now)
+	bt := cachedBridge(t, "adapter", "https://adapter.example.com")
+	body := []byte(`{"data":{"from":"ETH"}}`)
+
+	_, ok := cache.Get(bt, body)
+	assert.False(t, ok)
+	cache.Set(bt, body, []byte(`{"result":1}`))
+
+	response, ok := cache.Get(bt, body)
+	require.True(t, ok)
+	assert.Equal(t, `{"result":1}`, string(response))
+
+	// A different body or URL is a different request
+	_, ok = cache.Get(bt, []byte(`{"data":{"from":"BTC"}}`))
+	assert.False(t, ok)
+	moved := bt
+	moved.URL = cachedBridge(t, "adapter", "https://other.example.com").URL
+	_, ok = cache.Get(moved, body)
+	assert.False(t, ok)
+
+	// Past the TTL the response is only used when the bridge fails
+	clock.Advance(10 * time.Second)
+	_, ok = cache.Get(bt, body)
+	assert.False(t, ok)
+	response, ok = cache.GetStale(bt, body)
+	require.True(t, ok)
+	assert.Equal(t, `{"result":1}`, string(response))
+
+	clock.Advance(time.Minute)
+	_, ok = cache.GetStale(bt, body)
+	assert.False(t, ok)
+
+	// Bridges without a TTL are never cached
+	uncached := bridges.BridgeType{Name: "uncached", URL: bt.URL}
+	cache.Set(uncached, body, []byte(`{"result":2}`))
+	_, ok = cache.GetStale(uncached, body)
+	assert.False(t, ok)
+}
+
+func TestResponseCache_Flush(t *testing.T) {
+	t.Parallel()
+
+	clock := &testClock{now: time.Unix(1000, 0)}
+	cache := bridges.NewResponseCacheWithClock(clock.Now)
+	a := cachedBridge(t, "a", "https://a.example.com")
+	b := cachedBridge(t, "b", "https://b.example.com")
+
+	cache.Set(a, []byte("1"), []byte("one"))
+	cache.Set(a, []byte("2"), []byte("two"))
+	cache.Set(b, []byte("1"), []byte("one"))
+
+	assert.Equal(t, 2, cache.Flush(a.Name))
+	assert.Equal(t, 0, cache.Flush(a.Name))
+	_, ok := cache.GetStale(a, []byte("1"))
+	assert.False(t, ok)
+	_, ok = cache.Get(b, []byte("1"))
+	assert.True(t, ok)
+
+	cache.Set(a, []byte("1"), []byte("one"))
+	assert.Equal(t, 2, cache.FlushAll())
+	_, ok = cache.GetStale(b, []byte("1"))
+	assert.False(t, ok)
+}
+
+func TestResponseCache_MaxCachedResponses(t *testing.T) {
+	t.Parallel()
+
+	clock := &testClock{now: time.Unix(1000, 0)}
+	cache := bridges.NewResponseCacheWithClock(clock.Now)
+	bt := cachedBridge(t, "adapter", "https://adapter.example.com")
+
+	for i := 0; i < bridges.MaxCachedResponses+1; i++ {
+		cache.Set(bt, []byte{byte(i), byte(i >> 8)}, []byte("response"))
+	}
+	_, ok := cache.Get(bt, []byte{0, 0})
+	assert.True(t, ok)
+	last := bridges.MaxCachedResponses
+	_, ok = cache.Get(bt, []byte{byte(last), byte(last >> 8)})
+	assert.False(t, ok, "responses are not cached while the cache is full")
+
+	// Expired responses make room for new ones
+	clock.Advance(time.Minute + 10*time.Second)
+	cache.Set(bt, []byte{byte(last), byte(last >> 8)}, []byte("response"))
+	_, ok = cache.Get(bt, []byte{byte(last), byte(last >> 8)})
+	assert.True(t, ok)
+	assert.Equal(t, 1, cache.FlushAll())
+}
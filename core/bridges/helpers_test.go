@@ -0,0 +1,15 @@
+package bridges
+
+import (
+	"time"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+func NewBreakersWithClock(lggr logger.Logger, now func() time.Time) Breakers {
+	return newBreakers(lggr, now)
+}
+
+func NewResponseCacheWithClock(now func() time.Time) ResponseCache {
+	return newResponseCache(now)
+}
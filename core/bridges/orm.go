@@ -80,9 +80,12 @@ func (o *orm) BridgeTypes(offset int, limit int) (bridges []BridgeType, count in
 
 // CreateBridgeType saves the bridge type.
 func (o *orm) CreateBridgeType(bt *BridgeType) error {
-	stmt := `INSERT INTO bridge_types (name, url, confirmations, incoming_token_hash, salt, outgoing_token, minimum_contract_payment, created_at, updated_at)
-	VALUES (:name, :url, :confirmations, :incoming_token_hash, :salt, :outgoing_token, :minimum_contract_payment, now(), now())
+	stmt := `INSERT INTO bridge_types (name, url, confirmations, incoming_token_hash, salt, outgoing_token, minimum_contract_payment,
+	retry_on_status_codes, retries, attempt_timeout, max_concurrency, breaker_failure_threshold, breaker_cooldown, cache_ttl, cache_max_stale, created_at, updated_at)
+	VALUES (:name, :url, :confirmations, :incoming_token_hash, :salt, :outgoing_token, :minimum_contract_payment,
+	:retry_on_status_codes, :retries, :attempt_timeout, :max_concurrency, :breaker_failure_threshold, :breaker_cooldown, :cache_ttl, :cache_max_stale, now(), now())
 	RETURNING *;`
+	bt.RequestPolicy = bt.RequestPolicy.normalized()
 	err := o.q.Transaction(func(tx pg.Queryer) error {
 		stmt, err := tx.PrepareNamed(stmt)
 		if err != nil {
@@ -96,8 +99,15 @@ func (o *orm) CreateBridgeType(bt *BridgeType) error {
 // UpdateBridgeType updates the bridge type.
 func (o *orm) UpdateBridgeType(bt *BridgeType,
 	btr *BridgeTypeRequest) error {
-	sql := "UPDATE bridge_types SET url = $1, confirmations = $2, minimum_contract_payment = $3 WHERE name = $4 RETURNING *"
-	return o.q.Get(bt, sql, btr.URL, btr.Confirmations, btr.MinimumContractPayment, bt.Name)
+	sql := `UPDATE bridge_types SET url = $1, confirmations = $2, minimum_contract_payment = $3,
+	retry_on_status_codes = $4, retries = $5, attempt_timeout = $6, max_concurrency = $7, breaker_failure_threshold = $8, breaker_cooldown = $9,
+	cache_ttl = $10, cache_max_stale = $11
+	WHERE name = $12 RETURNING *`
+	policy := btr.RequestPolicy.normalized()
+	return o.q.Get(bt, sql, btr.URL, btr.Confirmations, btr.MinimumContractPayment,
+		policy.RetryOnStatusCodes, policy.Retries, policy.AttemptTimeout, policy.MaxConcurrency, policy.BreakerFailureThreshold, policy.BreakerCooldown,
+		policy.CacheTTL, policy.CacheMaxStale,
+		bt.Name)
 }
 
 // --- External Initiator
@@ -2,7 +2,9 @@ package bridges_test
 
 import (
 	"testing"
+	"time"
 
+	"github.com/lib/pq"
 	"github.com/smartcontractkit/sqlx"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -12,6 +14,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
 	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/store/models"
 )
 
 func setupORM(t *testing.T) (*sqlx.DB, bridges.ORM) {
@@ -71,6 +74,14 @@ func TestORM_UpdateBridgeType(t *testing.T) {
 
 	updateBridge := &bridges.BridgeTypeRequest{
 		URL: cltest.WebURL(t, "http:/updatedurl.com"),
+		RequestPolicy: bridges.RequestPolicy{
+			RetryOnStatusCodes:      pq.Int32Array{429, 503},
+			Retries:                 3,
+			AttemptTimeout:          models.Interval(5 * time.Second),
+			MaxConcurrency:          20,
+			BreakerFailureThreshold: 10,
+			BreakerCooldown:         models.Interval(time.Minute),
+		},
 	}
 
 	require.NoError(t, orm.UpdateBridgeType(firstBridge, updateBridge))
@@ -78,6 +89,13 @@ func TestORM_UpdateBridgeType(t *testing.T) {
 	foundbridge, err := orm.FindBridge("UniqueName")
 	require.NoError(t, err)
 	require.Equal(t, updateBridge.URL, foundbridge.URL)
+	require.Equal(t, updateBridge.RequestPolicy, foundbridge.RequestPolicy)
+
+	// Clearing the retry codes stores an empty array
+	updateBridge.RequestPolicy = bridges.RequestPolicy{}
+	require.NoError(t, orm.UpdateBridgeType(firstBridge, updateBridge))
+	require.Equal(t, pq.Int32Array{}, firstBridge.RetryOnStatusCodes)
+	require.Equal(t, uint32(0), firstBridge.Retries)
 }
 
 func TestORM_CreateExternalInitiator(t *testing.T) {
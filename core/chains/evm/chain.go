@@ -53,6 +53,8 @@ type chain struct {
 	headTracker     httypes.Tracker
 	logBroadcaster  log.Broadcaster
 	balanceMonitor  services.BalanceMonitor
+	eventWebhook    *bulletprooftxmanager.EventWebhook
+	keyFunder       services.KeyFunder
 	keyStore        keystore.Eth
 }
 
@@ -81,7 +83,7 @@ func newChain(dbchain types.Chain, opts ChainSetOpts) (*chain, error) {
 		client = eth.NewNullClient(chainID, l)
 	} else if opts.GenEthClient == nil {
 		var err2 error
-		client, err2 = newEthClientFromChain(l, dbchain)
+		client, err2 = newEthClientFromChain(cfg, l, dbchain)
 		if err2 != nil {
 			return nil, errors.Wrapf(err2, "failed to instantiate eth client for chain with ID %s", dbchain.ID.String())
 		}
@@ -108,27 +110,38 @@ func newChain(dbchain types.Chain, opts ChainSetOpts) (*chain, error) {
 		headTracker = opts.GenHeadTracker(dbchain)
 	}
 
+	var balanceMonitor services.BalanceMonitor
+	if !cfg.EthereumDisabled() && cfg.BalanceMonitorEnabled() {
+		balanceMonitor = services.NewBalanceMonitor(client, opts.KeyStore, l)
+		headBroadcaster.Subscribe(balanceMonitor)
+	}
+
 	var txm bulletprooftxmanager.TxManager
 	if cfg.EthereumDisabled() {
 		txm = &bulletprooftxmanager.NullTxManager{ErrMsg: fmt.Sprintf("Ethereum is disabled for chain %d", chainID)}
 	} else if opts.GenTxManager == nil {
-		txm = bulletprooftxmanager.NewBulletproofTxManager(db, client, cfg, opts.KeyStore, opts.EventBroadcaster, l)
+		txm = bulletprooftxmanager.NewBulletproofTxManager(db, client, cfg, opts.KeyStore, opts.EventBroadcaster, balanceMonitor, l)
 	} else {
 		txm = opts.GenTxManager(dbchain)
 	}
 
 	headBroadcaster.Subscribe(txm)
 
+	var eventWebhook *bulletprooftxmanager.EventWebhook
+	if webhookURL := cfg.EthTxEventsWebhookURL(); !cfg.EthereumDisabled() && webhookURL != nil {
+		eventWebhook = bulletprooftxmanager.NewEventWebhook(txm, *webhookURL, l)
+	}
+
 	// Highest seen head height is used as part of the start of LogBroadcaster backfill range
 	highestSeenHead, err := headTracker.HighestSeenHeadFromDB(context.Background())
 	if err != nil {
 		return nil, err
 	}
 
-	var balanceMonitor services.BalanceMonitor
-	if !cfg.EthereumDisabled() && cfg.BalanceMonitorEnabled() {
-		balanceMonitor = services.NewBalanceMonitor(client, opts.KeyStore, l)
-		headBroadcaster.Subscribe(balanceMonitor)
+	var keyFunder services.KeyFunder
+	if !cfg.EthereumDisabled() && cfg.KeyFunderEnabled() {
+		keyFunder = services.NewKeyFunder(db, client, opts.KeyStore, cfg, l)
+		headBroadcaster.Subscribe(keyFunder)
 	}
 
 	var logBroadcaster log.Broadcaster
@@ -159,6 +172,8 @@ func newChain(dbchain types.Chain, opts ChainSetOpts) (*chain, error) {
 		headTracker,
 		logBroadcaster,
 		balanceMonitor,
+		eventWebhook,
+		keyFunder,
 		opts.KeyStore,
 	}
 	return &c, nil
@@ -183,6 +198,12 @@ func (c *chain) Start() error {
 		if c.balanceMonitor != nil {
 			merr = multierr.Combine(merr, c.balanceMonitor.Start())
 		}
+		if c.eventWebhook != nil {
+			merr = multierr.Combine(merr, c.eventWebhook.Start())
+		}
+		if c.keyFunder != nil {
+			merr = multierr.Combine(merr, c.keyFunder.Start())
+		}
 
 		if merr != nil {
 			return merr
@@ -232,6 +253,14 @@ func (c *chain) Close() error {
 			c.logger.Debug("Chain: stopping balance monitor")
 			merr = c.balanceMonitor.Close()
 		}
+		if c.eventWebhook != nil {
+			c.logger.Debug("Chain: stopping event webhook")
+			merr = multierr.Combine(merr, c.eventWebhook.Close())
+		}
+		if c.keyFunder != nil {
+			c.logger.Debug("Chain: stopping key funder")
+			merr = multierr.Combine(merr, c.keyFunder.Close())
+		}
 		c.logger.Debug("Chain: stopping logBroadcaster")
 		merr = multierr.Combine(merr, c.logBroadcaster.Close())
 		c.logger.Debug("Chain: stopping headTracker")
@@ -258,6 +287,12 @@ func (c *chain) Ready() (merr error) {
 	if c.balanceMonitor != nil {
 		merr = multierr.Combine(merr, c.balanceMonitor.Ready())
 	}
+	if c.eventWebhook != nil {
+		merr = multierr.Combine(merr, c.eventWebhook.Ready())
+	}
+	if c.keyFunder != nil {
+		merr = multierr.Combine(merr, c.keyFunder.Ready())
+	}
 	return
 }
 
@@ -272,6 +307,12 @@ func (c *chain) Healthy() (merr error) {
 	if c.balanceMonitor != nil {
 		merr = multierr.Combine(merr, c.balanceMonitor.Healthy())
 	}
+	if c.eventWebhook != nil {
+		merr = multierr.Combine(merr, c.eventWebhook.Healthy())
+	}
+	if c.keyFunder != nil {
+		merr = multierr.Combine(merr, c.keyFunder.Healthy())
+	}
 	return
 }
 
@@ -287,7 +328,7 @@ func (c *chain) BalanceMonitor() services.BalanceMonitor   { return c.balanceMon
 
 var ErrNoPrimaryNode = errors.New("no primary node found")
 
-func newEthClientFromChain(lggr logger.Logger, chain types.Chain) (eth.Client, error) {
+func newEthClientFromChain(cfg eth.PoolConfig, lggr logger.Logger, chain types.Chain) (eth.Client, error) {
 	nodes := chain.Nodes
 	chainID := big.Int(chain.ID)
 	var primaries []eth.Node
@@ -310,7 +351,7 @@ func newEthClientFromChain(lggr logger.Logger, chain types.Chain) (eth.Client, e
 	if len(primaries) == 0 {
 		return nil, ErrNoPrimaryNode
 	}
-	return eth.NewClientWithNodes(lggr, primaries, sendonlys, &chainID)
+	return eth.NewClientWithNodes(lggr, cfg, primaries, sendonlys, &chainID)
 }
 
 func newPrimary(lggr logger.Logger, n types.Node) (eth.Node, error) {
@@ -333,7 +374,7 @@ func newPrimary(lggr logger.Logger, n types.Node) (eth.Node, error) {
 		httpuri = u
 	}
 
-	return eth.NewNode(lggr, *wsuri, httpuri, n.Name), nil
+	return eth.NewNode(lggr, *wsuri, httpuri, n.Name, n.EVMChainID.ToInt()), nil
 }
 
 func newSendOnly(lggr logger.Logger, n types.Node) (eth.SendOnlyNode, error) {
@@ -31,6 +31,8 @@ type (
 		ethTxReaperInterval                        time.Duration
 		ethTxReaperThreshold                       time.Duration
 		ethTxResendAfterThreshold                  time.Duration
+		feeHistoryEstimatorBlockCount              uint16
+		feeHistoryEstimatorRewardPercentile        uint16
 		finalityDepth                              uint32
 		flagsContractAddress                       string
 		gasBumpPercent                             uint16
@@ -44,9 +46,16 @@ type (
 		gasPriceDefault                            big.Int
 		gasTipCapDefault                           big.Int
 		gasTipCapMinimum                           big.Int
+		generateAccessLists                        bool
 		headTrackerHistoryDepth                    uint32
 		headTrackerMaxBufferSize                   uint32
 		headTrackerSamplingInterval                time.Duration
+		keyFunderEnabled                           bool
+		keyFunderFundingKeyMinBalanceWei           big.Int
+		keyFunderMaxDailyWei                       big.Int
+		keyFunderMinBalanceWei                     big.Int
+		keyFunderMinInterval                       time.Duration
+		keyFunderTargetBalanceWei                  big.Int
 		linkContractAddress                        string
 		logBackfillBatchSize                       uint32
 		maxGasPriceWei                             big.Int
@@ -57,6 +66,9 @@ type (
 		minRequiredOutgoingConfirmations           uint64
 		minimumContractPayment                     *assets.Link
 		nonceAutoSync                              bool
+		privateRelayEnabled                        bool
+		privateRelayFallbackBlocks                 uint32
+		privateRelayURL                            string
 		rpcDefaultBatchSize                        uint32
 		// set true if fully configured
 		complete bool
@@ -105,6 +117,8 @@ func setChainSpecificConfigDefaultSets() {
 		ethTxReaperInterval:                   1 * time.Hour,
 		ethTxReaperThreshold:                  168 * time.Hour,
 		ethTxResendAfterThreshold:             1 * time.Minute,
+		feeHistoryEstimatorBlockCount:         20,
+		feeHistoryEstimatorRewardPercentile:   60,
 		finalityDepth:                         50,
 		gasBumpPercent:                        20,
 		gasBumpThreshold:                      3,
@@ -117,9 +131,16 @@ func setChainSpecificConfigDefaultSets() {
 		gasPriceDefault:                       *DefaultGasPrice,
 		gasTipCapDefault:                      *DefaultGasTip,
 		gasTipCapMinimum:                      *big.NewInt(0),
+		generateAccessLists:                   false,
 		headTrackerHistoryDepth:               100,
 		headTrackerMaxBufferSize:              3,
 		headTrackerSamplingInterval:           1 * time.Second,
+		keyFunderEnabled:                      false,
+		keyFunderFundingKeyMinBalanceWei:      *big.NewInt(0),
+		keyFunderMaxDailyWei:                  *big.NewInt(0),
+		keyFunderMinBalanceWei:                *big.NewInt(0),
+		keyFunderMinInterval:                  1 * time.Hour,
+		keyFunderTargetBalanceWei:             *big.NewInt(0),
 		linkContractAddress:                   "",
 		logBackfillBatchSize:                  100,
 		maxGasPriceWei:                        *assets.GWei(5000),
@@ -130,6 +151,9 @@ func setChainSpecificConfigDefaultSets() {
 		minRequiredOutgoingConfirmations:      12,
 		minimumContractPayment:                DefaultMinimumContractPayment,
 		nonceAutoSync:                         true,
+		privateRelayEnabled:                   false,
+		privateRelayFallbackBlocks:            25,
+		privateRelayURL:                       "",
 		ocrContractConfirmations:              4,
 		ocr2ContractConfirmations:             4,
 		ocrContractTransmitterTransmitTimeout: 10 * time.Second,
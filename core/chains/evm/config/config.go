@@ -3,6 +3,7 @@ package config
 import (
 	"fmt"
 	"math/big"
+	"net/url"
 	"os"
 	"sync"
 	"time"
@@ -47,6 +48,7 @@ type ChainScopedOnlyConfig interface {
 	EvmGasPriceDefault() *big.Int
 	EvmGasTipCapDefault() *big.Int
 	EvmGasTipCapMinimum() *big.Int
+	EvmGenerateAccessLists() bool
 	EvmHeadTrackerHistoryDepth() uint32
 	EvmHeadTrackerMaxBufferSize() uint32
 	EvmHeadTrackerSamplingInterval() time.Duration
@@ -56,10 +58,21 @@ type ChainScopedOnlyConfig interface {
 	EvmMaxQueuedTransactions() uint64
 	EvmMinGasPriceWei() *big.Int
 	EvmNonceAutoSync() bool
+	EvmPrivateRelayEnabled() bool
+	EvmPrivateRelayFallbackBlocks() uint32
+	EvmPrivateRelayURL() string
 	EvmRPCDefaultBatchSize() uint32
+	FeeHistoryEstimatorBlockCount() uint16
+	FeeHistoryEstimatorRewardPercentile() uint16
 	FlagsContractAddress() string
 	GasEstimatorMode() string
 	ChainType() chains.ChainType
+	KeyFunderEnabled() bool
+	KeyFunderFundingKeyMinBalanceWei() *big.Int
+	KeyFunderMaxDailyWei() *big.Int
+	KeyFunderMinBalanceWei() *big.Int
+	KeyFunderMinInterval() time.Duration
+	KeyFunderTargetBalanceWei() *big.Int
 	KeySpecificMaxGasPriceWei(addr gethcommon.Address) *big.Int
 	LinkContractAddress() string
 	MinIncomingConfirmations() uint32
@@ -170,6 +183,35 @@ func (c *chainScopedConfig) validate() (err error) {
 	if c.GasEstimatorMode() == "BlockHistory" && c.BlockHistoryEstimatorBlockHistorySize() <= 0 {
 		err = multierr.Combine(err, errors.New("BLOCK_HISTORY_ESTIMATOR_BLOCK_HISTORY_SIZE must be greater than or equal to 1 if block history estimator is enabled"))
 	}
+	if c.GasEstimatorMode() == "FeeHistory" {
+		if c.FeeHistoryEstimatorBlockCount() < 1 || c.FeeHistoryEstimatorBlockCount() > 1024 {
+			err = multierr.Combine(err, errors.New("FEE_HISTORY_ESTIMATOR_BLOCK_COUNT must be between 1 and 1024 if fee history estimator is enabled"))
+		}
+		if c.FeeHistoryEstimatorRewardPercentile() > 100 {
+			err = multierr.Combine(err, errors.New("FEE_HISTORY_ESTIMATOR_REWARD_PERCENTILE must be less than or equal to 100"))
+		}
+	}
+	if c.KeyFunderEnabled() {
+		if c.KeyFunderMinBalanceWei().Sign() <= 0 {
+			err = multierr.Combine(err, errors.New("KEY_FUNDER_MIN_BALANCE_WEI must be greater than 0 if the key funder is enabled"))
+		}
+		if c.KeyFunderTargetBalanceWei().Cmp(c.KeyFunderMinBalanceWei()) <= 0 {
+			err = multierr.Combine(err, errors.New("KEY_FUNDER_TARGET_BALANCE_WEI must be greater than KEY_FUNDER_MIN_BALANCE_WEI if the key funder is enabled"))
+		}
+		if c.KeyFunderMaxDailyWei().Sign() <= 0 {
+			err = multierr.Combine(err, errors.New("KEY_FUNDER_MAX_DAILY_WEI must be greater than 0 if the key funder is enabled"))
+		}
+	}
+	if relayURL := c.EvmPrivateRelayURL(); relayURL != "" {
+		if u, perr := url.Parse(relayURL); perr != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			err = multierr.Combine(err, errors.New("EVM_PRIVATE_RELAY_URL must be a valid http(s) URL"))
+		}
+	} else if c.EvmPrivateRelayEnabled() {
+		err = multierr.Combine(err, errors.New("EVM_PRIVATE_RELAY_URL must be set if the private relay is enabled"))
+	}
+	if c.EvmGenerateAccessLists() && !c.EvmEIP1559DynamicFees() {
+		err = multierr.Combine(err, errors.New("EVM_GENERATE_ACCESS_LISTS requires EVM_EIP1559_DYNAMIC_FEES, since access lists are only generated for EIP-1559 transactions"))
+	}
 	if c.EvmFinalityDepth() < 1 {
 		err = multierr.Combine(err, errors.New("ETH_FINALITY_DEPTH must be greater than or equal to 1"))
 	}
@@ -198,9 +240,15 @@ func (c *chainScopedConfig) validate() (err error) {
 	} else {
 		switch chainType {
 		case chains.Arbitrum:
-			if gasEst := c.GasEstimatorMode(); gasEst != "FixedPrice" {
+			gasEst := c.GasEstimatorMode()
+			switch gasEst {
+			case "Arbitrum", "FixedPrice":
+			default:
 				err = multierr.Combine(err, errors.Errorf("GAS_ESTIMATOR_MODE %q is not allowed with chain type %q - "+
-					"must be %q", gasEst, chains.Arbitrum, "FixedPrice"))
+					"must be %q or %q", gasEst, chains.Arbitrum, "Arbitrum", "FixedPrice"))
+			}
+			if gasEst == "Arbitrum" && c.EvmEIP1559DynamicFees() {
+				err = multierr.Combine(err, errors.New("EVM_EIP1559_DYNAMIC_FEES is not supported by the Arbitrum gas estimator"))
 			}
 		case chains.ExChain:
 
@@ -833,6 +881,61 @@ func (c *chainScopedConfig) EvmNonceAutoSync() bool {
 	return c.defaultSet.nonceAutoSync
 }
 
+// EvmPrivateRelayEnabled sends transactions to the private relay at
+// EvmPrivateRelayURL instead of the public mempool. Jobs can override it.
+func (c *chainScopedConfig) EvmPrivateRelayEnabled() bool {
+	val, ok := c.GeneralConfig.GlobalEvmPrivateRelayEnabled()
+	if ok {
+		c.logEnvOverrideOnce("EvmPrivateRelayEnabled", val)
+		return val
+	}
+	c.persistMu.RLock()
+	p := c.persistedCfg.EvmPrivateRelayEnabled
+	c.persistMu.RUnlock()
+	if p.Valid {
+		c.logPersistedOverrideOnce("EvmPrivateRelayEnabled", p.Bool)
+		return p.Bool
+	}
+	return c.defaultSet.privateRelayEnabled
+}
+
+// EvmPrivateRelayFallbackBlocks is the number of blocks after which a
+// transaction sent to the private relay that was not mined yet is broadcast
+// to the public mempool. Set to 0 to never fall back.
+func (c *chainScopedConfig) EvmPrivateRelayFallbackBlocks() uint32 {
+	val, ok := c.GeneralConfig.GlobalEvmPrivateRelayFallbackBlocks()
+	if ok {
+		c.logEnvOverrideOnce("EvmPrivateRelayFallbackBlocks", val)
+		return val
+	}
+	c.persistMu.RLock()
+	p := c.persistedCfg.EvmPrivateRelayFallbackBlocks
+	c.persistMu.RUnlock()
+	if p.Valid {
+		c.logPersistedOverrideOnce("EvmPrivateRelayFallbackBlocks", p.Int64)
+		return uint32(p.Int64)
+	}
+	return c.defaultSet.privateRelayFallbackBlocks
+}
+
+// EvmPrivateRelayURL is the HTTP endpoint of a Flashbots-style private
+// transaction relay that accepts eth_sendPrivateTransaction
+func (c *chainScopedConfig) EvmPrivateRelayURL() string {
+	val, ok := c.GeneralConfig.GlobalEvmPrivateRelayURL()
+	if ok {
+		c.logEnvOverrideOnce("EvmPrivateRelayURL", val)
+		return val
+	}
+	c.persistMu.RLock()
+	p := c.persistedCfg.EvmPrivateRelayURL
+	c.persistMu.RUnlock()
+	if p.Valid {
+		c.logPersistedOverrideOnce("EvmPrivateRelayURL", p.String)
+		return p.String
+	}
+	return c.defaultSet.privateRelayURL
+}
+
 // EvmGasLimitMultiplier is a factor by which a transaction's GasLimit is
 // multiplied before transmission. So if the value is 1.1, and the GasLimit for
 // a transaction is 10, 10% will be added before transmission.
@@ -945,6 +1048,29 @@ func (c *chainScopedConfig) EvmRPCDefaultBatchSize() uint32 {
 	return c.defaultSet.rpcDefaultBatchSize
 }
 
+// FeeHistoryEstimatorBlockCount is the number of past blocks requested with
+// eth_feeHistory by the fee history estimator
+func (c *chainScopedConfig) FeeHistoryEstimatorBlockCount() uint16 {
+	val, ok := c.GeneralConfig.GlobalFeeHistoryEstimatorBlockCount()
+	if ok {
+		c.logEnvOverrideOnce("FeeHistoryEstimatorBlockCount", val)
+		return val
+	}
+	return c.defaultSet.feeHistoryEstimatorBlockCount
+}
+
+// FeeHistoryEstimatorRewardPercentile is the percentile of the priority fees
+// paid in each block that the fee history estimator asks the node for. The
+// same percentile is then taken across the blocks to pick the tip cap
+func (c *chainScopedConfig) FeeHistoryEstimatorRewardPercentile() uint16 {
+	val, ok := c.GeneralConfig.GlobalFeeHistoryEstimatorRewardPercentile()
+	if ok {
+		c.logEnvOverrideOnce("FeeHistoryEstimatorRewardPercentile", val)
+		return val
+	}
+	return c.defaultSet.feeHistoryEstimatorRewardPercentile
+}
+
 // FlagsContractAddress represents the Flags contract address
 func (c *chainScopedConfig) FlagsContractAddress() string {
 	val, ok := c.GeneralConfig.GlobalFlagsContractAddress()
@@ -972,6 +1098,75 @@ func (c *chainScopedConfig) BalanceMonitorEnabled() bool {
 	return c.defaultSet.balanceMonitorEnabled
 }
 
+// KeyFunderEnabled enables topping up sending keys that run low from the
+// chain's funding key
+func (c *chainScopedConfig) KeyFunderEnabled() bool {
+	val, ok := c.GeneralConfig.GlobalKeyFunderEnabled()
+	if ok {
+		c.logEnvOverrideOnce("KeyFunderEnabled", val)
+		return val
+	}
+	return c.defaultSet.keyFunderEnabled
+}
+
+// KeyFunderFundingKeyMinBalanceWei is the balance below which the funding key
+// is reported as running low. If zero, the funding key is reported as running
+// low once it cannot pay for a full top-up
+func (c *chainScopedConfig) KeyFunderFundingKeyMinBalanceWei() *big.Int {
+	val, ok := c.GeneralConfig.GlobalKeyFunderFundingKeyMinBalanceWei()
+	if ok {
+		c.logEnvOverrideOnce("KeyFunderFundingKeyMinBalanceWei", val)
+		return val
+	}
+	n := c.defaultSet.keyFunderFundingKeyMinBalanceWei
+	return &n
+}
+
+// KeyFunderMaxDailyWei is the most that the key funder sends from the funding
+// key within any 24 hours
+func (c *chainScopedConfig) KeyFunderMaxDailyWei() *big.Int {
+	val, ok := c.GeneralConfig.GlobalKeyFunderMaxDailyWei()
+	if ok {
+		c.logEnvOverrideOnce("KeyFunderMaxDailyWei", val)
+		return val
+	}
+	n := c.defaultSet.keyFunderMaxDailyWei
+	return &n
+}
+
+// KeyFunderMinBalanceWei is the balance below which a sending key is topped up
+func (c *chainScopedConfig) KeyFunderMinBalanceWei() *big.Int {
+	val, ok := c.GeneralConfig.GlobalKeyFunderMinBalanceWei()
+	if ok {
+		c.logEnvOverrideOnce("KeyFunderMinBalanceWei", val)
+		return val
+	}
+	n := c.defaultSet.keyFunderMinBalanceWei
+	return &n
+}
+
+// KeyFunderMinInterval is the minimum time between two top-ups of the same
+// sending key
+func (c *chainScopedConfig) KeyFunderMinInterval() time.Duration {
+	val, ok := c.GeneralConfig.GlobalKeyFunderMinInterval()
+	if ok {
+		c.logEnvOverrideOnce("KeyFunderMinInterval", val)
+		return val
+	}
+	return c.defaultSet.keyFunderMinInterval
+}
+
+// KeyFunderTargetBalanceWei is the balance that sending keys are topped up to
+func (c *chainScopedConfig) KeyFunderTargetBalanceWei() *big.Int {
+	val, ok := c.GeneralConfig.GlobalKeyFunderTargetBalanceWei()
+	if ok {
+		c.logEnvOverrideOnce("KeyFunderTargetBalanceWei", val)
+		return val
+	}
+	n := c.defaultSet.keyFunderTargetBalanceWei
+	return &n
+}
+
 // EvmEIP1559DynamicFees will send transactions with the 0x2 dynamic fee EIP-2718
 // type and gas fields when enabled
 func (c *chainScopedConfig) EvmEIP1559DynamicFees() bool {
@@ -1015,6 +1210,26 @@ func (c *chainScopedConfig) EvmGasTipCapDefault() *big.Int {
 	return &c.defaultSet.gasTipCapDefault
 }
 
+// EvmGenerateAccessLists makes the EthBroadcaster call eth_createAccessList
+// before the first attempt of a transaction, and attach the resulting EIP-2930
+// access list if it lowers the estimated gas. Only DynamicFee transactions
+// carry access lists.
+func (c *chainScopedConfig) EvmGenerateAccessLists() bool {
+	val, ok := c.GeneralConfig.GlobalEvmGenerateAccessLists()
+	if ok {
+		c.logEnvOverrideOnce("EvmGenerateAccessLists", val)
+		return val
+	}
+	c.persistMu.RLock()
+	p := c.persistedCfg.EvmGenerateAccessLists
+	c.persistMu.RUnlock()
+	if p.Valid {
+		c.logPersistedOverrideOnce("EvmGenerateAccessLists", p.Bool)
+		return p.Bool
+	}
+	return c.defaultSet.generateAccessLists
+}
+
 // EvmGasTipCapMinimum is the minimum allowed value to use for the gas tip on DynamicFee transactions
 // This is analogous to EthMinGasPriceWei except the base fee is excluded
 func (c *chainScopedConfig) EvmGasTipCapMinimum() *big.Int {
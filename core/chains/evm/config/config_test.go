@@ -17,6 +17,7 @@ import (
 	evmmocks "github.com/smartcontractkit/chainlink/core/chains/evm/mocks"
 	"github.com/smartcontractkit/chainlink/core/chains/evm/types"
 	evmtypes "github.com/smartcontractkit/chainlink/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/core/config"
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/configtest"
 	"github.com/smartcontractkit/chainlink/core/logger"
@@ -196,6 +197,23 @@ func Test_chainScopedConfig_Validate(t *testing.T) {
 			}, nil, lggr, gcfg)
 			assert.Error(t, cfg.Validate())
 		})
+		t.Run("arbitrum", func(t *testing.T) {
+			gcfg := cltest.NewTestGeneralConfig(t)
+			lggr := logger.TestLogger(t)
+			cfg := evmconfig.NewChainScopedConfig(big.NewInt(42161), evmtypes.ChainCfg{
+				GasEstimatorMode: null.StringFrom("Arbitrum"),
+			}, nil, lggr, gcfg)
+			assert.NoError(t, cfg.Validate())
+		})
+		t.Run("arbitrum with dynamic fees", func(t *testing.T) {
+			gcfg := cltest.NewTestGeneralConfig(t)
+			lggr := logger.TestLogger(t)
+			cfg := evmconfig.NewChainScopedConfig(big.NewInt(42161), evmtypes.ChainCfg{
+				GasEstimatorMode:      null.StringFrom("Arbitrum"),
+				EvmEIP1559DynamicFees: null.BoolFrom(true),
+			}, nil, lggr, gcfg)
+			assert.Error(t, cfg.Validate())
+		})
 	})
 
 	t.Run("optimism-estimator", func(t *testing.T) {
@@ -225,4 +243,91 @@ func Test_chainScopedConfig_Validate(t *testing.T) {
 			assert.Error(t, cfg.Validate())
 		})
 	})
+	t.Run("fee-history-estimator", func(t *testing.T) {
+		t.Run("defaults", func(t *testing.T) {
+			gcfg := cltest.NewTestGeneralConfig(t)
+			lggr := logger.TestLogger(t)
+			cfg := evmconfig.NewChainScopedConfig(big.NewInt(0), evmtypes.ChainCfg{
+				GasEstimatorMode: null.StringFrom("FeeHistory"),
+			}, nil, lggr, gcfg)
+			assert.NoError(t, cfg.Validate())
+		})
+		t.Run("block count", func(t *testing.T) {
+			t.Setenv(config.EnvVarName("FeeHistoryEstimatorBlockCount"), "1025")
+			gcfg := cltest.NewTestGeneralConfig(t)
+			lggr := logger.TestLogger(t)
+			cfg := evmconfig.NewChainScopedConfig(big.NewInt(0), evmtypes.ChainCfg{
+				GasEstimatorMode: null.StringFrom("FeeHistory"),
+			}, nil, lggr, gcfg)
+			assert.Error(t, cfg.Validate())
+		})
+		t.Run("reward percentile", func(t *testing.T) {
+			t.Setenv(config.EnvVarName("FeeHistoryEstimatorRewardPercentile"), "101")
+			gcfg := cltest.NewTestGeneralConfig(t)
+			lggr := logger.TestLogger(t)
+			cfg := evmconfig.NewChainScopedConfig(big.NewInt(0), evmtypes.ChainCfg{
+				GasEstimatorMode: null.StringFrom("FeeHistory"),
+			}, nil, lggr, gcfg)
+			assert.Error(t, cfg.Validate())
+		})
+	})
+	t.Run("key-funder", func(t *testing.T) {
+		newConfig := func(t *testing.T, minBalance, targetBalance, maxDaily int64) evmconfig.ChainScopedConfig {
+			gcfg := cltest.NewTestGeneralConfig(t)
+			gcfg.Overrides.GlobalKeyFunderEnabled = null.BoolFrom(true)
+			gcfg.Overrides.GlobalKeyFunderMinBalanceWei = big.NewInt(minBalance)
+			gcfg.Overrides.GlobalKeyFunderTargetBalanceWei = big.NewInt(targetBalance)
+			gcfg.Overrides.GlobalKeyFunderMaxDailyWei = big.NewInt(maxDaily)
+			return evmconfig.NewChainScopedConfig(big.NewInt(0), evmtypes.ChainCfg{}, nil, logger.TestLogger(t), gcfg)
+		}
+		t.Run("valid", func(t *testing.T) {
+			assert.NoError(t, newConfig(t, 1, 2, 10).Validate())
+		})
+		t.Run("defaults", func(t *testing.T) {
+			gcfg := cltest.NewTestGeneralConfig(t)
+			gcfg.Overrides.GlobalKeyFunderEnabled = null.BoolFrom(true)
+			cfg := evmconfig.NewChainScopedConfig(big.NewInt(0), evmtypes.ChainCfg{}, nil, logger.TestLogger(t), gcfg)
+			assert.Error(t, cfg.Validate())
+		})
+		t.Run("target balance not above min balance", func(t *testing.T) {
+			assert.Error(t, newConfig(t, 2, 2, 10).Validate())
+		})
+		t.Run("no daily maximum", func(t *testing.T) {
+			assert.Error(t, newConfig(t, 1, 2, 0).Validate())
+		})
+	})
+	t.Run("private-relay", func(t *testing.T) {
+		newConfig := func(t *testing.T, enabled bool, url string) evmconfig.ChainScopedConfig {
+			gcfg := cltest.NewTestGeneralConfig(t)
+			gcfg.Overrides.GlobalEvmPrivateRelayEnabled = null.BoolFrom(enabled)
+			gcfg.Overrides.GlobalEvmPrivateRelayURL = null.StringFrom(url)
+			return evmconfig.NewChainScopedConfig(big.NewInt(0), evmtypes.ChainCfg{}, nil, logger.TestLogger(t), gcfg)
+		}
+		t.Run("valid", func(t *testing.T) {
+			assert.NoError(t, newConfig(t, true, "https://relay.example.com").Validate())
+			assert.NoError(t, newConfig(t, false, "").Validate())
+		})
+		t.Run("enabled without url", func(t *testing.T) {
+			assert.Error(t, newConfig(t, true, "").Validate())
+		})
+		t.Run("invalid url", func(t *testing.T) {
+			assert.Error(t, newConfig(t, false, "ws://relay.example.com").Validate())
+			assert.Error(t, newConfig(t, true, "relay.example.com").Validate())
+		})
+	})
+	t.Run("access-lists", func(t *testing.T) {
+		newConfig := func(t *testing.T, generate, eip1559 bool) evmconfig.ChainScopedConfig {
+			gcfg := cltest.NewTestGeneralConfig(t)
+			gcfg.Overrides.GlobalEvmGenerateAccessLists = null.BoolFrom(generate)
+			gcfg.Overrides.GlobalEvmEIP1559DynamicFees = null.BoolFrom(eip1559)
+			return evmconfig.NewChainScopedConfig(big.NewInt(0), evmtypes.ChainCfg{}, nil, logger.TestLogger(t), gcfg)
+		}
+		t.Run("valid", func(t *testing.T) {
+			assert.NoError(t, newConfig(t, true, true).Validate())
+			assert.NoError(t, newConfig(t, false, false).Validate())
+		})
+		t.Run("enabled without EIP-1559", func(t *testing.T) {
+			assert.Error(t, newConfig(t, true, false).Validate())
+		})
+	})
 }
@@ -60,12 +60,16 @@ type ChainCfg struct {
 	EvmGasPriceDefault                    *utils.Big
 	EvmGasTipCapDefault                   *utils.Big
 	EvmGasTipCapMinimum                   *utils.Big
+	EvmGenerateAccessLists                null.Bool
 	EvmHeadTrackerHistoryDepth            null.Int
 	EvmHeadTrackerMaxBufferSize           null.Int
 	EvmHeadTrackerSamplingInterval        *models.Duration
 	EvmLogBackfillBatchSize               null.Int
 	EvmMaxGasPriceWei                     *utils.Big
 	EvmNonceAutoSync                      null.Bool
+	EvmPrivateRelayEnabled                null.Bool
+	EvmPrivateRelayFallbackBlocks         null.Int
+	EvmPrivateRelayURL                    null.String
 	EvmRPCDefaultBatchSize                null.Int
 	FlagsContractAddress                  null.String
 	GasEstimatorMode                      null.String
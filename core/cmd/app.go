@@ -60,6 +60,62 @@ func NewApp(client *Client) *cli.App {
 						},
 					},
 				},
+				{
+					Name:  "users",
+					Usage: "Create, edit permissions, or delete API users",
+					Subcommands: cli.Commands{
+						{
+							Name:   "list",
+							Usage:  "Lists all API users and their roles",
+							Action: client.ListUsers,
+						},
+						{
+							Name:   "create",
+							Usage:  "Create a new API user",
+							Action: client.CreateUser,
+							Flags: []cli.Flag{
+								cli.StringFlag{
+									Name:  "email",
+									Usage: "Email of new user to create",
+								},
+								cli.StringFlag{
+									Name:  "role",
+									Usage: "Permission level of new user. Options: 'admin', 'edit', 'run', 'view'.",
+								},
+								cli.StringFlag{
+									Name:  "password",
+									Usage: "text file holding the password of the new user, which is prompted for if not given",
+								},
+							},
+						},
+						{
+							Name:   "chrole",
+							Usage:  "Changes an API user's role",
+							Action: client.ChangeRole,
+							Flags: []cli.Flag{
+								cli.StringFlag{
+									Name:  "email",
+									Usage: "email of user to be edited",
+								},
+								cli.StringFlag{
+									Name:  "newrole",
+									Usage: "new permission level role to set for user. Options: 'admin', 'edit', 'run', 'view'.",
+								},
+							},
+						},
+						{
+							Name:   "delete",
+							Usage:  "Delete an API user",
+							Action: client.RemoveUser,
+							Flags: []cli.Flag{
+								cli.StringFlag{
+									Name:  "email",
+									Usage: "Email of API user to delete",
+								},
+							},
+						},
+					},
+				},
 			},
 		},
 
@@ -115,6 +171,17 @@ func NewApp(client *Client) *cli.App {
 					Usage:  "Destroys the Bridge for an External Adapter",
 					Action: client.RemoveBridge,
 				},
+				{
+					Name:   "flush-cache",
+					Usage:  "Flush the responses cached for a Bridge",
+					Action: client.FlushBridgeCache,
+					Flags: []cli.Flag{
+						cli.BoolFlag{
+							Name:  "all",
+							Usage: "flush the responses cached for all Bridges",
+						},
+					},
+				},
 				{
 					Name:   "list",
 					Usage:  "List all Bridges to External Adapters",
@@ -232,11 +299,53 @@ func NewApp(client *Client) *cli.App {
 					Usage:  "Delete a job",
 					Action: client.DeleteJob,
 				},
+				{
+					Name:   "update",
+					Usage:  "Update the pipeline of a job, storing it as a new version",
+					Action: client.UpdateJob,
+				},
+				{
+					Name:   "versions",
+					Usage:  "List the versions of the pipeline of a job",
+					Action: client.ListJobVersions,
+				},
+				{
+					Name:   "rollback",
+					Usage:  "Roll back the pipeline of a job to a previous version",
+					Action: client.RollbackJob,
+				},
+				{
+					Name:   "pause",
+					Usage:  "Pause a job, stopping it until it is resumed",
+					Action: client.PauseJob,
+				},
+				{
+					Name:   "resume",
+					Usage:  "Resume a paused job",
+					Action: client.ResumeJob,
+					Flags: []cli.Flag{
+						cli.BoolFlag{
+							Name:  "backfill",
+							Usage: "replay the logs emitted while the job was paused",
+						},
+					},
+				},
 				{
 					Name:   "run",
 					Usage:  "Trigger a job run",
 					Action: client.TriggerPipelineRun,
 				},
+				{
+					Name:  "runs",
+					Usage: "Commands for managing job runs",
+					Subcommands: []cli.Command{
+						{
+							Name:   "cancel",
+							Usage:  "Cancel a job run",
+							Action: client.CancelPipelineRun,
+						},
+					},
+				},
 			},
 		},
 		{
@@ -323,6 +432,38 @@ func NewApp(client *Client) *cli.App {
 							},
 							Action: client.ExportETHKey,
 						},
+						{
+							Name:  "pools",
+							Usage: "Remote commands for administering the named pools of sending keys that jobs send from",
+							Subcommands: cli.Commands{
+								{
+									Name:   "list",
+									Usage:  "List the key pools and their keys",
+									Action: client.ListKeyPools,
+								},
+								{
+									Name:   "set",
+									Usage:  format(`Create a key pool, or replace the strategy and keys of an existing one`),
+									Action: client.SetKeyPool,
+									Flags: []cli.Flag{
+										cli.StringFlag{
+											Name:  "strategy",
+											Usage: "How a key is selected: round_robin, least_unconfirmed, highest_balance or weighted",
+											Value: "round_robin",
+										},
+										cli.StringSliceFlag{
+											Name:  "key",
+											Usage: "Address of a key in the pool, optionally followed by :WEIGHT for the weighted strategy (repeatable)",
+										},
+									},
+								},
+								{
+									Name:   "delete",
+									Usage:  format(`Delete a key pool that no job sends from`),
+									Action: client.DeleteKeyPool,
+								},
+							},
+						},
 					},
 				},
 
@@ -553,9 +694,15 @@ func NewApp(client *Client) *cli.App {
 			Subcommands: []cli.Command{
 				{
 					Name:        "deleteuser",
-					Usage:       "Erase the *local node's* user and corresponding session to force recreation on next node launch.",
+					Usage:       "Erase a user of the *local node* and their sessions. If no users are left, one is created on next node launch.",
 					Description: "Does not work remotely over API.",
 					Action:      client.DeleteUser,
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:  "email",
+							Usage: "email of the user to delete",
+						},
+					},
 				},
 				{
 					Name:   "setnextnonce",
@@ -755,6 +902,22 @@ func NewApp(client *Client) *cli.App {
 					Usage:  "get information on a specific Ethereum Transaction",
 					Action: client.ShowTransaction,
 				},
+				{
+					Name:   "cancel",
+					Usage:  "Cancel a transaction by its ID, or by the hash of one of its attempts. Unstarted transactions are never sent, in-flight transactions are replaced by an empty transaction with a bumped fee",
+					Action: client.CancelTransaction,
+				},
+				{
+					Name:   "nonce-status",
+					Usage:  "Check the nonces of the node's keys against the chain, and list any nonces that are missing a transaction",
+					Action: client.NonceStatus,
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:  "evmChainID",
+							Usage: "only check the keys on the chain with this ID",
+						},
+					},
+				},
 			},
 		},
 		{
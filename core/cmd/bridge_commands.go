@@ -114,3 +114,48 @@ func (cli *Client) RemoveBridge(c *cli.Context) (err error) {
 
 	return cli.renderAPIResponse(resp, &BridgePresenter{})
 }
+
+type BridgeCacheFlushPresenter struct {
+	presenters.BridgeCacheFlushResource
+}
+
+// RenderTable implements TableRenderer
+func (p *BridgeCacheFlushPresenter) RenderTable(rt RendererTable) error {
+	name := p.ID
+	if name == "" {
+		name = "(all)"
+	}
+	table := rt.newTable([]string{"Bridge", "Flushed Responses"})
+	table.Append([]string{
+		name,
+		strconv.Itoa(p.FlushedResponses),
+	})
+	render("Bridge Cache", table)
+	return nil
+}
+
+// FlushBridgeCache drops the responses cached for a specific Bridge by name,
+// or for all bridges.
+func (cli *Client) FlushBridgeCache(c *cli.Context) (err error) {
+	path := "/v2/bridge_cache"
+	if c.Args().Present() {
+		if c.Bool("all") {
+			return cli.errorOut(errors.New("must pass either the name of the bridge or --all, not both"))
+		}
+		path = "/v2/bridge_types/" + c.Args().First() + "/cache"
+	} else if !c.Bool("all") {
+		return cli.errorOut(errors.New("must pass the name of the bridge to be flushed, or --all"))
+	}
+
+	resp, err := cli.HTTP.Delete(path)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(resp, &BridgeCacheFlushPresenter{})
+}
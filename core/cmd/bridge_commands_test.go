@@ -9,6 +9,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/bridges"
 	"github.com/smartcontractkit/chainlink/core/cmd"
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/store/models"
 	"github.com/smartcontractkit/chainlink/core/web/presenters"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -179,3 +180,45 @@ func TestClient_RemoveBridge(t *testing.T) {
 	assert.Equal(t, bt.URL.String(), p.URL)
 	assert.Equal(t, bt.Confirmations, p.Confirmations)
 }
+
+func TestClient_FlushBridgeCache(t *testing.T) {
+	t.Parallel()
+
+	app := startNewApplication(t)
+	client, r := app.NewClientAndRenderer()
+
+	bt := &bridges.BridgeType{
+		Name: bridges.MustNewTaskType("testingbridges1"),
+		URL:  cltest.WebURL(t, "https://testing.com/bridges"),
+		RequestPolicy: bridges.RequestPolicy{
+			CacheTTL: models.Interval(time.Hour),
+		},
+	}
+	require.NoError(t, app.BridgeORM().CreateBridgeType(bt))
+	app.BridgeCache().Set(*bt, []byte(`{"data":{}}`), []byte(`{"data":{"result":1}}`))
+
+	set := flag.NewFlagSet("test", 0)
+	set.Bool("all", false, "")
+	c := cli.NewContext(nil, set, nil)
+	require.EqualError(t, client.FlushBridgeCache(c), "must pass the name of the bridge to be flushed, or --all")
+
+	set = flag.NewFlagSet("test", 0)
+	set.Bool("all", false, "")
+	set.Parse([]string{bt.Name.String()})
+	c = cli.NewContext(nil, set, nil)
+	require.NoError(t, client.FlushBridgeCache(c))
+
+	require.Len(t, r.Renders, 1)
+	p := r.Renders[0].(*cmd.BridgeCacheFlushPresenter)
+	assert.Equal(t, bt.Name.String(), p.ID)
+	assert.Equal(t, 1, p.FlushedResponses)
+
+	set = flag.NewFlagSet("test", 0)
+	set.Bool("all", true, "")
+	c = cli.NewContext(nil, set, nil)
+	require.NoError(t, client.FlushBridgeCache(c))
+
+	require.Len(t, r.Renders, 2)
+	p = r.Renders[1].(*cmd.BridgeCacheFlushPresenter)
+	assert.Equal(t, 0, p.FlushedResponses)
+}
@@ -602,7 +602,7 @@ func NewPromptingAPIInitializer(prompter Prompter) APIInitializer {
 
 // Initialize uses the terminal to get credentials that it then saves in the store.
 func (t *promptingAPIInitializer) Initialize(orm sessions.ORM) (sessions.User, error) {
-	if user, err := orm.FindUser(); err == nil {
+	if user, exists, err := findExistingUser(orm); err != nil || exists {
 		return user, err
 	}
 
@@ -613,7 +613,7 @@ func (t *promptingAPIInitializer) Initialize(orm sessions.ORM) (sessions.User, e
 	for {
 		email := t.prompter.Prompt("Enter API Email: ")
 		pwd := t.prompter.PasswordPrompt("Enter API Password: ")
-		user, err := sessions.NewUser(email, pwd)
+		user, err := sessions.NewUser(email, pwd, sessions.UserRoleAdmin)
 		if err != nil {
 			fmt.Println("Error creating API user: ", err)
 			continue
@@ -637,7 +637,7 @@ func NewFileAPIInitializer(file string, lggr logger.Logger) APIInitializer {
 }
 
 func (f fileAPIInitializer) Initialize(orm sessions.ORM) (sessions.User, error) {
-	if user, err := orm.FindUser(); err == nil {
+	if user, exists, err := findExistingUser(orm); err != nil || exists {
 		return user, err
 	}
 
@@ -646,13 +646,31 @@ func (f fileAPIInitializer) Initialize(orm sessions.ORM) (sessions.User, error)
 		return sessions.User{}, err
 	}
 
-	user, err := sessions.NewUser(request.Email, request.Password)
+	user, err := sessions.NewUser(request.Email, request.Password, sessions.UserRoleAdmin)
 	if err != nil {
 		return user, err
 	}
 	return user, orm.CreateUser(&user)
 }
 
+// findExistingUser returns the newest admin user, or the newest user if there
+// are no admins. The initial API user is only created when there are no users.
+func findExistingUser(orm sessions.ORM) (user sessions.User, exists bool, err error) {
+	users, err := orm.ListUsers()
+	if err != nil {
+		return user, false, errors.Wrap(err, "failed to list API users")
+	}
+	if len(users) == 0 {
+		return user, false, nil
+	}
+	for i := len(users) - 1; i >= 0; i-- {
+		if users[i].Role == sessions.UserRoleAdmin {
+			return users[i], true, nil
+		}
+	}
+	return users[len(users)-1], true, nil
+}
+
 var ErrNoCredentialFile = errors.New("no API user credential file was passed")
 
 func credentialsFromFile(file string, lggr logger.Logger) (sessions.SessionRequest, error) {
@@ -145,7 +145,7 @@ func TestTerminalAPIInitializer_InitializeWithoutAPIUser(t *testing.T) {
 			tai := cmd.NewPromptingAPIInitializer(mock)
 
 			// Remove fixture user
-			err := orm.DeleteUser()
+			err := orm.DeleteUser(cltest.APIEmail)
 			require.NoError(t, err)
 
 			user, err := tai.Initialize(orm)
@@ -155,11 +155,12 @@ func TestTerminalAPIInitializer_InitializeWithoutAPIUser(t *testing.T) {
 				assert.NoError(t, err)
 				assert.Equal(t, len(test.enteredStrings), mock.Count)
 
-				persistedUser, err := orm.FindUser()
+				persistedUser, err := orm.FindUser(user.Email)
 				assert.NoError(t, err)
 
 				assert.Equal(t, user.Email, persistedUser.Email)
 				assert.Equal(t, user.HashedPassword, persistedUser.HashedPassword)
+				assert.Equal(t, sessions.UserRoleAdmin, persistedUser.Role)
 			}
 		})
 	}
@@ -200,7 +201,7 @@ func TestFileAPIInitializer_InitializeWithoutAPIUser(t *testing.T) {
 			db := pgtest.NewSqlxDB(t)
 			orm := sessions.NewORM(db, time.Minute, logger.TestLogger(t))
 			// Clear out fixture user
-			orm.DeleteUser()
+			orm.DeleteUser(cltest.APIEmail)
 
 			tfi := cmd.NewFileAPIInitializer(test.file, logger.TestLogger(t))
 			user, err := tfi.Initialize(orm)
@@ -209,7 +210,7 @@ func TestFileAPIInitializer_InitializeWithoutAPIUser(t *testing.T) {
 			} else {
 				assert.NoError(t, err)
 				assert.Equal(t, cltest.APIEmail, user.Email)
-				persistedUser, err := orm.FindUser()
+				persistedUser, err := orm.FindUser(user.Email)
 				assert.NoError(t, err)
 				assert.Equal(t, persistedUser.Email, user.Email)
 			}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
+	"strconv"
 	"time"
 
 	"github.com/pkg/errors"
@@ -109,6 +110,14 @@ func (p JobPresenter) FriendlyCreatedAt() string {
 		if p.WebhookSpec != nil {
 			return p.WebhookSpec.CreatedAt.Format(time.RFC3339)
 		}
+	case presenters.EventTriggerJobSpec:
+		if p.EventTriggerSpec != nil {
+			return p.EventTriggerSpec.CreatedAt.Format(time.RFC3339)
+		}
+	case presenters.BlockTriggerJobSpec:
+		if p.BlockTriggerSpec != nil {
+			return p.BlockTriggerSpec.CreatedAt.Format(time.RFC3339)
+		}
 	default:
 		return "unknown"
 	}
@@ -213,6 +222,99 @@ func (cli *Client) CreateJob(c *cli.Context) (err error) {
 	return err
 }
 
+// UpdateJob stores the pipeline of a TOML spec as a new version of a job
+// Valid input is a TOML string or a path to TOML file
+func (cli *Client) UpdateJob(c *cli.Context) (err error) {
+	if c.NArg() != 2 {
+		return cli.errorOut(errors.New("must pass the job id, and TOML or filepath"))
+	}
+
+	tomlString, err := getTOMLString(c.Args().Get(1))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+
+	request, err := json.Marshal(web.UpdateJobRequest{
+		TOML: tomlString,
+	})
+	if err != nil {
+		return cli.errorOut(err)
+	}
+
+	resp, err := cli.HTTP.Put("/v2/jobs/"+c.Args().First(), bytes.NewReader(request))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(resp, &JobPresenter{}, "Job updated")
+}
+
+// JobVersionPresenter wraps the JSONAPI Job Version Resource and adds rendering functionality
+type JobVersionPresenter struct {
+	JAID // This is needed to render the id for a JSONAPI Resource as normal JSON
+	presenters.JobVersionResource
+}
+
+// JobVersionPresenters implements TableRenderer for a slice of JobVersionPresenter
+type JobVersionPresenters []JobVersionPresenter
+
+// RenderTable implements TableRenderer
+func (ps JobVersionPresenters) RenderTable(rt RendererTable) error {
+	table := rt.newTable([]string{"Version", "Current", "Max Task Duration", "Created At"})
+	for _, p := range ps {
+		table.Append([]string{
+			strconv.FormatInt(int64(p.Version), 10),
+			strconv.FormatBool(p.Current),
+			p.MaxTaskDuration.Duration().String(),
+			p.CreatedAt.Format(time.RFC3339),
+		})
+	}
+
+	render("Job Versions", table)
+	return nil
+}
+
+// ListJobVersions lists every version of the pipeline of a job
+func (cli *Client) ListJobVersions(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("must provide the id of the job"))
+	}
+	resp, err := cli.HTTP.Get("/v2/jobs/" + c.Args().First() + "/versions")
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(resp, &JobVersionPresenters{})
+}
+
+// RollbackJob makes a previous version of the pipeline of a job current again
+func (cli *Client) RollbackJob(c *cli.Context) (err error) {
+	if c.NArg() != 2 {
+		return cli.errorOut(errors.New("must pass the job id and the version to roll back to"))
+	}
+	resp, err := cli.HTTP.Post("/v2/jobs/"+c.Args().First()+"/versions/"+c.Args().Get(1)+"/rollback", nil)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(resp, &JobPresenter{}, "Job rolled back")
+}
+
 // DeleteJob deletes a job
 func (cli *Client) DeleteJob(c *cli.Context) error {
 	if !c.Args().Present() {
@@ -231,6 +333,47 @@ func (cli *Client) DeleteJob(c *cli.Context) error {
 	return nil
 }
 
+// PauseJob pauses a job, stopping its services until it is resumed
+func (cli *Client) PauseJob(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("must pass the job id to be paused"))
+	}
+	resp, err := cli.HTTP.Post("/v2/jobs/"+c.Args().First()+"/pause", nil)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(resp, &JobPresenter{}, "Job successfully paused")
+}
+
+// ResumeJob resumes a paused job, optionally replaying the logs emitted
+// while it was paused
+func (cli *Client) ResumeJob(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("must pass the job id to be resumed"))
+	}
+	path := "/v2/jobs/" + c.Args().First() + "/resume"
+	if c.Bool("backfill") {
+		path += "?backfill=true"
+	}
+	resp, err := cli.HTTP.Post(path, nil)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(resp, &JobPresenter{}, "Job successfully resumed")
+}
+
 // TriggerPipelineRun triggers a job run based on a job ID
 func (cli *Client) TriggerPipelineRun(c *cli.Context) error {
 	if !c.Args().Present() {
@@ -250,3 +393,23 @@ func (cli *Client) TriggerPipelineRun(c *cli.Context) error {
 	err = cli.renderAPIResponse(resp, &run, "Pipeline run successfully triggered")
 	return err
 }
+
+// CancelPipelineRun cancels a pipeline run based on its ID
+func (cli *Client) CancelPipelineRun(c *cli.Context) error {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("Must pass the run id to be cancelled"))
+	}
+	resp, err := cli.HTTP.Post("/v2/pipeline/runs/"+c.Args().First()+"/cancel", nil)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	var run presenters.PipelineRunResource
+	err = cli.renderAPIResponse(resp, &run, "Pipeline run successfully cancelled")
+	return err
+}
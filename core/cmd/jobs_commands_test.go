@@ -386,6 +386,116 @@ func TestClient_DeleteJob(t *testing.T) {
 	requireJobsCount(t, app.JobORM(), 0)
 }
 
+func TestClient_PauseResumeJob(t *testing.T) {
+	t.Parallel()
+
+	app := startNewApplication(t, withConfigSet(func(c *configtest.TestGeneralConfig) {
+		c.Overrides.SetTriggerFallbackDBPollInterval(100 * time.Millisecond)
+		c.Overrides.EVMDisabled = null.BoolFrom(false)
+		c.Overrides.GlobalEvmNonceAutoSync = null.BoolFrom(false)
+		c.Overrides.GlobalBalanceMonitorEnabled = null.BoolFrom(false)
+		c.Overrides.GlobalGasEstimatorMode = null.StringFrom("FixedPrice")
+	}))
+	client, r := app.NewClientAndRenderer()
+
+	// Create the job
+	fs := flag.NewFlagSet("", flag.ExitOnError)
+	fs.Parse([]string{"../testdata/tomlspecs/direct-request-spec.toml"})
+	err := client.CreateJob(cli.NewContext(nil, fs, nil))
+	require.NoError(t, err)
+	require.NotEmpty(t, r.Renders)
+
+	output := *r.Renders[0].(*cmd.JobPresenter)
+	jobs, _, err := app.JobORM().FindJobs(0, 1000)
+	require.NoError(t, err)
+	jobID := jobs[0].ID
+	cltest.AwaitJobActive(t, app.JobSpawner(), jobID, 3*time.Second)
+
+	// Must supply job id
+	set := flag.NewFlagSet("test", 0)
+	c := cli.NewContext(nil, set, nil)
+	require.Equal(t, "must pass the job id to be paused", client.PauseJob(c).Error())
+	require.Equal(t, "must pass the job id to be resumed", client.ResumeJob(c).Error())
+
+	set = flag.NewFlagSet("test", 0)
+	set.Parse([]string{output.ID})
+	c = cli.NewContext(nil, set, nil)
+	require.NoError(t, client.PauseJob(c))
+
+	paused := *r.Renders[len(r.Renders)-1].(*cmd.JobPresenter)
+	assert.True(t, paused.PausedAt.Valid)
+
+	set = flag.NewFlagSet("test", 0)
+	set.Bool("backfill", false, "")
+	set.Parse([]string{output.ID})
+	c = cli.NewContext(nil, set, nil)
+	require.NoError(t, client.ResumeJob(c))
+
+	resumed := *r.Renders[len(r.Renders)-1].(*cmd.JobPresenter)
+	assert.False(t, resumed.PausedAt.Valid)
+	cltest.AwaitJobActive(t, app.JobSpawner(), jobID, 3*time.Second)
+}
+
+func TestClient_UpdateRollbackJob(t *testing.T) {
+	t.Parallel()
+
+	app := startNewApplication(t, withConfigSet(func(c *configtest.TestGeneralConfig) {
+		c.Overrides.SetTriggerFallbackDBPollInterval(100 * time.Millisecond)
+		c.Overrides.EVMDisabled = null.BoolFrom(false)
+		c.Overrides.GlobalEvmNonceAutoSync = null.BoolFrom(false)
+		c.Overrides.GlobalBalanceMonitorEnabled = null.BoolFrom(false)
+		c.Overrides.GlobalGasEstimatorMode = null.StringFrom("FixedPrice")
+	}))
+	client, r := app.NewClientAndRenderer()
+
+	// Create the job
+	fs := flag.NewFlagSet("", flag.ExitOnError)
+	fs.Parse([]string{"../testdata/tomlspecs/direct-request-spec.toml"})
+	err := client.CreateJob(cli.NewContext(nil, fs, nil))
+	require.NoError(t, err)
+	require.NotEmpty(t, r.Renders)
+
+	output := *r.Renders[0].(*cmd.JobPresenter)
+	jobs, _, err := app.JobORM().FindJobs(0, 1000)
+	require.NoError(t, err)
+	jobID := jobs[0].ID
+	cltest.AwaitJobActive(t, app.JobSpawner(), jobID, 3*time.Second)
+
+	// Must supply job id
+	set := flag.NewFlagSet("test", 0)
+	c := cli.NewContext(nil, set, nil)
+	require.Equal(t, "must pass the job id, and TOML or filepath", client.UpdateJob(c).Error())
+	require.Equal(t, "must provide the id of the job", client.ListJobVersions(c).Error())
+	require.Equal(t, "must pass the job id and the version to roll back to", client.RollbackJob(c).Error())
+
+	set = flag.NewFlagSet("test", 0)
+	set.Parse([]string{output.ID, "../testdata/tomlspecs/direct-request-spec.toml"})
+	c = cli.NewContext(nil, set, nil)
+	require.NoError(t, client.UpdateJob(c))
+
+	updated := *r.Renders[len(r.Renders)-1].(*cmd.JobPresenter)
+	assert.Equal(t, int32(2), updated.PipelineSpec.Version)
+	cltest.AwaitJobActive(t, app.JobSpawner(), jobID, 3*time.Second)
+
+	set = flag.NewFlagSet("test", 0)
+	set.Parse([]string{output.ID})
+	c = cli.NewContext(nil, set, nil)
+	require.NoError(t, client.ListJobVersions(c))
+
+	versions := *r.Renders[len(r.Renders)-1].(*cmd.JobVersionPresenters)
+	require.Len(t, versions, 2)
+	assert.True(t, versions[0].Current)
+
+	set = flag.NewFlagSet("test", 0)
+	set.Parse([]string{output.ID, "1"})
+	c = cli.NewContext(nil, set, nil)
+	require.NoError(t, client.RollbackJob(c))
+
+	rolledBack := *r.Renders[len(r.Renders)-1].(*cmd.JobPresenter)
+	assert.Equal(t, int32(1), rolledBack.PipelineSpec.Version)
+	cltest.AwaitJobActive(t, app.JobSpawner(), jobID, 3*time.Second)
+}
+
 func requireJobsCount(t *testing.T, orm job.ORM, expected int) {
 	jobs, _, err := orm.FindJobs(0, 1000)
 	require.NoError(t, err)
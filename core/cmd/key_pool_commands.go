@@ -0,0 +1,133 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	"github.com/urfave/cli"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/core/web"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+type KeyPoolPresenter struct {
+	presenters.KeyPoolResource
+}
+
+func (p *KeyPoolPresenter) ToRows() [][]string {
+	var rows [][]string
+	for _, key := range p.Keys {
+		rows = append(rows, []string{
+			p.ID,
+			string(p.Strategy),
+			key.Address.Hex(),
+			strconv.Itoa(int(key.Weight)),
+		})
+	}
+	return rows
+}
+
+var keyPoolHeaders = []string{"Name", "Strategy", "Key", "Weight"}
+
+// RenderTable implements TableRenderer
+func (p *KeyPoolPresenter) RenderTable(rt RendererTable) error {
+	table := rt.newTable(keyPoolHeaders)
+	table.AppendBulk(p.ToRows())
+	render("Key Pool", table)
+	return nil
+}
+
+type KeyPoolPresenters []KeyPoolPresenter
+
+// RenderTable implements TableRenderer
+func (ps KeyPoolPresenters) RenderTable(rt RendererTable) error {
+	table := rt.newTable(keyPoolHeaders)
+	for _, p := range ps {
+		table.AppendBulk(p.ToRows())
+	}
+	render("Key Pools", table)
+	return nil
+}
+
+// ListKeyPools lists the named pools of sending keys
+func (cli *Client) ListKeyPools(c *cli.Context) (err error) {
+	resp, err := cli.HTTP.Get("/v2/key_pools")
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(resp, &KeyPoolPresenters{})
+}
+
+// SetKeyPool creates the key pool with the given name, or replaces the
+// strategy and keys of the existing one
+func (cli *Client) SetKeyPool(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("must pass the name of the key pool"))
+	}
+	request := web.KeyPoolRequest{
+		Name:     c.Args().First(),
+		Strategy: c.String("strategy"),
+	}
+	for _, key := range c.StringSlice("key") {
+		// Keys are given as ADDRESS or ADDRESS:WEIGHT
+		parts := strings.SplitN(key, ":", 2)
+		if !common.IsHexAddress(parts[0]) {
+			return cli.errorOut(errors.Errorf("invalid key address: %s", parts[0]))
+		}
+		poolKey := presenters.KeyPoolKey{Address: common.HexToAddress(parts[0])}
+		if len(parts) == 2 {
+			weight, err2 := strconv.ParseInt(parts[1], 10, 32)
+			if err2 != nil {
+				return cli.errorOut(errors.Wrapf(err2, "invalid weight of key %s", parts[0]))
+			}
+			poolKey.Weight = int32(weight)
+		}
+		request.Keys = append(request.Keys, poolKey)
+	}
+
+	body, err := json.Marshal(request)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	resp, err := cli.HTTP.Post("/v2/key_pools", bytes.NewReader(body))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(resp, &KeyPoolPresenter{}, "Key pool updated")
+}
+
+// DeleteKeyPool deletes the key pool with the given name
+func (cli *Client) DeleteKeyPool(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("must pass the name of the key pool to be deleted"))
+	}
+	resp, err := cli.HTTP.Delete("/v2/key_pools/" + c.Args().First())
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	_, err = cli.parseResponse(resp)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+
+	fmt.Printf("Key pool %v deleted\n", c.Args().First())
+	return nil
+}
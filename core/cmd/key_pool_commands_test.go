@@ -0,0 +1,53 @@
+package cmd_test
+
+import (
+	"flag"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli"
+
+	"github.com/smartcontractkit/chainlink/core/cmd"
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/services/bulletprooftxmanager"
+)
+
+func TestClient_KeyPools(t *testing.T) {
+	t.Parallel()
+
+	app := startNewApplication(t)
+	client, r := app.NewClientAndRenderer()
+
+	_, addr1 := cltest.MustInsertRandomKey(t, app.KeyStore.Eth())
+	_, addr2 := cltest.MustInsertRandomKey(t, app.KeyStore.Eth())
+
+	set := flag.NewFlagSet("test", 0)
+	cli.StringFlag{Name: "strategy"}.Apply(set)
+	cli.StringSliceFlag{Name: "key"}.Apply(set)
+	require.NoError(t, set.Parse([]string{"--strategy", "weighted", "--key", addr1.Hex() + ":3", "--key", addr2.Hex(), "vrf"}))
+	require.NoError(t, client.SetKeyPool(cli.NewContext(nil, set, nil)))
+
+	pool := *r.Renders[0].(*cmd.KeyPoolPresenter)
+	assert.Equal(t, "vrf", pool.ID)
+	assert.Equal(t, bulletprooftxmanager.KeyPoolStrategyWeighted, pool.Strategy)
+	assert.Len(t, pool.Keys, 2)
+
+	set = flag.NewFlagSet("test", 0)
+	cli.StringFlag{Name: "strategy"}.Apply(set)
+	cli.StringSliceFlag{Name: "key"}.Apply(set)
+	require.NoError(t, set.Parse([]string{"--strategy", "weighted", "--key", "0xzz", "ocr"}))
+	require.Error(t, client.SetKeyPool(cli.NewContext(nil, set, nil)))
+
+	require.NoError(t, client.ListKeyPools(cltest.EmptyCLIContext()))
+	pools := *r.Renders[1].(*cmd.KeyPoolPresenters)
+	require.Len(t, pools, 1)
+	assert.Equal(t, "vrf", pools[0].ID)
+
+	set = flag.NewFlagSet("test", 0)
+	require.NoError(t, set.Parse([]string{"vrf"}))
+	require.NoError(t, client.DeleteKeyPool(cli.NewContext(nil, set, nil)))
+
+	_, err := app.BPTXMORM().FindKeyPool("vrf")
+	assert.ErrorIs(t, err, bulletprooftxmanager.ErrKeyPoolNotFound)
+}
@@ -28,6 +28,7 @@ import (
 
 	"github.com/smartcontractkit/sqlx"
 
+	"github.com/smartcontractkit/chainlink/core/audit"
 	"github.com/smartcontractkit/chainlink/core/config"
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/services/bulletprooftxmanager"
@@ -294,7 +295,17 @@ func (cli *Client) RebroadcastTransactions(c *clipkg.Context) (err error) {
 	}
 	ec := bulletprooftxmanager.NewEthConfirmer(app.GetSqlxDB(), ethClient, chain.Config(), keyStore.Eth(), keyStates, nil, nil, chain.Logger())
 	err = ec.ForceRebroadcast(beginningNonce, endingNonce, gasPriceWei, address, overrideGasLimit)
-	return cli.errorOut(err)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	app.AuditLogger().Record(audit.CLIActor(), audit.ActionTxsRebroadcast, audit.KeyTarget("eth", address.Hex()), audit.NewDiff(nil, map[string]interface{}{
+		"evmChainID":     chain.ID().String(),
+		"beginningNonce": beginningNonce,
+		"endingNonce":    endingNonce,
+		"gasPriceWei":    gasPriceWei,
+		"gasLimit":       overrideGasLimit,
+	}))
+	return nil
 }
 
 type HealthCheckPresenter struct {
@@ -631,8 +642,12 @@ func insertFixtures(config config.GeneralConfig, pathToFixtures string) (err err
 	return err
 }
 
-// DeleteUser is run locally to remove the User row from the node's database.
+// DeleteUser is run locally to remove a User row from the node's database.
 func (cli *Client) DeleteUser(c *clipkg.Context) (err error) {
+	email := c.String("email")
+	if email == "" {
+		return cli.errorOut(errors.New("must specify an email address (--email)"))
+	}
 	app, err := cli.AppFactory.NewApplication(cli.Config)
 	if err != nil {
 		return cli.errorOut(errors.Wrap(err, "creating application"))
@@ -642,17 +657,15 @@ func (cli *Client) DeleteUser(c *clipkg.Context) (err error) {
 			err = multierr.Append(err, serr)
 		}
 	}()
-	orm := app.SessionORM()
-	user, err := orm.FindUser()
-	if err == nil {
-		app.GetLogger().Info("No such API user ", user.Email)
+	if err = app.SessionORM().DeleteUser(email); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			app.GetLogger().Info("No such API user ", email)
+		}
 		return err
 	}
-	err = orm.DeleteUser()
-	if err == nil {
-		app.GetLogger().Info("Deleted API user ", user.Email)
-	}
-	return err
+	app.AuditLogger().Record(audit.CLIActor(), audit.ActionUserDeleted, email, nil)
+	app.GetLogger().Info("Deleted API user ", email)
+	return nil
 }
 
 // SetNextNonce manually updates the keys.next_nonce field for the given key with the given nonce value
@@ -681,5 +694,8 @@ func (cli *Client) SetNextNonce(c *clipkg.Context) error {
 	if rowsAffected == 0 {
 		return cli.errorOut(fmt.Errorf("no key found matching address %s", addressHex))
 	}
+	audit.NewAuditLogger(db, cli.Logger, cli.Config).Record(audit.CLIActor(), audit.ActionNonceSet, audit.KeyTarget("eth", gethCommon.BytesToAddress(address).Hex()), audit.NewDiff(nil, map[string]interface{}{
+		"nextNonce": nextNonce,
+	}))
 	return nil
 }
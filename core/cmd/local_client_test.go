@@ -2,6 +2,7 @@ package cmd_test
 
 import (
 	"database/sql"
+	"encoding/json"
 	"flag"
 	"fmt"
 	"math/big"
@@ -11,6 +12,8 @@ import (
 	"testing"
 	"time"
 
+	"github.com/smartcontractkit/chainlink/core/audit"
+	auditmocks "github.com/smartcontractkit/chainlink/core/audit/mocks"
 	"github.com/smartcontractkit/chainlink/core/cmd"
 	cmdMocks "github.com/smartcontractkit/chainlink/core/cmd/mocks"
 	"github.com/smartcontractkit/chainlink/core/config"
@@ -194,7 +197,7 @@ func TestClient_RunNodeWithPasswords(t *testing.T) {
 			keyStore := cltest.NewKeyStore(t, db, cfg)
 			sessionORM := sessions.NewORM(db, time.Minute, logger.TestLogger(t))
 			// Clear out fixture
-			err := sessionORM.DeleteUser()
+			err := sessionORM.DeleteUser(cltest.APIEmail)
 			require.NoError(t, err)
 
 			app := new(mocks.Application)
@@ -301,7 +304,7 @@ func TestClient_RunNodeWithAPICredentialsFile(t *testing.T) {
 			db := pgtest.NewSqlxDB(t)
 			sessionORM := sessions.NewORM(db, time.Minute, logger.TestLogger(t))
 			// Clear out fixture
-			err := sessionORM.DeleteUser()
+			err := sessionORM.DeleteUser(cltest.APIEmail)
 			require.NoError(t, err)
 			keyStore := cltest.NewKeyStore(t, db, cfg)
 			_, err = keyStore.Eth().Create(&cltest.FixtureChainID)
@@ -405,6 +408,12 @@ func TestClient_RebroadcastTransactions_BPTXM(t *testing.T) {
 	app.On("GetKeyStore").Return(keyStore)
 	app.On("Stop").Return(nil)
 	app.On("ID").Maybe().Return(uuid.NewV4())
+	auditLogger := new(auditmocks.AuditLogger)
+	auditLogger.Test(t)
+	auditLogger.On("Record", mock.MatchedBy(func(actor audit.Actor) bool {
+		return actor.AuthMethod == audit.AuthMethodCLI
+	}), audit.ActionTxsRebroadcast, audit.KeyTarget("eth", fromAddress.Hex()), mock.Anything).Once()
+	app.On("AuditLogger").Return(auditLogger)
 	ethClient := cltest.NewEthClientMockWithDefaultChain(t)
 	app.On("GetChainSet").Return(cltest.NewChainSetMockWithOneChain(t, ethClient, evmtest.NewChainScopedConfig(t, config))).Maybe()
 	ethClient.On("Dial", mock.Anything).Return(nil)
@@ -430,6 +439,7 @@ func TestClient_RebroadcastTransactions_BPTXM(t *testing.T) {
 
 	app.AssertExpectations(t)
 	ethClient.AssertExpectations(t)
+	auditLogger.AssertExpectations(t)
 }
 
 func TestClient_RebroadcastTransactions_OutsideRange_BPTXM(t *testing.T) {
@@ -477,6 +487,9 @@ func TestClient_RebroadcastTransactions_OutsideRange_BPTXM(t *testing.T) {
 			app.On("GetKeyStore").Return(keyStore)
 			app.On("Stop").Return(nil)
 			app.On("ID").Maybe().Return(uuid.NewV4())
+			auditLogger := new(auditmocks.AuditLogger)
+			auditLogger.On("Record", mock.Anything, audit.ActionTxsRebroadcast, mock.Anything, mock.Anything)
+			app.On("AuditLogger").Return(auditLogger)
 			ethClient := cltest.NewEthClientMockWithDefaultChain(t)
 			ethClient.On("Dial", mock.Anything).Return(nil)
 			app.On("GetChainSet").Return(cltest.NewChainSetMockWithOneChain(t, ethClient, evmtest.NewChainScopedConfig(t, config))).Maybe()
@@ -532,4 +545,11 @@ func TestClient_SetNextNonce(t *testing.T) {
 	require.NoError(t, sqlxDB.Get(&state, `SELECT * FROM eth_key_states`))
 	require.NotNil(t, state.NextNonce)
 	require.Equal(t, int64(42), state.NextNonce)
+
+	var entry audit.Entry
+	require.NoError(t, sqlxDB.Get(&entry, `SELECT * FROM audit_log_entries`))
+	assert.Equal(t, audit.ActionNonceSet, entry.Action)
+	assert.Equal(t, audit.AuthMethodCLI, entry.AuthMethod)
+	assert.Equal(t, audit.KeyTarget("eth", fromAddress.Hex()), entry.Target)
+	assert.Equal(t, audit.Diff{"nextNonce": {New: json.Number("42")}}, entry.Diff)
 }
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"strconv"
 
 	evmtypes "github.com/smartcontractkit/chainlink/core/chains/evm/types"
 	"github.com/smartcontractkit/chainlink/core/utils"
@@ -19,12 +20,19 @@ type NodePresenter struct {
 }
 
 func (p *NodePresenter) ToRow() []string {
+	var lastHead string
+	if p.LastHead.Valid {
+		lastHead = strconv.FormatInt(p.LastHead.Int64, 10)
+	}
 	row := []string{
 		p.GetID(),
 		p.Name,
 		p.EVMChainID.ToInt().String(),
 		p.WSURL.ValueOrZero(),
 		p.HTTPURL.ValueOrZero(),
+		p.State,
+		lastHead,
+		strconv.FormatUint(p.ErrorCount, 10),
 		p.CreatedAt.String(),
 		p.UpdatedAt.String(),
 	}
@@ -33,7 +41,7 @@ func (p *NodePresenter) ToRow() []string {
 
 // RenderTable implements TableRenderer
 func (p NodePresenter) RenderTable(rt RendererTable) error {
-	headers := []string{"ID", "Name", "Chain ID", "Websocket URL", "Created", "Updated"}
+	headers := []string{"ID", "Name", "Chain ID", "Websocket URL", "HTTP URL", "State", "Last Head", "Errors", "Created", "Updated"}
 	rows := [][]string{}
 	rows = append(rows, p.ToRow())
 	renderList(headers, rows, rt.Writer)
@@ -45,7 +53,7 @@ type NodePresenters []NodePresenter
 
 // RenderTable implements TableRenderer
 func (ps NodePresenters) RenderTable(rt RendererTable) error {
-	headers := []string{"ID", "Name", "Chain ID", "Websocket URL", "Created", "Updated"}
+	headers := []string{"ID", "Name", "Chain ID", "Websocket URL", "HTTP URL", "State", "Last Head", "Errors", "Created", "Updated"}
 	rows := [][]string{}
 
 	for _, p := range ps {
@@ -470,6 +470,34 @@ func TestClient_RunOCRJob_JobNotFound(t *testing.T) {
 	assert.Contains(t, err.Error(), "parseResponse error: Error; job ID 1")
 }
 
+func TestClient_CancelPipelineRun_MissingRunID(t *testing.T) {
+	t.Parallel()
+
+	app := startNewApplication(t)
+	client, _ := app.NewClientAndRenderer()
+
+	set := flag.NewFlagSet("test", 0)
+	c := cli.NewContext(nil, set, nil)
+
+	require.NoError(t, client.RemoteLogin(c))
+	assert.EqualError(t, client.CancelPipelineRun(c), "Must pass the run id to be cancelled")
+}
+
+func TestClient_CancelPipelineRun_RunNotFound(t *testing.T) {
+	t.Parallel()
+
+	app := startNewApplication(t)
+	client, _ := app.NewClientAndRenderer()
+
+	set := flag.NewFlagSet("test", 0)
+	set.Parse([]string{"1"})
+	c := cli.NewContext(nil, set, nil)
+
+	require.NoError(t, client.RemoteLogin(c))
+	err := client.CancelPipelineRun(c)
+	assert.Contains(t, err.Error(), "pipeline run not found")
+}
+
 func TestClient_AutoLogin(t *testing.T) {
 	t.Parallel()
 
@@ -216,7 +216,7 @@ func (rt RendererTable) renderConfigPatchResponse(config *web.ConfigPatchRespons
 }
 
 func (rt RendererTable) renderPipelineRun(run webpresenters.PipelineRunResource) error {
-	table := rt.newTable([]string{"ID", "Created At", "Finished At"})
+	table := rt.newTable([]string{"ID", "State", "Created At", "Finished At"})
 
 	var finishedAt string
 	if !run.FinishedAt.IsZero() {
@@ -225,6 +225,7 @@ func (rt RendererTable) renderPipelineRun(run webpresenters.PipelineRunResource)
 
 	row := []string{
 		run.GetID(),
+		string(run.State),
 		run.CreatedAt.String(),
 		finishedAt,
 	}
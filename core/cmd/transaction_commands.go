@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/smartcontractkit/chainlink/core/assets"
 	"github.com/smartcontractkit/chainlink/core/store/models"
@@ -79,6 +80,75 @@ func (cli *Client) ShowTransaction(c *cli.Context) (err error) {
 	return err
 }
 
+// CancelTransaction aborts the transaction with the given ID or attempt hash
+func (cli *Client) CancelTransaction(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("must pass the ID of the transaction, or the hash of one of its attempts"))
+	}
+	resp, err := cli.HTTP.Post("/v2/transactions/"+c.Args().First()+"/cancel", nil)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(resp, &EthTxPresenter{})
+}
+
+type NonceStatusPresenter struct {
+	JAID
+	presenters.NonceStatusResource
+}
+
+type NonceStatusPresenters []NonceStatusPresenter
+
+// RenderTable implements TableRenderer
+func (ps NonceStatusPresenters) RenderTable(rt RendererTable) error {
+	table := rt.newTable([]string{"Address", "EVM Chain ID", "Next Nonce", "Latest Nonce", "Pending Nonce", "In Flight", "Gaps", "Chain Ahead"})
+	for _, p := range ps {
+		gaps := make([]string, len(p.Gaps))
+		for i, gap := range p.Gaps {
+			gaps[i] = fmt.Sprint(gap)
+		}
+		table.Append([]string{
+			p.Address,
+			p.EVMChainID.String(),
+			fmt.Sprint(p.NextNonce),
+			fmt.Sprint(p.LatestNonce),
+			fmt.Sprint(p.PendingNonce),
+			fmt.Sprint(p.InFlight),
+			strings.Join(gaps, ", "),
+			fmt.Sprint(p.ChainAhead),
+		})
+	}
+
+	render("Nonce Statuses", table)
+	return nil
+}
+
+// NonceStatus checks the nonces of the node's keys against the chain, taking
+// an optional evmChainID parameter
+func (cli *Client) NonceStatus(c *cli.Context) (err error) {
+	path := "/v2/keys/eth/nonce_status"
+	if c.IsSet("evmChainID") {
+		path += "?evmChainID=" + c.String("evmChainID")
+	}
+	resp, err := cli.HTTP.Get(path)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(resp, &NonceStatusPresenters{})
+}
+
 // IndexTxAttempts returns the list of transactions in descending order,
 // taking an optional page parameter
 func (cli *Client) IndexTxAttempts(c *cli.Context) error {
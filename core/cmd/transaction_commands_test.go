@@ -2,6 +2,7 @@ package cmd_test
 
 import (
 	"flag"
+	"strconv"
 	"testing"
 
 	"github.com/smartcontractkit/chainlink/core/cmd"
@@ -69,6 +70,48 @@ func TestClient_ShowTransaction(t *testing.T) {
 	assert.Equal(t, &tx.FromAddress, renderedTx.From)
 }
 
+func TestClient_CancelTransaction(t *testing.T) {
+	t.Parallel()
+
+	app := startNewApplication(t)
+	client, r := app.NewClientAndRenderer()
+
+	_, from := cltest.MustAddRandomKeyToKeystore(t, app.KeyStore.Eth())
+	etx := cltest.MustInsertUnstartedEthTx(t, app.BPTXMORM(), from)
+
+	set := flag.NewFlagSet("test cancel tx", 0)
+	set.Parse([]string{strconv.FormatInt(etx.ID, 10)})
+	c := cli.NewContext(nil, set, nil)
+	require.NoError(t, client.CancelTransaction(c))
+
+	renderedTx := *r.Renders[0].(*cmd.EthTxPresenter)
+	assert.Equal(t, string(bulletprooftxmanager.EthTxFatalError), renderedTx.State)
+
+	set = flag.NewFlagSet("test cancel tx", 0)
+	c = cli.NewContext(nil, set, nil)
+	require.Error(t, client.CancelTransaction(c))
+}
+
+func TestClient_NonceStatus(t *testing.T) {
+	t.Parallel()
+
+	app := startNewApplication(t)
+	client, r := app.NewClientAndRenderer()
+
+	set := flag.NewFlagSet("test nonce status", 0)
+	c := cli.NewContext(nil, set, nil)
+	require.NoError(t, client.NonceStatus(c))
+
+	renderedStatuses := *r.Renders[0].(*cmd.NonceStatusPresenters)
+	assert.Len(t, renderedStatuses, 0)
+
+	set = flag.NewFlagSet("test nonce status", 0)
+	set.String("evmChainID", "", "")
+	require.NoError(t, set.Set("evmChainID", "foo"))
+	c = cli.NewContext(nil, set, nil)
+	require.Error(t, client.NonceStatus(c))
+}
+
 func TestClient_IndexTxAttempts(t *testing.T) {
 	t.Parallel()
 
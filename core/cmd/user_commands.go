@@ -0,0 +1,161 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"strings"
+
+	"github.com/pkg/errors"
+	clipkg "github.com/urfave/cli"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/core/sessions"
+	"github.com/smartcontractkit/chainlink/core/web"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+type UserPresenter struct {
+	JAID
+	presenters.UserResource
+}
+
+// RenderTable implements TableRenderer
+func (p *UserPresenter) RenderTable(rt RendererTable) error {
+	table := rt.newTable([]string{"Email", "Role", "Created at"})
+	table.Append(p.ToRow())
+	render("User", table)
+	return nil
+}
+
+func (p *UserPresenter) ToRow() []string {
+	return []string{
+		p.Email,
+		p.Role,
+		p.CreatedAt.String(),
+	}
+}
+
+type UserPresenters []UserPresenter
+
+// RenderTable implements TableRenderer
+func (ps UserPresenters) RenderTable(rt RendererTable) error {
+	table := rt.newTable([]string{"Email", "Role", "Created at"})
+	for _, p := range ps {
+		table.Append(p.ToRow())
+	}
+	render("Users", table)
+	return nil
+}
+
+// ListUsers lists all users of the node
+func (cli *Client) ListUsers(c *clipkg.Context) (err error) {
+	resp, err := cli.HTTP.Get("/v2/users", nil)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(resp, &UserPresenters{})
+}
+
+// CreateUser creates a new user with the given email and role, prompting for
+// their password unless a password file is given
+func (cli *Client) CreateUser(c *clipkg.Context) (err error) {
+	email := c.String("email")
+	if email == "" {
+		return cli.errorOut(errors.New("must specify an email address (--email)"))
+	}
+	role, err := sessions.GetUserRole(c.String("role"))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+
+	var password string
+	if passwordFile := c.String("password"); passwordFile != "" {
+		b, rerr := ioutil.ReadFile(passwordFile)
+		if rerr != nil {
+			return cli.errorOut(errors.Wrap(rerr, "could not read password file"))
+		}
+		password = strings.TrimSpace(string(b))
+	} else {
+		password = cli.PasswordPrompter.Prompt()
+	}
+
+	request := web.CreateUserRequest{
+		Email:    email,
+		Password: password,
+		Role:     string(role),
+	}
+	requestData, err := json.Marshal(request)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+
+	resp, err := cli.HTTP.Post("/v2/users", bytes.NewBuffer(requestData))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(resp, &UserPresenter{}, "Created user")
+}
+
+// ChangeRole changes the role of a user
+func (cli *Client) ChangeRole(c *clipkg.Context) (err error) {
+	email := c.String("email")
+	if email == "" {
+		return cli.errorOut(errors.New("must specify an email address (--email)"))
+	}
+	role, err := sessions.GetUserRole(c.String("newrole"))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+
+	requestData, err := json.Marshal(web.UpdateRoleRequest{Role: string(role)})
+	if err != nil {
+		return cli.errorOut(err)
+	}
+
+	resp, err := cli.HTTP.Patch("/v2/users/"+url.PathEscape(email), bytes.NewBuffer(requestData))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(resp, &UserPresenter{}, "Updated user")
+}
+
+// RemoveUser deletes a user and their sessions
+func (cli *Client) RemoveUser(c *clipkg.Context) (err error) {
+	email := c.String("email")
+	if email == "" {
+		return cli.errorOut(errors.New("must specify an email address (--email)"))
+	}
+
+	resp, err := cli.HTTP.Delete("/v2/users/" + url.PathEscape(email))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	_, err = cli.parseResponse(resp)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+
+	fmt.Printf("User %v deleted\n", email)
+	return nil
+}
@@ -0,0 +1,51 @@
+package cmd_test
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/core/cmd"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUserPresenter_RenderTable(t *testing.T) {
+	t.Parallel()
+
+	var (
+		email     = "oncall@chain.link"
+		role      = "view"
+		createdAt = time.Date(2021, 11, 1, 12, 0, 0, 0, time.UTC)
+		buffer    = bytes.NewBufferString("")
+		r         = cmd.RendererTable{Writer: buffer}
+	)
+
+	p := cmd.UserPresenter{
+		UserResource: presenters.UserResource{
+			JAID:      presenters.NewJAID(email),
+			Email:     email,
+			Role:      role,
+			CreatedAt: createdAt,
+		},
+	}
+
+	// Render a single resource
+	require.NoError(t, p.RenderTable(r))
+
+	output := buffer.String()
+	assert.Contains(t, output, email)
+	assert.Contains(t, output, role)
+	assert.Contains(t, output, "2021-11-01 12:00:00")
+
+	// Render many resources
+	buffer.Reset()
+	ps := cmd.UserPresenters{p}
+	require.NoError(t, ps.RenderTable(r))
+
+	output = buffer.String()
+	assert.Contains(t, output, email)
+	assert.Contains(t, output, role)
+	assert.Contains(t, output, "2021-11-01 12:00:00")
+}
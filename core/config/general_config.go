@@ -45,6 +45,7 @@ type GeneralOnlyConfig interface {
 	AdvisoryLockCheckInterval() time.Duration
 	AdvisoryLockID() int64
 	AllowOrigins() string
+	AuditLogForwardToLogger() bool
 	AuthenticatedRateLimit() int64
 	AuthenticatedRateLimitPeriod() models.Duration
 	AutoPprofEnabled() bool
@@ -61,6 +62,7 @@ type GeneralOnlyConfig interface {
 	AutoPprofGoroutineThreshold() int
 	BlockBackfillDepth() uint64
 	BlockBackfillSkip() bool
+	BlockTriggerMaxCatchUpBlocks() uint32
 	BridgeResponseURL() *url.URL
 	CertFile() string
 	ClientNodeURL() string
@@ -79,6 +81,10 @@ type GeneralOnlyConfig interface {
 	DefaultMaxHTTPAttempts() uint
 	Dev() bool
 	EVMDisabled() bool
+	EthNonceCheckFillGaps() bool
+	EthNonceCheckInterval() time.Duration
+	EthNonceCheckResync() bool
+	EthTxEventsWebhookURL() *url.URL
 	EthereumDisabled() bool
 	EthereumHTTPURL() *url.URL
 	EthereumSecondaryURLs() []url.URL
@@ -122,6 +128,9 @@ type GeneralOnlyConfig interface {
 	LogToDisk() bool
 	LogUnixTimestamps() bool
 	MigrateDatabase() bool
+	NodeOutOfSyncThreshold() uint32
+	NodePollInterval() time.Duration
+	NodeSelectionMode() string
 	ORMMaxIdleConns() int
 	ORMMaxOpenConns() int
 	Port() uint16
@@ -180,6 +189,7 @@ type GlobalConfig interface {
 	GlobalEvmGasPriceDefault() (*big.Int, bool)
 	GlobalEvmGasTipCapDefault() (*big.Int, bool)
 	GlobalEvmGasTipCapMinimum() (*big.Int, bool)
+	GlobalEvmGenerateAccessLists() (bool, bool)
 	GlobalEvmHeadTrackerHistoryDepth() (uint32, bool)
 	GlobalEvmHeadTrackerMaxBufferSize() (uint32, bool)
 	GlobalEvmHeadTrackerSamplingInterval() (time.Duration, bool)
@@ -189,10 +199,21 @@ type GlobalConfig interface {
 	GlobalEvmMaxQueuedTransactions() (uint64, bool)
 	GlobalEvmMinGasPriceWei() (*big.Int, bool)
 	GlobalEvmNonceAutoSync() (bool, bool)
+	GlobalEvmPrivateRelayEnabled() (bool, bool)
+	GlobalEvmPrivateRelayFallbackBlocks() (uint32, bool)
+	GlobalEvmPrivateRelayURL() (string, bool)
 	GlobalEvmRPCDefaultBatchSize() (uint32, bool)
+	GlobalFeeHistoryEstimatorBlockCount() (uint16, bool)
+	GlobalFeeHistoryEstimatorRewardPercentile() (uint16, bool)
 	GlobalFlagsContractAddress() (string, bool)
 	GlobalGasEstimatorMode() (string, bool)
 	GlobalChainType() (string, bool)
+	GlobalKeyFunderEnabled() (bool, bool)
+	GlobalKeyFunderFundingKeyMinBalanceWei() (*big.Int, bool)
+	GlobalKeyFunderMaxDailyWei() (*big.Int, bool)
+	GlobalKeyFunderMinBalanceWei() (*big.Int, bool)
+	GlobalKeyFunderMinInterval() (time.Duration, bool)
+	GlobalKeyFunderTargetBalanceWei() (*big.Int, bool)
 	GlobalLinkContractAddress() (string, bool)
 	GlobalMinIncomingConfirmations() (uint32, bool)
 	GlobalMinRequiredOutgoingConfirmations() (uint64, bool)
@@ -350,6 +371,12 @@ func (c *generalConfig) Validate() error {
 		return errors.Errorf("unrecognised value for DATABASE_LOCKING_MODE: %s (valid options are 'dual', 'lease', 'advisorylock' or 'none')", c.DatabaseLockingMode())
 	}
 
+	switch c.NodeSelectionMode() {
+	case "RoundRobin", "HighestHead", "LowestLatency", "PriorityLevel":
+	default:
+		return errors.Errorf("unrecognised value for NODE_SELECTION_MODE: %s (valid options are 'RoundRobin', 'HighestHead', 'LowestLatency' or 'PriorityLevel')", c.NodeSelectionMode())
+	}
+
 	if c.LeaseLockRefreshInterval() > c.LeaseLockDuration()/2 {
 		return errors.Errorf("LEASE_LOCK_REFRESH_INTERVAL must be less than or equal to half of LEASE_LOCK_DURATION (got LEASE_LOCK_REFRESH_INTERVAL=%s, LEASE_LOCK_DURATION=%s)", c.LeaseLockRefreshInterval().String(), c.LeaseLockDuration().String())
 	}
@@ -395,6 +422,12 @@ func (c *generalConfig) AuthenticatedRateLimitPeriod() models.Duration {
 	return models.MustMakeDuration(c.getWithFallback("AuthenticatedRateLimitPeriod", ParseDuration).(time.Duration))
 }
 
+// AuditLogForwardToLogger enables emitting each audit log entry as a
+// structured log line, in addition to storing it in the database
+func (c *generalConfig) AuditLogForwardToLogger() bool {
+	return c.viper.GetBool(EnvVarName("AuditLogForwardToLogger"))
+}
+
 func (c *generalConfig) AutoPprofEnabled() bool {
 	return c.viper.GetBool(EnvVarName("AutoPprofEnabled"))
 }
@@ -458,6 +491,12 @@ func (c *generalConfig) BlockBackfillSkip() bool {
 	return c.getWithFallback("BlockBackfillSkip", ParseBool).(bool)
 }
 
+// BlockTriggerMaxCatchUpBlocks is the maximum number of missed blocks that a
+// block trigger job with runMissedBlocks runs for on each new head
+func (c *generalConfig) BlockTriggerMaxCatchUpBlocks() uint32 {
+	return c.getWithFallback("BlockTriggerMaxCatchUpBlocks", ParseUint32).(uint32)
+}
+
 // BridgeResponseURL represents the URL for bridges to send a response to.
 func (c *generalConfig) BridgeResponseURL() *url.URL {
 	return c.getWithFallback("BridgeResponseURL", ParseURL).(*url.URL)
@@ -542,6 +581,27 @@ func (c *generalConfig) MigrateDatabase() bool {
 	return c.viper.GetBool(EnvVarName("MigrateDatabase"))
 }
 
+// NodeOutOfSyncThreshold is the number of blocks a primary node may lag
+// behind the highest head seen across all primaries on the same chain before
+// it is considered out of sync and excluded from node selection. Set to 0 to
+// disable sync checking.
+func (c *generalConfig) NodeOutOfSyncThreshold() uint32 {
+	return c.getWithFallback("NodeOutOfSyncThreshold", ParseUint32).(uint32)
+}
+
+// NodePollInterval controls how often each primary node is polled for its
+// latest head
+func (c *generalConfig) NodePollInterval() time.Duration {
+	return c.getWithFallback("NodePollInterval", ParseDuration).(time.Duration)
+}
+
+// NodeSelectionMode can be one of 'RoundRobin', 'HighestHead', 'LowestLatency'
+// or 'PriorityLevel'. It controls which live primary node is chosen to serve
+// each RPC call.
+func (c *generalConfig) NodeSelectionMode() string {
+	return c.getWithFallback("NodeSelectionMode", ParseString).(string)
+}
+
 // DefaultMaxHTTPAttempts defines the limit for HTTP requests.
 func (c *generalConfig) DefaultMaxHTTPAttempts() uint {
 	return uint(c.getWithFallback("DefaultMaxHTTPAttempts", ParseUint64).(uint64))
@@ -595,6 +655,38 @@ func (c *generalConfig) FMSimulateTransactions() bool {
 	return c.viper.GetBool(EnvVarName("FMSimulateTransactions"))
 }
 
+// EthNonceCheckFillGaps enables filling nonce gaps found by the nonce checker
+// with empty transactions
+func (c *generalConfig) EthNonceCheckFillGaps() bool {
+	return c.viper.GetBool(EnvVarName("EthNonceCheckFillGaps"))
+}
+
+// EthNonceCheckInterval is how often the nonce checker compares the nonces of
+// each key on chain with the local ones. Set to 0 to disable.
+func (c *generalConfig) EthNonceCheckInterval() time.Duration {
+	return c.getWithFallback("EthNonceCheckInterval", ParseDuration).(time.Duration)
+}
+
+// EthNonceCheckResync enables fast-forwarding the local next nonce of keys
+// that the nonce checker found were used by another wallet
+func (c *generalConfig) EthNonceCheckResync() bool {
+	return c.viper.GetBool(EnvVarName("EthNonceCheckResync"))
+}
+
+// EthTxEventsWebhookURL is the URL that transaction lifecycle events are
+// POSTed to as JSON, or nil if they are not sent anywhere.
+func (c *generalConfig) EthTxEventsWebhookURL() *url.URL {
+	rval := c.getWithFallback("EthTxEventsWebhookURL", ParseURL)
+	switch t := rval.(type) {
+	case nil:
+		return nil
+	case *url.URL:
+		return t
+	default:
+		panic(fmt.Sprintf("invariant: EthTxEventsWebhookURL returned as type %T", rval))
+	}
+}
+
 // EthereumURL represents the URL of the Ethereum node to connect Chainlink to.
 func (c *generalConfig) EthereumURL() string {
 	return c.viper.GetString(EnvVarName("EthereumURL"))
@@ -1188,6 +1280,13 @@ func (*generalConfig) GlobalEvmGasPriceDefault() (*big.Int, bool) {
 	}
 	return val.(*big.Int), ok
 }
+func (*generalConfig) GlobalEvmGenerateAccessLists() (bool, bool) {
+	val, ok := lookupEnv(EnvVarName("EvmGenerateAccessLists"), ParseBool)
+	if val == nil {
+		return false, false
+	}
+	return val.(bool), ok
+}
 func (*generalConfig) GlobalEvmHeadTrackerHistoryDepth() (uint32, bool) {
 	val, ok := lookupEnv(EnvVarName("EvmHeadTrackerHistoryDepth"), ParseUint32)
 	if val == nil {
@@ -1251,6 +1350,27 @@ func (*generalConfig) GlobalEvmNonceAutoSync() (bool, bool) {
 	}
 	return val.(bool), ok
 }
+func (*generalConfig) GlobalEvmPrivateRelayEnabled() (bool, bool) {
+	val, ok := lookupEnv(EnvVarName("EvmPrivateRelayEnabled"), ParseBool)
+	if val == nil {
+		return false, false
+	}
+	return val.(bool), ok
+}
+func (*generalConfig) GlobalEvmPrivateRelayFallbackBlocks() (uint32, bool) {
+	val, ok := lookupEnv(EnvVarName("EvmPrivateRelayFallbackBlocks"), ParseUint32)
+	if val == nil {
+		return 0, false
+	}
+	return val.(uint32), ok
+}
+func (*generalConfig) GlobalEvmPrivateRelayURL() (string, bool) {
+	val, ok := lookupEnv(EnvVarName("EvmPrivateRelayURL"), ParseString)
+	if val == nil {
+		return "", false
+	}
+	return val.(string), ok
+}
 func (*generalConfig) GlobalEvmRPCDefaultBatchSize() (uint32, bool) {
 	val, ok := lookupEnv(EnvVarName("EvmRPCDefaultBatchSize"), ParseUint32)
 	if val == nil {
@@ -1258,6 +1378,20 @@ func (*generalConfig) GlobalEvmRPCDefaultBatchSize() (uint32, bool) {
 	}
 	return val.(uint32), ok
 }
+func (*generalConfig) GlobalFeeHistoryEstimatorBlockCount() (uint16, bool) {
+	val, ok := lookupEnv(EnvVarName("FeeHistoryEstimatorBlockCount"), ParseUint16)
+	if val == nil {
+		return 0, false
+	}
+	return val.(uint16), ok
+}
+func (*generalConfig) GlobalFeeHistoryEstimatorRewardPercentile() (uint16, bool) {
+	val, ok := lookupEnv(EnvVarName("FeeHistoryEstimatorRewardPercentile"), ParseUint16)
+	if val == nil {
+		return 0, false
+	}
+	return val.(uint16), ok
+}
 func (*generalConfig) GlobalFlagsContractAddress() (string, bool) {
 	val, ok := lookupEnv(EnvVarName("FlagsContractAddress"), ParseString)
 	if val == nil {
@@ -1279,6 +1413,48 @@ func (*generalConfig) GlobalChainType() (string, bool) {
 	}
 	return val.(string), ok
 }
+func (*generalConfig) GlobalKeyFunderEnabled() (bool, bool) {
+	val, ok := lookupEnv(EnvVarName("KeyFunderEnabled"), ParseBool)
+	if val == nil {
+		return false, false
+	}
+	return val.(bool), ok
+}
+func (*generalConfig) GlobalKeyFunderFundingKeyMinBalanceWei() (*big.Int, bool) {
+	val, ok := lookupEnv(EnvVarName("KeyFunderFundingKeyMinBalanceWei"), ParseBigInt)
+	if val == nil {
+		return nil, false
+	}
+	return val.(*big.Int), ok
+}
+func (*generalConfig) GlobalKeyFunderMaxDailyWei() (*big.Int, bool) {
+	val, ok := lookupEnv(EnvVarName("KeyFunderMaxDailyWei"), ParseBigInt)
+	if val == nil {
+		return nil, false
+	}
+	return val.(*big.Int), ok
+}
+func (*generalConfig) GlobalKeyFunderMinBalanceWei() (*big.Int, bool) {
+	val, ok := lookupEnv(EnvVarName("KeyFunderMinBalanceWei"), ParseBigInt)
+	if val == nil {
+		return nil, false
+	}
+	return val.(*big.Int), ok
+}
+func (*generalConfig) GlobalKeyFunderMinInterval() (time.Duration, bool) {
+	val, ok := lookupEnv(EnvVarName("KeyFunderMinInterval"), ParseDuration)
+	if val == nil {
+		return 0, false
+	}
+	return val.(time.Duration), ok
+}
+func (*generalConfig) GlobalKeyFunderTargetBalanceWei() (*big.Int, bool) {
+	val, ok := lookupEnv(EnvVarName("KeyFunderTargetBalanceWei"), ParseBigInt)
+	if val == nil {
+		return nil, false
+	}
+	return val.(*big.Int), ok
+}
 func (*generalConfig) GlobalLinkContractAddress() (string, bool) {
 	val, ok := lookupEnv(EnvVarName("LinkContractAddress"), ParseString)
 	if val == nil {
@@ -23,6 +23,7 @@ type ConfigSchema struct {
 	AdvisoryLockCheckInterval                  time.Duration   `env:"ADVISORY_LOCK_CHECK_INTERVAL" default:"1s"`
 	AdvisoryLockID                             int64           `env:"ADVISORY_LOCK_ID" default:"1027321974924625846"`
 	AllowOrigins                               string          `env:"ALLOW_ORIGINS" default:"http://localhost:3000,http://localhost:6688"`
+	AuditLogForwardToLogger                    bool            `env:"AUDIT_LOG_FORWARD_TO_LOGGER" default:"false"`
 	AuthenticatedRateLimit                     int64           `env:"AUTHENTICATED_RATE_LIMIT" default:"1000"`
 	AuthenticatedRateLimitPeriod               time.Duration   `env:"AUTHENTICATED_RATE_LIMIT_PERIOD" default:"1m"`
 	AutoPprofEnabled                           bool            `env:"AUTO_PPROF_ENABLED" default:"false"`
@@ -45,6 +46,7 @@ type ConfigSchema struct {
 	BlockHistoryEstimatorBlockDelay            uint16          `env:"BLOCK_HISTORY_ESTIMATOR_BLOCK_DELAY"`
 	BlockHistoryEstimatorBlockHistorySize      uint16          `env:"BLOCK_HISTORY_ESTIMATOR_BLOCK_HISTORY_SIZE"`
 	BlockHistoryEstimatorTransactionPercentile uint16          `env:"BLOCK_HISTORY_ESTIMATOR_TRANSACTION_PERCENTILE"`
+	BlockTriggerMaxCatchUpBlocks               uint32          `env:"BLOCK_TRIGGER_MAX_CATCH_UP_BLOCKS" default:"100"`
 	BridgeResponseURL                          url.URL         `env:"BRIDGE_RESPONSE_URL"`
 	ChainType                                  string          `env:"CHAIN_TYPE"`
 	ClientNodeURL                              string          `env:"CLIENT_NODE_URL" default:"http://localhost:6688"`
@@ -63,6 +65,10 @@ type ConfigSchema struct {
 	DefaultMaxHTTPAttempts                     uint            `env:"MAX_HTTP_ATTEMPTS" default:"5"`
 	Dev                                        bool            `env:"CHAINLINK_DEV" default:"false"`
 	EVMDisabled                                bool            `env:"EVM_DISABLED" default:"false"`
+	EthNonceCheckFillGaps                      bool            `env:"ETH_NONCE_CHECK_FILL_GAPS" default:"false"`
+	EthNonceCheckInterval                      time.Duration   `env:"ETH_NONCE_CHECK_INTERVAL" default:"1m"`
+	EthNonceCheckResync                        bool            `env:"ETH_NONCE_CHECK_RESYNC" default:"false"`
+	EthTxEventsWebhookURL                      *url.URL        `env:"ETH_TX_EVENTS_WEBHOOK_URL"`
 	EthTxReaperInterval                        time.Duration   `env:"ETH_TX_REAPER_INTERVAL"`
 	EthTxReaperThreshold                       time.Duration   `env:"ETH_TX_REAPER_THRESHOLD"`
 	EthTxResendAfterThreshold                  time.Duration   `env:"ETH_TX_RESEND_AFTER_THRESHOLD"`
@@ -84,6 +90,7 @@ type ConfigSchema struct {
 	EvmGasPriceDefault                         *big.Int        `env:"ETH_GAS_PRICE_DEFAULT"`
 	EvmGasTipCapDefault                        *big.Int        `env:"EVM_GAS_TIP_CAP_DEFAULT"`
 	EvmGasTipCapMinimum                        *big.Int        `env:"EVM_GAS_TIP_CAP_MINIMUM"`
+	EvmGenerateAccessLists                     bool            `env:"EVM_GENERATE_ACCESS_LISTS"`
 	EvmHeadTrackerHistoryDepth                 uint            `env:"ETH_HEAD_TRACKER_HISTORY_DEPTH"`
 	EvmHeadTrackerMaxBufferSize                uint            `env:"ETH_HEAD_TRACKER_MAX_BUFFER_SIZE"`
 	EvmHeadTrackerSamplingInterval             time.Duration   `env:"ETH_HEAD_TRACKER_SAMPLING_INTERVAL"`
@@ -93,6 +100,9 @@ type ConfigSchema struct {
 	EvmMaxQueuedTransactions                   uint64          `env:"ETH_MAX_QUEUED_TRANSACTIONS"`
 	EvmMinGasPriceWei                          *big.Int        `env:"ETH_MIN_GAS_PRICE_WEI"`
 	EvmNonceAutoSync                           bool            `env:"ETH_NONCE_AUTO_SYNC"`
+	EvmPrivateRelayEnabled                     bool            `env:"EVM_PRIVATE_RELAY_ENABLED"`
+	EvmPrivateRelayFallbackBlocks              uint32          `env:"EVM_PRIVATE_RELAY_FALLBACK_BLOCKS"`
+	EvmPrivateRelayURL                         string          `env:"EVM_PRIVATE_RELAY_URL"`
 	EvmRPCDefaultBatchSize                     uint32          `env:"ETH_RPC_DEFAULT_BATCH_SIZE"`
 	ExplorerAccessKey                          string          `env:"EXPLORER_ACCESS_KEY"`
 	ExplorerSecret                             string          `env:"EXPLORER_SECRET"`
@@ -104,6 +114,8 @@ type ConfigSchema struct {
 	FeatureOffchainReporting2                  bool            `env:"FEATURE_OFFCHAIN_REPORTING2" default:"false"`
 	FeatureUICSAKeys                           bool            `env:"FEATURE_UI_CSA_KEYS" default:"false"`
 	FeatureUIFeedsManager                      bool            `env:"FEATURE_UI_FEEDS_MANAGER" default:"false"`
+	FeeHistoryEstimatorBlockCount              uint16          `env:"FEE_HISTORY_ESTIMATOR_BLOCK_COUNT"`
+	FeeHistoryEstimatorRewardPercentile        uint16          `env:"FEE_HISTORY_ESTIMATOR_REWARD_PERCENTILE"`
 	FlagsContractAddress                       string          `env:"FLAGS_CONTRACT_ADDRESS"`
 	GasEstimatorMode                           string          `env:"GAS_ESTIMATOR_MODE"`
 	GlobalLockRetryInterval                    models.Duration `env:"GLOBAL_LOCK_RETRY_INTERVAL" default:"1s"`
@@ -123,6 +135,12 @@ type ConfigSchema struct {
 	KeeperRegistryPerformGasOverhead           uint64          `env:"KEEPER_REGISTRY_PERFORM_GAS_OVERHEAD" default:"150000"`
 	KeeperRegistrySyncInterval                 time.Duration   `env:"KEEPER_REGISTRY_SYNC_INTERVAL" default:"30m"`
 	KeeperRegistrySyncUpkeepQueueSize          uint32          `env:"KEEPER_REGISTRY_SYNC_UPKEEP_QUEUE_SIZE" default:"10"`
+	KeyFunderEnabled                           bool            `env:"KEY_FUNDER_ENABLED"`
+	KeyFunderFundingKeyMinBalanceWei           *big.Int        `env:"KEY_FUNDER_FUNDING_KEY_MIN_BALANCE_WEI"`
+	KeyFunderMaxDailyWei                       *big.Int        `env:"KEY_FUNDER_MAX_DAILY_WEI"`
+	KeyFunderMinBalanceWei                     *big.Int        `env:"KEY_FUNDER_MIN_BALANCE_WEI"`
+	KeyFunderMinInterval                       time.Duration   `env:"KEY_FUNDER_MIN_INTERVAL"`
+	KeyFunderTargetBalanceWei                  *big.Int        `env:"KEY_FUNDER_TARGET_BALANCE_WEI"`
 	LinkContractAddress                        string          `env:"LINK_CONTRACT_ADDRESS"`
 	LogLevel                                   LogLevel        `env:"LOG_LEVEL"`
 	LogSQLMigrations                           bool            `env:"LOG_SQL_MIGRATIONS" default:"true"`
@@ -133,6 +151,9 @@ type ConfigSchema struct {
 	MinIncomingConfirmations                   uint32          `env:"MIN_INCOMING_CONFIRMATIONS"`
 	MinRequiredOutgoingConfirmations           uint64          `env:"MIN_OUTGOING_CONFIRMATIONS"`
 	MinimumContractPayment                     assets.Link     `env:"MINIMUM_CONTRACT_PAYMENT_LINK_JUELS"`
+	NodeOutOfSyncThreshold                     uint32          `env:"NODE_OUT_OF_SYNC_THRESHOLD" default:"5"`
+	NodePollInterval                           time.Duration   `env:"NODE_POLL_INTERVAL" default:"10s"`
+	NodeSelectionMode                          string          `env:"NODE_SELECTION_MODE" default:"RoundRobin"`
 	ORMMaxIdleConns                            int             `env:"ORM_MAX_IDLE_CONNS" default:"10"`
 	ORMMaxOpenConns                            int             `env:"ORM_MAX_OPEN_CONNS" default:"20"`
 	Port                                       uint16          `env:"CHAINLINK_PORT" default:"6688"`
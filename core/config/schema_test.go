@@ -16,6 +16,7 @@ func TestConfigSchema(t *testing.T) {
 		"AllowOrigins":                               "ALLOW_ORIGINS",
 		"AuthenticatedRateLimit":                     "AUTHENTICATED_RATE_LIMIT",
 		"AuthenticatedRateLimitPeriod":               "AUTHENTICATED_RATE_LIMIT_PERIOD",
+		"AuditLogForwardToLogger":                    "AUDIT_LOG_FORWARD_TO_LOGGER",
 		"AutoPprofEnabled":                           "AUTO_PPROF_ENABLED",
 		"AutoPprofProfileRoot":                       "AUTO_PPROF_PROFILE_ROOT",
 		"AutoPprofPollInterval":                      "AUTO_PPROF_POLL_INTERVAL",
@@ -36,6 +37,7 @@ func TestConfigSchema(t *testing.T) {
 		"BlockHistoryEstimatorBlockDelay":            "BLOCK_HISTORY_ESTIMATOR_BLOCK_DELAY",
 		"BlockHistoryEstimatorBlockHistorySize":      "BLOCK_HISTORY_ESTIMATOR_BLOCK_HISTORY_SIZE",
 		"BlockHistoryEstimatorTransactionPercentile": "BLOCK_HISTORY_ESTIMATOR_TRANSACTION_PERCENTILE",
+		"BlockTriggerMaxCatchUpBlocks":               "BLOCK_TRIGGER_MAX_CATCH_UP_BLOCKS",
 		"BridgeResponseURL":                          "BRIDGE_RESPONSE_URL",
 		"ChainType":                                  "CHAIN_TYPE",
 		"ClientNodeURL":                              "CLIENT_NODE_URL",
@@ -54,6 +56,10 @@ func TestConfigSchema(t *testing.T) {
 		"DefaultMaxHTTPAttempts":                     "MAX_HTTP_ATTEMPTS",
 		"Dev":                                        "CHAINLINK_DEV",
 		"EVMDisabled":                                "EVM_DISABLED",
+		"EthNonceCheckFillGaps":                      "ETH_NONCE_CHECK_FILL_GAPS",
+		"EthNonceCheckInterval":                      "ETH_NONCE_CHECK_INTERVAL",
+		"EthNonceCheckResync":                        "ETH_NONCE_CHECK_RESYNC",
+		"EthTxEventsWebhookURL":                      "ETH_TX_EVENTS_WEBHOOK_URL",
 		"EthTxReaperInterval":                        "ETH_TX_REAPER_INTERVAL",
 		"EthTxReaperThreshold":                       "ETH_TX_REAPER_THRESHOLD",
 		"EthTxResendAfterThreshold":                  "ETH_TX_RESEND_AFTER_THRESHOLD",
@@ -76,6 +82,7 @@ func TestConfigSchema(t *testing.T) {
 		"EvmGasPriceDefault":                         "ETH_GAS_PRICE_DEFAULT",
 		"EvmGasTipCapDefault":                        "EVM_GAS_TIP_CAP_DEFAULT",
 		"EvmGasTipCapMinimum":                        "EVM_GAS_TIP_CAP_MINIMUM",
+		"EvmGenerateAccessLists":                     "EVM_GENERATE_ACCESS_LISTS",
 		"EvmHeadTrackerHistoryDepth":                 "ETH_HEAD_TRACKER_HISTORY_DEPTH",
 		"EvmHeadTrackerMaxBufferSize":                "ETH_HEAD_TRACKER_MAX_BUFFER_SIZE",
 		"EvmHeadTrackerSamplingInterval":             "ETH_HEAD_TRACKER_SAMPLING_INTERVAL",
@@ -85,6 +92,9 @@ func TestConfigSchema(t *testing.T) {
 		"EvmMaxQueuedTransactions":                   "ETH_MAX_QUEUED_TRANSACTIONS",
 		"EvmMinGasPriceWei":                          "ETH_MIN_GAS_PRICE_WEI",
 		"EvmNonceAutoSync":                           "ETH_NONCE_AUTO_SYNC",
+		"EvmPrivateRelayEnabled":                     "EVM_PRIVATE_RELAY_ENABLED",
+		"EvmPrivateRelayFallbackBlocks":              "EVM_PRIVATE_RELAY_FALLBACK_BLOCKS",
+		"EvmPrivateRelayURL":                         "EVM_PRIVATE_RELAY_URL",
 		"EvmRPCDefaultBatchSize":                     "ETH_RPC_DEFAULT_BATCH_SIZE",
 		"ExplorerAccessKey":                          "EXPLORER_ACCESS_KEY",
 		"ExplorerSecret":                             "EXPLORER_SECRET",
@@ -96,6 +106,8 @@ func TestConfigSchema(t *testing.T) {
 		"FeatureOffchainReporting2":                  "FEATURE_OFFCHAIN_REPORTING2",
 		"FeatureUICSAKeys":                           "FEATURE_UI_CSA_KEYS",
 		"FeatureUIFeedsManager":                      "FEATURE_UI_FEEDS_MANAGER",
+		"FeeHistoryEstimatorBlockCount":              "FEE_HISTORY_ESTIMATOR_BLOCK_COUNT",
+		"FeeHistoryEstimatorRewardPercentile":        "FEE_HISTORY_ESTIMATOR_REWARD_PERCENTILE",
 		"FlagsContractAddress":                       "FLAGS_CONTRACT_ADDRESS",
 		"GasEstimatorMode":                           "GAS_ESTIMATOR_MODE",
 		"GasUpdaterBatchSize":                        "GAS_UPDATER_BATCH_SIZE",
@@ -120,6 +132,12 @@ func TestConfigSchema(t *testing.T) {
 		"KeeperRegistryPerformGasOverhead":           "KEEPER_REGISTRY_PERFORM_GAS_OVERHEAD",
 		"KeeperRegistrySyncInterval":                 "KEEPER_REGISTRY_SYNC_INTERVAL",
 		"KeeperRegistrySyncUpkeepQueueSize":          "KEEPER_REGISTRY_SYNC_UPKEEP_QUEUE_SIZE",
+		"KeyFunderEnabled":                           "KEY_FUNDER_ENABLED",
+		"KeyFunderFundingKeyMinBalanceWei":           "KEY_FUNDER_FUNDING_KEY_MIN_BALANCE_WEI",
+		"KeyFunderMaxDailyWei":                       "KEY_FUNDER_MAX_DAILY_WEI",
+		"KeyFunderMinBalanceWei":                     "KEY_FUNDER_MIN_BALANCE_WEI",
+		"KeyFunderMinInterval":                       "KEY_FUNDER_MIN_INTERVAL",
+		"KeyFunderTargetBalanceWei":                  "KEY_FUNDER_TARGET_BALANCE_WEI",
 		"LeaseLockDuration":                          "LEASE_LOCK_DURATION",
 		"LeaseLockRefreshInterval":                   "LEASE_LOCK_REFRESH_INTERVAL",
 		"LinkContractAddress":                        "LINK_CONTRACT_ADDRESS",
@@ -134,6 +152,9 @@ func TestConfigSchema(t *testing.T) {
 		"MinRequiredOutgoingConfirmations":           "MIN_OUTGOING_CONFIRMATIONS",
 		"MinimumContractPayment":                     "MINIMUM_CONTRACT_PAYMENT_LINK_JUELS",
 		"MinimumServiceDuration":                     "MINIMUM_SERVICE_DURATION",
+		"NodeOutOfSyncThreshold":                     "NODE_OUT_OF_SYNC_THRESHOLD",
+		"NodePollInterval":                           "NODE_POLL_INTERVAL",
+		"NodeSelectionMode":                          "NODE_SELECTION_MODE",
 		"ORMMaxIdleConns":                            "ORM_MAX_IDLE_CONNS",
 		"ORMMaxOpenConns":                            "ORM_MAX_OPEN_CONNS",
 		"OptimismGasFees":                            "OPTIMISM_GAS_FEES",
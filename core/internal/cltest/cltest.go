@@ -167,7 +167,7 @@ func NewJobPipelineV2(t testing.TB, cfg config.GeneralConfig, cc evm.ChainSet, d
 	lggr := logger.TestLogger(t)
 	prm := pipeline.NewORM(db, lggr, cfg)
 	jrm := job.NewORM(db, cc, prm, keyStore, lggr, cfg)
-	pr := pipeline.NewRunner(prm, cfg, cc, keyStore.Eth(), keyStore.VRF(), lggr)
+	pr := pipeline.NewRunner(prm, cfg, cc, keyStore.Eth(), keyStore.VRF(), bridges.NewBreakers(lggr), bridges.NewResponseCache(), lggr)
 	return JobPipelineV2TestHelper{
 		prm,
 		jrm,
@@ -564,7 +564,7 @@ func (ta *TestApplication) Stop() error {
 
 func (ta *TestApplication) MustSeedNewSession() (id string) {
 	session := NewSession()
-	err := ta.GetSqlxDB().Get(&id, `INSERT INTO sessions (id, last_used, created_at) VALUES ($1, $2, NOW()) RETURNING id`, session.ID, session.LastUsed)
+	err := ta.GetSqlxDB().Get(&id, `INSERT INTO sessions (id, email, last_used, created_at) VALUES ($1, $2, $3, NOW()) RETURNING id`, session.ID, session.Email, session.LastUsed)
 	require.NoError(ta.t, err)
 	return id
 }
@@ -1132,8 +1132,10 @@ func MustParseDuration(t testing.TB, durationStr string) time.Duration {
 	return duration
 }
 
+// NewSession returns a new session for the fixture API user
 func NewSession(optionalSessionID ...string) clsessions.Session {
 	session := clsessions.NewSession()
+	session.Email = APIEmail
 	if len(optionalSessionID) > 0 {
 		session.ID = optionalSessionID[0]
 	}
@@ -76,8 +76,9 @@ func Random32Byte() (b [32]byte) {
 }
 
 type BridgeOpts struct {
-	Name string
-	URL  string
+	Name          string
+	URL           string
+	RequestPolicy bridges.RequestPolicy
 }
 
 // NewBridgeType create new bridge type given info slice
@@ -98,6 +99,7 @@ func NewBridgeType(t testing.TB, opts BridgeOpts) (*bridges.BridgeTypeAuthentica
 	} else {
 		btr.URL = WebURL(t, fmt.Sprintf("https://bridge.example.com/api?%s", rnd))
 	}
+	btr.RequestPolicy = opts.RequestPolicy
 
 	bta, bt, err := bridges.NewBridgeType(btr)
 	require.NoError(t, err)
@@ -323,7 +323,7 @@ func (ns NeverSleeper) Duration() time.Duration { return 0 * time.Microsecond }
 
 func MustRandomUser(t testing.TB) sessions.User {
 	email := fmt.Sprintf("user-%v@chainlink.test", NewRandomInt64())
-	r, err := sessions.NewUser(email, Password)
+	r, err := sessions.NewUser(email, Password, sessions.UserRoleAdmin)
 	if err != nil {
 		logger.TestLogger(t).Panic(err)
 	}
@@ -331,7 +331,11 @@ func MustRandomUser(t testing.TB) sessions.User {
 }
 
 func MustNewUser(t *testing.T, email, password string) sessions.User {
-	r, err := sessions.NewUser(email, password)
+	return MustNewUserWithRole(t, email, password, sessions.UserRoleAdmin)
+}
+
+func MustNewUserWithRole(t *testing.T, email, password string, role sessions.UserRole) sessions.User {
+	r, err := sessions.NewUser(email, password, role)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -348,8 +352,8 @@ func NewMockAPIInitializer(t testing.TB) *MockAPIInitializer {
 }
 
 func (m *MockAPIInitializer) Initialize(orm sessions.ORM) (sessions.User, error) {
-	if user, err := orm.FindUser(); err == nil {
-		return user, err
+	if users, err := orm.ListUsers(); err == nil && len(users) > 0 {
+		return users[0], nil
 	}
 	m.Count++
 	user := MustRandomUser(m.t)
@@ -123,6 +123,11 @@ func (c *SimulatedBackendClient) Dial(context.Context) error {
 // other simulated clients might still be using it
 func (c *SimulatedBackendClient) Close() {}
 
+// NodeStatuses returns nil, the simulated backend has no primary nodes
+func (c *SimulatedBackendClient) NodeStatuses() []eth.NodeStatus {
+	return nil
+}
+
 // checkEthCallArgs extracts and verifies the arguments for an eth_call RPC
 func (c *SimulatedBackendClient) checkEthCallArgs(
 	args []interface{}) (*eth.CallArgs, *big.Int, error) {
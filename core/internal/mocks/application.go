@@ -41,6 +41,8 @@ import (
 	webhook "github.com/smartcontractkit/chainlink/core/services/webhook"
 
 	zapcore "go.uber.org/zap/zapcore"
+
+	audit "github.com/smartcontractkit/chainlink/core/audit"
 )
 
 // Application is an autogenerated mock type for the Application type
@@ -62,6 +64,22 @@ func (_m *Application) AddJobV2(ctx context.Context, _a1 *job.Job) error {
 	return r0
 }
 
+// AuditLogger provides a mock function with given fields:
+func (_m *Application) AuditLogger() audit.AuditLogger {
+	ret := _m.Called()
+
+	var r0 audit.AuditLogger
+	if rf, ok := ret.Get(0).(func() audit.AuditLogger); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(audit.AuditLogger)
+		}
+	}
+
+	return r0
+}
+
 // BPTXMORM provides a mock function with given fields:
 func (_m *Application) BPTXMORM() bulletprooftxmanager.ORM {
 	ret := _m.Called()
@@ -78,6 +96,38 @@ func (_m *Application) BPTXMORM() bulletprooftxmanager.ORM {
 	return r0
 }
 
+// BridgeBreakers provides a mock function with given fields:
+func (_m *Application) BridgeBreakers() bridges.Breakers {
+	ret := _m.Called()
+
+	var r0 bridges.Breakers
+	if rf, ok := ret.Get(0).(func() bridges.Breakers); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(bridges.Breakers)
+		}
+	}
+
+	return r0
+}
+
+// BridgeCache provides a mock function with given fields:
+func (_m *Application) BridgeCache() bridges.ResponseCache {
+	ret := _m.Called()
+
+	var r0 bridges.ResponseCache
+	if rf, ok := ret.Get(0).(func() bridges.ResponseCache); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(bridges.ResponseCache)
+		}
+	}
+
+	return r0
+}
+
 // BridgeORM provides a mock function with given fields:
 func (_m *Application) BridgeORM() bridges.ORM {
 	ret := _m.Called()
@@ -94,6 +144,20 @@ func (_m *Application) BridgeORM() bridges.ORM {
 	return r0
 }
 
+// CancelJobRun provides a mock function with given fields: ctx, runID
+func (_m *Application) CancelJobRun(ctx context.Context, runID int64) error {
+	ret := _m.Called(ctx, runID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, runID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // DeleteJob provides a mock function with given fields: ctx, jobID
 func (_m *Application) DeleteJob(ctx context.Context, jobID int32) error {
 	ret := _m.Called(ctx, jobID)
@@ -330,6 +394,20 @@ func (_m *Application) JobSpawner() job.Spawner {
 	return r0
 }
 
+// PauseJob provides a mock function with given fields: ctx, jobID
+func (_m *Application) PauseJob(ctx context.Context, jobID int32) error {
+	ret := _m.Called(ctx, jobID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) error); ok {
+		r0 = rf(ctx, jobID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // PipelineORM provides a mock function with given fields:
 func (_m *Application) PipelineORM() pipeline.ORM {
 	ret := _m.Called()
@@ -360,6 +438,20 @@ func (_m *Application) ReplayFromBlock(chainID *big.Int, number uint64) error {
 	return r0
 }
 
+// ResumeJob provides a mock function with given fields: ctx, jobID, backfill
+func (_m *Application) ResumeJob(ctx context.Context, jobID int32, backfill bool) error {
+	ret := _m.Called(ctx, jobID, backfill)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32, bool) error); ok {
+		r0 = rf(ctx, jobID, backfill)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // ResumeJobV2 provides a mock function with given fields: ctx, taskID, result
 func (_m *Application) ResumeJobV2(ctx context.Context, taskID uuid.UUID, result pipeline.Result) error {
 	ret := _m.Called(ctx, taskID, result)
@@ -374,6 +466,20 @@ func (_m *Application) ResumeJobV2(ctx context.Context, taskID uuid.UUID, result
 	return r0
 }
 
+// RollbackJob provides a mock function with given fields: ctx, jobID, version
+func (_m *Application) RollbackJob(ctx context.Context, jobID int32, version int32) error {
+	ret := _m.Called(ctx, jobID, version)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32, int32) error); ok {
+		r0 = rf(ctx, jobID, version)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // RunJobV2 provides a mock function with given fields: ctx, jobID, meta
 func (_m *Application) RunJobV2(ctx context.Context, jobID int32, meta map[string]interface{}) (int64, error) {
 	ret := _m.Called(ctx, jobID, meta)
@@ -488,6 +594,20 @@ func (_m *Application) Stop() error {
 	return r0
 }
 
+// UpdateJobV2 provides a mock function with given fields: ctx, _a1
+func (_m *Application) UpdateJobV2(ctx context.Context, _a1 *job.Job) error {
+	ret := _m.Called(ctx, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *job.Job) error); ok {
+		r0 = rf(ctx, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // WakeSessionReaper provides a mock function with given fields:
 func (_m *Application) WakeSessionReaper() {
 	_m.Called()
@@ -43,6 +43,7 @@ type GeneralConfigOverrides struct {
 	AllowOrigins                              null.String
 	BlockBackfillDepth                        null.Int
 	BlockBackfillSkip                         null.Bool
+	BlockTriggerMaxCatchUpBlocks              null.Int
 	ClientNodeURL                             null.String
 	DatabaseURL                               null.String
 	DefaultChainID                            *big.Int
@@ -69,16 +70,27 @@ type GeneralConfigOverrides struct {
 	GlobalEvmGasPriceDefault                  *big.Int
 	GlobalEvmGasTipCapDefault                 *big.Int
 	GlobalEvmGasTipCapMinimum                 *big.Int
+	GlobalEvmGenerateAccessLists              null.Bool
 	GlobalEvmHeadTrackerHistoryDepth          null.Int
 	GlobalEvmHeadTrackerMaxBufferSize         null.Int
 	GlobalEvmHeadTrackerSamplingInterval      *time.Duration
 	GlobalEvmLogBackfillBatchSize             null.Int
 	GlobalEvmMaxGasPriceWei                   *big.Int
+	GlobalEvmMaxQueuedTransactions            null.Int
 	GlobalEvmMinGasPriceWei                   *big.Int
 	GlobalEvmNonceAutoSync                    null.Bool
+	GlobalEvmPrivateRelayEnabled              null.Bool
+	GlobalEvmPrivateRelayFallbackBlocks       null.Int
+	GlobalEvmPrivateRelayURL                  null.String
 	GlobalEvmRPCDefaultBatchSize              null.Int
 	GlobalFlagsContractAddress                null.String
 	GlobalGasEstimatorMode                    null.String
+	GlobalKeyFunderEnabled                    null.Bool
+	GlobalKeyFunderFundingKeyMinBalanceWei    *big.Int
+	GlobalKeyFunderMaxDailyWei                *big.Int
+	GlobalKeyFunderMinBalanceWei              *big.Int
+	GlobalKeyFunderMinInterval                *time.Duration
+	GlobalKeyFunderTargetBalanceWei           *big.Int
 	GlobalMinIncomingConfirmations            null.Int
 	GlobalMinRequiredOutgoingConfirmations    null.Int
 	GlobalMinimumContractPayment              *assets.Link
@@ -375,6 +387,13 @@ func (c *TestGeneralConfig) BlockBackfillDepth() uint64 {
 	return c.GeneralConfig.BlockBackfillDepth()
 }
 
+func (c *TestGeneralConfig) BlockTriggerMaxCatchUpBlocks() uint32 {
+	if c.Overrides.BlockTriggerMaxCatchUpBlocks.Valid {
+		return uint32(c.Overrides.BlockTriggerMaxCatchUpBlocks.Int64)
+	}
+	return c.GeneralConfig.BlockTriggerMaxCatchUpBlocks()
+}
+
 func (c *TestGeneralConfig) KeeperMaximumGracePeriod() int64 {
 	if c.Overrides.KeeperMaximumGracePeriod.Valid {
 		return c.Overrides.KeeperMaximumGracePeriod.Int64
@@ -431,6 +450,48 @@ func (c *TestGeneralConfig) GlobalGasEstimatorMode() (string, bool) {
 	return c.GeneralConfig.GlobalGasEstimatorMode()
 }
 
+func (c *TestGeneralConfig) GlobalKeyFunderEnabled() (bool, bool) {
+	if c.Overrides.GlobalKeyFunderEnabled.Valid {
+		return c.Overrides.GlobalKeyFunderEnabled.Bool, true
+	}
+	return c.GeneralConfig.GlobalKeyFunderEnabled()
+}
+
+func (c *TestGeneralConfig) GlobalKeyFunderFundingKeyMinBalanceWei() (*big.Int, bool) {
+	if c.Overrides.GlobalKeyFunderFundingKeyMinBalanceWei != nil {
+		return c.Overrides.GlobalKeyFunderFundingKeyMinBalanceWei, true
+	}
+	return c.GeneralConfig.GlobalKeyFunderFundingKeyMinBalanceWei()
+}
+
+func (c *TestGeneralConfig) GlobalKeyFunderMaxDailyWei() (*big.Int, bool) {
+	if c.Overrides.GlobalKeyFunderMaxDailyWei != nil {
+		return c.Overrides.GlobalKeyFunderMaxDailyWei, true
+	}
+	return c.GeneralConfig.GlobalKeyFunderMaxDailyWei()
+}
+
+func (c *TestGeneralConfig) GlobalKeyFunderMinBalanceWei() (*big.Int, bool) {
+	if c.Overrides.GlobalKeyFunderMinBalanceWei != nil {
+		return c.Overrides.GlobalKeyFunderMinBalanceWei, true
+	}
+	return c.GeneralConfig.GlobalKeyFunderMinBalanceWei()
+}
+
+func (c *TestGeneralConfig) GlobalKeyFunderMinInterval() (time.Duration, bool) {
+	if c.Overrides.GlobalKeyFunderMinInterval != nil {
+		return *c.Overrides.GlobalKeyFunderMinInterval, true
+	}
+	return c.GeneralConfig.GlobalKeyFunderMinInterval()
+}
+
+func (c *TestGeneralConfig) GlobalKeyFunderTargetBalanceWei() (*big.Int, bool) {
+	if c.Overrides.GlobalKeyFunderTargetBalanceWei != nil {
+		return c.Overrides.GlobalKeyFunderTargetBalanceWei, true
+	}
+	return c.GeneralConfig.GlobalKeyFunderTargetBalanceWei()
+}
+
 func (c *TestGeneralConfig) GlobalChainType() (string, bool) {
 	if c.Overrides.GlobalChainType.Valid {
 		return c.Overrides.GlobalChainType.String, true
@@ -444,6 +505,27 @@ func (c *TestGeneralConfig) GlobalEvmNonceAutoSync() (bool, bool) {
 	}
 	return c.GeneralConfig.GlobalEvmNonceAutoSync()
 }
+
+func (c *TestGeneralConfig) GlobalEvmPrivateRelayEnabled() (bool, bool) {
+	if c.Overrides.GlobalEvmPrivateRelayEnabled.Valid {
+		return c.Overrides.GlobalEvmPrivateRelayEnabled.Bool, true
+	}
+	return c.GeneralConfig.GlobalEvmPrivateRelayEnabled()
+}
+
+func (c *TestGeneralConfig) GlobalEvmPrivateRelayFallbackBlocks() (uint32, bool) {
+	if c.Overrides.GlobalEvmPrivateRelayFallbackBlocks.Valid {
+		return uint32(c.Overrides.GlobalEvmPrivateRelayFallbackBlocks.Int64), true
+	}
+	return c.GeneralConfig.GlobalEvmPrivateRelayFallbackBlocks()
+}
+
+func (c *TestGeneralConfig) GlobalEvmPrivateRelayURL() (string, bool) {
+	if c.Overrides.GlobalEvmPrivateRelayURL.Valid {
+		return c.Overrides.GlobalEvmPrivateRelayURL.String, true
+	}
+	return c.GeneralConfig.GlobalEvmPrivateRelayURL()
+}
 func (c *TestGeneralConfig) GlobalBalanceMonitorEnabled() (bool, bool) {
 	if c.Overrides.GlobalBalanceMonitorEnabled.Valid {
 		return c.Overrides.GlobalBalanceMonitorEnabled.Bool, true
@@ -570,6 +652,13 @@ func (c *TestGeneralConfig) GlobalEvmHeadTrackerMaxBufferSize() (uint32, bool) {
 	return c.GeneralConfig.GlobalEvmHeadTrackerMaxBufferSize()
 }
 
+func (c *TestGeneralConfig) GlobalEvmGenerateAccessLists() (bool, bool) {
+	if c.Overrides.GlobalEvmGenerateAccessLists.Valid {
+		return c.Overrides.GlobalEvmGenerateAccessLists.Bool, true
+	}
+	return c.GeneralConfig.GlobalEvmGenerateAccessLists()
+}
+
 func (c *TestGeneralConfig) GlobalEvmHeadTrackerHistoryDepth() (uint32, bool) {
 	if c.Overrides.GlobalEvmHeadTrackerHistoryDepth.Valid {
 		return uint32(c.Overrides.GlobalEvmHeadTrackerHistoryDepth.Int64), true
@@ -577,6 +666,13 @@ func (c *TestGeneralConfig) GlobalEvmHeadTrackerHistoryDepth() (uint32, bool) {
 	return c.GeneralConfig.GlobalEvmHeadTrackerHistoryDepth()
 }
 
+func (c *TestGeneralConfig) GlobalEvmMaxQueuedTransactions() (uint64, bool) {
+	if c.Overrides.GlobalEvmMaxQueuedTransactions.Valid {
+		return uint64(c.Overrides.GlobalEvmMaxQueuedTransactions.Int64), true
+	}
+	return c.GeneralConfig.GlobalEvmMaxQueuedTransactions()
+}
+
 func (c *TestGeneralConfig) GlobalEvmHeadTrackerSamplingInterval() (time.Duration, bool) {
 	if c.Overrides.GlobalEvmHeadTrackerSamplingInterval != nil {
 		return *c.Overrides.GlobalEvmHeadTrackerSamplingInterval, true
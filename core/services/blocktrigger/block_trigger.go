@@ -0,0 +1,252 @@
+package blocktrigger
+
+import (
+	"context"
+	"math/big"
+	"sync"
+
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/eth"
+	httypes "github.com/smartcontractkit/chainlink/core/services/headtracker/types"
+	"github.com/smartcontractkit/chainlink/core/services/job"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// BlockTrigger fulfills Service and HeadTrackable interfaces
+var (
+	_ job.Service           = (*BlockTrigger)(nil)
+	_ httypes.HeadTrackable = (*BlockTrigger)(nil)
+)
+
+// Config contains the configuration options used by block trigger jobs
+type Config interface {
+	BlockTriggerMaxCatchUpBlocks() uint32
+}
+
+// BlockTrigger runs the pipeline of a block trigger job for every
+// BlockInterval'th block, i.e. for each block whose number is a multiple of
+// the interval, once it has the configured number of confirmations
+type BlockTrigger struct {
+	job             job.Job
+	headBroadcaster httypes.HeadBroadcasterRegistry
+	ethClient       eth.Client
+	pipelineRunner  pipeline.Runner
+	pipelineORM     pipeline.ORM
+	config          Config
+	logger          logger.Logger
+	mailbox         *utils.Mailbox
+	// lastBlock is the number of the last block that was considered for a run,
+	// or -1 before the first head was received. It is restored from the
+	// job's latest run on the first head so that downtime can be caught up.
+	lastBlock int64
+	chStop    chan struct{}
+	wgDone    sync.WaitGroup
+	utils.StartStopOnce
+}
+
+// NewBlockTrigger is the constructor of BlockTrigger
+func NewBlockTrigger(
+	jb job.Job,
+	headBroadcaster httypes.HeadBroadcasterRegistry,
+	ethClient eth.Client,
+	pipelineRunner pipeline.Runner,
+	pipelineORM pipeline.ORM,
+	config Config,
+	lggr logger.Logger,
+) *BlockTrigger {
+	return &BlockTrigger{
+		job:             jb,
+		headBroadcaster: headBroadcaster,
+		ethClient:       ethClient,
+		pipelineRunner:  pipelineRunner,
+		pipelineORM:     pipelineORM,
+		config:          config,
+		logger: lggr.With(
+			"jobID", jb.ID,
+			"blockInterval", jb.BlockTriggerSpec.BlockInterval,
+			"confirmations", jb.BlockTriggerSpec.Confirmations,
+		),
+		mailbox:   utils.NewMailbox(1),
+		lastBlock: -1,
+		chStop:    make(chan struct{}),
+	}
+}
+
+// Start implements the job.Service interface.
+func (bt *BlockTrigger) Start() error {
+	return bt.StartOnce("BlockTrigger", func() error {
+		bt.wgDone.Add(2)
+		go bt.run()
+		latestHead, unsubscribeHeads := bt.headBroadcaster.Subscribe(bt)
+		if latestHead != nil {
+			bt.mailbox.Deliver(latestHead)
+		}
+		go func() {
+			defer unsubscribeHeads()
+			defer bt.wgDone.Done()
+			<-bt.chStop
+		}()
+		return nil
+	})
+}
+
+// Close implements the job.Service interface.
+func (bt *BlockTrigger) Close() error {
+	return bt.StopOnce("BlockTrigger", func() error {
+		close(bt.chStop)
+		bt.wgDone.Wait()
+		return nil
+	})
+}
+
+// OnNewLongestChain handles the given head of a new longest chain
+func (bt *BlockTrigger) OnNewLongestChain(_ context.Context, head *eth.Head) {
+	bt.mailbox.Deliver(head)
+}
+
+func (bt *BlockTrigger) run() {
+	defer bt.wgDone.Done()
+	for {
+		select {
+		case <-bt.chStop:
+			return
+		case <-bt.mailbox.Notify():
+			item, exists := bt.mailbox.Retrieve()
+			if !exists {
+				continue
+			}
+			bt.processHead(eth.AsHead(item))
+		}
+	}
+}
+
+// processHead runs the pipeline for the blocks that became due with the given
+// head. Since only the latest head is kept while runs are in progress, more
+// than one block may have become due at once. At most
+// BlockTriggerMaxCatchUpBlocks of them are run per head; the rest are left
+// for the following heads. If a block cannot be run, the blocks from it
+// onwards are retried with the next head.
+func (bt *BlockTrigger) processHead(head *eth.Head) {
+	spec := bt.job.BlockTriggerSpec
+	target := head.Number - int64(spec.Confirmations)
+	if target < 0 {
+		return
+	}
+	if bt.lastBlock < 0 {
+		bt.lastBlock = bt.lastRunBlock(target)
+	}
+	if target <= bt.lastBlock {
+		return
+	}
+
+	maxBlocks := int(bt.config.BlockTriggerMaxCatchUpBlocks())
+	blocks := dueBlocks(bt.lastBlock, target, int64(spec.BlockInterval), spec.RunMissedBlocks)
+	truncated := maxBlocks > 0 && len(blocks) > maxBlocks
+	if truncated {
+		bt.logger.Warnw("Too many missed blocks, catching up over the following heads", "dueBlocks", len(blocks), "maxCatchUpBlocks", maxBlocks)
+		blocks = blocks[:maxBlocks]
+	}
+	for _, blockNumber := range blocks {
+		select {
+		case <-bt.chStop:
+			return
+		default:
+		}
+		if err := bt.runPipeline(head, blockNumber); err != nil {
+			bt.logger.Errorw("Error executing new run for block trigger job, retrying with the next head", "blockNumber", blockNumber, "error", err)
+			return
+		}
+		bt.lastBlock = blockNumber
+	}
+	if !truncated {
+		bt.lastBlock = target
+	}
+}
+
+// lastRunBlock returns the block number of the job's latest run, so that the
+// blocks that became due while the job was not running are not skipped. If
+// the job has never been run, or the run cannot be loaded, it starts at
+// target.
+func (bt *BlockTrigger) lastRunBlock(target int64) int64 {
+	ctx, cancel := utils.ContextFromChan(bt.chStop)
+	defer cancel()
+	last, err := bt.pipelineORM.GetLastRunBlockNumber(ctx, bt.job.ID)
+	if err != nil {
+		bt.logger.Errorw("Error loading the latest run of block trigger job", "error", err)
+		return target - 1
+	}
+	if !last.Valid {
+		return target - 1
+	}
+	return last.Int64
+}
+
+// dueBlocks returns the numbers of the blocks after lastBlock, up to and
+// including target, that the pipeline should be run for. Unless
+// runMissedBlocks is set, only the latest of them is returned.
+func dueBlocks(lastBlock, target, interval int64, runMissedBlocks bool) (blocks []int64) {
+	if interval < 1 {
+		interval = 1
+	}
+	latest := target - target%interval
+	if latest <= lastBlock {
+		return nil
+	}
+	if !runMissedBlocks {
+		return []int64{latest}
+	}
+	for n := (lastBlock/interval + 1) * interval; n <= latest; n += interval {
+		blocks = append(blocks, n)
+	}
+	return blocks
+}
+
+func (bt *BlockTrigger) runPipeline(head *eth.Head, blockNumber int64) error {
+	ctx, cancel := utils.ContextFromChan(bt.chStop)
+	defer cancel()
+
+	block, err := bt.blockAt(ctx, head, blockNumber)
+	if err != nil {
+		return err
+	}
+
+	vars := pipeline.NewVarsFrom(map[string]interface{}{
+		"jobSpec": map[string]interface{}{
+			"databaseID":    bt.job.ID,
+			"externalJobID": bt.job.ExternalJobID,
+			"name":          bt.job.Name.ValueOrZero(),
+		},
+		"jobRun": map[string]interface{}{
+			"meta":        map[string]interface{}{},
+			"blockNumber": block.Number,
+			"blockHash":   block.Hash,
+			"timestamp":   block.Timestamp.Unix(),
+		},
+	})
+
+	run := pipeline.NewRun(*bt.job.PipelineSpec, vars)
+
+	_, err = bt.pipelineRunner.Run(ctx, &run, bt.logger, false, nil)
+	return err
+}
+
+// blockAt returns the block with the given number, from the head's chain if
+// it is still in it
+func (bt *BlockTrigger) blockAt(ctx context.Context, head *eth.Head, blockNumber int64) (*eth.Head, error) {
+	for h := head; h != nil; h = h.Parent {
+		if h.Number == blockNumber {
+			return h, nil
+		}
+	}
+	block, err := bt.ethClient.HeadByNumber(ctx, big.NewInt(blockNumber))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to fetch block %d", blockNumber)
+	}
+	if block == nil {
+		return nil, errors.Errorf("block %d not found", blockNumber)
+	}
+	return block, nil
+}
@@ -0,0 +1,229 @@
+package blocktrigger_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/blocktrigger"
+	"github.com/smartcontractkit/chainlink/core/services/eth"
+	ethmocks "github.com/smartcontractkit/chainlink/core/services/eth/mocks"
+	htmocks "github.com/smartcontractkit/chainlink/core/services/headtracker/mocks"
+	"github.com/smartcontractkit/chainlink/core/services/job"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+	pipeline_mocks "github.com/smartcontractkit/chainlink/core/services/pipeline/mocks"
+)
+
+type blockRun struct {
+	number    int64
+	hash      common.Hash
+	timestamp int64
+}
+
+// chainOf returns a head with the given number and depth-1 parents
+func chainOf(number int64, depth int) *eth.Head {
+	head := cltest.Head(number)
+	head.Timestamp = time.Unix(1000+number, 0)
+	h := head
+	for i := 1; i < depth; i++ {
+		h.Parent = cltest.Head(number - int64(i))
+		h.Parent.Timestamp = time.Unix(1000+h.Parent.Number, 0)
+		h = h.Parent
+	}
+	return head
+}
+
+func startBlockTrigger(t *testing.T, spec job.BlockTriggerSpec, lastRunBlock null.Int, maxCatchUpBlocks int64) (*blocktrigger.BlockTrigger, *ethmocks.Client, chan blockRun) {
+	cfg := cltest.NewTestGeneralConfig(t)
+	cfg.Overrides.BlockTriggerMaxCatchUpBlocks = null.IntFrom(maxCatchUpBlocks)
+	orm := new(pipeline_mocks.ORM)
+	orm.On("GetLastRunBlockNumber", mock.Anything, int32(1)).Return(lastRunBlock, nil).Once()
+	t.Cleanup(func() { orm.AssertExpectations(t) })
+	hb := new(htmocks.HeadBroadcaster)
+	hb.On("Subscribe", mock.Anything).Return(nil, func() {})
+	ethClient := cltest.NewEthClientMock(t)
+	runner := new(pipeline_mocks.Runner)
+
+	runs := make(chan blockRun, 10)
+	runner.On("Run", mock.Anything, mock.AnythingOfType("*pipeline.Run"), mock.Anything, false, mock.Anything).
+		Return(false, nil).
+		Run(func(args mock.Arguments) {
+			run := args.Get(1).(*pipeline.Run)
+			jobRun := run.Inputs.Val.(map[string]interface{})["jobRun"].(map[string]interface{})
+			runs <- blockRun{
+				number:    jobRun["blockNumber"].(int64),
+				hash:      jobRun["blockHash"].(common.Hash),
+				timestamp: jobRun["timestamp"].(int64),
+			}
+		})
+
+	jb := job.Job{
+		ID:               1,
+		Type:             job.BlockTrigger,
+		BlockTriggerSpec: &spec,
+		PipelineSpec:     &pipeline.Spec{},
+	}
+	bt := blocktrigger.NewBlockTrigger(jb, hb, ethClient, runner, orm, cfg, logger.TestLogger(t))
+	require.NoError(t, bt.Start())
+	t.Cleanup(func() { bt.Close() })
+	return bt, ethClient, runs
+}
+
+func awaitRun(t *testing.T, runs chan blockRun) blockRun {
+	t.Helper()
+	select {
+	case run := <-runs:
+		return run
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for pipeline run")
+	}
+	return blockRun{}
+}
+
+func TestBlockTrigger_RunsEveryIntervalAtConfirmationDepth(t *testing.T) {
+	bt, _, runs := startBlockTrigger(t, job.BlockTriggerSpec{BlockInterval: 3, Confirmations: 2}, null.Int{}, 100)
+
+	head := chainOf(11, 5)
+	bt.OnNewLongestChain(context.Background(), head)
+
+	run := awaitRun(t, runs)
+	assert.Equal(t, int64(9), run.number)
+	assert.Equal(t, head.Parent.Parent.Hash, run.hash)
+	assert.Equal(t, int64(1009), run.timestamp)
+
+	// Block 10 is not a multiple of the interval
+	bt.OnNewLongestChain(context.Background(), chainOf(12, 5))
+	bt.OnNewLongestChain(context.Background(), chainOf(14, 5))
+
+	run = awaitRun(t, runs)
+	assert.Equal(t, int64(12), run.number)
+
+	require.NoError(t, bt.Close())
+	assert.Len(t, runs, 0)
+}
+
+func TestBlockTrigger_SkipsMissedBlocks(t *testing.T) {
+	bt, _, runs := startBlockTrigger(t, job.BlockTriggerSpec{BlockInterval: 1}, null.Int{}, 100)
+
+	bt.OnNewLongestChain(context.Background(), chainOf(5, 1))
+	assert.Equal(t, int64(5), awaitRun(t, runs).number)
+
+	bt.OnNewLongestChain(context.Background(), chainOf(9, 1))
+	assert.Equal(t, int64(9), awaitRun(t, runs).number)
+
+	// Heads that are not ahead of the last run block are ignored
+	bt.OnNewLongestChain(context.Background(), chainOf(8, 1))
+
+	require.NoError(t, bt.Close())
+	assert.Len(t, runs, 0)
+}
+
+func TestBlockTrigger_RunsMissedBlocks(t *testing.T) {
+	bt, ethClient, runs := startBlockTrigger(t, job.BlockTriggerSpec{BlockInterval: 2, RunMissedBlocks: true}, null.Int{}, 100)
+
+	bt.OnNewLongestChain(context.Background(), chainOf(4, 1))
+	assert.Equal(t, int64(4), awaitRun(t, runs).number)
+
+	// Blocks that are no longer in the head's chain are fetched from the node
+	block6, block8 := chainOf(6, 1), chainOf(8, 1)
+	ethClient.On("HeadByNumber", mock.Anything, big.NewInt(6)).Return(block6, nil).Once()
+	ethClient.On("HeadByNumber", mock.Anything, big.NewInt(8)).Return(block8, nil).Once()
+
+	bt.OnNewLongestChain(context.Background(), chainOf(11, 2))
+	assert.Equal(t, blockRun{6, block6.Hash, 1006}, awaitRun(t, runs))
+	assert.Equal(t, blockRun{8, block8.Hash, 1008}, awaitRun(t, runs))
+	assert.Equal(t, int64(10), awaitRun(t, runs).number)
+
+	require.NoError(t, bt.Close())
+	assert.Len(t, runs, 0)
+	ethClient.AssertExpectations(t)
+}
+
+func TestBlockTrigger_RetriesFailedBlocks(t *testing.T) {
+	bt, ethClient, runs := startBlockTrigger(t, job.BlockTriggerSpec{BlockInterval: 2, RunMissedBlocks: true}, null.IntFrom(4), 100)
+
+	failed := make(chan struct{})
+	ethClient.On("HeadByNumber", mock.Anything, big.NewInt(6)).Return(nil, errors.New("connection refused")).Once().
+		Run(func(mock.Arguments) { close(failed) })
+	bt.OnNewLongestChain(context.Background(), chainOf(11, 2))
+	select {
+	case <-failed:
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for block 6 to be fetched")
+	}
+
+	// The failed block and the blocks after it are run with the next head
+	block6, block8 := chainOf(6, 1), chainOf(8, 1)
+	ethClient.On("HeadByNumber", mock.Anything, big.NewInt(6)).Return(block6, nil).Once()
+	ethClient.On("HeadByNumber", mock.Anything, big.NewInt(8)).Return(block8, nil).Once()
+	bt.OnNewLongestChain(context.Background(), chainOf(12, 3))
+	assert.Equal(t, int64(6), awaitRun(t, runs).number)
+	assert.Equal(t, int64(8), awaitRun(t, runs).number)
+	assert.Equal(t, int64(10), awaitRun(t, runs).number)
+	assert.Equal(t, int64(12), awaitRun(t, runs).number)
+
+	require.NoError(t, bt.Close())
+	assert.Len(t, runs, 0)
+	ethClient.AssertExpectations(t)
+}
+
+func TestBlockTrigger_CatchesUpFromLastRun(t *testing.T) {
+	t.Run("runs the blocks missed since the last run", func(t *testing.T) {
+		bt, _, runs := startBlockTrigger(t, job.BlockTriggerSpec{BlockInterval: 2, RunMissedBlocks: true}, null.IntFrom(4), 100)
+
+		bt.OnNewLongestChain(context.Background(), chainOf(10, 7))
+		assert.Equal(t, int64(6), awaitRun(t, runs).number)
+		assert.Equal(t, int64(8), awaitRun(t, runs).number)
+		assert.Equal(t, int64(10), awaitRun(t, runs).number)
+
+		require.NoError(t, bt.Close())
+		assert.Len(t, runs, 0)
+	})
+
+	t.Run("only runs the latest block unless runMissedBlocks is set", func(t *testing.T) {
+		bt, _, runs := startBlockTrigger(t, job.BlockTriggerSpec{BlockInterval: 2}, null.IntFrom(4), 100)
+
+		bt.OnNewLongestChain(context.Background(), chainOf(10, 7))
+		assert.Equal(t, int64(10), awaitRun(t, runs).number)
+
+		require.NoError(t, bt.Close())
+		assert.Len(t, runs, 0)
+	})
+
+	t.Run("does not rerun the block of the last run", func(t *testing.T) {
+		bt, _, runs := startBlockTrigger(t, job.BlockTriggerSpec{BlockInterval: 2, RunMissedBlocks: true}, null.IntFrom(10), 100)
+
+		bt.OnNewLongestChain(context.Background(), chainOf(11, 1))
+		bt.OnNewLongestChain(context.Background(), chainOf(12, 1))
+		assert.Equal(t, int64(12), awaitRun(t, runs).number)
+
+		require.NoError(t, bt.Close())
+		assert.Len(t, runs, 0)
+	})
+}
+
+func TestBlockTrigger_LimitsCatchUpBatch(t *testing.T) {
+	bt, _, runs := startBlockTrigger(t, job.BlockTriggerSpec{BlockInterval: 2, RunMissedBlocks: true}, null.IntFrom(4), 2)
+
+	bt.OnNewLongestChain(context.Background(), chainOf(12, 9))
+	assert.Equal(t, int64(6), awaitRun(t, runs).number)
+	assert.Equal(t, int64(8), awaitRun(t, runs).number)
+
+	// The remaining blocks are run with the next head
+	bt.OnNewLongestChain(context.Background(), chainOf(13, 9))
+	assert.Equal(t, int64(10), awaitRun(t, runs).number)
+	assert.Equal(t, int64(12), awaitRun(t, runs).number)
+
+	require.NoError(t, bt.Close())
+	assert.Len(t, runs, 0)
+}
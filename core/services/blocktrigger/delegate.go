@@ -0,0 +1,61 @@
+package blocktrigger
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/chains/evm"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/job"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+)
+
+type Delegate struct {
+	logger         logger.Logger
+	pipelineRunner pipeline.Runner
+	pipelineORM    pipeline.ORM
+	chainSet       evm.ChainSet
+	config         Config
+}
+
+var _ job.Delegate = (*Delegate)(nil)
+
+func NewDelegate(
+	logger logger.Logger,
+	pipelineRunner pipeline.Runner,
+	pipelineORM pipeline.ORM,
+	chainSet evm.ChainSet,
+	config Config,
+) *Delegate {
+	return &Delegate{
+		logger.Named("BlockTrigger"),
+		pipelineRunner,
+		pipelineORM,
+		chainSet,
+		config,
+	}
+}
+
+func (d *Delegate) JobType() job.Type {
+	return job.BlockTrigger
+}
+
+func (Delegate) AfterJobCreated(spec job.Job)  {}
+func (Delegate) BeforeJobDeleted(spec job.Job) {}
+
+// ServicesForSpec returns the head tracker that runs a block trigger job
+func (d *Delegate) ServicesForSpec(jb job.Job) ([]job.Service, error) {
+	if jb.BlockTriggerSpec == nil {
+		return nil, errors.Errorf("BlockTrigger: blocktrigger.Delegate expects a *job.BlockTriggerSpec to be present, got %v", jb)
+	}
+	chain, err := d.chainSet.Get(jb.BlockTriggerSpec.EVMChainID.ToInt())
+	if err != nil {
+		return nil, err
+	}
+
+	// TODO: we need to fill these out manually, find a better fix
+	jb.PipelineSpec.JobName = jb.Name.ValueOrZero()
+	jb.PipelineSpec.JobID = jb.ID
+
+	trigger := NewBlockTrigger(jb, chain.HeadBroadcaster(), chain.Client(), d.pipelineRunner, d.pipelineORM, d.config, d.logger)
+	return []job.Service{trigger}, nil
+}
@@ -0,0 +1,44 @@
+package blocktrigger
+
+import (
+	"github.com/pelletier/go-toml"
+	"github.com/pkg/errors"
+	uuid "github.com/satori/go.uuid"
+
+	"github.com/smartcontractkit/chainlink/core/services/job"
+)
+
+func ValidatedBlockTriggerSpec(tomlString string) (job.Job, error) {
+	var jb = job.Job{
+		ExternalJobID: uuid.NewV4(), // Default to generating a uuid, can be overwritten by the specified one in tomlString.
+	}
+
+	tree, err := toml.Load(tomlString)
+	if err != nil {
+		return jb, errors.Wrap(err, "toml error on load")
+	}
+
+	err = tree.Unmarshal(&jb)
+	if err != nil {
+		return jb, errors.Wrap(err, "toml unmarshal error on job")
+	}
+
+	var spec job.BlockTriggerSpec
+	err = tree.Unmarshal(&spec)
+	if err != nil {
+		return jb, errors.Wrap(err, "toml unmarshal error on spec")
+	}
+
+	jb.BlockTriggerSpec = &spec
+	if jb.Type != job.BlockTrigger {
+		return jb, errors.Errorf("unsupported type %s", jb.Type)
+	}
+	if !tree.Has("blockInterval") {
+		// Run on every block by default
+		spec.BlockInterval = 1
+	} else if spec.BlockInterval == 0 {
+		return jb, errors.New("blockInterval must be greater than 0")
+	}
+
+	return jb, nil
+}
@@ -0,0 +1,94 @@
+package blocktrigger_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/services/blocktrigger"
+	"github.com/smartcontractkit/chainlink/core/services/job"
+)
+
+func TestValidatedBlockTriggerSpec(t *testing.T) {
+	var tt = []struct {
+		name      string
+		toml      string
+		assertion func(t *testing.T, jb job.Job, err error)
+	}{
+		{
+			name: "valid spec",
+			toml: `
+type              = "blocktrigger"
+schemaVersion     = 1
+blockInterval     = 10
+confirmations     = 3
+runMissedBlocks   = true
+evmChainID        = 42
+observationSource = """
+ds [type=http method=GET url="https://example.com/$(jobRun.blockNumber)"];
+"""
+`,
+			assertion: func(t *testing.T, jb job.Job, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, jb.BlockTriggerSpec)
+				assert.Equal(t, uint32(10), jb.BlockTriggerSpec.BlockInterval)
+				assert.Equal(t, uint32(3), jb.BlockTriggerSpec.Confirmations)
+				assert.True(t, jb.BlockTriggerSpec.RunMissedBlocks)
+				assert.Equal(t, "42", jb.BlockTriggerSpec.EVMChainID.String())
+			},
+		},
+		{
+			name: "runs on every block by default",
+			toml: `
+type              = "blocktrigger"
+schemaVersion     = 1
+observationSource = """
+ds [type=http method=GET url="https://example.com"];
+"""
+`,
+			assertion: func(t *testing.T, jb job.Job, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, jb.BlockTriggerSpec)
+				assert.Equal(t, uint32(1), jb.BlockTriggerSpec.BlockInterval)
+				assert.Equal(t, uint32(0), jb.BlockTriggerSpec.Confirmations)
+				assert.False(t, jb.BlockTriggerSpec.RunMissedBlocks)
+			},
+		},
+		{
+			name: "zero block interval",
+			toml: `
+type              = "blocktrigger"
+schemaVersion     = 1
+blockInterval     = 0
+observationSource = """
+ds [type=http method=GET url="https://example.com"];
+"""
+`,
+			assertion: func(t *testing.T, jb job.Job, err error) {
+				require.EqualError(t, err, "blockInterval must be greater than 0")
+			},
+		},
+		{
+			name: "wrong type",
+			toml: `
+type              = "cron"
+schemaVersion     = 1
+observationSource = """
+ds [type=http method=GET url="https://example.com"];
+"""
+`,
+			assertion: func(t *testing.T, jb job.Job, err error) {
+				require.EqualError(t, err, "unsupported type cron")
+			},
+		},
+	}
+
+	for _, tc := range tt {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			s, err := blocktrigger.ValidatedBlockTriggerSpec(tc.toml)
+			tc.assertion(t, s, err)
+		})
+	}
+}
@@ -0,0 +1,105 @@
+package bulletprooftxmanager
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/services/eth"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+)
+
+// AccessListTimeout must be short since generating an access list adds
+// latency to broadcasting a tx
+const AccessListTimeout = 2 * time.Second
+
+// accessListResult is the result of eth_createAccessList
+type accessListResult struct {
+	AccessList types.AccessList `json:"accessList"`
+	GasUsed    hexutil.Uint64   `json:"gasUsed"`
+	Error      string           `json:"error,omitempty"`
+}
+
+// jobGenerateAccessLists returns whether the given job overrides
+// EVM_GENERATE_ACCESS_LISTS for its transactions
+func jobGenerateAccessLists(q pg.Queryer, jobID int32) (generate null.Bool, err error) {
+	err = q.Get(&generate, `SELECT generate_access_lists FROM jobs WHERE id = $1`, jobID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return generate, nil
+	}
+	return generate, errors.Wrap(err, "failed to load access list setting of job")
+}
+
+// generatesAccessList returns whether an access list should be generated
+// before the first attempt of the given eth_tx
+func (eb *EthBroadcaster) generatesAccessList(etx EthTx) bool {
+	if !eb.config.EvmEIP1559DynamicFees() || etx.AccessList.Valid {
+		return false
+	}
+	if etx.GenerateAccessList.Valid {
+		return etx.GenerateAccessList.Bool
+	}
+	return eb.config.EvmGenerateAccessLists()
+}
+
+// attachAccessList calls eth_createAccessList for the given eth_tx, and
+// attaches the resulting access list to it if it lowers the estimated gas.
+// It returns the gas saved, which is null if no access list was attached.
+// Failing to generate an access list is not fatal; the transaction is sent
+// without one.
+func (eb *EthBroadcaster) attachAccessList(ctx context.Context, etx *EthTx) (gasSaved null.Int) {
+	ctx, cancel := context.WithTimeout(ctx, AccessListTimeout)
+	defer cancel()
+
+	lggr := eb.logger.With("ethTxID", etx.ID, "fromAddress", etx.FromAddress, "toAddress", etx.ToAddress)
+	al, err := createAccessList(ctx, eb.ethClient, *etx)
+	if err != nil {
+		lggr.Warnw("Failed to generate access list, will send transaction without one", "err", err)
+		return gasSaved
+	}
+	estimate, err := eb.ethClient.EstimateGas(ctx, ethereum.CallMsg{
+		From:  etx.FromAddress,
+		To:    &etx.ToAddress,
+		Gas:   etx.GasLimit,
+		Value: etx.Value.ToInt(),
+		Data:  etx.EncodedPayload,
+	})
+	if err != nil {
+		lggr.Warnw("Failed to estimate gas without access list, will send transaction without one", "err", err)
+		return gasSaved
+	}
+	if uint64(al.GasUsed) >= estimate {
+		lggr.Debugw("Access list does not lower estimated gas, will send transaction without one", "gasWithAccessList", uint64(al.GasUsed), "gasWithoutAccessList", estimate)
+		return gasSaved
+	}
+
+	etx.AccessList = NullableEIP2930AccessListFrom(al.AccessList)
+	gasSaved = null.IntFrom(int64(estimate - uint64(al.GasUsed)))
+	lggr.Debugw("Attached access list to transaction", "gasWithAccessList", uint64(al.GasUsed), "gasWithoutAccessList", estimate, "gasSaved", gasSaved.Int64)
+	return gasSaved
+}
+
+func createAccessList(ctx context.Context, ethClient eth.Client, e EthTx) (accessListResult, error) {
+	// See: https://github.com/ethereum/go-ethereum/blob/acdf9238fb03d79c9b1c20c2fa476a7e6f4ac2ac/ethclient/gethclient/gethclient.go#L193
+	callArg := map[string]interface{}{
+		"from":  e.FromAddress,
+		"to":    &e.ToAddress,
+		"gas":   hexutil.Uint64(e.GasLimit),
+		"value": (*hexutil.Big)(e.Value.ToInt()),
+		"data":  hexutil.Bytes(e.EncodedPayload),
+	}
+	var result accessListResult
+	if err := ethClient.CallContext(ctx, &result, "eth_createAccessList", callArg, "pending"); err != nil {
+		return result, errors.Wrap(err, "eth_createAccessList failed")
+	}
+	if result.Error != "" {
+		return result, errors.Errorf("eth_createAccessList failed: %s", result.Error)
+	}
+	return result, nil
+}
@@ -15,6 +15,7 @@ import (
 	exchainutils "github.com/okex/exchain-ethereum-compatible/utils"
 	"github.com/pkg/errors"
 	uuid "github.com/satori/go.uuid"
+	nullv4 "gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/chainlink/core/assets"
 	"github.com/smartcontractkit/chainlink/core/chains"
@@ -36,15 +37,23 @@ import (
 //go:generate mockery --recursive --name Config --output ./mocks/ --case=underscore --structname Config --filename config.go
 type Config interface {
 	gas.Config
+	EthNonceCheckFillGaps() bool
+	EthNonceCheckInterval() time.Duration
+	EthNonceCheckResync() bool
 	EthTxReaperInterval() time.Duration
 	EthTxReaperThreshold() time.Duration
 	EthTxResendAfterThreshold() time.Duration
 	EvmGasBumpThreshold() uint64
 	EvmGasBumpTxDepth() uint16
 	EvmGasLimitDefault() uint64
+	EvmGasLimitTransfer() uint64
+	EvmGenerateAccessLists() bool
 	EvmMaxInFlightTransactions() uint32
 	EvmMaxQueuedTransactions() uint64
 	EvmNonceAutoSync() bool
+	EvmPrivateRelayEnabled() bool
+	EvmPrivateRelayFallbackBlocks() uint32
+	EvmPrivateRelayURL() string
 	EvmRPCDefaultBatchSize() uint32
 	KeySpecificMaxGasPriceWei(addr common.Address) *big.Int
 	TriggerFallbackDBPollInterval() time.Duration
@@ -58,6 +67,12 @@ type KeyStore interface {
 	SubscribeToKeyChanges() (ch chan struct{}, unsub func())
 }
 
+// BalanceMonitor encompasses the subset of the balance monitor used by
+// bulletprooftxmanager
+type BalanceMonitor interface {
+	GetEthBalance(common.Address) *assets.Eth
+}
+
 // For more information about the BulletproofTxManager architecture, see the design doc:
 // https://www.notion.so/chainlink/BulletproofTxManager-Architecture-Overview-9dc62450cd7a443ba9e7dceffa1a8d6b
 
@@ -74,6 +89,11 @@ type TxManager interface {
 	CreateEthTransaction(newTx NewTx, qopts ...pg.QOpt) (etx EthTx, err error)
 	GetGasEstimator() gas.Estimator
 	RegisterResumeCallback(fn ResumeCallback)
+	SubscribeToEvents() (ch <-chan Event, unsub func())
+	CancelEthTransaction(id int64) (etx EthTx, err error)
+	ReplaceEthTransaction(id int64, newPayload []byte) (etx EthTx, err error)
+	NonceStatus(ctx context.Context) ([]NonceStatus, error)
+	SelectFromAddress(ctx context.Context, pool string, gasLimit uint64) (common.Address, error)
 }
 
 type BulletproofTxManager struct {
@@ -86,26 +106,52 @@ type BulletproofTxManager struct {
 	config           Config
 	keyStore         KeyStore
 	eventBroadcaster pg.EventBroadcaster
+	balanceMonitor   BalanceMonitor
 	gasEstimator     gas.Estimator
 	chainID          big.Int
 
 	chHeads        chan *eth.Head
 	trigger        chan common.Address
 	resumeCallback ResumeCallback
+	events         *txEvents
 
 	chStop   chan struct{}
 	chSubbed chan struct{}
 	wg       sync.WaitGroup
 
-	reaper      *Reaper
-	ethResender *EthResender
+	reaper       *Reaper
+	ethResender  *EthResender
+	nonceChecker *NonceChecker
 }
 
 func (b *BulletproofTxManager) RegisterResumeCallback(fn ResumeCallback) {
 	b.resumeCallback = fn
 }
 
-func NewBulletproofTxManager(db *sqlx.DB, ethClient eth.Client, config Config, keyStore KeyStore, eventBroadcaster pg.EventBroadcaster, lggr logger.Logger) *BulletproofTxManager {
+// SubscribeToEvents returns a channel that receives the lifecycle events of
+// all transactions on this chain, and a function that unsubscribes it. Events
+// are dropped if the channel is not drained quickly enough.
+func (b *BulletproofTxManager) SubscribeToEvents() (ch <-chan Event, unsub func()) {
+	return b.events.Subscribe()
+}
+
+// Healthy returns an error if the BulletproofTxManager is not started, or if
+// the last nonce check found a gap in the nonces of a key, or a key that was
+// used by another wallet
+func (b *BulletproofTxManager) Healthy() error {
+	if err := b.StartStopOnce.Healthy(); err != nil {
+		return err
+	}
+	return b.nonceChecker.Healthy()
+}
+
+// NonceStatus checks the nonces of every key on this chain against the chain,
+// without repairing anything
+func (b *BulletproofTxManager) NonceStatus(ctx context.Context) ([]NonceStatus, error) {
+	return b.nonceChecker.CheckAll(ctx, false, false)
+}
+
+func NewBulletproofTxManager(db *sqlx.DB, ethClient eth.Client, config Config, keyStore KeyStore, eventBroadcaster pg.EventBroadcaster, balanceMonitor BalanceMonitor, lggr logger.Logger) *BulletproofTxManager {
 	lggr = lggr.Named("BulletproofTxManager")
 	b := BulletproofTxManager{
 		StartStopOnce:    utils.StartStopOnce{},
@@ -116,12 +162,14 @@ func NewBulletproofTxManager(db *sqlx.DB, ethClient eth.Client, config Config, k
 		config:           config,
 		keyStore:         keyStore,
 		eventBroadcaster: eventBroadcaster,
+		balanceMonitor:   balanceMonitor,
 		gasEstimator:     gas.NewEstimator(lggr, ethClient, config),
 		chainID:          *ethClient.ChainID(),
 		chHeads:          make(chan *eth.Head),
 		trigger:          make(chan common.Address),
 		chStop:           make(chan struct{}),
 		chSubbed:         make(chan struct{}),
+		events:           newTxEvents(lggr),
 	}
 	if config.EthTxResendAfterThreshold() > 0 {
 		b.ethResender = NewEthResender(lggr, db, ethClient, defaultResenderPollInterval, config)
@@ -133,6 +181,10 @@ func NewBulletproofTxManager(db *sqlx.DB, ethClient eth.Client, config Config, k
 	} else {
 		b.logger.Info("EthTxReaper: Disabled")
 	}
+	b.nonceChecker = NewNonceChecker(db, ethClient, config, keyStore, b.gasEstimator, lggr)
+	if config.EthNonceCheckInterval() <= 0 {
+		b.logger.Info("NonceChecker: Disabled")
+	}
 
 	return &b
 }
@@ -150,8 +202,7 @@ func (b *BulletproofTxManager) Start() (merr error) {
 			b.logger.Warnf("Chain %s does not have any eth keys, no transactions will be sent on this chain", b.chainID.String())
 		}
 
-		eb := NewEthBroadcaster(b.db, b.ethClient, b.config, b.keyStore, b.eventBroadcaster, keyStates, b.gasEstimator, b.resumeCallback, b.logger)
-		ec := NewEthConfirmer(b.db, b.ethClient, b.config, b.keyStore, keyStates, b.gasEstimator, b.resumeCallback, b.logger)
+		eb, ec := b.newEthBroadcasterAndConfirmer(keyStates)
 		if err := eb.Start(); err != nil {
 			return errors.Wrap(err, "BulletproofTxManager: EthBroadcaster failed to start")
 		}
@@ -175,6 +226,10 @@ func (b *BulletproofTxManager) Start() (merr error) {
 			b.ethResender.Start()
 		}
 
+		if b.config.EthNonceCheckInterval() > 0 {
+			b.nonceChecker.Start()
+		}
+
 		return nil
 	})
 }
@@ -189,6 +244,9 @@ func (b *BulletproofTxManager) Close() (merr error) {
 		if b.ethResender != nil {
 			b.ethResender.Stop()
 		}
+		if b.config.EthNonceCheckInterval() > 0 {
+			b.nonceChecker.Stop()
+		}
 
 		b.wg.Wait()
 
@@ -198,6 +256,14 @@ func (b *BulletproofTxManager) Close() (merr error) {
 	})
 }
 
+func (b *BulletproofTxManager) newEthBroadcasterAndConfirmer(keyStates []ethkey.State) (*EthBroadcaster, *EthConfirmer) {
+	eb := NewEthBroadcaster(b.db, b.ethClient, b.config, b.keyStore, b.eventBroadcaster, keyStates, b.gasEstimator, b.resumeCallback, b.logger)
+	eb.events = b.events
+	ec := NewEthConfirmer(b.db, b.ethClient, b.config, b.keyStore, keyStates, b.gasEstimator, b.resumeCallback, b.logger)
+	ec.events = b.events
+	return eb, ec
+}
+
 func (b *BulletproofTxManager) runLoop(eb *EthBroadcaster, ec *EthConfirmer) {
 	defer b.wg.Done()
 	keysChanged, unsub := b.keyStore.SubscribeToKeyChanges()
@@ -226,8 +292,7 @@ func (b *BulletproofTxManager) runLoop(eb *EthBroadcaster, ec *EthConfirmer) {
 			b.logger.ErrorIfClosing(eb, "EthBroadcaster")
 			b.logger.ErrorIfClosing(ec, "EthConfirmer")
 
-			eb = NewEthBroadcaster(b.db, b.ethClient, b.config, b.keyStore, b.eventBroadcaster, keyStates, b.gasEstimator, b.resumeCallback, b.logger)
-			ec = NewEthConfirmer(b.db, b.ethClient, b.config, b.keyStore, keyStates, b.gasEstimator, b.resumeCallback, b.logger)
+			eb, ec = b.newEthBroadcasterAndConfirmer(keyStates)
 
 			if err := eb.Start(); err != nil {
 				b.logger.Errorw("Failed to start EthBroadcaster", "error", err)
@@ -275,6 +340,13 @@ type NewTx struct {
 	MinConfirmations  null.Uint32
 	PipelineTaskRunID *uuid.UUID
 
+	// GenerateAccessList overrides EVM_GENERATE_ACCESS_LISTS. If not set, the
+	// setting of the job in Meta is used, if any.
+	GenerateAccessList nullv4.Bool
+	// PrivateRelay overrides EVM_PRIVATE_RELAY_ENABLED. If not set, the
+	// setting of the job in Meta is used, if any.
+	PrivateRelay nullv4.Bool
+
 	Strategy TxStrategy
 }
 
@@ -287,7 +359,12 @@ func (b *BulletproofTxManager) CreateEthTransaction(newTx NewTx, qs ...pg.QOpt)
 		return etx, errors.Wrap(err, "BulletproofTxManager#CreateEthTransaction")
 	}
 
+	// When the caller passes in its own transaction, the eth_tx is not
+	// committed until the caller commits, and may yet be rolled back
+	_, inCallerTx := q.Queryer.(*sqlx.Tx)
+
 	value := 0
+	existing := false
 	err = q.Transaction(func(tx pg.Queryer) error {
 		if newTx.PipelineTaskRunID != nil {
 			err = tx.Get(&etx, `SELECT * FROM eth_txes WHERE pipeline_task_run_id = $1 AND evm_chain_id = $2`, newTx.PipelineTaskRunID, b.chainID.String())
@@ -297,19 +374,37 @@ func (b *BulletproofTxManager) CreateEthTransaction(newTx NewTx, qs ...pg.QOpt)
 					return errors.Wrap(err, "BulletproofTxManager#CreateEthTransaction")
 				}
 				// if a previous transaction for this task run exists, immediately return it
+				existing = true
 				return nil
 			}
 		}
+		if newTx.Meta != nil && newTx.Meta.JobID != 0 {
+			if err = checkGasSpendBudget(tx, newTx.Meta.JobID, b.chainID); err != nil {
+				return errors.Wrap(err, "BulletproofTxManager#CreateEthTransaction")
+			}
+		}
 		if err = b.checkStateExists(tx, newTx.FromAddress); err != nil {
 			return err
 		}
+		generateAccessList := newTx.GenerateAccessList
+		if !generateAccessList.Valid && newTx.Meta != nil && newTx.Meta.JobID != 0 {
+			if generateAccessList, err = jobGenerateAccessLists(tx, newTx.Meta.JobID); err != nil {
+				return errors.Wrap(err, "BulletproofTxManager#CreateEthTransaction")
+			}
+		}
+		privateRelay := newTx.PrivateRelay
+		if !privateRelay.Valid && newTx.Meta != nil && newTx.Meta.JobID != 0 {
+			if privateRelay, err = jobPrivateRelay(tx, newTx.Meta.JobID); err != nil {
+				return errors.Wrap(err, "BulletproofTxManager#CreateEthTransaction")
+			}
+		}
 		err := tx.Get(&etx, `
-INSERT INTO eth_txes (from_address, to_address, encoded_payload, value, gas_limit, state, created_at, meta, subject, evm_chain_id, min_confirmations, pipeline_task_run_id, simulate)
+INSERT INTO eth_txes (from_address, to_address, encoded_payload, value, gas_limit, state, created_at, meta, subject, evm_chain_id, min_confirmations, pipeline_task_run_id, simulate, generate_access_list, private_relay)
 VALUES (
-$1,$2,$3,$4,$5,'unstarted',NOW(),$6,$7,$8,$9,$10,$11
+$1,$2,$3,$4,$5,'unstarted',NOW(),$6,$7,$8,$9,$10,$11,$12,$13
 )
 RETURNING "eth_txes".*
-`, newTx.FromAddress, newTx.ToAddress, newTx.EncodedPayload, value, newTx.GasLimit, newTx.Meta, newTx.Strategy.Subject(), b.chainID.String(), newTx.MinConfirmations, newTx.PipelineTaskRunID, newTx.Strategy.Simulate())
+`, newTx.FromAddress, newTx.ToAddress, newTx.EncodedPayload, value, newTx.GasLimit, newTx.Meta, newTx.Strategy.Subject(), b.chainID.String(), newTx.MinConfirmations, newTx.PipelineTaskRunID, newTx.Strategy.Simulate(), generateAccessList, privateRelay)
 		if err != nil {
 			return errors.Wrap(err, "BulletproofTxManager#CreateEthTransaction failed to insert eth_tx")
 		}
@@ -323,6 +418,13 @@ RETURNING "eth_txes".*
 		}
 		return nil
 	})
+	if err == nil && !existing {
+		if inCallerTx {
+			b.events.deferCreated(etx)
+		} else {
+			b.events.emitEthTx(EventCreated, etx, nil)
+		}
+	}
 	return
 }
 
@@ -345,6 +447,162 @@ func (b *BulletproofTxManager) GetGasEstimator() gas.Estimator {
 	return b.gasEstimator
 }
 
+var (
+	// ErrEthTxCancelled is the error saved on unstarted transactions that were
+	// cancelled, and passed to the pipeline task runs waiting on cancelled
+	// transactions
+	ErrEthTxCancelled = errors.New("transaction was cancelled by the node operator")
+	// ErrEthTxNotReplaceable is returned when cancelling or replacing a
+	// transaction that is being broadcast or is already finished
+	ErrEthTxNotReplaceable = errors.New("transaction cannot be cancelled or replaced")
+)
+
+// CancelEthTransaction aborts the transaction with the given ID.
+//
+// Unstarted transactions are marked as fatally errored and are never sent.
+// Unconfirmed transactions are replaced by a zero-value transfer to the
+// sending address with the same nonce, at a bumped fee. The EthConfirmer
+// broadcasts the replacement on the next head and tracks it like any other
+// attempt. Note that one of the earlier attempts may still be mined first.
+func (b *BulletproofTxManager) CancelEthTransaction(id int64) (etx EthTx, err error) {
+	etx, err = b.replaceEthTransaction(id, true, toEmptyTransaction)
+	if err != nil {
+		return etx, errors.Wrap(err, "BulletproofTxManager#CancelEthTransaction")
+	}
+	b.resumeCancelledTaskRun(etx)
+	return etx, nil
+}
+
+// ReplaceEthTransaction replaces the payload of the transaction with the given
+// ID. Unstarted transactions are updated in place. Unconfirmed transactions are
+// replaced by a transaction with the new payload and the same nonce, at a
+// bumped fee, in the same way as CancelEthTransaction.
+func (b *BulletproofTxManager) ReplaceEthTransaction(id int64, newPayload []byte) (etx EthTx, err error) {
+	etx, err = b.replaceEthTransaction(id, false, func(etx *EthTx) {
+		etx.EncodedPayload = newPayload
+	})
+	return etx, errors.Wrap(err, "BulletproofTxManager#ReplaceEthTransaction")
+}
+
+// toEmptyTransaction turns the transaction into a zero-value transfer to the
+// sending address, like makeEmptyTransaction
+func toEmptyTransaction(etx *EthTx) {
+	etx.ToAddress = etx.FromAddress
+	etx.EncodedPayload = []byte{}
+	etx.Value = assets.NewEthValue(0)
+}
+
+// replaceEthTransaction applies replace to the transaction with the given ID,
+// or marks it as cancelled if it is unstarted and cancel is true
+func (b *BulletproofTxManager) replaceEthTransaction(id int64, cancel bool, replace func(etx *EthTx)) (etx EthTx, err error) {
+	err = b.q.Transaction(func(tx pg.Queryer) error {
+		if err = tx.Get(&etx, `SELECT * FROM eth_txes WHERE id = $1 AND evm_chain_id = $2 FOR UPDATE`, id, b.chainID.String()); err != nil {
+			return errors.Wrapf(err, "failed to load eth_tx %d", id)
+		}
+
+		switch etx.State {
+		case EthTxUnstarted:
+			if cancel {
+				err = tx.Get(&etx, `UPDATE eth_txes SET state = $1, error = $2 WHERE id = $3 RETURNING *`, EthTxFatalError, ErrEthTxCancelled.Error(), etx.ID)
+				return errors.Wrap(err, "failed to cancel eth_tx")
+			}
+			replace(&etx)
+			err = tx.Get(&etx, `UPDATE eth_txes SET to_address = $1, encoded_payload = $2, value = $3 WHERE id = $4 RETURNING *`, etx.ToAddress, etx.EncodedPayload, etx.Value, etx.ID)
+			return errors.Wrap(err, "failed to update eth_tx")
+		case EthTxUnconfirmed:
+			return b.saveReplacementEthTx(tx, &etx, replace)
+		default:
+			return errors.Wrapf(ErrEthTxNotReplaceable, "eth_tx %d is %s", etx.ID, etx.State)
+		}
+	})
+	if err != nil {
+		return etx, err
+	}
+	switch etx.State {
+	case EthTxFatalError:
+		b.events.emitEthTx(EventFatalError, etx, nil)
+	case EthTxUnconfirmed:
+		// The replacement attempt is the highest priced one
+		b.events.emitEthTx(EventGasBumped, etx, nil)
+	}
+	return etx, nil
+}
+
+// saveReplacementEthTx rewrites an unconfirmed transaction and inserts an
+// in_progress attempt for it, at a fee bumped from the highest priced attempt
+// so that it replaces the earlier attempts in the mempool. Attempts that were
+// never accepted by the eth node are deleted so that they are not resent.
+func (b *BulletproofTxManager) saveReplacementEthTx(tx pg.Queryer, etx *EthTx, replace func(etx *EthTx)) error {
+	if err := loadEthTxAttempts(tx, etx); err != nil {
+		return err
+	}
+	if len(etx.EthTxAttempts) == 0 {
+		return errors.Errorf("invariant violation: eth_tx %d was unconfirmed but didn't have any attempts", etx.ID)
+	}
+	previousAttempt := etx.EthTxAttempts[0]
+	replace(etx)
+
+	attempt, err := b.bumpedAttempt(*etx, previousAttempt)
+	if err != nil {
+		return err
+	}
+
+	if _, err = tx.Exec(`DELETE FROM eth_tx_attempts WHERE eth_tx_id = $1 AND state <> 'broadcast'`, etx.ID); err != nil {
+		return errors.Wrap(err, "failed to delete unbroadcast eth_tx_attempts")
+	}
+	if err = tx.Get(etx, `UPDATE eth_txes SET to_address = $1, encoded_payload = $2, value = $3 WHERE id = $4 RETURNING *`, etx.ToAddress, etx.EncodedPayload, etx.Value, etx.ID); err != nil {
+		return errors.Wrap(err, "failed to update eth_tx")
+	}
+	query, args, err := tx.BindNamed(insertIntoEthTxAttemptsQuery, &attempt)
+	if err != nil {
+		return errors.Wrap(err, "failed to BindNamed")
+	}
+	if err = tx.Get(&attempt, query, args...); err != nil {
+		return errors.Wrap(err, "failed to insert replacement eth_tx_attempt")
+	}
+	etx.EthTxAttempts = nil
+	return loadEthTxAttempts(tx, etx)
+}
+
+func (b *BulletproofTxManager) bumpedAttempt(etx EthTx, previousAttempt EthTxAttempt) (attempt EthTxAttempt, err error) {
+	cks := NewChainKeyStore(b.chainID, b.config, b.keyStore)
+	switch previousAttempt.TxType {
+	case 0x0:
+		var bumpedGasPrice *big.Int
+		var bumpedGasLimit uint64
+		bumpedGasPrice, bumpedGasLimit, err = b.gasEstimator.BumpLegacyGas(previousAttempt.GasPrice.ToInt(), etx.GasLimit)
+		if err != nil {
+			return attempt, errors.Wrap(err, "error bumping gas")
+		}
+		return cks.NewLegacyAttempt(etx, bumpedGasPrice, bumpedGasLimit)
+	case 0x2:
+		var bumpedFee gas.DynamicFee
+		var bumpedGasLimit uint64
+		bumpedFee, bumpedGasLimit, err = b.gasEstimator.BumpDynamicFee(previousAttempt.DynamicFee(), etx.GasLimit)
+		if err != nil {
+			return attempt, errors.Wrap(err, "error bumping gas")
+		}
+		return cks.NewDynamicFeeAttempt(etx, bumpedFee, bumpedGasLimit)
+	default:
+		return attempt, errors.Errorf("invariant violation: Attempt %v had unrecognised transaction type %v", previousAttempt.ID, previousAttempt.TxType)
+	}
+}
+
+// resumeCancelledTaskRun fails the pipeline task run waiting on a cancelled
+// transaction, if any. The task run is not resumed again once the transaction
+// confirms, since its pipeline run is no longer suspended.
+func (b *BulletproofTxManager) resumeCancelledTaskRun(etx EthTx) {
+	if !etx.PipelineTaskRunID.Valid || b.resumeCallback == nil {
+		return
+	}
+	err := b.resumeCallback(etx.PipelineTaskRunID.UUID, nil, ErrEthTxCancelled)
+	if errors.Is(err, sql.ErrNoRows) {
+		b.logger.Debugw("callback missing or already resumed", "etxID", etx.ID)
+	} else if err != nil {
+		b.logger.Errorw("Failed to resume pipeline task run of cancelled transaction", "etxID", etx.ID, "err", err)
+	}
+}
+
 // SendEther creates a transaction that transfers the given value of ether
 // TODO: Make this a method on the bulletprooftxmanager
 func SendEther(q pg.Q, chainID *big.Int, from, to common.Address, value assets.Eth, gasLimit uint64) (etx EthTx, err error) {
@@ -406,9 +664,10 @@ func signedTxHash(signedTx *gethTypes.Transaction, chainType chains.ChainType) (
 	return hash, nil
 }
 
-// send broadcasts the transaction to the ethereum network, writes any relevant
-// data onto the attempt and returns an error (or nil) depending on the status
-func sendTransaction(ctx context.Context, ethClient eth.Client, a EthTxAttempt, e EthTx, logger logger.Logger) *eth.SendError {
+// send broadcasts the transaction to the ethereum network, or to the private
+// relay if the eth_tx is sent privately, writes any relevant data onto the
+// attempt and returns an error (or nil) depending on the status
+func sendTransaction(ctx context.Context, ethClient eth.Client, relay *privateRelay, a EthTxAttempt, e EthTx, logger logger.Logger) *eth.SendError {
 	signedTx, err := a.GetSignedTx()
 	if err != nil {
 		return eth.NewFatalSendError(err)
@@ -417,11 +676,16 @@ func sendTransaction(ctx context.Context, ethClient eth.Client, a EthTxAttempt,
 	ctx, cancel := eth.DefaultQueryCtx(ctx)
 	defer cancel()
 
-	err = ethClient.SendTransaction(ctx, signedTx)
+	private := relay.sendsPrivately(e)
+	if private {
+		err = relay.sendTransaction(ctx, a.SignedRawTx)
+	} else {
+		err = ethClient.SendTransaction(ctx, signedTx)
+	}
 	err = errors.WithStack(err)
 
 	a.EthTx = e // for logging
-	logger.Debugw("Sent transaction", "ethTxAttemptID", a.ID, "txHash", a.Hash, "err", err, "meta", e.Meta, "gasLimit", e.GasLimit, "attempt", a)
+	logger.Debugw("Sent transaction", "ethTxAttemptID", a.ID, "txHash", a.Hash, "err", err, "meta", e.Meta, "gasLimit", e.GasLimit, "privateRelay", private, "attempt", a)
 	sendErr := eth.NewSendError(err)
 	if sendErr.IsTransactionAlreadyInMempool() {
 		logger.Debugw("Transaction already in mempool", "txHash", a.Hash, "nodeErr", sendErr.Error())
@@ -486,8 +750,8 @@ func makeEmptyTransaction(keyStore KeyStore, nonce uint64, gasLimit uint64, gasP
 }
 
 const insertIntoEthTxAttemptsQuery = `
-INSERT INTO eth_tx_attempts (eth_tx_id, gas_price, signed_raw_tx, hash, broadcast_before_block_num, state, created_at, chain_specific_gas_limit, tx_type, gas_tip_cap, gas_fee_cap)
-VALUES (:eth_tx_id, :gas_price, :signed_raw_tx, :hash, :broadcast_before_block_num, :state, NOW(), :chain_specific_gas_limit, :tx_type, :gas_tip_cap, :gas_fee_cap)
+INSERT INTO eth_tx_attempts (eth_tx_id, gas_price, signed_raw_tx, hash, broadcast_before_block_num, state, created_at, chain_specific_gas_limit, tx_type, gas_tip_cap, gas_fee_cap, access_list_gas_saved)
+VALUES (:eth_tx_id, :gas_price, :signed_raw_tx, :hash, :broadcast_before_block_num, :state, NOW(), :chain_specific_gas_limit, :tx_type, :gas_tip_cap, :gas_fee_cap, :access_list_gas_saved)
 RETURNING *;
 `
 
@@ -562,3 +826,22 @@ func (n *NullTxManager) Healthy() error                           { return nil }
 func (n *NullTxManager) Ready() error                             { return nil }
 func (n *NullTxManager) GetGasEstimator() gas.Estimator           { return nil }
 func (n *NullTxManager) RegisterResumeCallback(fn ResumeCallback) {}
+
+// SubscribeToEvents returns a channel that never receives, since no
+// transactions are sent
+func (n *NullTxManager) SubscribeToEvents() (<-chan Event, func()) {
+	ch := make(chan Event)
+	return ch, func() { close(ch) }
+}
+func (n *NullTxManager) CancelEthTransaction(int64) (etx EthTx, err error) {
+	return etx, errors.New(n.ErrMsg)
+}
+func (n *NullTxManager) ReplaceEthTransaction(int64, []byte) (etx EthTx, err error) {
+	return etx, errors.New(n.ErrMsg)
+}
+func (n *NullTxManager) NonceStatus(context.Context) ([]NonceStatus, error) {
+	return nil, errors.New(n.ErrMsg)
+}
+func (n *NullTxManager) SelectFromAddress(context.Context, string, uint64) (common.Address, error) {
+	return common.Address{}, errors.New(n.ErrMsg)
+}
@@ -2,6 +2,9 @@ package bulletprooftxmanager_test
 
 import (
 	"context"
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"math/big"
 	"testing"
@@ -9,14 +12,17 @@ import (
 
 	gethcommon "github.com/ethereum/go-ethereum/common"
 	gethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
 	uuid "github.com/satori/go.uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/chainlink/core/assets"
 	"github.com/smartcontractkit/chainlink/core/chains"
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/evmtest"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/services/bulletprooftxmanager"
@@ -199,7 +205,7 @@ func TestBulletproofTxManager_CreateEthTransaction(t *testing.T) {
 	ethClient := cltest.NewEthClientMockWithDefaultChain(t)
 
 	lggr := logger.TestLogger(t)
-	bptxm := bulletprooftxmanager.NewBulletproofTxManager(db, ethClient, config, nil, nil, lggr)
+	bptxm := bulletprooftxmanager.NewBulletproofTxManager(db, ethClient, config, nil, nil, nil, lggr)
 
 	t.Run("with queue under capacity inserts eth_tx", func(t *testing.T) {
 		subject := uuid.NewV4()
@@ -281,6 +287,36 @@ func TestBulletproofTxManager_CreateEthTransaction(t *testing.T) {
 		assert.Equal(t, tx1.ID, tx2.ID)
 	})
 
+	t.Run("uses the access list setting of the job unless overridden", func(t *testing.T) {
+		jb, _ := cltest.MustInsertWebhookSpec(t, db)
+		pgtest.MustExec(t, db, `UPDATE jobs SET generate_access_lists = false WHERE id = $1`, jb.ID)
+
+		config.On("EvmMaxQueuedTransactions").Return(uint64(4)).Once()
+		etx, err := bptxm.CreateEthTransaction(bulletprooftxmanager.NewTx{
+			FromAddress:    fromAddress,
+			ToAddress:      cltest.NewAddress(),
+			EncodedPayload: []byte{1, 2, 3},
+			GasLimit:       21000,
+			Meta:           &bulletprooftxmanager.EthTxMeta{JobID: jb.ID},
+			Strategy:       bulletprooftxmanager.SendEveryStrategy{},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, null.BoolFrom(false), etx.GenerateAccessList)
+
+		config.On("EvmMaxQueuedTransactions").Return(uint64(5)).Once()
+		etx, err = bptxm.CreateEthTransaction(bulletprooftxmanager.NewTx{
+			FromAddress:        fromAddress,
+			ToAddress:          cltest.NewAddress(),
+			EncodedPayload:     []byte{1, 2, 3},
+			GasLimit:           21000,
+			Meta:               &bulletprooftxmanager.EthTxMeta{JobID: jb.ID},
+			GenerateAccessList: null.BoolFrom(true),
+			Strategy:           bulletprooftxmanager.SendEveryStrategy{},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, null.BoolFrom(true), etx.GenerateAccessList)
+	})
+
 	t.Run("returns error if eth key state is missing or doesn't match chain ID", func(t *testing.T) {
 		config.On("EvmMaxQueuedTransactions").Return(uint64(3)).Twice()
 		rndAddr := cltest.NewAddress()
@@ -335,7 +371,7 @@ func TestBulletproofTxManager_CreateEthTransaction_OutOfEth(t *testing.T) {
 	config.On("LogSQL").Return(false)
 	ethClient := cltest.NewEthClientMockWithDefaultChain(t)
 	lggr := logger.TestLogger(t)
-	bptxm := bulletprooftxmanager.NewBulletproofTxManager(db, ethClient, config, nil, nil, lggr)
+	bptxm := bulletprooftxmanager.NewBulletproofTxManager(db, ethClient, config, nil, nil, nil, lggr)
 
 	t.Run("if another key has any transactions with insufficient eth errors, transmits as normal", func(t *testing.T) {
 		payload := cltest.MustRandomBytes(t, 100)
@@ -408,6 +444,255 @@ func TestBulletproofTxManager_CreateEthTransaction_OutOfEth(t *testing.T) {
 	})
 }
 
+func TestBulletproofTxManager_CancelEthTransaction(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	cfg := cltest.NewTestGeneralConfig(t)
+	cfg.Overrides.GlobalGasEstimatorMode = null.StringFrom("FixedPrice")
+	borm := cltest.NewBulletproofTxManagerORM(t, db, cfg)
+	ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
+	_, fromAddress := cltest.MustInsertRandomKey(t, ethKeyStore, 0)
+
+	ethClient := cltest.NewEthClientMockWithDefaultChain(t)
+	evmcfg := evmtest.NewChainScopedConfig(t, cfg)
+	bptxm := bulletprooftxmanager.NewBulletproofTxManager(db, ethClient, evmcfg, ethKeyStore, nil, nil, logger.TestLogger(t))
+
+	t.Run("marks unstarted transactions as cancelled", func(t *testing.T) {
+		etx := cltest.MustInsertUnstartedEthTx(t, borm, fromAddress)
+
+		cancelled, err := bptxm.CancelEthTransaction(etx.ID)
+		require.NoError(t, err)
+
+		assert.Equal(t, bulletprooftxmanager.EthTxFatalError, cancelled.State)
+		assert.Equal(t, bulletprooftxmanager.ErrEthTxCancelled.Error(), cancelled.Error.String)
+		assert.Nil(t, cancelled.Nonce)
+		assert.Equal(t, etx.ToAddress, cancelled.ToAddress)
+	})
+
+	t.Run("replaces unconfirmed transactions with an empty transaction at a bumped fee", func(t *testing.T) {
+		etx := cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, borm, 0, fromAddress)
+		previousAttempt := etx.EthTxAttempts[0]
+
+		cancelled, err := bptxm.CancelEthTransaction(etx.ID)
+		require.NoError(t, err)
+
+		assert.Equal(t, bulletprooftxmanager.EthTxUnconfirmed, cancelled.State)
+		assert.Equal(t, etx.Nonce, cancelled.Nonce)
+		assert.Equal(t, fromAddress, cancelled.ToAddress)
+		assert.Empty(t, cancelled.EncodedPayload)
+		assert.Equal(t, assets.NewEthValue(0), cancelled.Value)
+
+		require.Len(t, cancelled.EthTxAttempts, 2)
+		attempt := cancelled.EthTxAttempts[0]
+		assert.Equal(t, bulletprooftxmanager.EthTxAttemptInProgress, attempt.State)
+		assert.Equal(t, 1, attempt.GasPrice.ToInt().Cmp(previousAttempt.GasPrice.ToInt()))
+
+		tx, err := attempt.GetSignedTx()
+		require.NoError(t, err)
+		assert.Equal(t, uint64(*etx.Nonce), tx.Nonce())
+		assert.Equal(t, fromAddress, *tx.To())
+		assert.Zero(t, tx.Value().Sign())
+	})
+
+	t.Run("does not cancel finished transactions", func(t *testing.T) {
+		etx := cltest.MustInsertConfirmedEthTxWithLegacyAttempt(t, borm, 1, 1, fromAddress)
+
+		_, err := bptxm.CancelEthTransaction(etx.ID)
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, bulletprooftxmanager.ErrEthTxNotReplaceable))
+	})
+
+	t.Run("returns sql.ErrNoRows for missing transactions", func(t *testing.T) {
+		_, err := bptxm.CancelEthTransaction(-1)
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, sql.ErrNoRows))
+	})
+}
+
+func TestBulletproofTxManager_ReplaceEthTransaction(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	cfg := cltest.NewTestGeneralConfig(t)
+	cfg.Overrides.GlobalGasEstimatorMode = null.StringFrom("FixedPrice")
+	borm := cltest.NewBulletproofTxManagerORM(t, db, cfg)
+	ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
+	_, fromAddress := cltest.MustInsertRandomKey(t, ethKeyStore, 0)
+
+	ethClient := cltest.NewEthClientMockWithDefaultChain(t)
+	evmcfg := evmtest.NewChainScopedConfig(t, cfg)
+	bptxm := bulletprooftxmanager.NewBulletproofTxManager(db, ethClient, evmcfg, ethKeyStore, nil, nil, logger.TestLogger(t))
+	payload := []byte{4, 5, 6}
+
+	t.Run("updates unstarted transactions in place", func(t *testing.T) {
+		etx := cltest.MustInsertUnstartedEthTx(t, borm, fromAddress)
+
+		replaced, err := bptxm.ReplaceEthTransaction(etx.ID, payload)
+		require.NoError(t, err)
+
+		assert.Equal(t, bulletprooftxmanager.EthTxUnstarted, replaced.State)
+		assert.Equal(t, payload, replaced.EncodedPayload)
+		assert.Equal(t, etx.ToAddress, replaced.ToAddress)
+	})
+
+	t.Run("replaces unconfirmed transactions at a bumped fee", func(t *testing.T) {
+		etx := cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, borm, 0, fromAddress)
+
+		replaced, err := bptxm.ReplaceEthTransaction(etx.ID, payload)
+		require.NoError(t, err)
+
+		assert.Equal(t, payload, replaced.EncodedPayload)
+		assert.Equal(t, etx.ToAddress, replaced.ToAddress)
+		require.Len(t, replaced.EthTxAttempts, 2)
+
+		tx, err := replaced.EthTxAttempts[0].GetSignedTx()
+		require.NoError(t, err)
+		assert.Equal(t, payload, tx.Data())
+		assert.Equal(t, etx.ToAddress, *tx.To())
+	})
+
+	t.Run("does not replace transactions that are being broadcast", func(t *testing.T) {
+		etx := cltest.MustInsertInProgressEthTxWithAttempt(t, borm, 1, fromAddress)
+
+		_, err := bptxm.ReplaceEthTransaction(etx.ID, payload)
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, bulletprooftxmanager.ErrEthTxNotReplaceable))
+	})
+}
+
+func TestBulletproofTxManager_SubscribeToEvents(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	cfg := cltest.NewTestGeneralConfig(t)
+	cfg.Overrides.GlobalGasEstimatorMode = null.StringFrom("FixedPrice")
+	borm := cltest.NewBulletproofTxManagerORM(t, db, cfg)
+	ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
+	_, fromAddress := cltest.MustInsertRandomKey(t, ethKeyStore, 0)
+
+	ethClient := cltest.NewEthClientMockWithDefaultChain(t)
+	evmcfg := evmtest.NewChainScopedConfig(t, cfg)
+	bptxm := bulletprooftxmanager.NewBulletproofTxManager(db, ethClient, evmcfg, ethKeyStore, nil, nil, logger.TestLogger(t))
+
+	events, unsub := bptxm.SubscribeToEvents()
+	defer unsub()
+
+	t.Run("emits created for new transactions", func(t *testing.T) {
+		etx, err := bptxm.CreateEthTransaction(bulletprooftxmanager.NewTx{
+			FromAddress:    fromAddress,
+			ToAddress:      cltest.NewAddress(),
+			EncodedPayload: []byte{1, 2, 3},
+			GasLimit:       1000,
+			Meta:           &bulletprooftxmanager.EthTxMeta{JobID: 42},
+			Strategy:       bulletprooftxmanager.NewSendEveryStrategy(false),
+		})
+		require.NoError(t, err)
+
+		event := <-events
+		assert.Equal(t, bulletprooftxmanager.EventCreated, event.Type)
+		assert.Equal(t, etx.ID, event.EthTxID)
+		assert.Equal(t, fromAddress, event.FromAddress)
+		assert.Equal(t, etx.ToAddress, event.ToAddress)
+		require.NotNil(t, event.Meta)
+		var meta bulletprooftxmanager.EthTxMeta
+		require.NoError(t, json.Unmarshal(*event.Meta, &meta))
+		assert.Equal(t, int32(42), meta.JobID)
+		assert.Nil(t, event.AttemptHash)
+	})
+
+	t.Run("emits created for transactions created within the caller's transaction once it is committed", func(t *testing.T) {
+		inserts := make(chan pg.Event, 1)
+		sub := new(pgmocks.Subscription)
+		sub.On("Events").Return((<-chan pg.Event)(inserts))
+		sub.On("Close").Return()
+		eventBroadcaster := new(pgmocks.EventBroadcaster)
+		eventBroadcaster.On("Subscribe", pg.ChannelInsertOnEthTx, "").Return(sub, nil)
+		eb := bulletprooftxmanager.NewEthBroadcaster(db, ethClient, evmcfg, ethKeyStore, eventBroadcaster, nil, nil, nil, logger.TestLogger(t))
+		bulletprooftxmanager.SetEventsOnEthBroadcaster(bptxm, eb)
+		require.NoError(t, eb.Start())
+		defer eb.Close()
+
+		newTx := bulletprooftxmanager.NewTx{
+			FromAddress:    fromAddress,
+			ToAddress:      cltest.NewAddress(),
+			EncodedPayload: []byte{1, 2, 3},
+			GasLimit:       1000,
+			Strategy:       bulletprooftxmanager.NewSendEveryStrategy(false),
+		}
+		rollback := errors.New("rollback")
+		err := pg.SqlxTransactionWithDefaultCtx(db, logger.TestLogger(t), func(tx pg.Queryer) error {
+			_, err := bptxm.CreateEthTransaction(newTx, pg.WithQueryer(tx))
+			require.NoError(t, err)
+			return rollback
+		})
+		require.Equal(t, rollback, errors.Cause(err))
+
+		var etx bulletprooftxmanager.EthTx
+		err = pg.SqlxTransactionWithDefaultCtx(db, logger.TestLogger(t), func(tx pg.Queryer) error {
+			etx, err = bptxm.CreateEthTransaction(newTx, pg.WithQueryer(tx))
+			require.NoError(t, err)
+
+			select {
+			case event := <-events:
+				t.Fatalf("unexpected %s event for eth_tx %d before commit", event.Type, event.EthTxID)
+			default:
+			}
+			return nil
+		})
+		require.NoError(t, err)
+
+		// Postgres notifies the insert once the transaction is committed
+		inserts <- pg.Event{Channel: pg.ChannelInsertOnEthTx, Payload: hex.EncodeToString(fromAddress.Bytes())}
+
+		select {
+		case event := <-events:
+			assert.Equal(t, bulletprooftxmanager.EventCreated, event.Type)
+			assert.Equal(t, etx.ID, event.EthTxID)
+		case <-time.After(cltest.WaitTimeout(t)):
+			t.Fatal("timed out waiting for created event")
+		}
+		select {
+		case event := <-events:
+			t.Fatalf("unexpected %s event for eth_tx %d", event.Type, event.EthTxID)
+		default:
+		}
+	})
+
+	t.Run("emits fatal_error for cancelled unstarted transactions", func(t *testing.T) {
+		etx := cltest.MustInsertUnstartedEthTx(t, borm, fromAddress)
+
+		_, err := bptxm.CancelEthTransaction(etx.ID)
+		require.NoError(t, err)
+
+		event := <-events
+		assert.Equal(t, bulletprooftxmanager.EventFatalError, event.Type)
+		assert.Equal(t, etx.ID, event.EthTxID)
+		assert.Equal(t, bulletprooftxmanager.ErrEthTxCancelled.Error(), event.Error.String)
+	})
+
+	t.Run("emits gas_bumped with the replacement attempt for replaced unconfirmed transactions", func(t *testing.T) {
+		etx := cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, borm, 0, fromAddress)
+
+		replaced, err := bptxm.ReplaceEthTransaction(etx.ID, []byte{4, 5})
+		require.NoError(t, err)
+
+		event := <-events
+		assert.Equal(t, bulletprooftxmanager.EventGasBumped, event.Type)
+		assert.Equal(t, etx.ID, event.EthTxID)
+		require.NotNil(t, event.AttemptHash)
+		assert.Equal(t, replaced.EthTxAttempts[0].Hash, *event.AttemptHash)
+		assert.Equal(t, replaced.EthTxAttempts[0].GasPrice, event.GasPrice)
+		assert.Equal(t, etx.Nonce, event.Nonce)
+	})
+
+	t.Run("stops emitting once unsubscribed", func(t *testing.T) {
+		unsub()
+		_, open := <-events
+		assert.False(t, open)
+	})
+}
+
 func TestBulletproofTxManager_Lifecycle(t *testing.T) {
 	db := pgtest.NewSqlxDB(t)
 
@@ -432,7 +717,7 @@ func TestBulletproofTxManager_Lifecycle(t *testing.T) {
 	unsub := cltest.NewAwaiter()
 	kst.On("SubscribeToKeyChanges").Return(keyChangeCh, unsub.ItHappened)
 	lggr := logger.TestLogger(t)
-	bptxm := bulletprooftxmanager.NewBulletproofTxManager(db, ethClient, config, kst, eventBroadcaster, lggr)
+	bptxm := bulletprooftxmanager.NewBulletproofTxManager(db, ethClient, config, kst, eventBroadcaster, nil, lggr)
 
 	head := cltest.Head(42)
 	// It should not hang or panic
@@ -29,6 +29,8 @@ import (
 // transaction
 const InFlightTransactionRecheckInterval = 1 * time.Second
 
+// errEthTxRemoved is returned when an eth_tx was removed, cancelled or
+// replaced between being loaded and saved as in_progress
 var errEthTxRemoved = errors.New("eth_tx removed")
 
 // EthBroadcaster monitors eth_txes for transactions that need to
@@ -52,6 +54,9 @@ type EthBroadcaster struct {
 	ChainKeyStore
 	estimator      gas.Estimator
 	resumeCallback ResumeCallback
+	// events is set by the BulletproofTxManager and may be nil
+	events *txEvents
+	relay  *privateRelay
 
 	ethTxInsertListener pg.Subscription
 	eventBroadcaster    pg.EventBroadcaster
@@ -88,6 +93,7 @@ func NewEthBroadcaster(db *sqlx.DB, ethClient eth.Client, config Config, keystor
 			keystore: keystore,
 		},
 		estimator:        estimator,
+		relay:            newPrivateRelay(config, logger),
 		eventBroadcaster: eventBroadcaster,
 		keyStates:        keyStates,
 		triggers:         triggers,
@@ -174,6 +180,7 @@ func (eb *EthBroadcaster) ethTxInsertTriggerer() {
 			hexAddr := ev.Payload
 			address := gethCommon.HexToAddress(hexAddr)
 			eb.Trigger(address)
+			eb.events.emitCommittedCreated(eb.q, address)
 		case <-eb.chStop:
 			return
 		}
@@ -262,6 +269,10 @@ func (eb *EthBroadcaster) processUnstartedEthTxs(ctx context.Context, fromAddres
 		n++
 		var a EthTxAttempt
 		if eb.config.EvmEIP1559DynamicFees() {
+			var gasSaved null.Int
+			if eb.generatesAccessList(*etx) {
+				gasSaved = eb.attachAccessList(ctx, etx)
+			}
 			fee, gasLimit, err := eb.estimator.GetDynamicFee(etx.GasLimit)
 			if err != nil {
 				return errors.Wrap(err, "failed to get dynamic gas fee")
@@ -270,6 +281,7 @@ func (eb *EthBroadcaster) processUnstartedEthTxs(ctx context.Context, fromAddres
 			if err != nil {
 				return errors.Wrap(err, "processUnstartedEthTxs failed")
 			}
+			a.AccessListGasSaved = gasSaved
 		} else {
 			gasPrice, gasLimit, err := eb.estimator.GetLegacyGas(etx.EncodedPayload, etx.GasLimit)
 			if err != nil {
@@ -282,7 +294,7 @@ func (eb *EthBroadcaster) processUnstartedEthTxs(ctx context.Context, fromAddres
 		}
 
 		if err := eb.saveInProgressTransaction(etx, &a); errors.Is(err, errEthTxRemoved) {
-			eb.logger.Debugw("eth_tx removed", "etxID", etx.ID, "subject", etx.Subject)
+			eb.logger.Debugw("eth_tx removed or replaced, reloading", "etxID", etx.ID, "subject", etx.Subject)
 			continue
 		} else if err != nil {
 			return errors.Wrap(err, "processUnstartedEthTxs failed")
@@ -361,7 +373,7 @@ func (eb *EthBroadcaster) handleInProgressEthTx(etx EthTx, attempt EthTxAttempt,
 		}
 	}
 
-	sendError := sendTransaction(parentCtx, eb.ethClient, attempt, etx, eb.logger)
+	sendError := sendTransaction(parentCtx, eb.ethClient, eb.relay, attempt, etx, eb.logger)
 
 	if sendError.IsTooExpensive() {
 		eb.logger.CriticalW("Transaction gas price was rejected by the eth node for being too high. Consider increasing your eth node's RPCTxFeeCap (it is suggested to run geth with no cap i.e. --rpc.gascap=0 --rpc.txfeecap=0)",
@@ -458,7 +470,11 @@ func (eb *EthBroadcaster) handleInProgressEthTx(etx EthTx, attempt EthTxAttempt,
 	}
 
 	if sendError == nil {
-		return saveAttempt(eb.q, &etx, attempt, EthTxAttemptBroadcast)
+		if err := saveAttempt(eb.q, &etx, attempt, EthTxAttemptBroadcast); err != nil {
+			return err
+		}
+		eb.events.emitEthTx(EventBroadcast, etx, &attempt)
+		return nil
 	}
 
 	// Any other type of error is considered temporary or resolvable by the
@@ -510,7 +526,12 @@ func (eb *EthBroadcaster) saveInProgressTransaction(etx *EthTx, attempt *EthTxAt
 			}
 			return errors.Wrap(err, "saveInProgressTransaction failed to create eth_tx_attempt")
 		}
-		err = tx.Get(etx, `UPDATE eth_txes SET nonce=$1, state=$2, broadcast_at=$3 WHERE id=$4 RETURNING *`, etx.Nonce, etx.State, etx.BroadcastAt, etx.ID)
+		// The eth_tx may have been cancelled or replaced since it was loaded,
+		// in which case the attempt was built from a stale payload
+		err = tx.Get(etx, `UPDATE eth_txes SET nonce=$1, state=$2, broadcast_at=$3, access_list=$4 WHERE id=$5 AND state='unstarted' AND to_address=$6 AND encoded_payload=$7 AND value=$8 RETURNING *`, etx.Nonce, etx.State, etx.BroadcastAt, etx.AccessList, etx.ID, etx.ToAddress, etx.EncodedPayload, etx.Value)
+		if errors.Is(err, sql.ErrNoRows) {
+			return errEthTxRemoved
+		}
 		return errors.Wrap(err, "saveInProgressTransaction failed to save eth_tx")
 	})
 }
@@ -631,12 +652,17 @@ func (eb *EthBroadcaster) saveFatallyErroredTransaction(etx *EthTx) error {
 	}
 	etx.Nonce = nil
 	etx.State = EthTxFatalError
-	return eb.q.Transaction(func(tx pg.Queryer) error {
+	err := eb.q.Transaction(func(tx pg.Queryer) error {
 		if _, err := tx.Exec(`DELETE FROM eth_tx_attempts WHERE eth_tx_id = $1`, etx.ID); err != nil {
 			return errors.Wrapf(err, "saveFatallyErroredTransaction failed to delete eth_tx_attempt with eth_tx.ID %v", etx.ID)
 		}
 		return errors.Wrap(tx.Get(etx, `UPDATE eth_txes SET state=$1, error=$2, broadcast_at=NULL, nonce=NULL WHERE id=$3 RETURNING *`, etx.State, etx.Error, etx.ID), "saveFatallyErroredTransaction failed to save eth_tx")
 	})
+	if err != nil {
+		return err
+	}
+	eb.events.emitEthTx(EventFatalError, *etx, nil)
+	return nil
 }
 
 // GetNextNonce returns keys.next_nonce for the given address
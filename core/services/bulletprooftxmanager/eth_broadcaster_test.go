@@ -1,6 +1,7 @@
 package bulletprooftxmanager_test
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
@@ -10,6 +11,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/ethereum/go-ethereum"
 	gethCommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	gethTypes "github.com/ethereum/go-ethereum/core/types"
@@ -470,6 +472,64 @@ func TestEthBroadcaster_ProcessUnstartedEthTxs_OptimisticLockingOnEthTx(t *testi
 	estimator.AssertExpectations(t)
 }
 
+func TestEthBroadcaster_ProcessUnstartedEthTxs_ReplacedWhileBroadcasting(t *testing.T) {
+	db := pgtest.NewSqlxDB(t)
+	cfg := cltest.NewTestGeneralConfig(t)
+	borm := cltest.NewBulletproofTxManagerORM(t, db, cfg)
+	evmcfg := evmtest.NewChainScopedConfig(t, cfg)
+	ethClient := cltest.NewEthClientMockWithDefaultChain(t)
+	ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
+	keyState, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore, 0)
+	bptxm := bulletprooftxmanager.NewBulletproofTxManager(db, ethClient, evmcfg, ethKeyStore, nil, nil, logger.TestLogger(t))
+
+	etx := bulletprooftxmanager.EthTx{
+		FromAddress:    fromAddress,
+		ToAddress:      cltest.NewAddress(),
+		EncodedPayload: []byte{42, 42, 0},
+		Value:          *assets.NewEth(0),
+		GasLimit:       500000,
+		State:          bulletprooftxmanager.EthTxUnstarted,
+	}
+	require.NoError(t, borm.InsertEthTx(&etx))
+	newPayload := []byte{4, 5, 6}
+
+	estimator := new(gasmocks.Estimator)
+	// The transaction is replaced after the broadcaster has loaded it, but
+	// before it is saved as in_progress
+	estimator.On("GetLegacyGas", []byte{42, 42, 0}, mock.Anything).Return(assets.GWei(32), uint64(500000), nil).Run(func(_ mock.Arguments) {
+		_, err := bptxm.ReplaceEthTransaction(etx.ID, newPayload)
+		require.NoError(t, err)
+	}).Once()
+	estimator.On("GetLegacyGas", newPayload, mock.Anything).Return(assets.GWei(32), uint64(500000), nil).Once()
+
+	eb := bulletprooftxmanager.NewEthBroadcaster(
+		db,
+		ethClient,
+		evmcfg,
+		ethKeyStore,
+		&pg.NullEventBroadcaster{},
+		[]ethkey.State{keyState},
+		estimator,
+		nil,
+		logger.TestLogger(t),
+	)
+
+	ethClient.On("SendTransaction", mock.Anything, mock.MatchedBy(func(tx *gethTypes.Transaction) bool {
+		return tx.Nonce() == uint64(0) && bytes.Equal(tx.Data(), newPayload)
+	})).Return(nil).Once()
+
+	require.NoError(t, eb.ProcessUnstartedEthTxs(context.Background(), keyState))
+
+	etx, err := borm.FindEthTxWithAttempts(etx.ID)
+	require.NoError(t, err)
+	assert.Equal(t, bulletprooftxmanager.EthTxUnconfirmed, etx.State)
+	assert.Equal(t, newPayload, etx.EncodedPayload)
+	require.Len(t, etx.EthTxAttempts, 1)
+
+	estimator.AssertExpectations(t)
+	ethClient.AssertExpectations(t)
+}
+
 func TestEthBroadcaster_ProcessUnstartedEthTxs_Success_WithMultiplier(t *testing.T) {
 	db := pgtest.NewSqlxDB(t)
 	cfg := cltest.NewTestGeneralConfig(t)
@@ -506,6 +566,121 @@ func TestEthBroadcaster_ProcessUnstartedEthTxs_Success_WithMultiplier(t *testing
 	ethClient.AssertExpectations(t)
 }
 
+func TestEthBroadcaster_ProcessUnstartedEthTxs_AccessLists(t *testing.T) {
+	db := pgtest.NewSqlxDB(t)
+	cfg := configtest.NewTestGeneralConfig(t)
+	cfg.Overrides.GlobalEvmEIP1559DynamicFees = null.BoolFrom(true)
+	cfg.Overrides.GlobalEvmGenerateAccessLists = null.BoolFrom(true)
+	borm := cltest.NewBulletproofTxManagerORM(t, db, cfg)
+	ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
+	keyState, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore, 0)
+
+	ethClient := cltest.NewEthClientMockWithDefaultChain(t)
+	evmcfg := evmtest.NewChainScopedConfig(t, cfg)
+
+	eb := cltest.NewEthBroadcaster(t, db, ethClient, ethKeyStore, evmcfg, []ethkey.State{keyState})
+
+	toAddress := cltest.NewAddress()
+	slotAddress := cltest.NewAddress()
+	gasLimit := uint64(100000)
+
+	insertEthTx := func(t *testing.T, value int64, generateAccessList null.Bool) bulletprooftxmanager.EthTx {
+		etx := bulletprooftxmanager.EthTx{
+			FromAddress:        fromAddress,
+			ToAddress:          toAddress,
+			EncodedPayload:     []byte{1, 2, 3},
+			Value:              assets.NewEthValue(value),
+			GasLimit:           gasLimit,
+			State:              bulletprooftxmanager.EthTxUnstarted,
+			GenerateAccessList: generateAccessList,
+		}
+		require.NoError(t, borm.InsertEthTx(&etx))
+		return etx
+	}
+	mockCreateAccessList := func(value int64, result string) {
+		ethClient.On("CallContext", mock.Anything, mock.Anything, "eth_createAccessList", mock.MatchedBy(func(callarg map[string]interface{}) bool {
+			return callarg["value"].(*hexutil.Big).ToInt().Int64() == value
+		}), "pending").Run(func(args mock.Arguments) {
+			require.NoError(t, json.Unmarshal([]byte(result), args.Get(1)))
+		}).Return(nil).Once()
+	}
+	mockEstimateGas := func(value int64, gas uint64) {
+		ethClient.On("EstimateGas", mock.Anything, mock.MatchedBy(func(call ethereum.CallMsg) bool {
+			return call.Value.Int64() == value
+		})).Return(gas, nil).Once()
+	}
+	mockSendTransaction := func(value int64, accessListLen int) {
+		ethClient.On("SendTransaction", mock.Anything, mock.MatchedBy(func(tx *gethTypes.Transaction) bool {
+			return tx.Value().Int64() == value && len(tx.AccessList()) == accessListLen
+		})).Return(nil).Once()
+	}
+	accessList := fmt.Sprintf(`{"accessList": [{"address": "%s", "storageKeys": ["%s"]}], "gasUsed": "0xc350"}`, slotAddress.Hex(), utils.NewHash().Hex())
+
+	t.Run("attaches the access list if it lowers the estimated gas", func(t *testing.T) {
+		etx := insertEthTx(t, 1, null.Bool{})
+		mockCreateAccessList(1, accessList)
+		mockEstimateGas(1, 60000)
+		mockSendTransaction(1, 1)
+
+		require.NoError(t, eb.ProcessUnstartedEthTxs(context.Background(), keyState))
+
+		etx, err := borm.FindEthTxWithAttempts(etx.ID)
+		require.NoError(t, err)
+		require.True(t, etx.AccessList.Valid)
+		require.Len(t, etx.AccessList.AccessList, 1)
+		assert.Equal(t, slotAddress, etx.AccessList.AccessList[0].Address)
+		require.Len(t, etx.EthTxAttempts, 1)
+		assert.Equal(t, null.IntFrom(10000), etx.EthTxAttempts[0].AccessListGasSaved)
+
+		ethClient.AssertExpectations(t)
+	})
+
+	t.Run("does not attach the access list if it does not lower the estimated gas", func(t *testing.T) {
+		etx := insertEthTx(t, 2, null.Bool{})
+		mockCreateAccessList(2, accessList)
+		mockEstimateGas(2, 50000)
+		mockSendTransaction(2, 0)
+
+		require.NoError(t, eb.ProcessUnstartedEthTxs(context.Background(), keyState))
+
+		etx, err := borm.FindEthTxWithAttempts(etx.ID)
+		require.NoError(t, err)
+		assert.False(t, etx.AccessList.Valid)
+		require.Len(t, etx.EthTxAttempts, 1)
+		assert.False(t, etx.EthTxAttempts[0].AccessListGasSaved.Valid)
+
+		ethClient.AssertExpectations(t)
+	})
+
+	t.Run("sends the transaction without an access list if eth_createAccessList fails", func(t *testing.T) {
+		etx := insertEthTx(t, 3, null.Bool{})
+		mockCreateAccessList(3, `{"accessList": [], "gasUsed": "0x0", "error": "execution reverted"}`)
+		mockSendTransaction(3, 0)
+
+		require.NoError(t, eb.ProcessUnstartedEthTxs(context.Background(), keyState))
+
+		etx, err := borm.FindEthTxWithAttempts(etx.ID)
+		require.NoError(t, err)
+		assert.Equal(t, bulletprooftxmanager.EthTxUnconfirmed, etx.State)
+		assert.False(t, etx.AccessList.Valid)
+
+		ethClient.AssertExpectations(t)
+	})
+
+	t.Run("does not generate an access list if the eth_tx opts out", func(t *testing.T) {
+		etx := insertEthTx(t, 4, null.BoolFrom(false))
+		mockSendTransaction(4, 0)
+
+		require.NoError(t, eb.ProcessUnstartedEthTxs(context.Background(), keyState))
+
+		etx, err := borm.FindEthTxWithAttempts(etx.ID)
+		require.NoError(t, err)
+		assert.False(t, etx.AccessList.Valid)
+
+		ethClient.AssertExpectations(t)
+	})
+}
+
 func TestEthBroadcaster_AssignsNonceOnStart(t *testing.T) {
 	var err error
 	db := pgtest.NewSqlxDB(t)
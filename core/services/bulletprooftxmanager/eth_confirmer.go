@@ -76,13 +76,18 @@ var (
 		Name: "tx_manager_tx_attempt_count",
 		Help: "The number of transaction attempts that are currently being processed by the transaction manager",
 	}, []string{"evmChainID"})
+	promGasSpendWei = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "tx_manager_gas_spend_wei",
+		Help: "Total fees paid for confirmed transactions, in wei. jobID is empty for transactions that were not sent by a job. Note that this can err to be too high since transactions are counted on each confirmation, which can happen multiple times per transaction in the case of re-orgs",
+	}, []string{"evmChainID", "fromAddress", "jobID"})
 )
 
-// EthConfirmer is a broad service which performs four different tasks in sequence on every new longest chain
+// EthConfirmer is a broad service which performs five different tasks in sequence on every new longest chain
 // Step 1: Mark that all currently pending transaction attempts were broadcast before this block
 // Step 2: Check pending transactions for receipts
-// Step 3: See if any transactions have exceeded the gas bumping block threshold and, if so, bump them
-// Step 4: Check confirmed transactions to make sure they are still in the longest chain (reorg protection)
+// Step 3: Broadcast publicly any transactions that the private relay failed to get mined in time
+// Step 4: See if any transactions have exceeded the gas bumping block threshold and, if so, bump them
+// Step 5: Check confirmed transactions to make sure they are still in the longest chain (reorg protection)
 type EthConfirmer struct {
 	utils.StartStopOnce
 
@@ -93,6 +98,9 @@ type EthConfirmer struct {
 	ChainKeyStore
 	estimator      gas.Estimator
 	resumeCallback ResumeCallback
+	// events is set by the BulletproofTxManager and may be nil
+	events *txEvents
+	relay  *privateRelay
 
 	keyStates []ethkey.State
 
@@ -125,6 +133,8 @@ func NewEthConfirmer(db *sqlx.DB, ethClient eth.Client, config Config, keystore
 		},
 		estimator,
 		resumeCallback,
+		nil,
+		newPrivateRelay(config, lggr),
 		keyStates,
 		utils.NewMailbox(1),
 		context,
@@ -210,6 +220,13 @@ func (ec *EthConfirmer) processHead(ctx context.Context, head *eth.Head) error {
 	ec.lggr.Debugw("Finished CheckForReceipts", "headNum", head.Number, "time", time.Since(mark), "id", "eth_confirmer")
 	mark = time.Now()
 
+	if err := ec.FallBackToPublicBroadcast(ctx, head.Number); err != nil {
+		return errors.Wrap(err, "FallBackToPublicBroadcast failed")
+	}
+
+	ec.lggr.Debugw("Finished FallBackToPublicBroadcast", "headNum", head.Number, "time", time.Since(mark), "id", "eth_confirmer")
+	mark = time.Now()
+
 	if err := ec.RebroadcastWhereNecessary(ctx, head.Number); err != nil {
 		return errors.Wrap(err, "RebroadcastWhereNecessary failed")
 	}
@@ -235,6 +252,50 @@ func (ec *EthConfirmer) processHead(ctx context.Context, head *eth.Head) error {
 	return nil
 }
 
+// FallBackToPublicBroadcast broadcasts transactions to the eth node that
+// were sent to the private relay but have not been mined within
+// EVM_PRIVATE_RELAY_FALLBACK_BLOCKS of their first attempt. They are sent
+// publicly from then on, including any bumped attempts.
+func (ec *EthConfirmer) FallBackToPublicBroadcast(ctx context.Context, blockNum int64) error {
+	fallbackBlocks := ec.config.EvmPrivateRelayFallbackBlocks()
+	if fallbackBlocks == 0 {
+		return nil
+	}
+
+	var etxs []*EthTx
+	err := ec.q.Transaction(func(tx pg.Queryer) error {
+		err := tx.Select(&etxs, `
+UPDATE eth_txes SET private_relay = false
+WHERE state = 'unconfirmed' AND evm_chain_id = $1 AND COALESCE(private_relay, $2)
+AND (SELECT MIN(broadcast_before_block_num) FROM eth_tx_attempts WHERE eth_tx_attempts.eth_tx_id = eth_txes.id) <= $3
+RETURNING *
+`, ec.chainID.String(), ec.config.EvmPrivateRelayEnabled(), blockNum-int64(fallbackBlocks))
+		if err != nil {
+			return errors.Wrap(err, "failed to update eth_txes")
+		}
+		return loadEthTxesAttempts(tx, etxs)
+	})
+	if err != nil {
+		return errors.Wrap(err, "FallBackToPublicBroadcast failed")
+	}
+
+	for _, etx := range etxs {
+		if len(etx.EthTxAttempts) == 0 {
+			continue
+		}
+		// Attempts are sorted by gas price descending, so this is the highest
+		// priced attempt
+		attempt := etx.EthTxAttempts[0]
+		ec.lggr.Warnw(fmt.Sprintf("Transaction was not mined within %d blocks of being sent to the private relay, will broadcast it publicly", fallbackBlocks),
+			"ethTxID", etx.ID, "nonce", etx.Nonce, "fromAddress", etx.FromAddress, "txHash", attempt.Hash)
+		if sendErr := sendTransaction(ctx, ec.ethClient, ec.relay, attempt, *etx, ec.lggr); sendErr != nil {
+			// Not fatal; the EthResender and gas bumping will send it again
+			ec.lggr.Warnw("Failed to broadcast transaction publicly", "ethTxID", etx.ID, "txHash", attempt.Hash, "err", sendErr)
+		}
+	}
+	return nil
+}
+
 // SetBroadcastBeforeBlockNum updates already broadcast attempts with the
 // current block number. This is safe no matter how old the head is because if
 // the attempt is already broadcast it _must_ have been before this head.
@@ -504,21 +565,23 @@ func (ec *EthConfirmer) saveFetchedReceipts(receipts []Receipt) (err error) {
 		if err != nil {
 			return errors.Wrap(err, "saveFetchedReceipts failed to marshal JSON")
 		}
-		valueStrs = append(valueStrs, "(?,?,?,?,?,NOW())")
-		valueArgs = append(valueArgs, r.TxHash, r.BlockHash, r.BlockNumber.Int64(), r.TransactionIndex, receiptJSON)
+		valueStrs = append(valueStrs, "(?,?,?,?,?,?,?,NOW())")
+		valueArgs = append(valueArgs, r.TxHash, r.BlockHash, r.BlockNumber.Int64(), r.TransactionIndex, receiptJSON, r.GasUsed, utils.NewBig(r.EffectiveGasPrice))
 	}
 	valueArgs = append(valueArgs, ec.chainID.String())
 
 	/* #nosec G201 */
 	sql := `
 	WITH inserted_receipts AS (
-		INSERT INTO eth_receipts (tx_hash, block_hash, block_number, transaction_index, receipt, created_at)
+		INSERT INTO eth_receipts (tx_hash, block_hash, block_number, transaction_index, receipt, gas_used, effective_gas_price, created_at)
 		VALUES %s
 		ON CONFLICT (tx_hash, block_hash) DO UPDATE SET
 			block_number = EXCLUDED.block_number,
 			transaction_index = EXCLUDED.transaction_index,
-			receipt = EXCLUDED.receipt
-		RETURNING eth_receipts.tx_hash, eth_receipts.block_number
+			receipt = EXCLUDED.receipt,
+			gas_used = EXCLUDED.gas_used,
+			effective_gas_price = EXCLUDED.effective_gas_price
+		RETURNING eth_receipts.tx_hash, eth_receipts.block_number, eth_receipts.gas_used, eth_receipts.effective_gas_price
 	),
 	updated_eth_tx_attempts AS (
 		UPDATE eth_tx_attempts
@@ -527,13 +590,15 @@ func (ec *EthConfirmer) saveFetchedReceipts(receipts []Receipt) (err error) {
 			broadcast_before_block_num = COALESCE(eth_tx_attempts.broadcast_before_block_num, inserted_receipts.block_number)
 		FROM inserted_receipts
 		WHERE inserted_receipts.tx_hash = eth_tx_attempts.hash
-		RETURNING eth_tx_attempts.eth_tx_id
+		RETURNING eth_tx_attempts.eth_tx_id, eth_tx_attempts.hash,
+			inserted_receipts.gas_used * COALESCE(inserted_receipts.effective_gas_price, eth_tx_attempts.gas_price, eth_tx_attempts.gas_fee_cap) AS fee
 	)
 	UPDATE eth_txes
 	SET state = 'confirmed'
 	FROM updated_eth_tx_attempts
 	WHERE updated_eth_tx_attempts.eth_tx_id = eth_txes.id
 	AND evm_chain_id = ?
+	RETURNING eth_txes.id, updated_eth_tx_attempts.hash, eth_txes.from_address, COALESCE(eth_txes.meta->>'JobID', '') AS job_id, updated_eth_tx_attempts.fee
 	`
 
 	stmt := fmt.Sprintf(sql, strings.Join(valueStrs, ","))
@@ -543,8 +608,32 @@ func (ec *EthConfirmer) saveFetchedReceipts(receipts []Receipt) (err error) {
 	ctx, cancel := pg.DefaultQueryCtx()
 	defer cancel()
 
-	_, err = ec.q.ExecContext(ctx, stmt, valueArgs...)
-	return errors.Wrap(err, "saveFetchedReceipts failed to save receipts")
+	var confirmed []struct {
+		ID          int64
+		Hash        gethCommon.Hash
+		FromAddress gethCommon.Address
+		JobID       string
+		Fee         *utils.Big
+	}
+	if err = ec.q.SelectContext(ctx, &confirmed, stmt, valueArgs...); err != nil {
+		return errors.Wrap(err, "saveFetchedReceipts failed to save receipts")
+	}
+	ids := make([]int64, len(confirmed))
+	hashes := make(map[int64]gethCommon.Hash, len(confirmed))
+	for i, c := range confirmed {
+		ids[i] = c.ID
+		hashes[c.ID] = c.Hash
+		if c.Fee != nil {
+			jobID := c.JobID
+			if jobID == "0" {
+				jobID = ""
+			}
+			fee, _ := new(big.Float).SetInt(c.Fee.ToInt()).Float64()
+			promGasSpendWei.WithLabelValues(ec.chainID.String(), c.FromAddress.Hex(), jobID).Add(fee)
+		}
+	}
+	ec.events.emitEthTxIDs(ec.q, EventConfirmed, ids, hashes)
+	return nil
 }
 
 // markAllConfirmedMissingReceipt
@@ -568,7 +657,8 @@ func (ec *EthConfirmer) saveFetchedReceipts(receipts []Receipt) (err error) {
 // We will continue to try to fetch a receipt for these attempts until all
 // attempts are below the finality depth from current head.
 func (ec *EthConfirmer) markAllConfirmedMissingReceipt() (err error) {
-	res, err := ec.q.Exec(`
+	var ids []int64
+	err = ec.q.Select(&ids, `
 UPDATE eth_txes
 SET state = 'confirmed_missing_receipt'
 WHERE state = 'unconfirmed'
@@ -577,17 +667,15 @@ AND nonce < (
 	WHERE state = 'confirmed'
 )
 AND evm_chain_id = $1
+RETURNING id
 	`, ec.chainID.String())
 	if err != nil {
 		return errors.Wrap(err, "markAllConfirmedMissingReceipt failed")
 	}
-	rowsAffected, err := res.RowsAffected()
-	if err != nil {
-		return errors.Wrap(err, "markAllConfirmedMissingReceipt RowsAffected failed")
-	}
-	if rowsAffected > 0 {
-		ec.lggr.Infow(fmt.Sprintf("%d transactions missing receipt", rowsAffected), "n", rowsAffected)
+	if len(ids) > 0 {
+		ec.lggr.Infow(fmt.Sprintf("%d transactions missing receipt", len(ids)), "n", len(ids))
 	}
+	ec.events.emitEthTxIDs(ec.q, EventConfirmedMissingReceipt, ids, nil)
 	return
 }
 
@@ -629,6 +717,7 @@ RETURNING e0.id, e0.nonce, e0.from_address`, ErrCouldNotGetReceipt, cutoff, ec.c
 	}
 	defer ec.lggr.ErrorIfClosing(rows, "eth_txes rows")
 
+	var ids []int64
 	for rows.Next() {
 		var ethTxID int64
 		var nonce null.Int64
@@ -644,9 +733,14 @@ RETURNING e0.id, e0.nonce, e0.from_address`, ErrCouldNotGetReceipt, cutoff, ec.c
 			" Please note that Chainlink requires exclusive ownership of it's private keys and sharing keys across multiple"+
 			" chainlink instances, or using the chainlink keys with an external wallet is NOT SUPPORTED and WILL lead to missed transactions",
 			ethTxID, blockNum, fromAddress.Hex(), nonce.Int64), "ethTxID", ethTxID, "nonce", nonce, "fromAddress", fromAddress)
+		ids = append(ids, ethTxID)
+	}
+	if err = rows.Err(); err != nil {
+		return err
 	}
 
-	return rows.Err()
+	ec.events.emitEthTxIDs(ec.q, EventFatalError, ids, nil)
+	return nil
 }
 
 // RebroadcastWhereNecessary bumps gas or resends transactions that were previously out-of-eth
@@ -698,9 +792,13 @@ func (ec *EthConfirmer) rebroadcastWhereNecessary(ctx context.Context, address g
 
 		ec.lggr.Debugw("Rebroadcasting transaction", "ethTxID", etx.ID, "nonce", etx.Nonce, "nPreviousAttempts", len(etx.EthTxAttempts), "gasPrice", attempt.GasPrice, "gasTipCap", attempt.GasTipCap, "gasFeeCap", attempt.GasFeeCap)
 
+		bumped := attempt.ID == 0
 		if err := ec.saveInProgressAttempt(&attempt); err != nil {
 			return errors.Wrap(err, "saveInProgressAttempt failed")
 		}
+		if bumped {
+			ec.events.emitEthTx(EventGasBumped, *etx, &attempt)
+		}
 
 		if err := ec.handleInProgressAttempt(ctx, *etx, attempt, blockHeight); err != nil {
 			return errors.Wrap(err, "handleInProgressAttempt failed")
@@ -1014,7 +1112,7 @@ func (ec *EthConfirmer) handleInProgressAttempt(ctx context.Context, etx EthTx,
 	}
 
 	now := time.Now()
-	sendError := sendTransaction(ctx, ec.ethClient, attempt, etx, ec.lggr)
+	sendError := sendTransaction(ctx, ec.ethClient, ec.relay, attempt, etx, ec.lggr)
 
 	if sendError.IsTerminallyUnderpriced() {
 		// This should really not ever happen in normal operation since we
@@ -1329,7 +1427,11 @@ func (ec *EthConfirmer) markForRebroadcast(etx EthTx, head *eth.Head) error {
 		}
 		return unbroadcastAttempt(tx, attempt)
 	})
-	return errors.Wrap(err, "markForRebroadcast failed")
+	if err != nil {
+		return errors.Wrap(err, "markForRebroadcast failed")
+	}
+	ec.events.emitEthTx(EventReorged, etx, &attempt)
+	return nil
 }
 
 func deleteAllReceipts(q pg.Queryer, etxID int64) (err error) {
@@ -1390,7 +1492,7 @@ func (ec *EthConfirmer) ForceRebroadcast(beginningNonce uint, endingNonce uint,
 				ec.lggr.Errorw("ForceRebroadcast: failed to create new attempt", "ethTxID", etx.ID, "err", err)
 				continue
 			}
-			if err := sendTransaction(context.TODO(), ec.ethClient, attempt, *etx, ec.lggr); err != nil {
+			if err := sendTransaction(context.TODO(), ec.ethClient, ec.relay, attempt, *etx, ec.lggr); err != nil {
 				ec.lggr.Errorw(fmt.Sprintf("ForceRebroadcast: failed to rebroadcast eth_tx %v with nonce %v at gas price %s wei and gas limit %v: %s", etx.ID, *etx.Nonce, attempt.GasPrice.String(), etx.GasLimit, err.Error()), "err", err)
 				continue
 			}
@@ -37,6 +37,7 @@ type EthResender struct {
 	chainID   big.Int
 	interval  time.Duration
 	config    Config
+	relay     *privateRelay
 	logger    logger.Logger
 
 	chStop chan struct{}
@@ -54,6 +55,7 @@ func NewEthResender(lggr logger.Logger, db *sqlx.DB, ethClient eth.Client, pollI
 		*ethClient.ChainID(),
 		pollInterval,
 		config,
+		newPrivateRelay(config, lggr),
 		lggr.Named("EthResender"),
 		make(chan struct{}),
 		make(chan struct{}),
@@ -107,6 +109,14 @@ func (er *EthResender) resendUnconfirmed() error {
 		return nil
 	}
 
+	now := time.Now()
+	if attempts, err = er.resendPrivately(now, attempts); err != nil {
+		return err
+	}
+	if len(attempts) == 0 {
+		return nil
+	}
+
 	er.logger.Infow(fmt.Sprintf("Re-sending %d unconfirmed transactions that were last sent over %s ago. These transactions are taking longer than usual to be mined. %s", len(attempts), ageThreshold, static.EthNodeConnectivityProblemLabel), "n", len(attempts))
 
 	reqs := make([]rpc.BatchElem, len(attempts))
@@ -121,7 +131,6 @@ func (er *EthResender) resendUnconfirmed() error {
 		reqs[i] = req
 	}
 
-	now := time.Now()
 	batchSize := int(er.config.EvmRPCDefaultBatchSize())
 	if batchSize == 0 {
 		batchSize = len(reqs)
@@ -150,6 +159,37 @@ func (er *EthResender) resendUnconfirmed() error {
 	return nil
 }
 
+// resendPrivately resends the attempts of eth_txes that are sent privately to
+// the private relay, so that they do not leak into the public mempool. It
+// returns the remaining attempts, which are resent to the eth node.
+func (er *EthResender) resendPrivately(now time.Time, attempts []EthTxAttempt) (public []EthTxAttempt, err error) {
+	if err = loadEthTxes(er.db, attempts); err != nil {
+		return nil, errors.Wrap(err, "failed to load eth_txes")
+	}
+	var ethTxIDs []int64
+	for _, attempt := range attempts {
+		if !er.relay.sendsPrivately(attempt.EthTx) {
+			public = append(public, attempt)
+			continue
+		}
+		ctx, cancel := eth.DefaultQueryCtx()
+		err = er.relay.sendTransaction(ctx, attempt.SignedRawTx)
+		cancel()
+		if err != nil {
+			er.logger.Warnw("Failed to resend transaction to the private relay", "ethTxID", attempt.EthTxID, "txHash", attempt.Hash, "err", err)
+			continue
+		}
+		ethTxIDs = append(ethTxIDs, attempt.EthTxID)
+	}
+	if len(ethTxIDs) > 0 {
+		er.logger.Debugw("Resent transactions to the private relay", "n", len(ethTxIDs))
+		if err = er.updateBroadcastAts(now, ethTxIDs); err != nil {
+			return nil, errors.Wrap(err, "failed to update last succeeded on attempts")
+		}
+	}
+	return public, nil
+}
+
 // FindEthTxesRequiringResend returns the highest priced attempt for each
 // eth_tx that was last sent before or at the given time (up to limit)
 func FindEthTxesRequiringResend(db *sqlx.DB, olderThan time.Time, maxInFlightTransactions uint32, chainID big.Int) (attempts []EthTxAttempt, err error) {
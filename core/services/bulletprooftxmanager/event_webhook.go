@@ -0,0 +1,132 @@
+package bulletprooftxmanager
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+const (
+	// eventWebhookMaxAttempts is the number of times delivery of an event is
+	// attempted before it is dropped
+	eventWebhookMaxAttempts = 3
+	// eventWebhookRetryInterval is the delay before the first retry, doubled
+	// for every further retry
+	eventWebhookRetryInterval = 1 * time.Second
+	eventWebhookTimeout       = 10 * time.Second
+)
+
+// EventWebhook POSTs the lifecycle events of a TxManager as JSON to a URL,
+// one event per request and in the order they were emitted. Delivery is
+// retried a few times on failure, after which the event is dropped.
+type EventWebhook struct {
+	utils.StartStopOnce
+
+	txm    TxManager
+	url    url.URL
+	client *http.Client
+	lggr   logger.Logger
+
+	chStop chan struct{}
+	wg     sync.WaitGroup
+}
+
+// NewEventWebhook returns a new EventWebhook that sends the events of txm to
+// the given URL
+func NewEventWebhook(txm TxManager, u url.URL, lggr logger.Logger) *EventWebhook {
+	return &EventWebhook{
+		txm:    txm,
+		url:    u,
+		client: &http.Client{Timeout: eventWebhookTimeout},
+		lggr:   lggr.Named("EventWebhook"),
+		chStop: make(chan struct{}),
+	}
+}
+
+// Start subscribes to the events of the TxManager
+func (w *EventWebhook) Start() error {
+	return w.StartOnce("EventWebhook", func() error {
+		events, unsub := w.txm.SubscribeToEvents()
+		w.wg.Add(1)
+		go w.runLoop(events, unsub)
+		return nil
+	})
+}
+
+// Close stops sending events. An event that is being sent may be dropped.
+func (w *EventWebhook) Close() error {
+	return w.StopOnce("EventWebhook", func() error {
+		close(w.chStop)
+		w.wg.Wait()
+		return nil
+	})
+}
+
+func (w *EventWebhook) runLoop(events <-chan Event, unsub func()) {
+	defer w.wg.Done()
+	defer unsub()
+	for {
+		select {
+		case <-w.chStop:
+			return
+		case event, ok := <-events:
+			if !ok {
+				return
+			}
+			w.deliver(event)
+		}
+	}
+}
+
+func (w *EventWebhook) deliver(event Event) {
+	body, err := json.Marshal(event)
+	if err != nil {
+		w.lggr.Errorw("Failed to marshal transaction event", "type", event.Type, "ethTxID", event.EthTxID, "err", err)
+		return
+	}
+	retryInterval := eventWebhookRetryInterval
+	for attempt := 1; ; attempt++ {
+		err = w.post(body)
+		if err == nil {
+			return
+		}
+		if attempt >= eventWebhookMaxAttempts {
+			w.lggr.Errorw("Failed to deliver transaction event, dropping it", "type", event.Type, "ethTxID", event.EthTxID, "attempts", attempt, "err", err)
+			return
+		}
+		w.lggr.Warnw("Failed to deliver transaction event, retrying", "type", event.Type, "ethTxID", event.EthTxID, "attempt", attempt, "err", err)
+		select {
+		case <-w.chStop:
+			return
+		case <-time.After(retryInterval):
+		}
+		retryInterval *= 2
+	}
+}
+
+func (w *EventWebhook) post(body []byte) error {
+	ctx, cancel := utils.ContextFromChan(w.chStop)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.url.String(), bytes.NewReader(body))
+	if err != nil {
+		return errors.Wrap(err, "failed to create request")
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "failed to send request")
+	}
+	defer w.lggr.ErrorIfClosing(resp.Body, "EventWebhook response body")
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return errors.Errorf("webhook responded with status %d", resp.StatusCode)
+	}
+	return nil
+}
@@ -0,0 +1,79 @@
+package bulletprooftxmanager_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/bulletprooftxmanager"
+	bptxmmocks "github.com/smartcontractkit/chainlink/core/services/bulletprooftxmanager/mocks"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+func TestEventWebhook(t *testing.T) {
+	t.Parallel()
+
+	received := make(chan map[string]interface{}, 2)
+	failures := 1
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		if failures > 0 {
+			failures--
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		var event map[string]interface{}
+		require.NoError(t, json.Unmarshal(body, &event))
+		received <- event
+	}))
+	defer server.Close()
+	u, err := url.Parse(server.URL)
+	require.NoError(t, err)
+
+	events := make(chan bulletprooftxmanager.Event, 1)
+	unsubscribed := make(chan struct{})
+	txm := new(bptxmmocks.TxManager)
+	txm.On("SubscribeToEvents").Return((<-chan bulletprooftxmanager.Event)(events), func() { close(unsubscribed) })
+
+	webhook := bulletprooftxmanager.NewEventWebhook(txm, *u, logger.TestLogger(t))
+	require.NoError(t, webhook.Start())
+
+	hash := gethcommon.HexToHash("0x2c6b0bc2efa3e5ce4a3bbcbd1f3ab1e80dc1e3e2a8a6ba9d3d77bf8fa1e2a4c5")
+	nonce := int64(3)
+	events <- bulletprooftxmanager.Event{
+		Type:        bulletprooftxmanager.EventConfirmed,
+		EthTxID:     7,
+		EVMChainID:  *utils.NewBigI(42),
+		FromAddress: cltest.NewAddress(),
+		ToAddress:   cltest.NewAddress(),
+		Nonce:       &nonce,
+		AttemptHash: &hash,
+		GasPrice:    utils.NewBigI(1000),
+	}
+
+	// The first delivery fails and is retried
+	event := <-received
+	assert.Equal(t, "confirmed", event["type"])
+	assert.Equal(t, float64(7), event["ethTxID"])
+	assert.Equal(t, "42", event["evmChainID"])
+	assert.Equal(t, float64(3), event["nonce"])
+	assert.Equal(t, hash.Hex(), event["attemptHash"])
+	assert.Equal(t, "1000", event["gasPrice"])
+	assert.Nil(t, event["gasTipCap"])
+
+	require.NoError(t, webhook.Close())
+	<-unsubscribed
+	txm.AssertExpectations(t)
+}
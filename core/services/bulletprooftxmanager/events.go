@@ -0,0 +1,266 @@
+package bulletprooftxmanager
+
+import (
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+	"github.com/smartcontractkit/chainlink/core/services/pg/datatypes"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// EventType is the lifecycle transition that an Event reports
+type EventType string
+
+const (
+	// EventCreated is emitted when a transaction is inserted into the queue.
+	// For transactions created within a transaction of the caller, it is
+	// emitted once that transaction has been committed.
+	EventCreated EventType = "created"
+	// EventBroadcast is emitted when the first attempt of a transaction has
+	// been accepted by the eth node
+	EventBroadcast EventType = "broadcast"
+	// EventGasBumped is emitted when a new attempt with a higher fee is
+	// created for an unconfirmed transaction
+	EventGasBumped EventType = "gas_bumped"
+	// EventConfirmed is emitted when a receipt has been fetched for one of the
+	// attempts of a transaction
+	EventConfirmed EventType = "confirmed"
+	// EventConfirmedMissingReceipt is emitted when the nonce of a transaction
+	// was used on-chain, but no receipt was found for any of its attempts
+	EventConfirmedMissingReceipt EventType = "confirmed_missing_receipt"
+	// EventReorged is emitted when a confirmed transaction was re-org'd out of
+	// the main chain and is about to be rebroadcast
+	EventReorged EventType = "reorged"
+	// EventFatalError is emitted when a transaction will never be sent or
+	// never confirm
+	EventFatalError EventType = "fatal_error"
+)
+
+// eventSubscriberBufferSize is the number of events buffered per subscriber.
+// Events are dropped for subscribers that fall further behind than this.
+const eventSubscriberBufferSize = 100
+
+// pendingCreatedTimeout is how long the created event of a transaction that
+// was created within a transaction of the caller waits for it to be committed.
+// Transactions that are not committed by then are assumed to be rolled back.
+const pendingCreatedTimeout = time.Hour
+
+// Event describes a transition in the lifecycle of a transaction
+type Event struct {
+	Type        EventType       `json:"type"`
+	EthTxID     int64           `json:"ethTxID"`
+	EVMChainID  utils.Big       `json:"evmChainID"`
+	FromAddress common.Address  `json:"fromAddress"`
+	ToAddress   common.Address  `json:"toAddress"`
+	Nonce       *int64          `json:"nonce"`
+	Meta        *datatypes.JSON `json:"meta"`
+	// AttemptHash, GasPrice, GasTipCap and GasFeeCap are taken from the
+	// attempt the event applies to, or from the highest priced attempt if the
+	// event applies to the transaction as a whole. They are empty for
+	// transactions that have no attempts.
+	AttemptHash *common.Hash `json:"attemptHash"`
+	GasPrice    *utils.Big   `json:"gasPrice"`
+	GasTipCap   *utils.Big   `json:"gasTipCap"`
+	GasFeeCap   *utils.Big   `json:"gasFeeCap"`
+	Error       null.String  `json:"error"`
+	Timestamp   time.Time    `json:"timestamp"`
+}
+
+// NewEvent creates an event of the given type for etx. attempt may be nil.
+func NewEvent(typ EventType, etx EthTx, attempt *EthTxAttempt) Event {
+	event := Event{
+		Type:        typ,
+		EthTxID:     etx.ID,
+		EVMChainID:  etx.EVMChainID,
+		FromAddress: etx.FromAddress,
+		ToAddress:   etx.ToAddress,
+		Nonce:       etx.Nonce,
+		Meta:        etx.Meta,
+		Error:       etx.Error,
+		Timestamp:   time.Now(),
+	}
+	if attempt == nil && len(etx.EthTxAttempts) > 0 {
+		attempt = &etx.EthTxAttempts[0]
+	}
+	if attempt != nil {
+		hash := attempt.Hash
+		event.AttemptHash = &hash
+		event.GasPrice = attempt.GasPrice
+		event.GasTipCap = attempt.GasTipCap
+		event.GasFeeCap = attempt.GasFeeCap
+	}
+	return event
+}
+
+// txEvents fans out transaction lifecycle events to subscribers. Sends never
+// block, so a slow subscriber cannot hold up the EthBroadcaster or
+// EthConfirmer. A nil *txEvents is valid and drops all events.
+type txEvents struct {
+	lggr logger.Logger
+
+	subscribersMu sync.RWMutex
+	subscribers   []chan Event
+
+	// pendingCreated holds the transactions created within a transaction of
+	// the caller whose created event waits for that transaction to commit
+	pendingCreatedMu sync.Mutex
+	pendingCreated   map[int64]pendingCreated
+}
+
+type pendingCreated struct {
+	fromAddress common.Address
+	createdAt   time.Time
+}
+
+func newTxEvents(lggr logger.Logger) *txEvents {
+	return &txEvents{lggr: lggr.Named("Events"), pendingCreated: make(map[int64]pendingCreated)}
+}
+
+// Subscribe returns a channel that receives all events emitted from now on,
+// and a function that closes it
+func (e *txEvents) Subscribe() (ch <-chan Event, unsub func()) {
+	sub := make(chan Event, eventSubscriberBufferSize)
+	e.subscribersMu.Lock()
+	defer e.subscribersMu.Unlock()
+	e.subscribers = append(e.subscribers, sub)
+	return sub, func() {
+		e.subscribersMu.Lock()
+		defer e.subscribersMu.Unlock()
+		for i, s := range e.subscribers {
+			if s == sub {
+				e.subscribers = append(e.subscribers[:i], e.subscribers[i+1:]...)
+				close(sub)
+				return
+			}
+		}
+	}
+}
+
+// hasSubscribers allows callers to skip loading the data for events that
+// nobody will receive
+func (e *txEvents) hasSubscribers() bool {
+	if e == nil {
+		return false
+	}
+	e.subscribersMu.RLock()
+	defer e.subscribersMu.RUnlock()
+	return len(e.subscribers) > 0
+}
+
+func (e *txEvents) emit(events ...Event) {
+	if e == nil {
+		return
+	}
+	e.subscribersMu.RLock()
+	defer e.subscribersMu.RUnlock()
+	for _, event := range events {
+		for _, sub := range e.subscribers {
+			select {
+			case sub <- event:
+			default:
+				e.lggr.Warnw("Subscriber is not keeping up, dropping transaction event", "type", event.Type, "ethTxID", event.EthTxID)
+			}
+		}
+	}
+}
+
+// emitEthTx emits an event for etx. attempt may be nil.
+func (e *txEvents) emitEthTx(typ EventType, etx EthTx, attempt *EthTxAttempt) {
+	if !e.hasSubscribers() {
+		return
+	}
+	e.emit(NewEvent(typ, etx, attempt))
+}
+
+// emitEthTxIDs loads the eth_txes with the given IDs and emits an event for
+// each of them. hashes optionally maps eth_tx IDs to the hash of the attempt
+// the event applies to.
+func (e *txEvents) emitEthTxIDs(q pg.Queryer, typ EventType, ids []int64, hashes map[int64]common.Hash) {
+	if len(ids) == 0 || !e.hasSubscribers() {
+		return
+	}
+	var etxs []*EthTx
+	if err := q.Select(&etxs, `SELECT * FROM eth_txes WHERE id = ANY($1) ORDER BY id ASC`, pq.Array(ids)); err != nil {
+		e.lggr.Errorw("Failed to load eth_txes for transaction events", "type", typ, "err", errors.Wrap(err, "emitEthTxIDs failed"))
+		return
+	}
+	if err := loadEthTxesAttempts(q, etxs); err != nil {
+		e.lggr.Errorw("Failed to load eth_tx_attempts for transaction events", "type", typ, "err", errors.Wrap(err, "emitEthTxIDs failed"))
+		return
+	}
+	events := make([]Event, len(etxs))
+	for i, etx := range etxs {
+		var attempt *EthTxAttempt
+		if hash, exists := hashes[etx.ID]; exists {
+			for j := range etx.EthTxAttempts {
+				if etx.EthTxAttempts[j].Hash == hash {
+					attempt = &etx.EthTxAttempts[j]
+				}
+			}
+		}
+		events[i] = NewEvent(typ, *etx, attempt)
+	}
+	e.emit(events...)
+}
+
+// deferCreated holds back the created event of etx, which was inserted within
+// a transaction of the caller, until that transaction is committed
+func (e *txEvents) deferCreated(etx EthTx) {
+	if !e.hasSubscribers() {
+		return
+	}
+	e.pendingCreatedMu.Lock()
+	defer e.pendingCreatedMu.Unlock()
+	for id, p := range e.pendingCreated {
+		if time.Since(p.createdAt) > pendingCreatedTimeout {
+			delete(e.pendingCreated, id)
+		}
+	}
+	e.pendingCreated[etx.ID] = pendingCreated{fromAddress: etx.FromAddress, createdAt: time.Now()}
+}
+
+// emitCommittedCreated emits the held back created events of the
+// transactions from address that have since been committed. It is called on
+// the insert notifications for address, which Postgres only delivers once the
+// inserting transaction has been committed.
+func (e *txEvents) emitCommittedCreated(q pg.Queryer, address common.Address) {
+	if e == nil {
+		return
+	}
+	var ids []int64
+	e.pendingCreatedMu.Lock()
+	for id, p := range e.pendingCreated {
+		if p.fromAddress == address {
+			ids = append(ids, id)
+		}
+	}
+	e.pendingCreatedMu.Unlock()
+	if len(ids) == 0 {
+		return
+	}
+
+	// Transactions of the caller that are still open, or were rolled back,
+	// are not visible yet
+	var committed []int64
+	if err := q.Select(&committed, `SELECT id FROM eth_txes WHERE id = ANY($1)`, pq.Array(ids)); err != nil {
+		e.lggr.Errorw("Failed to load committed eth_txes for transaction events", "err", errors.Wrap(err, "emitCommittedCreated failed"))
+		return
+	}
+	ids = ids[:0]
+	e.pendingCreatedMu.Lock()
+	for _, id := range committed {
+		if _, exists := e.pendingCreated[id]; exists {
+			delete(e.pendingCreated, id)
+			ids = append(ids, id)
+		}
+	}
+	e.pendingCreatedMu.Unlock()
+	e.emitEthTxIDs(q, EventCreated, ids, nil)
+}
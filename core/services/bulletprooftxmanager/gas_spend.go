@@ -0,0 +1,135 @@
+package bulletprooftxmanager
+
+import (
+	"database/sql"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// ErrGasSpendBudgetExceeded is returned by CreateEthTransaction for
+// transactions of jobs that have spent their gas spend budget
+var ErrGasSpendBudgetExceeded = errors.New("gas spend budget exceeded")
+
+// GasSpendGroupBy selects how gas spend is aggregated. Gas spend is always
+// aggregated per chain, since fees on different chains are paid in different
+// currencies.
+type GasSpendGroupBy string
+
+const (
+	GasSpendGroupByChain       GasSpendGroupBy = "chain"
+	GasSpendGroupByKey         GasSpendGroupBy = "key"
+	GasSpendGroupByJob         GasSpendGroupBy = "job"
+	GasSpendGroupByPipelineRun GasSpendGroupBy = "pipeline_run"
+)
+
+// GasSpend is the total fee paid for the confirmed transactions of a group
+type GasSpend struct {
+	EVMChainID utils.Big
+	// FromAddress is only set when grouping by key
+	FromAddress *common.Address
+	// JobID is only set when grouping by job or pipeline run, and is null for
+	// transactions that were not sent by a job
+	JobID null.Int
+	// PipelineRunID is only set when grouping by pipeline run, and is null for
+	// transactions that were not sent by a pipeline run
+	PipelineRunID    null.Int
+	FeeWei           utils.Big
+	TransactionCount int64
+}
+
+// gasSpendFee is the fee paid by the transaction of a receipt, see
+// EthReceipt.Fee
+const gasSpendFee = `eth_receipts.gas_used * COALESCE(eth_receipts.effective_gas_price, eth_tx_attempts.gas_price, eth_tx_attempts.gas_fee_cap)`
+
+// gasSpendJobID is the ID of the job that sent a transaction, as recorded in
+// its meta
+const gasSpendJobID = `NULLIF(eth_txes.meta->>'JobID', '0')::integer`
+
+func gasSpendColumns(groupBy GasSpendGroupBy) (string, error) {
+	switch groupBy {
+	case GasSpendGroupByChain:
+		return `eth_txes.evm_chain_id, NULL::bytea AS from_address, NULL::integer AS job_id, NULL::bigint AS pipeline_run_id`, nil
+	case GasSpendGroupByKey:
+		return `eth_txes.evm_chain_id, eth_txes.from_address, NULL::integer AS job_id, NULL::bigint AS pipeline_run_id`, nil
+	case GasSpendGroupByJob:
+		return fmt.Sprintf(`eth_txes.evm_chain_id, NULL::bytea AS from_address, %s AS job_id, NULL::bigint AS pipeline_run_id`, gasSpendJobID), nil
+	case GasSpendGroupByPipelineRun:
+		return fmt.Sprintf(`eth_txes.evm_chain_id, NULL::bytea AS from_address, %s AS job_id, pipeline_task_runs.pipeline_run_id`, gasSpendJobID), nil
+	default:
+		return "", errors.Errorf("unknown gas spend grouping: %s", groupBy)
+	}
+}
+
+// GasSpend returns the fees paid for the transactions confirmed since the
+// given time, grouped as requested. Transactions that are re-org'd out of the
+// main chain no longer count towards the spend.
+func (o *orm) GasSpend(groupBy GasSpendGroupBy, since time.Time) (spends []GasSpend, err error) {
+	columns, err := gasSpendColumns(groupBy)
+	if err != nil {
+		return nil, err
+	}
+	/* #nosec G201 */
+	query := fmt.Sprintf(`
+SELECT %s, COALESCE(SUM(%s), 0) AS fee_wei, COUNT(DISTINCT eth_txes.id) AS transaction_count
+FROM eth_receipts
+INNER JOIN eth_tx_attempts ON eth_tx_attempts.hash = eth_receipts.tx_hash
+INNER JOIN eth_txes ON eth_txes.id = eth_tx_attempts.eth_tx_id
+LEFT JOIN pipeline_task_runs ON pipeline_task_runs.id = eth_txes.pipeline_task_run_id
+WHERE eth_receipts.created_at >= $1
+GROUP BY 1, 2, 3, 4
+ORDER BY 1, 2, 3, 4
+`, columns, gasSpendFee)
+	err = o.q.Select(&spends, query, since)
+	return spends, errors.Wrap(err, "GasSpend failed")
+}
+
+// jobGasSpend returns the fees paid on the given chain for the transactions
+// of the given job that were confirmed since the given time
+func jobGasSpend(q pg.Queryer, jobID int32, chainID big.Int, since time.Time) (*big.Int, error) {
+	var spent utils.Big
+	/* #nosec G201 */
+	err := q.Get(&spent, fmt.Sprintf(`
+SELECT COALESCE(SUM(%s), 0)
+FROM eth_receipts
+INNER JOIN eth_tx_attempts ON eth_tx_attempts.hash = eth_receipts.tx_hash
+INNER JOIN eth_txes ON eth_txes.id = eth_tx_attempts.eth_tx_id
+WHERE eth_txes.meta->>'JobID' = $1 AND eth_txes.evm_chain_id = $2 AND eth_receipts.created_at >= $3
+`, gasSpendFee), fmt.Sprintf("%d", jobID), chainID.String(), since)
+	return spent.ToInt(), errors.Wrap(err, "jobGasSpend failed")
+}
+
+// checkGasSpendBudget returns ErrGasSpendBudgetExceeded if the given job has
+// a gas spend budget, and spent all of it on the given chain within the
+// budget's rolling window
+func checkGasSpendBudget(q pg.Queryer, jobID int32, chainID big.Int) error {
+	var budget struct {
+		GasSpendBudgetWei    *utils.Big
+		GasSpendBudgetWindow null.Int
+	}
+	err := q.Get(&budget, `SELECT gas_spend_budget_wei, gas_spend_budget_window FROM jobs WHERE id = $1`, jobID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil
+	} else if err != nil {
+		return errors.Wrap(err, "failed to load gas spend budget")
+	}
+	if budget.GasSpendBudgetWei == nil {
+		return nil
+	}
+	window := time.Duration(budget.GasSpendBudgetWindow.Int64)
+	spent, err := jobGasSpend(q, jobID, chainID, time.Now().Add(-window))
+	if err != nil {
+		return err
+	}
+	if spent.Cmp(budget.GasSpendBudgetWei.ToInt()) >= 0 {
+		return errors.Wrapf(ErrGasSpendBudgetExceeded, "job %d spent %s wei of its %s wei budget within the last %s", jobID, spent, budget.GasSpendBudgetWei, window)
+	}
+	return nil
+}
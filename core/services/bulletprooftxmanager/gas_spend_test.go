@@ -0,0 +1,199 @@
+package bulletprooftxmanager_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/bulletprooftxmanager"
+	bptxmmocks "github.com/smartcontractkit/chainlink/core/services/bulletprooftxmanager/mocks"
+	"github.com/smartcontractkit/chainlink/core/services/ocrcommon"
+	"github.com/smartcontractkit/chainlink/core/utils"
+	"github.com/smartcontractkit/sqlx"
+)
+
+func TestEthReceipt_Fee(t *testing.T) {
+	t.Parallel()
+
+	legacy := bulletprooftxmanager.EthTxAttempt{GasPrice: utils.NewBigI(10)}
+	dynamic := bulletprooftxmanager.EthTxAttempt{GasTipCap: utils.NewBigI(2), GasFeeCap: utils.NewBigI(20)}
+
+	t.Run("uses the effective gas price if known", func(t *testing.T) {
+		r := bulletprooftxmanager.EthReceipt{GasUsed: null.IntFrom(21000), EffectiveGasPrice: utils.NewBigI(5)}
+		assert.Equal(t, big.NewInt(105000), r.Fee(legacy))
+		assert.Equal(t, big.NewInt(105000), r.Fee(dynamic))
+	})
+
+	t.Run("falls back to the gas price or fee cap of the attempt", func(t *testing.T) {
+		r := bulletprooftxmanager.EthReceipt{GasUsed: null.IntFrom(21000)}
+		assert.Equal(t, big.NewInt(210000), r.Fee(legacy))
+		assert.Equal(t, big.NewInt(420000), r.Fee(dynamic))
+	})
+
+	t.Run("returns nil if the gas used is unknown", func(t *testing.T) {
+		r := bulletprooftxmanager.EthReceipt{EffectiveGasPrice: utils.NewBigI(5)}
+		assert.Nil(t, r.Fee(legacy))
+	})
+}
+
+func mustInsertConfirmedEthTxWithGasSpend(t *testing.T, db *sqlx.DB, borm bulletprooftxmanager.ORM, fromAddress common.Address, nonce int64, jobID int32, gasUsed int64, effectiveGasPrice *big.Int) bulletprooftxmanager.EthTx {
+	etx := cltest.MustInsertConfirmedEthTxWithLegacyAttempt(t, borm, nonce, 1, fromAddress)
+	_, err := db.Exec(`UPDATE eth_txes SET meta = jsonb_build_object('JobID', $1::integer) WHERE id = $2`, jobID, etx.ID)
+	require.NoError(t, err)
+	r := cltest.NewEthReceipt(t, 1, utils.NewHash(), etx.EthTxAttempts[0].Hash)
+	r.GasUsed = null.IntFrom(gasUsed)
+	r.EffectiveGasPrice = utils.NewBig(effectiveGasPrice)
+	require.NoError(t, borm.InsertEthReceipt(&r))
+	return etx
+}
+
+func TestORM_GasSpend(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	cfg := cltest.NewTestGeneralConfig(t)
+	borm := cltest.NewBulletproofTxManagerORM(t, db, cfg)
+	ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
+
+	_, fromAddress := cltest.MustInsertRandomKey(t, ethKeyStore, 0)
+	_, otherAddress := cltest.MustInsertRandomKey(t, ethKeyStore, 0)
+
+	since := time.Now().Add(-time.Hour)
+	mustInsertConfirmedEthTxWithGasSpend(t, db, borm, fromAddress, 0, 1, 21000, big.NewInt(10))
+	mustInsertConfirmedEthTxWithGasSpend(t, db, borm, fromAddress, 1, 2, 21000, big.NewInt(20))
+	// Falls back to the attempt's gas price of 1 wei
+	mustInsertConfirmedEthTxWithGasSpend(t, db, borm, otherAddress, 0, 0, 50000, nil)
+	// Unconfirmed transactions are not counted
+	cltest.MustInsertConfirmedEthTxWithLegacyAttempt(t, borm, 1, 1, otherAddress)
+
+	t.Run("by chain", func(t *testing.T) {
+		spends, err := borm.GasSpend(bulletprooftxmanager.GasSpendGroupByChain, since)
+		require.NoError(t, err)
+		require.Len(t, spends, 1)
+		assert.Equal(t, cltest.FixtureChainID.String(), spends[0].EVMChainID.String())
+		assert.Nil(t, spends[0].FromAddress)
+		assert.False(t, spends[0].JobID.Valid)
+		assert.Equal(t, "680000", spends[0].FeeWei.String())
+		assert.Equal(t, int64(3), spends[0].TransactionCount)
+	})
+
+	t.Run("by key", func(t *testing.T) {
+		spends, err := borm.GasSpend(bulletprooftxmanager.GasSpendGroupByKey, since)
+		require.NoError(t, err)
+		require.Len(t, spends, 2)
+		fees := make(map[common.Address]string)
+		for _, s := range spends {
+			require.NotNil(t, s.FromAddress)
+			fees[*s.FromAddress] = s.FeeWei.String()
+		}
+		assert.Equal(t, "630000", fees[fromAddress])
+		assert.Equal(t, "50000", fees[otherAddress])
+	})
+
+	t.Run("by job", func(t *testing.T) {
+		spends, err := borm.GasSpend(bulletprooftxmanager.GasSpendGroupByJob, since)
+		require.NoError(t, err)
+		require.Len(t, spends, 3)
+		assert.Equal(t, null.IntFrom(1), spends[0].JobID)
+		assert.Equal(t, "210000", spends[0].FeeWei.String())
+		assert.Equal(t, null.IntFrom(2), spends[1].JobID)
+		assert.Equal(t, "420000", spends[1].FeeWei.String())
+		assert.False(t, spends[2].JobID.Valid, "transactions without a job are grouped together")
+		assert.Equal(t, "50000", spends[2].FeeWei.String())
+	})
+
+	t.Run("by pipeline run", func(t *testing.T) {
+		spends, err := borm.GasSpend(bulletprooftxmanager.GasSpendGroupByPipelineRun, since)
+		require.NoError(t, err)
+		require.Len(t, spends, 3)
+		for _, s := range spends {
+			assert.False(t, s.PipelineRunID.Valid)
+		}
+	})
+
+	t.Run("since excludes older receipts", func(t *testing.T) {
+		spends, err := borm.GasSpend(bulletprooftxmanager.GasSpendGroupByChain, time.Now().Add(time.Hour))
+		require.NoError(t, err)
+		assert.Len(t, spends, 0)
+	})
+
+	t.Run("unknown grouping", func(t *testing.T) {
+		_, err := borm.GasSpend("foo", since)
+		require.EqualError(t, err, "unknown gas spend grouping: foo")
+	})
+}
+
+func TestBulletproofTxManager_CreateEthTransaction_GasSpendBudget(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	cfg := cltest.NewTestGeneralConfig(t)
+	borm := cltest.NewBulletproofTxManagerORM(t, db, cfg)
+	ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
+	_, fromAddress := cltest.MustInsertRandomKey(t, ethKeyStore, 0)
+
+	config := new(bptxmmocks.Config)
+	config.On("EthTxResendAfterThreshold").Return(time.Duration(0))
+	config.On("EthTxReaperThreshold").Return(time.Duration(0))
+	config.On("GasEstimatorMode").Return("FixedPrice")
+	config.On("LogSQL").Return(false)
+	config.On("EvmMaxQueuedTransactions").Return(uint64(0))
+	ethClient := cltest.NewEthClientMockWithDefaultChain(t)
+	bptxm := bulletprooftxmanager.NewBulletproofTxManager(db, ethClient, config, nil, nil, nil, logger.TestLogger(t))
+
+	jb, _ := cltest.MustInsertWebhookSpec(t, db)
+	_, err := db.Exec(`UPDATE jobs SET gas_spend_budget_wei = 400000, gas_spend_budget_window = $1 WHERE id = $2`, time.Hour.Nanoseconds(), jb.ID)
+	require.NoError(t, err)
+
+	createEthTransaction := func() error {
+		strategy := newMockTxStrategy(t)
+		strategy.On("Subject").Return(uuid.NullUUID{})
+		strategy.On("PruneQueue", mock.AnythingOfType("*sqlx.Tx")).Return(int64(0), nil)
+		_, err := bptxm.CreateEthTransaction(bulletprooftxmanager.NewTx{
+			FromAddress:    fromAddress,
+			ToAddress:      cltest.NewAddress(),
+			EncodedPayload: []byte{1, 2, 3},
+			GasLimit:       1000,
+			Meta:           &bulletprooftxmanager.EthTxMeta{JobID: jb.ID},
+			Strategy:       strategy,
+		})
+		return err
+	}
+
+	t.Run("under budget", func(t *testing.T) {
+		mustInsertConfirmedEthTxWithGasSpend(t, db, borm, fromAddress, 0, jb.ID, 21000, big.NewInt(10))
+		require.NoError(t, createEthTransaction())
+	})
+
+	t.Run("over budget", func(t *testing.T) {
+		mustInsertConfirmedEthTxWithGasSpend(t, db, borm, fromAddress, 1, jb.ID, 21000, big.NewInt(10))
+		err := createEthTransaction()
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, bulletprooftxmanager.ErrGasSpendBudgetExceeded))
+	})
+
+	t.Run("over budget stops OCR transmissions", func(t *testing.T) {
+		transmitter := ocrcommon.NewTransmitter(bptxm, fromAddress, 1000, bulletprooftxmanager.NewSendEveryStrategy(false), null.Bool{}, jb.ID)
+		err := transmitter.CreateEthTransaction(context.Background(), cltest.NewAddress(), []byte{1, 2, 3})
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, bulletprooftxmanager.ErrGasSpendBudgetExceeded))
+	})
+
+	t.Run("spend outside the window is not counted", func(t *testing.T) {
+		_, err := db.Exec(`UPDATE eth_receipts SET created_at = NOW() - interval '2 hours'`)
+		require.NoError(t, err)
+		require.NoError(t, createEthTransaction())
+	})
+}
@@ -11,3 +11,7 @@ func SetEthClientOnEthConfirmer(ethClient eth.Client, ethConfirmer *EthConfirmer
 func SetResumeCallbackOnEthBroadcaster(resumeCallback ResumeCallback, ethBroadcaster *EthBroadcaster) {
 	ethBroadcaster.resumeCallback = resumeCallback
 }
+
+func SetEventsOnEthBroadcaster(bptxm *BulletproofTxManager, ethBroadcaster *EthBroadcaster) {
+	ethBroadcaster.events = bptxm.events
+}
@@ -0,0 +1,347 @@
+package bulletprooftxmanager
+
+import (
+	"context"
+	"database/sql"
+	"math/big"
+	mrand "math/rand"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/services/eth"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+)
+
+// KeyPoolStrategy decides which key of a key pool sends the next transaction
+type KeyPoolStrategy string
+
+const (
+	// KeyPoolStrategyRoundRobin selects the key that least recently sent a
+	// transaction
+	KeyPoolStrategyRoundRobin KeyPoolStrategy = "round_robin"
+	// KeyPoolStrategyLeastUnconfirmed selects the key with the fewest
+	// transactions that are not yet confirmed
+	KeyPoolStrategyLeastUnconfirmed KeyPoolStrategy = "least_unconfirmed"
+	// KeyPoolStrategyHighestBalance selects the key with the highest balance
+	KeyPoolStrategyHighestBalance KeyPoolStrategy = "highest_balance"
+	// KeyPoolStrategyWeighted selects a random key, with a probability
+	// proportional to its weight
+	KeyPoolStrategyWeighted KeyPoolStrategy = "weighted"
+)
+
+// ParseKeyPoolStrategy returns the strategy with the given name
+func ParseKeyPoolStrategy(s string) (KeyPoolStrategy, error) {
+	switch strategy := KeyPoolStrategy(s); strategy {
+	case KeyPoolStrategyRoundRobin, KeyPoolStrategyLeastUnconfirmed, KeyPoolStrategyHighestBalance, KeyPoolStrategyWeighted:
+		return strategy, nil
+	default:
+		return "", errors.Errorf("unknown key pool strategy %q, must be one of %s, %s, %s or %s", s,
+			KeyPoolStrategyRoundRobin, KeyPoolStrategyLeastUnconfirmed, KeyPoolStrategyHighestBalance, KeyPoolStrategyWeighted)
+	}
+}
+
+var (
+	// ErrKeyPoolNotFound is returned for key pools that do not exist
+	ErrKeyPoolNotFound = errors.New("key pool not found")
+	// ErrKeyPoolInUse is returned by DeleteKeyPool for key pools that jobs
+	// send from
+	ErrKeyPoolInUse = errors.New("key pool is used by jobs")
+	// ErrKeyPoolNoKeyAvailable is returned by SelectFromAddress if every key
+	// of the key pool was skipped
+	ErrKeyPoolNoKeyAvailable = errors.New("no key of the key pool can send the transaction")
+)
+
+// KeyPool is a named group of sending keys that jobs send their transactions
+// from, instead of sharing every sending key of the node
+type KeyPool struct {
+	Name      string
+	Strategy  KeyPoolStrategy
+	Members   []KeyPoolMember
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// KeyPoolMember is a key of a key pool. The weight is only used by the
+// weighted strategy.
+type KeyPoolMember struct {
+	PoolName string
+	Address  common.Address
+	Weight   int32
+}
+
+// Validate returns an error if the key pool cannot be saved
+func (p KeyPool) Validate() error {
+	if p.Name == "" {
+		return errors.New("key pool name must not be empty")
+	}
+	if _, err := ParseKeyPoolStrategy(string(p.Strategy)); err != nil {
+		return err
+	}
+	if len(p.Members) == 0 {
+		return errors.New("key pool must have at least one key")
+	}
+	seen := make(map[common.Address]struct{})
+	for _, m := range p.Members {
+		if _, exists := seen[m.Address]; exists {
+			return errors.Errorf("key %s is in the key pool more than once", m.Address.Hex())
+		}
+		seen[m.Address] = struct{}{}
+		if m.Weight <= 0 {
+			return errors.Errorf("weight of key %s must be positive", m.Address.Hex())
+		}
+	}
+	return nil
+}
+
+// UpsertKeyPool creates the key pool, or replaces the strategy and keys of
+// the existing key pool with the same name
+func (o *orm) UpsertKeyPool(pool *KeyPool) error {
+	if err := pool.Validate(); err != nil {
+		return err
+	}
+	err := o.q.Transaction(func(tx pg.Queryer) error {
+		err := tx.Get(pool, `INSERT INTO eth_key_pools (name, strategy, created_at, updated_at) VALUES ($1, $2, NOW(), NOW())
+ON CONFLICT (name) DO UPDATE SET strategy = EXCLUDED.strategy, updated_at = NOW()
+RETURNING *`, pool.Name, pool.Strategy)
+		if err != nil {
+			return err
+		}
+		if _, err = tx.Exec(`DELETE FROM eth_key_pool_members WHERE pool_name = $1`, pool.Name); err != nil {
+			return err
+		}
+		for i := range pool.Members {
+			pool.Members[i].PoolName = pool.Name
+			if _, err = tx.Exec(`INSERT INTO eth_key_pool_members (pool_name, address, weight) VALUES ($1, $2, $3)`,
+				pool.Name, pool.Members[i].Address, pool.Members[i].Weight); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	return errors.Wrap(err, "UpsertKeyPool failed")
+}
+
+// DeleteKeyPool deletes the key pool, unless jobs still send from it
+func (o *orm) DeleteKeyPool(name string) error {
+	err := o.q.Transaction(func(tx pg.Queryer) error {
+		var jobIDs []int32
+		if err := tx.Select(&jobIDs, `SELECT id FROM jobs WHERE key_pool = $1 ORDER BY id`, name); err != nil {
+			return err
+		}
+		if len(jobIDs) > 0 {
+			return errors.Wrapf(ErrKeyPoolInUse, "key pool %s is used by jobs %v", name, jobIDs)
+		}
+		result, err := tx.Exec(`DELETE FROM eth_key_pools WHERE name = $1`, name)
+		if err != nil {
+			return err
+		}
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if rowsAffected == 0 {
+			return ErrKeyPoolNotFound
+		}
+		return nil
+	})
+	return errors.Wrap(err, "DeleteKeyPool failed")
+}
+
+// FindKeyPool returns the key pool with the given name and its keys
+func (o *orm) FindKeyPool(name string) (KeyPool, error) {
+	return findKeyPool(o.q, name)
+}
+
+// FindKeyPools returns every key pool and its keys, ordered by name
+func (o *orm) FindKeyPools() (pools []KeyPool, err error) {
+	err = o.q.Transaction(func(tx pg.Queryer) error {
+		if err = tx.Select(&pools, `SELECT * FROM eth_key_pools ORDER BY name`); err != nil {
+			return err
+		}
+		var members []KeyPoolMember
+		if err = tx.Select(&members, `SELECT * FROM eth_key_pool_members ORDER BY pool_name, address`); err != nil {
+			return err
+		}
+		poolIdx := make(map[string]int)
+		for i, pool := range pools {
+			poolIdx[pool.Name] = i
+		}
+		for _, m := range members {
+			i := poolIdx[m.PoolName]
+			pools[i].Members = append(pools[i].Members, m)
+		}
+		return nil
+	}, pg.OptReadOnlyTx())
+	return pools, errors.Wrap(err, "FindKeyPools failed")
+}
+
+func findKeyPool(q pg.Queryer, name string) (pool KeyPool, err error) {
+	err = q.Get(&pool, `SELECT * FROM eth_key_pools WHERE name = $1`, name)
+	if errors.Is(err, sql.ErrNoRows) {
+		return pool, errors.Wrapf(ErrKeyPoolNotFound, "key pool %s", name)
+	} else if err != nil {
+		return pool, errors.Wrap(err, "failed to load key pool")
+	}
+	err = q.Select(&pool.Members, `SELECT * FROM eth_key_pool_members WHERE pool_name = $1 ORDER BY address`, name)
+	return pool, errors.Wrap(err, "failed to load key pool members")
+}
+
+// JobKeyPool returns the name of the key pool the given job sends its
+// transactions from, if it has one
+func JobKeyPool(q pg.Queryer, jobID int32) (pool null.String, err error) {
+	err = q.Get(&pool, `SELECT key_pool FROM jobs WHERE id = $1`, jobID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return pool, nil
+	}
+	return pool, errors.Wrap(err, "failed to load key pool of job")
+}
+
+type keyPoolCandidate struct {
+	KeyPoolMember
+	balance     *big.Int
+	unconfirmed int64
+	lastUsed    time.Time
+}
+
+// SelectFromAddress selects the key of the key pool that sends the next
+// transaction with the given gas limit on this chain. Keys that are not on
+// this chain, that have a full queue of unstarted transactions, or whose
+// balance does not cover the expected cost of the transaction are skipped.
+func (b *BulletproofTxManager) SelectFromAddress(ctx context.Context, pool string, gasLimit uint64) (common.Address, error) {
+	kp, err := findKeyPool(b.q, pool)
+	if err != nil {
+		return common.Address{}, errors.Wrap(err, "BulletproofTxManager#SelectFromAddress")
+	}
+	states, err := b.keyStore.GetStatesForChain(&b.chainID)
+	if err != nil {
+		return common.Address{}, errors.Wrap(err, "BulletproofTxManager#SelectFromAddress failed to get key states")
+	}
+	onChain := make(map[common.Address]struct{})
+	for _, state := range states {
+		onChain[state.Address.Address()] = struct{}{}
+	}
+	cost, err := b.expectedCost(gasLimit)
+	if err != nil {
+		return common.Address{}, errors.Wrap(err, "BulletproofTxManager#SelectFromAddress failed to estimate transaction cost")
+	}
+
+	lggr := b.logger.With("keyPool", kp.Name, "gasLimit", gasLimit)
+	var candidates []keyPoolCandidate
+	for _, m := range kp.Members {
+		if _, exists := onChain[m.Address]; !exists {
+			lggr.Debugw("Skipping key that is not on this chain", "address", m.Address)
+			continue
+		}
+		if err = CheckEthTxQueueCapacity(b.q, m.Address, b.config.EvmMaxQueuedTransactions(), b.chainID); err != nil {
+			lggr.Debugw("Skipping key with a full queue", "address", m.Address, "err", err)
+			continue
+		}
+		balance, err := b.balanceOf(ctx, m.Address)
+		if err != nil {
+			lggr.Warnw("Skipping key with an unknown balance", "address", m.Address, "err", err)
+			continue
+		}
+		if balance.Cmp(cost) < 0 {
+			lggr.Debugw("Skipping key with too low a balance", "address", m.Address, "balance", balance, "expectedCost", cost)
+			continue
+		}
+		candidates = append(candidates, keyPoolCandidate{KeyPoolMember: m, balance: balance})
+	}
+	if len(candidates) == 0 {
+		return common.Address{}, errors.Wrapf(ErrKeyPoolNoKeyAvailable, "all %d keys of key pool %s were skipped", len(kp.Members), kp.Name)
+	}
+
+	selected, err := b.selectCandidate(kp.Strategy, candidates)
+	if err != nil {
+		return common.Address{}, errors.Wrap(err, "BulletproofTxManager#SelectFromAddress")
+	}
+	return selected.Address, nil
+}
+
+// balanceOf returns the balance of the key as last seen by the balance
+// monitor, or fetches it from the chain if the balance monitor has not seen it
+func (b *BulletproofTxManager) balanceOf(ctx context.Context, address common.Address) (*big.Int, error) {
+	if b.balanceMonitor != nil {
+		if balance := b.balanceMonitor.GetEthBalance(address); balance != nil {
+			return balance.ToInt(), nil
+		}
+	}
+	ctx, cancel := eth.DefaultQueryCtx(ctx)
+	defer cancel()
+	return b.ethClient.BalanceAt(ctx, address, nil)
+}
+
+// expectedCost is the most the transaction costs at the current gas price
+func (b *BulletproofTxManager) expectedCost(gasLimit uint64) (*big.Int, error) {
+	if b.config.EvmEIP1559DynamicFees() {
+		fee, chainSpecificGasLimit, err := b.gasEstimator.GetDynamicFee(gasLimit)
+		if err != nil {
+			return nil, err
+		}
+		return new(big.Int).Mul(fee.FeeCap, new(big.Int).SetUint64(chainSpecificGasLimit)), nil
+	}
+	gasPrice, chainSpecificGasLimit, err := b.gasEstimator.GetLegacyGas(nil, gasLimit)
+	if err != nil {
+		return nil, err
+	}
+	return new(big.Int).Mul(gasPrice, new(big.Int).SetUint64(chainSpecificGasLimit)), nil
+}
+
+func (b *BulletproofTxManager) selectCandidate(strategy KeyPoolStrategy, candidates []keyPoolCandidate) (selected keyPoolCandidate, err error) {
+	switch strategy {
+	case KeyPoolStrategyRoundRobin:
+		for i := range candidates {
+			var lastUsed null.Time
+			err = b.q.Get(&lastUsed, `SELECT MAX(created_at) FROM eth_txes WHERE from_address = $1 AND evm_chain_id = $2`, candidates[i].Address, b.chainID.String())
+			if err != nil {
+				return selected, errors.Wrap(err, "failed to load last use of key")
+			}
+			candidates[i].lastUsed = lastUsed.Time
+		}
+		selected = candidates[0]
+		for _, c := range candidates[1:] {
+			if c.lastUsed.Before(selected.lastUsed) {
+				selected = c
+			}
+		}
+	case KeyPoolStrategyLeastUnconfirmed:
+		for i := range candidates {
+			err = b.q.Get(&candidates[i].unconfirmed, `SELECT count(*) FROM eth_txes WHERE from_address = $1 AND evm_chain_id = $2 AND state IN ('unstarted', 'in_progress', 'unconfirmed')`, candidates[i].Address, b.chainID.String())
+			if err != nil {
+				return selected, errors.Wrap(err, "failed to count unconfirmed transactions of key")
+			}
+		}
+		selected = candidates[0]
+		for _, c := range candidates[1:] {
+			if c.unconfirmed < selected.unconfirmed {
+				selected = c
+			}
+		}
+	case KeyPoolStrategyHighestBalance:
+		selected = candidates[0]
+		for _, c := range candidates[1:] {
+			if c.balance.Cmp(selected.balance) > 0 {
+				selected = c
+			}
+		}
+	case KeyPoolStrategyWeighted:
+		var total int64
+		for _, c := range candidates {
+			total += int64(c.Weight)
+		}
+		/* #nosec G404 */
+		n := mrand.Int63n(total)
+		for _, c := range candidates {
+			if n < int64(c.Weight) {
+				return c, nil
+			}
+			n -= int64(c.Weight)
+		}
+	default:
+		return selected, errors.Errorf("unknown key pool strategy: %s", strategy)
+	}
+	return selected, nil
+}
@@ -0,0 +1,215 @@
+package bulletprooftxmanager_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/assets"
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/evmtest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/bulletprooftxmanager"
+	"github.com/smartcontractkit/chainlink/core/services/eth/mocks"
+	servicesMocks "github.com/smartcontractkit/chainlink/core/services/mocks"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+func mustUpsertKeyPool(t *testing.T, borm bulletprooftxmanager.ORM, name string, strategy bulletprooftxmanager.KeyPoolStrategy, addresses ...common.Address) {
+	t.Helper()
+
+	pool := bulletprooftxmanager.KeyPool{Name: name, Strategy: strategy}
+	for _, address := range addresses {
+		pool.Members = append(pool.Members, bulletprooftxmanager.KeyPoolMember{Address: address, Weight: 1})
+	}
+	require.NoError(t, borm.UpsertKeyPool(&pool))
+}
+
+func mockBalances(ethClient *mocks.Client, balances map[common.Address]*big.Int) {
+	for address, balance := range balances {
+		ethClient.On("BalanceAt", mock.Anything, address, (*big.Int)(nil)).Return(balance, nil)
+	}
+}
+
+func TestBulletproofTxManager_SelectFromAddress(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	cfg := cltest.NewTestGeneralConfig(t)
+	cfg.Overrides.GlobalGasEstimatorMode = null.StringFrom("FixedPrice")
+	cfg.Overrides.GlobalEvmGasPriceDefault = big.NewInt(10)
+	cfg.Overrides.GlobalEvmMaxQueuedTransactions = null.IntFrom(1)
+	evmcfg := evmtest.NewChainScopedConfig(t, cfg)
+	borm := cltest.NewBulletproofTxManagerORM(t, db, cfg)
+	ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
+	ethClient := cltest.NewEthClientMockWithDefaultChain(t)
+	bptxm := bulletprooftxmanager.NewBulletproofTxManager(db, ethClient, evmcfg, ethKeyStore, nil, nil, logger.TestLogger(t))
+
+	// A transaction with a gas limit of 1000 costs 10000 wei
+	const gasLimit = 1000
+
+	_, fullAddress := cltest.MustInsertRandomKey(t, ethKeyStore, int64(0))
+	cltest.MustInsertUnstartedEthTx(t, borm, fullAddress)
+	_, poorAddress := cltest.MustInsertRandomKey(t, ethKeyStore, int64(0))
+	_, busyAddress := cltest.MustInsertRandomKey(t, ethKeyStore, int64(2))
+	cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, borm, 0, busyAddress)
+	cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, borm, 1, busyAddress)
+	_, idleAddress := cltest.MustInsertRandomKey(t, ethKeyStore, int64(1))
+	cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, borm, 0, idleAddress)
+	_, otherChainAddress := cltest.MustInsertRandomKey(t, ethKeyStore, *utils.NewBigI(1337))
+
+	mockBalances(ethClient, map[common.Address]*big.Int{
+		poorAddress: big.NewInt(9999),
+		busyAddress: big.NewInt(20000),
+		idleAddress: big.NewInt(10000),
+	})
+
+	t.Run("skips keys with a full queue, too low a balance or on another chain", func(t *testing.T) {
+		mustUpsertKeyPool(t, borm, "least_unconfirmed", bulletprooftxmanager.KeyPoolStrategyLeastUnconfirmed,
+			fullAddress, poorAddress, busyAddress, idleAddress, otherChainAddress)
+
+		address, err := bptxm.SelectFromAddress(context.Background(), "least_unconfirmed", gasLimit)
+		require.NoError(t, err)
+		assert.Equal(t, idleAddress, address)
+	})
+
+	t.Run("selects the key with the highest balance", func(t *testing.T) {
+		mustUpsertKeyPool(t, borm, "highest_balance", bulletprooftxmanager.KeyPoolStrategyHighestBalance,
+			poorAddress, busyAddress, idleAddress)
+
+		address, err := bptxm.SelectFromAddress(context.Background(), "highest_balance", gasLimit)
+		require.NoError(t, err)
+		assert.Equal(t, busyAddress, address)
+	})
+
+	t.Run("selects the key that least recently sent a transaction", func(t *testing.T) {
+		mustUpsertKeyPool(t, borm, "round_robin", bulletprooftxmanager.KeyPoolStrategyRoundRobin,
+			busyAddress, idleAddress)
+
+		address, err := bptxm.SelectFromAddress(context.Background(), "round_robin", gasLimit)
+		require.NoError(t, err)
+		assert.Equal(t, busyAddress, address)
+	})
+
+	t.Run("selects a key with a weight if weighted", func(t *testing.T) {
+		require.NoError(t, borm.UpsertKeyPool(&bulletprooftxmanager.KeyPool{
+			Name:     "weighted",
+			Strategy: bulletprooftxmanager.KeyPoolStrategyWeighted,
+			Members: []bulletprooftxmanager.KeyPoolMember{
+				{Address: poorAddress, Weight: 100},
+				{Address: idleAddress, Weight: 1},
+			},
+		}))
+
+		address, err := bptxm.SelectFromAddress(context.Background(), "weighted", gasLimit)
+		require.NoError(t, err)
+		assert.Equal(t, idleAddress, address)
+	})
+
+	t.Run("returns an error if every key is skipped", func(t *testing.T) {
+		mustUpsertKeyPool(t, borm, "skipped", bulletprooftxmanager.KeyPoolStrategyRoundRobin,
+			fullAddress, poorAddress)
+
+		_, err := bptxm.SelectFromAddress(context.Background(), "skipped", gasLimit)
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, bulletprooftxmanager.ErrKeyPoolNoKeyAvailable))
+	})
+
+	t.Run("uses the balances seen by the balance monitor", func(t *testing.T) {
+		mustUpsertKeyPool(t, borm, "monitored", bulletprooftxmanager.KeyPoolStrategyHighestBalance,
+			busyAddress, idleAddress)
+
+		idleBalance := assets.NewEthValue(30000)
+		balanceMonitor := new(servicesMocks.BalanceMonitor)
+		balanceMonitor.Test(t)
+		balanceMonitor.On("GetEthBalance", idleAddress).Return(&idleBalance)
+		balanceMonitor.On("GetEthBalance", busyAddress).Return((*assets.Eth)(nil))
+		// Only the key the balance monitor has not seen is fetched from the chain
+		monitoredEthClient := cltest.NewEthClientMockWithDefaultChain(t)
+		mockBalances(monitoredEthClient, map[common.Address]*big.Int{busyAddress: big.NewInt(20000)})
+		monitoredBptxm := bulletprooftxmanager.NewBulletproofTxManager(db, monitoredEthClient, evmcfg, ethKeyStore, nil, balanceMonitor, logger.TestLogger(t))
+
+		address, err := monitoredBptxm.SelectFromAddress(context.Background(), "monitored", gasLimit)
+		require.NoError(t, err)
+		assert.Equal(t, idleAddress, address)
+		balanceMonitor.AssertExpectations(t)
+		monitoredEthClient.AssertExpectations(t)
+	})
+
+	t.Run("returns an error if the key pool does not exist", func(t *testing.T) {
+		_, err := bptxm.SelectFromAddress(context.Background(), "missing", gasLimit)
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, bulletprooftxmanager.ErrKeyPoolNotFound))
+	})
+}
+
+func TestORM_KeyPools(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	cfg := cltest.NewTestGeneralConfig(t)
+	borm := cltest.NewBulletproofTxManagerORM(t, db, cfg)
+
+	addr1, addr2 := cltest.NewAddress(), cltest.NewAddress()
+
+	t.Run("rejects invalid key pools", func(t *testing.T) {
+		err := borm.UpsertKeyPool(&bulletprooftxmanager.KeyPool{Name: "invalid", Strategy: "fastest", Members: []bulletprooftxmanager.KeyPoolMember{{Address: addr1, Weight: 1}}})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "unknown key pool strategy")
+
+		err = borm.UpsertKeyPool(&bulletprooftxmanager.KeyPool{Name: "invalid", Strategy: bulletprooftxmanager.KeyPoolStrategyWeighted, Members: []bulletprooftxmanager.KeyPoolMember{{Address: addr1, Weight: 0}}})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "must be positive")
+
+		err = borm.UpsertKeyPool(&bulletprooftxmanager.KeyPool{Name: "invalid", Strategy: bulletprooftxmanager.KeyPoolStrategyWeighted})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "at least one key")
+	})
+
+	t.Run("replaces the strategy and keys of an existing key pool", func(t *testing.T) {
+		mustUpsertKeyPool(t, borm, "ocr", bulletprooftxmanager.KeyPoolStrategyRoundRobin, addr1, addr2)
+		mustUpsertKeyPool(t, borm, "ocr", bulletprooftxmanager.KeyPoolStrategyHighestBalance, addr2)
+
+		pool, err := borm.FindKeyPool("ocr")
+		require.NoError(t, err)
+		assert.Equal(t, bulletprooftxmanager.KeyPoolStrategyHighestBalance, pool.Strategy)
+		require.Len(t, pool.Members, 1)
+		assert.Equal(t, addr2, pool.Members[0].Address)
+
+		pools, err := borm.FindKeyPools()
+		require.NoError(t, err)
+		require.Len(t, pools, 1)
+		assert.Equal(t, pool.Members, pools[0].Members)
+	})
+
+	t.Run("does not delete key pools that jobs send from", func(t *testing.T) {
+		mustUpsertKeyPool(t, borm, "vrf", bulletprooftxmanager.KeyPoolStrategyRoundRobin, addr1)
+		jb, _ := cltest.MustInsertWebhookSpec(t, db)
+		_, err := db.Exec(`UPDATE jobs SET key_pool = 'vrf' WHERE id = $1`, jb.ID)
+		require.NoError(t, err)
+
+		pool, err := bulletprooftxmanager.JobKeyPool(db, jb.ID)
+		require.NoError(t, err)
+		assert.Equal(t, null.StringFrom("vrf"), pool)
+
+		err = borm.DeleteKeyPool("vrf")
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, bulletprooftxmanager.ErrKeyPoolInUse))
+
+		_, err = db.Exec(`UPDATE jobs SET key_pool = NULL WHERE id = $1`, jb.ID)
+		require.NoError(t, err)
+		require.NoError(t, borm.DeleteKeyPool("vrf"))
+
+		_, err = borm.FindKeyPool("vrf")
+		assert.True(t, errors.Is(err, bulletprooftxmanager.ErrKeyPoolNotFound))
+		assert.True(t, errors.Is(borm.DeleteKeyPool("vrf"), bulletprooftxmanager.ErrKeyPoolNotFound))
+	})
+}
@@ -22,6 +22,27 @@ type TxManager struct {
 	mock.Mock
 }
 
+// CancelEthTransaction provides a mock function with given fields: id
+func (_m *TxManager) CancelEthTransaction(id int64) (bulletprooftxmanager.EthTx, error) {
+	ret := _m.Called(id)
+
+	var r0 bulletprooftxmanager.EthTx
+	if rf, ok := ret.Get(0).(func(int64) bulletprooftxmanager.EthTx); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Get(0).(bulletprooftxmanager.EthTx)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int64) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Close provides a mock function with given fields:
 func (_m *TxManager) Close() error {
 	ret := _m.Called()
@@ -94,6 +115,29 @@ func (_m *TxManager) Healthy() error {
 	return r0
 }
 
+// NonceStatus provides a mock function with given fields: ctx
+func (_m *TxManager) NonceStatus(ctx context.Context) ([]bulletprooftxmanager.NonceStatus, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []bulletprooftxmanager.NonceStatus
+	if rf, ok := ret.Get(0).(func(context.Context) []bulletprooftxmanager.NonceStatus); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]bulletprooftxmanager.NonceStatus)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // OnNewLongestChain provides a mock function with given fields: ctx, head
 func (_m *TxManager) OnNewLongestChain(ctx context.Context, head *eth.Head) {
 	_m.Called(ctx, head)
@@ -118,6 +162,50 @@ func (_m *TxManager) RegisterResumeCallback(fn bulletprooftxmanager.ResumeCallba
 	_m.Called(fn)
 }
 
+// ReplaceEthTransaction provides a mock function with given fields: id, newPayload
+func (_m *TxManager) ReplaceEthTransaction(id int64, newPayload []byte) (bulletprooftxmanager.EthTx, error) {
+	ret := _m.Called(id, newPayload)
+
+	var r0 bulletprooftxmanager.EthTx
+	if rf, ok := ret.Get(0).(func(int64, []byte) bulletprooftxmanager.EthTx); ok {
+		r0 = rf(id, newPayload)
+	} else {
+		r0 = ret.Get(0).(bulletprooftxmanager.EthTx)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int64, []byte) error); ok {
+		r1 = rf(id, newPayload)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SelectFromAddress provides a mock function with given fields: ctx, pool, gasLimit
+func (_m *TxManager) SelectFromAddress(ctx context.Context, pool string, gasLimit uint64) (common.Address, error) {
+	ret := _m.Called(ctx, pool, gasLimit)
+
+	var r0 common.Address
+	if rf, ok := ret.Get(0).(func(context.Context, string, uint64) common.Address); ok {
+		r0 = rf(ctx, pool, gasLimit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(common.Address)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, uint64) error); ok {
+		r1 = rf(ctx, pool, gasLimit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Start provides a mock function with given fields:
 func (_m *TxManager) Start() error {
 	ret := _m.Called()
@@ -132,6 +220,31 @@ func (_m *TxManager) Start() error {
 	return r0
 }
 
+// SubscribeToEvents provides a mock function with given fields:
+func (_m *TxManager) SubscribeToEvents() (<-chan bulletprooftxmanager.Event, func()) {
+	ret := _m.Called()
+
+	var r0 <-chan bulletprooftxmanager.Event
+	if rf, ok := ret.Get(0).(func() <-chan bulletprooftxmanager.Event); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan bulletprooftxmanager.Event)
+		}
+	}
+
+	var r1 func()
+	if rf, ok := ret.Get(1).(func() func()); ok {
+		r1 = rf()
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(func())
+		}
+	}
+
+	return r0, r1
+}
+
 // Trigger provides a mock function with given fields: addr
 func (_m *TxManager) Trigger(addr common.Address) {
 	_m.Called(addr)
@@ -5,9 +5,11 @@ import (
 	"database/sql/driver"
 	"encoding/json"
 	"fmt"
+	"math/big"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/pkg/errors"
@@ -134,6 +136,11 @@ type EthTx struct {
 	// AccessList is optional and only has an effect on DynamicFee transactions
 	// on chains that support it (e.g. Ethereum Mainnet after London hard fork)
 	AccessList NullableEIP2930AccessList
+	// GenerateAccessList overrides EVM_GENERATE_ACCESS_LISTS for this eth_tx
+	GenerateAccessList null.Bool
+	// PrivateRelay overrides EVM_PRIVATE_RELAY_ENABLED for this eth_tx. It is
+	// set to false once the eth_tx falls back to public broadcast.
+	PrivateRelay null.Bool
 
 	// Simulate if set to true will cause this eth_tx to be simulated before
 	// initial send and aborted on revert
@@ -152,6 +159,17 @@ func (e EthTx) GetID() string {
 	return fmt.Sprintf("%d", e.ID)
 }
 
+// AuditFields are the fields of a transaction that are recorded in the audit
+// log when it is cancelled or replaced
+func (e EthTx) AuditFields() map[string]interface{} {
+	return map[string]interface{}{
+		"state": e.State,
+		"to":    e.ToAddress.Hex(),
+		"data":  hexutil.Encode(e.EncodedPayload),
+		"value": e.Value.String(),
+	}
+}
+
 type EthTxAttempt struct {
 	ID      int64
 	EthTxID int64
@@ -170,6 +188,9 @@ type EthTxAttempt struct {
 	State                   EthTxAttemptState
 	EthReceipts             []EthReceipt `json:"-"`
 	TxType                  int
+	// AccessListGasSaved is the gas that the generated access list of the
+	// eth_tx was estimated to save. It is only set on the first attempt.
+	AccessListGasSaved null.Int
 }
 
 // GetSignedTx decodes the SignedRawTx into a types.Transaction struct
@@ -197,4 +218,32 @@ type EthReceipt struct {
 	TransactionIndex uint
 	Receipt          []byte
 	CreatedAt        time.Time
+	// GasUsed is null for receipts saved before gas spend was tracked whose
+	// gasUsed could not be recovered
+	GasUsed null.Int
+	// EffectiveGasPrice is only set if it was reported by the eth node
+	EffectiveGasPrice *utils.Big
+}
+
+// Fee returns the fee paid for the transaction of this receipt, which was
+// sent with the given attempt. If the eth node did not report the effective
+// gas price, the gas price is used for legacy transactions, and the fee cap
+// (an upper bound) for dynamic fee transactions. Fee returns nil if the gas
+// used is unknown.
+func (r EthReceipt) Fee(attempt EthTxAttempt) *big.Int {
+	if !r.GasUsed.Valid {
+		return nil
+	}
+	var price *big.Int
+	switch {
+	case r.EffectiveGasPrice != nil:
+		price = r.EffectiveGasPrice.ToInt()
+	case attempt.GasPrice != nil:
+		price = attempt.GasPrice.ToInt()
+	case attempt.GasFeeCap != nil:
+		price = attempt.GasFeeCap.ToInt()
+	default:
+		return nil
+	}
+	return new(big.Int).Mul(big.NewInt(r.GasUsed.Int64), price)
 }
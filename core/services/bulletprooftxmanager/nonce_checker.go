@@ -0,0 +1,366 @@
+package bulletprooftxmanager
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/core/assets"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/eth"
+	"github.com/smartcontractkit/chainlink/core/services/gas"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+	"github.com/smartcontractkit/chainlink/core/utils"
+	"github.com/smartcontractkit/sqlx"
+)
+
+// maxReportedNonceGaps limits the number of missing nonces that are reported,
+// and filled, per key and check
+const maxReportedNonceGaps = 50
+
+var (
+	promNonceGaps = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "tx_manager_nonce_gaps",
+		Help: "The number of nonces between the on-chain nonce and the local next nonce of a key that have no local transaction, as of the last nonce check (at most 50)",
+	}, []string{"evmChainID", "fromAddress"})
+	promNonceChainAhead = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "tx_manager_nonce_chain_ahead",
+		Help: "Set to 1 if the on-chain nonce of a key was higher than its local next nonce as of the last nonce check, i.e. the key was used by another wallet",
+	}, []string{"evmChainID", "fromAddress"})
+)
+
+// NonceStatus compares the on-chain nonces of a key with the local ones
+type NonceStatus struct {
+	Address    common.Address
+	EVMChainID utils.Big
+	// NextNonce is the nonce that the next local transaction will be sent with
+	NextNonce int64
+	// LatestNonce is the number of transactions of the key mined on chain
+	LatestNonce uint64
+	// PendingNonce also counts the transactions of the key in the mempool
+	PendingNonce uint64
+	// InFlight is the number of local transactions that were sent but are not
+	// confirmed yet
+	InFlight int64
+	// Gaps are the nonces from LatestNonce up to NextNonce that have no local
+	// transaction. Transactions at later nonces can never be mined until
+	// these are filled.
+	Gaps []int64
+	// ChainAhead is true if LatestNonce is higher than NextNonce, which means
+	// that the key was used by another wallet and the next local transaction
+	// will be rejected for its nonce being too low
+	ChainAhead bool
+	CheckedAt  time.Time
+}
+
+// Healthy returns an error describing the problems found by the check, if any
+func (s NonceStatus) Healthy() (merr error) {
+	if len(s.Gaps) > 0 {
+		merr = multierr.Combine(merr, errors.Errorf("key %s has no transactions for nonces %v", s.Address.Hex(), s.Gaps))
+	}
+	if s.ChainAhead {
+		merr = multierr.Combine(merr, errors.Errorf("key %s was used by another wallet: on-chain nonce is %d but local next nonce is %d", s.Address.Hex(), s.LatestNonce, s.NextNonce))
+	}
+	return merr
+}
+
+// NonceChecker periodically compares the on-chain nonces of each key with the
+// local next nonce and the nonces of the transactions in flight, to catch
+// gaps in the nonce sequence and nonces consumed by other wallets at runtime.
+// NonceSyncer only handles the latter, and only on startup.
+//
+// Optionally, gaps are filled with empty transactions, which are then sent and
+// bumped by the EthConfirmer like any other transaction, and the local next
+// nonce of keys used by another wallet is fast-forwarded to the pending nonce.
+type NonceChecker struct {
+	q         pg.Q
+	ethClient eth.Client
+	config    Config
+	keyStore  KeyStore
+	estimator gas.Estimator
+	chainID   big.Int
+	logger    logger.Logger
+
+	statusesMu sync.RWMutex
+	statuses   map[common.Address]NonceStatus
+
+	chStop chan struct{}
+	wg     sync.WaitGroup
+}
+
+// NewNonceChecker returns a new NonceChecker
+func NewNonceChecker(db *sqlx.DB, ethClient eth.Client, config Config, keyStore KeyStore, estimator gas.Estimator, lggr logger.Logger) *NonceChecker {
+	lggr = lggr.Named("NonceChecker")
+	return &NonceChecker{
+		q:         pg.NewQ(db, lggr, config),
+		ethClient: ethClient,
+		config:    config,
+		keyStore:  keyStore,
+		estimator: estimator,
+		chainID:   *ethClient.ChainID(),
+		logger:    lggr,
+		statuses:  make(map[common.Address]NonceStatus),
+		chStop:    make(chan struct{}),
+	}
+}
+
+// Start runs the periodic checks. Should only be called once.
+func (c *NonceChecker) Start() {
+	c.logger.Debugw("NonceChecker: started", "interval", c.config.EthNonceCheckInterval(), "fillGaps", c.config.EthNonceCheckFillGaps(), "resync", c.config.EthNonceCheckResync())
+	c.wg.Add(1)
+	go c.runLoop()
+}
+
+// Stop the periodic checks. Should only be called once.
+func (c *NonceChecker) Stop() {
+	close(c.chStop)
+	c.wg.Wait()
+}
+
+func (c *NonceChecker) runLoop() {
+	defer c.wg.Done()
+	ticker := time.NewTicker(utils.WithJitter(c.config.EthNonceCheckInterval()))
+	defer ticker.Stop()
+	for {
+		select {
+		case <-c.chStop:
+			return
+		case <-ticker.C:
+			ctx, cancel := utils.ContextFromChan(c.chStop)
+			if _, err := c.CheckAll(ctx, c.config.EthNonceCheckFillGaps(), c.config.EthNonceCheckResync()); err != nil {
+				c.logger.Errorw("Failed to check nonces", "err", err)
+			}
+			cancel()
+		}
+	}
+}
+
+// Healthy returns an error if the last check of any key found a problem
+func (c *NonceChecker) Healthy() (merr error) {
+	c.statusesMu.RLock()
+	defer c.statusesMu.RUnlock()
+	for _, status := range c.statuses {
+		merr = multierr.Combine(merr, status.Healthy())
+	}
+	return merr
+}
+
+// CheckAll checks the nonces of every key on the chain. If fillGaps is true,
+// missing nonces are filled with empty transactions. If resync is true, the
+// local next nonce of keys that were used by another wallet is fast-forwarded.
+// The returned statuses are those found before repairing anything.
+func (c *NonceChecker) CheckAll(ctx context.Context, fillGaps, resync bool) (statuses []NonceStatus, merr error) {
+	keyStates, err := c.keyStore.GetStatesForChain(&c.chainID)
+	if err != nil {
+		return nil, errors.Wrap(err, "NonceChecker#CheckAll failed to load key states")
+	}
+	for _, state := range keyStates {
+		status, err := c.check(ctx, state)
+		if err != nil {
+			merr = multierr.Combine(merr, errors.Wrapf(err, "failed to check nonces of key %s", state.Address.Hex()))
+			continue
+		}
+		statuses = append(statuses, status)
+		if herr := status.Healthy(); herr != nil {
+			c.logger.Errorw(fmt.Sprintf("Nonce check failed: %v", herr), "address", status.Address, "nextNonce", status.NextNonce, "latestNonce", status.LatestNonce, "pendingNonce", status.PendingNonce, "gaps", status.Gaps)
+		}
+		repaired, err := c.repair(status, fillGaps, resync)
+		if err != nil {
+			merr = multierr.Combine(merr, errors.Wrapf(err, "failed to repair nonces of key %s", state.Address.Hex()))
+		}
+		c.setStatus(repaired)
+	}
+	return statuses, merr
+}
+
+func (c *NonceChecker) setStatus(status NonceStatus) {
+	c.statusesMu.Lock()
+	defer c.statusesMu.Unlock()
+	c.statuses[status.Address] = status
+	promNonceGaps.WithLabelValues(c.chainID.String(), status.Address.Hex()).Set(float64(len(status.Gaps)))
+	chainAhead := 0.0
+	if status.ChainAhead {
+		chainAhead = 1
+	}
+	promNonceChainAhead.WithLabelValues(c.chainID.String(), status.Address.Hex()).Set(chainAhead)
+}
+
+func (c *NonceChecker) check(ctx context.Context, state ethkey.State) (status NonceStatus, err error) {
+	address := state.Address.Address()
+	status = NonceStatus{
+		Address:    address,
+		EVMChainID: *utils.NewBig(&c.chainID),
+		CheckedAt:  time.Now(),
+	}
+
+	// The chain nonces are fetched first, so that transactions confirmed in
+	// between cannot show up as gaps
+	ctx, cancel := eth.DefaultQueryCtx(ctx)
+	defer cancel()
+	status.LatestNonce, err = c.ethClient.NonceAt(ctx, address, nil)
+	if err != nil {
+		return status, errors.Wrap(err, "failed to get latest nonce")
+	}
+	status.PendingNonce, err = c.ethClient.PendingNonceAt(ctx, address)
+	if err != nil {
+		return status, errors.Wrap(err, "failed to get pending nonce")
+	}
+
+	q := c.q.WithOpts(pg.WithParentCtx(ctx))
+	status.NextNonce, err = GetNextNonce(q, address, &c.chainID)
+	if err != nil {
+		return status, errors.Wrap(err, "failed to get next nonce")
+	}
+	if err = q.Get(&status.InFlight, `SELECT count(*) FROM eth_txes WHERE from_address = $1 AND evm_chain_id = $2 AND state = 'unconfirmed'`, address, c.chainID.String()); err != nil {
+		return status, errors.Wrap(err, "failed to count unconfirmed transactions")
+	}
+	if status.LatestNonce > uint64(status.NextNonce) {
+		status.ChainAhead = true
+		return status, nil
+	}
+	err = q.Select(&status.Gaps, `
+SELECT nonce FROM generate_series($1::bigint, $2::bigint - 1) AS nonce
+WHERE NOT EXISTS (
+	SELECT 1 FROM eth_txes
+	WHERE eth_txes.from_address = $3 AND eth_txes.evm_chain_id = $4 AND eth_txes.nonce = nonce
+)
+ORDER BY nonce ASC
+LIMIT $5
+`, int64(status.LatestNonce), status.NextNonce, address, c.chainID.String(), maxReportedNonceGaps)
+	return status, errors.Wrap(err, "failed to find nonce gaps")
+}
+
+// repair returns the status of the key after repairing the problems found
+func (c *NonceChecker) repair(status NonceStatus, fillGaps, resync bool) (NonceStatus, error) {
+	if fillGaps && len(status.Gaps) > 0 {
+		for _, nonce := range status.Gaps {
+			if err := c.fillGap(status.Address, nonce); err != nil {
+				return status, errors.Wrapf(err, "failed to fill nonce %d", nonce)
+			}
+		}
+		status.Gaps = nil
+	}
+	if resync && status.ChainAhead {
+		resynced, err := c.resync(status)
+		if err != nil {
+			return status, err
+		}
+		status.ChainAhead = !resynced
+	}
+	return status, nil
+}
+
+// fillGap inserts an empty transaction with the given nonce. It is saved as
+// unconfirmed with an in_progress attempt, which the EthConfirmer sends on the
+// next head.
+func (c *NonceChecker) fillGap(address common.Address, nonce int64) error {
+	now := time.Now()
+	etx := EthTx{
+		FromAddress:    address,
+		ToAddress:      address,
+		EncodedPayload: []byte{},
+		Value:          assets.NewEthValue(0),
+		GasLimit:       c.config.EvmGasLimitTransfer(),
+		Nonce:          &nonce,
+		BroadcastAt:    &now,
+		State:          EthTxUnconfirmed,
+		EVMChainID:     *utils.NewBig(&c.chainID),
+	}
+
+	cks := NewChainKeyStore(c.chainID, c.config, c.keyStore)
+	var attempt EthTxAttempt
+	if c.config.EvmEIP1559DynamicFees() {
+		fee, gasLimit, err := c.estimator.GetDynamicFee(etx.GasLimit)
+		if err != nil {
+			return errors.Wrap(err, "failed to get dynamic gas fee")
+		}
+		attempt, err = cks.NewDynamicFeeAttempt(etx, fee, gasLimit)
+		if err != nil {
+			return err
+		}
+	} else {
+		gasPrice, gasLimit, err := c.estimator.GetLegacyGas(etx.EncodedPayload, etx.GasLimit)
+		if err != nil {
+			return errors.Wrap(err, "failed to estimate gas")
+		}
+		attempt, err = cks.NewLegacyAttempt(etx, gasPrice, gasLimit)
+		if err != nil {
+			return err
+		}
+	}
+
+	err := c.q.Transaction(func(tx pg.Queryer) error {
+		// Another transaction may have taken the nonce since the check
+		var exists bool
+		if err := tx.Get(&exists, `SELECT EXISTS(SELECT 1 FROM eth_txes WHERE from_address = $1 AND evm_chain_id = $2 AND nonce = $3)`, address, c.chainID.String(), nonce); err != nil {
+			return errors.Wrap(err, "failed to check for existing eth_tx")
+		}
+		if exists {
+			return nil
+		}
+		query, args, err := tx.BindNamed(`
+INSERT INTO eth_txes (from_address, to_address, encoded_payload, value, gas_limit, nonce, broadcast_at, state, evm_chain_id, created_at)
+VALUES (:from_address, :to_address, :encoded_payload, :value, :gas_limit, :nonce, :broadcast_at, :state, :evm_chain_id, NOW())
+RETURNING *`, &etx)
+		if err != nil {
+			return errors.Wrap(err, "failed to BindNamed")
+		}
+		if err = tx.Get(&etx, query, args...); err != nil {
+			return errors.Wrap(err, "failed to insert eth_tx")
+		}
+		attempt.EthTxID = etx.ID
+		query, args, err = tx.BindNamed(insertIntoEthTxAttemptsQuery, &attempt)
+		if err != nil {
+			return errors.Wrap(err, "failed to BindNamed")
+		}
+		return errors.Wrap(tx.Get(&attempt, query, args...), "failed to insert eth_tx_attempt")
+	})
+	if err != nil {
+		return err
+	}
+	if etx.ID != 0 {
+		c.logger.Warnw(fmt.Sprintf("Filled nonce gap of key %s at nonce %d with an empty transaction", address.Hex(), nonce), "address", address, "nonce", nonce, "etxID", etx.ID)
+	}
+	return nil
+}
+
+// resync fast-forwards the local next nonce of a key that was used by another
+// wallet to its pending nonce, like NonceSyncer does on startup. The next
+// nonce is used as an optimistic lock, so that nonces assigned by the
+// EthBroadcaster in the meantime are not overwritten.
+func (c *NonceChecker) resync(status NonceStatus) (resynced bool, err error) {
+	err = c.q.Transaction(func(tx pg.Queryer) error {
+		var inProgress bool
+		if err := tx.Get(&inProgress, `SELECT EXISTS(SELECT 1 FROM eth_txes WHERE state = 'in_progress' AND from_address = $1 AND evm_chain_id = $2)`, status.Address, c.chainID.String()); err != nil {
+			return errors.Wrap(err, "failed to query for in_progress transaction")
+		}
+		if inProgress {
+			// The EthBroadcaster handles the nonce of an in_progress
+			// transaction being used already
+			return nil
+		}
+		res, err := tx.Exec(`UPDATE eth_key_states SET next_nonce = $1, updated_at = NOW() WHERE address = $2 AND next_nonce = $3 AND evm_chain_id = $4`, status.PendingNonce, status.Address, status.NextNonce, c.chainID.String())
+		if err != nil {
+			return errors.Wrap(err, "failed to update next nonce")
+		}
+		rowsAffected, err := res.RowsAffected()
+		if err != nil {
+			return errors.Wrap(err, "failed to get RowsAffected")
+		}
+		resynced = rowsAffected > 0
+		if resynced {
+			c.logger.Warnw(fmt.Sprintf("Key %s was used by another wallet, fast-forwarded its next nonce from %d to %d", status.Address.Hex(), status.NextNonce, status.PendingNonce), "address", status.Address, "nextNonce", status.NextNonce, "pendingNonce", status.PendingNonce)
+		}
+		return nil
+	})
+	return resynced, err
+}
@@ -0,0 +1,194 @@
+package bulletprooftxmanager_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/evmtest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/bulletprooftxmanager"
+	"github.com/smartcontractkit/chainlink/core/services/eth/mocks"
+	"github.com/smartcontractkit/chainlink/core/services/gas"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func mockChainNonces(ethClient *mocks.Client, address common.Address, latest, pending uint64) {
+	ethClient.On("NonceAt", mock.Anything, address, (*big.Int)(nil)).Return(latest, nil)
+	ethClient.On("PendingNonceAt", mock.Anything, address).Return(pending, nil)
+}
+
+func Test_NonceChecker_CheckAll(t *testing.T) {
+	t.Parallel()
+
+	t.Run("returns error if NonceAt fails", func(t *testing.T) {
+		db := pgtest.NewSqlxDB(t)
+		cfg := cltest.NewTestGeneralConfig(t)
+		evmcfg := evmtest.NewChainScopedConfig(t, cfg)
+		ethClient := cltest.NewEthClientMockWithDefaultChain(t)
+		ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
+		lggr := logger.TestLogger(t)
+
+		_, from := cltest.MustInsertRandomKey(t, ethKeyStore, int64(0))
+
+		ethClient.On("NonceAt", mock.Anything, from, (*big.Int)(nil)).Return(uint64(0), errors.New("something exploded"))
+
+		nc := bulletprooftxmanager.NewNonceChecker(db, ethClient, evmcfg, ethKeyStore, gas.NewFixedPriceEstimator(evmcfg, lggr), lggr)
+		statuses, err := nc.CheckAll(context.Background(), true, true)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "something exploded")
+		assert.Len(t, statuses, 0)
+
+		cltest.AssertCount(t, db, "eth_txes", 0)
+		ethClient.AssertExpectations(t)
+	})
+
+	t.Run("reports no problems if every nonce has a transaction", func(t *testing.T) {
+		db := pgtest.NewSqlxDB(t)
+		cfg := cltest.NewTestGeneralConfig(t)
+		evmcfg := evmtest.NewChainScopedConfig(t, cfg)
+		ethClient := cltest.NewEthClientMockWithDefaultChain(t)
+		ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
+		borm := cltest.NewBulletproofTxManagerORM(t, db, cfg)
+		lggr := logger.TestLogger(t)
+
+		_, from := cltest.MustInsertRandomKey(t, ethKeyStore, int64(3))
+		cltest.MustInsertConfirmedEthTxWithLegacyAttempt(t, borm, 0, 1, from)
+		cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, borm, 1, from)
+		cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, borm, 2, from)
+
+		mockChainNonces(ethClient, from, 1, 3)
+
+		nc := bulletprooftxmanager.NewNonceChecker(db, ethClient, evmcfg, ethKeyStore, gas.NewFixedPriceEstimator(evmcfg, lggr), lggr)
+		statuses, err := nc.CheckAll(context.Background(), false, false)
+		require.NoError(t, err)
+		require.Len(t, statuses, 1)
+
+		status := statuses[0]
+		assert.Equal(t, from, status.Address)
+		assert.Equal(t, int64(3), status.NextNonce)
+		assert.Equal(t, uint64(1), status.LatestNonce)
+		assert.Equal(t, uint64(3), status.PendingNonce)
+		assert.Equal(t, int64(2), status.InFlight)
+		assert.Len(t, status.Gaps, 0)
+		assert.False(t, status.ChainAhead)
+		assert.NoError(t, status.Healthy())
+		assert.NoError(t, nc.Healthy())
+
+		ethClient.AssertExpectations(t)
+	})
+
+	t.Run("reports gaps and fills them with empty transactions if enabled", func(t *testing.T) {
+		db := pgtest.NewSqlxDB(t)
+		cfg := cltest.NewTestGeneralConfig(t)
+		evmcfg := evmtest.NewChainScopedConfig(t, cfg)
+		ethClient := cltest.NewEthClientMockWithDefaultChain(t)
+		ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
+		borm := cltest.NewBulletproofTxManagerORM(t, db, cfg)
+		lggr := logger.TestLogger(t)
+
+		_, from := cltest.MustInsertRandomKey(t, ethKeyStore, int64(5))
+		cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, borm, 1, from)
+		cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, borm, 3, from)
+
+		mockChainNonces(ethClient, from, 1, 2)
+
+		nc := bulletprooftxmanager.NewNonceChecker(db, ethClient, evmcfg, ethKeyStore, gas.NewFixedPriceEstimator(evmcfg, lggr), lggr)
+
+		statuses, err := nc.CheckAll(context.Background(), false, false)
+		require.NoError(t, err)
+		require.Len(t, statuses, 1)
+		assert.Equal(t, []int64{2, 4}, statuses[0].Gaps)
+		require.Error(t, nc.Healthy())
+		assert.Contains(t, nc.Healthy().Error(), "has no transactions for nonces [2 4]")
+		cltest.AssertCount(t, db, "eth_txes", 2)
+
+		statuses, err = nc.CheckAll(context.Background(), true, false)
+		require.NoError(t, err)
+		require.Len(t, statuses, 1)
+		assert.Equal(t, []int64{2, 4}, statuses[0].Gaps)
+		assert.NoError(t, nc.Healthy())
+
+		cltest.AssertCount(t, db, "eth_txes", 4)
+		for _, nonce := range []int64{2, 4} {
+			var etx bulletprooftxmanager.EthTx
+			require.NoError(t, db.Get(&etx, `SELECT * FROM eth_txes WHERE nonce = $1`, nonce))
+			assert.Equal(t, bulletprooftxmanager.EthTxUnconfirmed, etx.State)
+			assert.Equal(t, from, etx.FromAddress)
+			assert.Equal(t, from, etx.ToAddress)
+			assert.Len(t, etx.EncodedPayload, 0)
+			assert.Equal(t, evmcfg.EvmGasLimitTransfer(), etx.GasLimit)
+
+			var attempt bulletprooftxmanager.EthTxAttempt
+			require.NoError(t, db.Get(&attempt, `SELECT * FROM eth_tx_attempts WHERE eth_tx_id = $1`, etx.ID))
+			assert.Equal(t, bulletprooftxmanager.EthTxAttemptInProgress, attempt.State)
+			assert.Equal(t, evmcfg.EvmGasPriceDefault().String(), attempt.GasPrice.String())
+		}
+		// The next nonce is left alone
+		assertDatabaseNonce(t, db, from, 5)
+
+		ethClient.AssertExpectations(t)
+	})
+
+	t.Run("reports keys used by another wallet and fast-forwards the next nonce if enabled", func(t *testing.T) {
+		db := pgtest.NewSqlxDB(t)
+		cfg := cltest.NewTestGeneralConfig(t)
+		evmcfg := evmtest.NewChainScopedConfig(t, cfg)
+		ethClient := cltest.NewEthClientMockWithDefaultChain(t)
+		ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
+		lggr := logger.TestLogger(t)
+
+		_, from := cltest.MustInsertRandomKey(t, ethKeyStore, int64(2))
+
+		mockChainNonces(ethClient, from, 5, 6)
+
+		nc := bulletprooftxmanager.NewNonceChecker(db, ethClient, evmcfg, ethKeyStore, gas.NewFixedPriceEstimator(evmcfg, lggr), lggr)
+
+		statuses, err := nc.CheckAll(context.Background(), false, false)
+		require.NoError(t, err)
+		require.Len(t, statuses, 1)
+		assert.True(t, statuses[0].ChainAhead)
+		assert.Len(t, statuses[0].Gaps, 0)
+		require.Error(t, nc.Healthy())
+		assert.Contains(t, nc.Healthy().Error(), "was used by another wallet")
+		assertDatabaseNonce(t, db, from, 2)
+
+		_, err = nc.CheckAll(context.Background(), false, true)
+		require.NoError(t, err)
+		assert.NoError(t, nc.Healthy())
+		assertDatabaseNonce(t, db, from, 6)
+
+		cltest.AssertCount(t, db, "eth_txes", 0)
+		ethClient.AssertExpectations(t)
+	})
+
+	t.Run("does not fast-forward the next nonce while a transaction is in progress", func(t *testing.T) {
+		db := pgtest.NewSqlxDB(t)
+		cfg := cltest.NewTestGeneralConfig(t)
+		evmcfg := evmtest.NewChainScopedConfig(t, cfg)
+		ethClient := cltest.NewEthClientMockWithDefaultChain(t)
+		ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
+		borm := cltest.NewBulletproofTxManagerORM(t, db, cfg)
+		lggr := logger.TestLogger(t)
+
+		_, from := cltest.MustInsertRandomKey(t, ethKeyStore, int64(2))
+		cltest.MustInsertInProgressEthTxWithAttempt(t, borm, 2, from)
+
+		mockChainNonces(ethClient, from, 5, 6)
+
+		nc := bulletprooftxmanager.NewNonceChecker(db, ethClient, evmcfg, ethKeyStore, gas.NewFixedPriceEstimator(evmcfg, lggr), lggr)
+
+		_, err := nc.CheckAll(context.Background(), false, true)
+		require.NoError(t, err)
+		assert.Error(t, nc.Healthy())
+		assertDatabaseNonce(t, db, from, 2)
+
+		ethClient.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,96 @@
+package bulletprooftxmanager
+
+import (
+	"context"
+	"database/sql"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/pkg/errors"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+)
+
+// privateRelay sends signed transactions to a Flashbots-style private relay
+// with eth_sendPrivateTransaction, which keeps them out of the public mempool
+// until they are mined.
+//
+// The relay URL is read from the config on every send so that it can be
+// changed at runtime.
+type privateRelay struct {
+	config Config
+	lggr   logger.Logger
+
+	mu     sync.Mutex
+	url    string
+	client *rpc.Client
+}
+
+func newPrivateRelay(config Config, lggr logger.Logger) *privateRelay {
+	return &privateRelay{config: config, lggr: lggr.Named("PrivateRelay")}
+}
+
+// jobPrivateRelay returns whether the given job overrides
+// EVM_PRIVATE_RELAY_ENABLED for its transactions
+func jobPrivateRelay(q pg.Queryer, jobID int32) (private null.Bool, err error) {
+	err = q.Get(&private, `SELECT private_relay FROM jobs WHERE id = $1`, jobID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return private, nil
+	}
+	return private, errors.Wrap(err, "failed to load private relay setting of job")
+}
+
+// sendsPrivately returns whether the attempts of the given eth_tx should be
+// sent to the private relay instead of the eth node
+func (r *privateRelay) sendsPrivately(etx EthTx) bool {
+	enabled := r.config.EvmPrivateRelayEnabled()
+	if etx.PrivateRelay.Valid {
+		enabled = etx.PrivateRelay.Bool
+	}
+	if !enabled {
+		return false
+	}
+	if r.config.EvmPrivateRelayURL() == "" {
+		r.lggr.Warnw("Transaction should be sent to the private relay but EVM_PRIVATE_RELAY_URL is not set, will broadcast it publicly instead", "ethTxID", etx.ID)
+		return false
+	}
+	return true
+}
+
+// sendTransaction sends the signed transaction to the private relay
+func (r *privateRelay) sendTransaction(ctx context.Context, signedRawTx []byte) error {
+	client, err := r.getClient()
+	if err != nil {
+		return err
+	}
+	// See: https://docs.flashbots.net/flashbots-protect/rpc/private-transactions
+	params := map[string]interface{}{
+		"tx": hexutil.Encode(signedRawTx),
+	}
+	var hash common.Hash
+	return errors.Wrap(client.CallContext(ctx, &hash, "eth_sendPrivateTransaction", params), "eth_sendPrivateTransaction failed")
+}
+
+func (r *privateRelay) getClient() (*rpc.Client, error) {
+	url := r.config.EvmPrivateRelayURL()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.client != nil && r.url == url {
+		return r.client, nil
+	}
+	if r.client != nil {
+		r.client.Close()
+		r.client = nil
+	}
+	client, err := rpc.DialHTTP(url)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to dial private relay at %s", url)
+	}
+	r.url, r.client = url, client
+	return client, nil
+}
@@ -0,0 +1,173 @@
+package bulletprooftxmanager_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	gethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/assets"
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/configtest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/evmtest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/core/services/bulletprooftxmanager"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// newPrivateRelayServer returns a JSON-RPC server that accepts
+// eth_sendPrivateTransaction and records the raw transactions sent to it
+func newPrivateRelayServer(t *testing.T) (*httptest.Server, chan string) {
+	received := make(chan string, 10)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			ID     json.RawMessage `json:"id"`
+			Method string          `json:"method"`
+			Params []struct {
+				Tx string `json:"tx"`
+			} `json:"params"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, "eth_sendPrivateTransaction", req.Method)
+		require.Len(t, req.Params, 1)
+		received <- req.Params[0].Tx
+
+		w.Header().Set("Content-Type", "application/json")
+		require.NoError(t, json.NewEncoder(w).Encode(map[string]interface{}{
+			"jsonrpc": "2.0",
+			"id":      req.ID,
+			"result":  utils.NewHash().Hex(),
+		}))
+	}))
+	t.Cleanup(server.Close)
+	return server, received
+}
+
+func TestEthBroadcaster_ProcessUnstartedEthTxs_PrivateRelay(t *testing.T) {
+	server, received := newPrivateRelayServer(t)
+
+	db := pgtest.NewSqlxDB(t)
+	cfg := configtest.NewTestGeneralConfig(t)
+	cfg.Overrides.GlobalEvmPrivateRelayEnabled = null.BoolFrom(true)
+	cfg.Overrides.GlobalEvmPrivateRelayURL = null.StringFrom(server.URL)
+	borm := cltest.NewBulletproofTxManagerORM(t, db, cfg)
+	ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
+	keyState, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore, 0)
+
+	ethClient := cltest.NewEthClientMockWithDefaultChain(t)
+	evmcfg := evmtest.NewChainScopedConfig(t, cfg)
+
+	eb := cltest.NewEthBroadcaster(t, db, ethClient, ethKeyStore, evmcfg, []ethkey.State{keyState})
+
+	insertEthTx := func(t *testing.T, value int64, privateRelay null.Bool) bulletprooftxmanager.EthTx {
+		etx := bulletprooftxmanager.EthTx{
+			FromAddress:    fromAddress,
+			ToAddress:      cltest.NewAddress(),
+			EncodedPayload: []byte{1, 2, 3},
+			Value:          assets.NewEthValue(value),
+			GasLimit:       100000,
+			State:          bulletprooftxmanager.EthTxUnstarted,
+			PrivateRelay:   privateRelay,
+		}
+		require.NoError(t, borm.InsertEthTx(&etx))
+		return etx
+	}
+
+	t.Run("sends the transaction to the private relay", func(t *testing.T) {
+		etx := insertEthTx(t, 1, null.Bool{})
+
+		require.NoError(t, eb.ProcessUnstartedEthTxs(context.Background(), keyState))
+
+		etx, err := borm.FindEthTxWithAttempts(etx.ID)
+		require.NoError(t, err)
+		assert.Equal(t, bulletprooftxmanager.EthTxUnconfirmed, etx.State)
+		require.Len(t, etx.EthTxAttempts, 1)
+		assert.Equal(t, bulletprooftxmanager.EthTxAttemptBroadcast, etx.EthTxAttempts[0].State)
+
+		select {
+		case rawTx := <-received:
+			assert.Equal(t, hexutil.Encode(etx.EthTxAttempts[0].SignedRawTx), rawTx)
+		default:
+			t.Fatal("expected the transaction to be sent to the private relay")
+		}
+		ethClient.AssertExpectations(t)
+	})
+
+	t.Run("broadcasts the transaction publicly if the eth_tx overrides the config", func(t *testing.T) {
+		etx := insertEthTx(t, 2, null.BoolFrom(false))
+		ethClient.On("SendTransaction", mock.Anything, mock.MatchedBy(func(tx *gethTypes.Transaction) bool {
+			return tx.Value().Int64() == 2
+		})).Return(nil).Once()
+
+		require.NoError(t, eb.ProcessUnstartedEthTxs(context.Background(), keyState))
+
+		etx, err := borm.FindEthTxWithAttempts(etx.ID)
+		require.NoError(t, err)
+		assert.Equal(t, bulletprooftxmanager.EthTxUnconfirmed, etx.State)
+		assert.Len(t, received, 0)
+		ethClient.AssertExpectations(t)
+	})
+}
+
+func TestEthConfirmer_FallBackToPublicBroadcast(t *testing.T) {
+	server, received := newPrivateRelayServer(t)
+
+	db := pgtest.NewSqlxDB(t)
+	cfg := configtest.NewTestGeneralConfig(t)
+	cfg.Overrides.GlobalEvmPrivateRelayURL = null.StringFrom(server.URL)
+	cfg.Overrides.GlobalEvmPrivateRelayFallbackBlocks = null.IntFrom(25)
+	borm := cltest.NewBulletproofTxManagerORM(t, db, cfg)
+	ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
+	state, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore, 0)
+
+	ethClient := cltest.NewEthClientMockWithDefaultChain(t)
+	evmcfg := evmtest.NewChainScopedConfig(t, cfg)
+
+	ec := cltest.NewEthConfirmer(t, db, ethClient, evmcfg, ethKeyStore, []ethkey.State{state}, nil)
+
+	privateEtx := cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, borm, 0, fromAddress)
+	pgtest.MustExec(t, db, `UPDATE eth_txes SET private_relay = true WHERE id = $1`, privateEtx.ID)
+	publicEtx := cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, borm, 1, fromAddress)
+	require.NoError(t, ec.SetBroadcastBeforeBlockNum(100))
+
+	t.Run("does nothing before the fallback block", func(t *testing.T) {
+		require.NoError(t, ec.FallBackToPublicBroadcast(context.Background(), 124))
+
+		etx, err := borm.FindEthTxWithAttempts(privateEtx.ID)
+		require.NoError(t, err)
+		assert.Equal(t, null.BoolFrom(true), etx.PrivateRelay)
+		ethClient.AssertExpectations(t)
+	})
+
+	t.Run("broadcasts transactions publicly that were not mined by the private relay", func(t *testing.T) {
+		ethClient.On("SendTransaction", mock.Anything, mock.MatchedBy(func(tx *gethTypes.Transaction) bool {
+			return tx.Nonce() == uint64(*privateEtx.Nonce)
+		})).Return(nil).Once()
+
+		require.NoError(t, ec.FallBackToPublicBroadcast(context.Background(), 125))
+
+		etx, err := borm.FindEthTxWithAttempts(privateEtx.ID)
+		require.NoError(t, err)
+		assert.Equal(t, null.BoolFrom(false), etx.PrivateRelay)
+		etx, err = borm.FindEthTxWithAttempts(publicEtx.ID)
+		require.NoError(t, err)
+		assert.False(t, etx.PrivateRelay.Valid)
+		assert.Len(t, received, 0)
+		ethClient.AssertExpectations(t)
+	})
+
+	t.Run("does not broadcast transactions again that already fell back", func(t *testing.T) {
+		require.NoError(t, ec.FallBackToPublicBroadcast(context.Background(), 126))
+
+		ethClient.AssertExpectations(t)
+	})
+}
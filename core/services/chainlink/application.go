@@ -21,6 +21,7 @@ import (
 	"go.uber.org/multierr"
 	"go.uber.org/zap/zapcore"
 
+	"github.com/smartcontractkit/chainlink/core/audit"
 	"github.com/smartcontractkit/chainlink/core/bridges"
 	"github.com/smartcontractkit/chainlink/core/chains/evm"
 	evmtypes "github.com/smartcontractkit/chainlink/core/chains/evm/types"
@@ -28,9 +29,11 @@ import (
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/service"
 	"github.com/smartcontractkit/chainlink/core/services"
+	"github.com/smartcontractkit/chainlink/core/services/blocktrigger"
 	"github.com/smartcontractkit/chainlink/core/services/bulletprooftxmanager"
 	"github.com/smartcontractkit/chainlink/core/services/cron"
 	"github.com/smartcontractkit/chainlink/core/services/directrequest"
+	"github.com/smartcontractkit/chainlink/core/services/eventtrigger"
 	"github.com/smartcontractkit/chainlink/core/services/feeds"
 	"github.com/smartcontractkit/chainlink/core/services/fluxmonitorv2"
 	"github.com/smartcontractkit/chainlink/core/services/health"
@@ -76,12 +79,20 @@ type Application interface {
 	EVMORM() evmtypes.ORM
 	PipelineORM() pipeline.ORM
 	BridgeORM() bridges.ORM
+	BridgeBreakers() bridges.Breakers
+	BridgeCache() bridges.ResponseCache
 	SessionORM() sessions.ORM
+	AuditLogger() audit.AuditLogger
 	BPTXMORM() bulletprooftxmanager.ORM
 	AddJobV2(ctx context.Context, job *job.Job) error
+	UpdateJobV2(ctx context.Context, job *job.Job) error
+	RollbackJob(ctx context.Context, jobID int32, version int32) error
 	DeleteJob(ctx context.Context, jobID int32) error
+	PauseJob(ctx context.Context, jobID int32) error
+	ResumeJob(ctx context.Context, jobID int32, backfill bool) error
 	RunWebhookJobV2(ctx context.Context, jobUUID uuid.UUID, requestBody string, meta pipeline.JSONSerializable) (int64, error)
 	ResumeJobV2(ctx context.Context, taskID uuid.UUID, result pipeline.Result) error
+	CancelJobRun(ctx context.Context, runID int64) error
 	// Testing only
 	RunJobV2(ctx context.Context, jobID int32, meta map[string]interface{}) (int64, error)
 	SetServiceLogLevel(ctx context.Context, service string, level zapcore.Level) error
@@ -108,7 +119,10 @@ type ChainlinkApplication struct {
 	pipelineORM              pipeline.ORM
 	pipelineRunner           pipeline.Runner
 	bridgeORM                bridges.ORM
+	bridgeBreakers           bridges.Breakers
+	bridgeCache              bridges.ResponseCache
 	sessionORM               sessions.ORM
+	auditLogger              audit.AuditLogger
 	bptxmORM                 bulletprooftxmanager.ORM
 	FeedsService             feeds.Service
 	webhookJobRunner         webhook.JobRunner
@@ -210,8 +224,11 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 	var (
 		pipelineORM    = pipeline.NewORM(db, globalLogger, cfg)
 		bridgeORM      = bridges.NewORM(db, globalLogger, cfg)
+		bridgeBreakers = bridges.NewBreakers(globalLogger)
+		bridgeCache    = bridges.NewResponseCache()
 		sessionORM     = sessions.NewORM(db, cfg.SessionTimeout().Duration(), globalLogger)
-		pipelineRunner = pipeline.NewRunner(pipelineORM, cfg, chainSet, keyStore.Eth(), keyStore.VRF(), globalLogger)
+		auditLogger    = audit.NewAuditLogger(db, globalLogger, cfg)
+		pipelineRunner = pipeline.NewRunner(pipelineORM, cfg, chainSet, keyStore.Eth(), keyStore.VRF(), bridgeBreakers, bridgeCache, globalLogger)
 		jobORM         = job.NewORM(db, chainSet, pipelineORM, keyStore, globalLogger, cfg)
 		bptxmORM       = bulletprooftxmanager.NewORM(db, globalLogger, cfg)
 	)
@@ -248,7 +265,18 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 				globalLogger),
 			job.Cron: cron.NewDelegate(
 				pipelineRunner,
+				pipelineORM,
 				globalLogger),
+			job.EventTrigger: eventtrigger.NewDelegate(
+				globalLogger,
+				pipelineRunner,
+				chainSet),
+			job.BlockTrigger: blocktrigger.NewDelegate(
+				globalLogger,
+				pipelineRunner,
+				pipelineORM,
+				chainSet,
+				cfg),
 		}
 		webhookJobRunner = delegates[job.Webhook].(*webhook.Delegate).WebhookJobRunner()
 	)
@@ -335,7 +363,10 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 		pipelineRunner:           pipelineRunner,
 		pipelineORM:              pipelineORM,
 		bridgeORM:                bridgeORM,
+		bridgeBreakers:           bridgeBreakers,
+		bridgeCache:              bridgeCache,
 		sessionORM:               sessionORM,
+		auditLogger:              auditLogger,
 		bptxmORM:                 bptxmORM,
 		FeedsService:             feedsService,
 		Config:                   cfg,
@@ -545,10 +576,27 @@ func (app *ChainlinkApplication) BridgeORM() bridges.ORM {
 	return app.bridgeORM
 }
 
+// BridgeBreakers returns the circuit breakers of the bridges called by the
+// pipeline runner
+func (app *ChainlinkApplication) BridgeBreakers() bridges.Breakers {
+	return app.bridgeBreakers
+}
+
+// BridgeCache returns the responses cached for the bridges called by the
+// pipeline runner
+func (app *ChainlinkApplication) BridgeCache() bridges.ResponseCache {
+	return app.bridgeCache
+}
+
 func (app *ChainlinkApplication) SessionORM() sessions.ORM {
 	return app.sessionORM
 }
 
+// AuditLogger returns the log of privileged operator actions
+func (app *ChainlinkApplication) AuditLogger() audit.AuditLogger {
+	return app.auditLogger
+}
+
 func (app *ChainlinkApplication) EVMORM() evmtypes.ORM {
 	return app.ChainSet.ORM()
 }
@@ -588,6 +636,72 @@ func (app *ChainlinkApplication) DeleteJob(ctx context.Context, jobID int32) err
 	return app.jobSpawner.DeleteJob(jobID, pg.WithParentCtx(ctx))
 }
 
+// UpdateJobV2 stores the pipeline of the job as a new version of the existing
+// job with the same ID, and restarts the job's services with it
+func (app *ChainlinkApplication) UpdateJobV2(ctx context.Context, j *job.Job) error {
+	// Do not allow the job to be updated if it is managed by the Feeds Manager
+	isManaged, err := app.FeedsService.IsJobManaged(ctx, int64(j.ID))
+	if err != nil {
+		return err
+	}
+
+	if isManaged {
+		return errors.New("job must be updated in the feeds manager")
+	}
+
+	return app.jobSpawner.UpdateJob(j, pg.WithParentCtx(ctx))
+}
+
+// RollbackJob makes a previous version of the job current again, and
+// restarts the job's services with it
+func (app *ChainlinkApplication) RollbackJob(ctx context.Context, jobID int32, version int32) error {
+	// Do not allow the job to be rolled back if it is managed by the Feeds Manager
+	isManaged, err := app.FeedsService.IsJobManaged(ctx, int64(jobID))
+	if err != nil {
+		return err
+	}
+
+	if isManaged {
+		return errors.New("job must be updated in the feeds manager")
+	}
+
+	_, err = app.jobSpawner.RollbackJob(jobID, version, pg.WithParentCtx(ctx))
+	return err
+}
+
+// PauseJob stops the services of the job without deleting it
+func (app *ChainlinkApplication) PauseJob(ctx context.Context, jobID int32) error {
+	_, err := app.jobSpawner.PauseJob(jobID, pg.WithParentCtx(ctx))
+	return err
+}
+
+// ResumeJob starts the services of a paused job again. With backfill, the
+// logs of the contracts and topics the job listens to are replayed from the
+// block it was paused at. Listeners skip the logs they have already consumed,
+// so this only delivers logs that were missed.
+func (app *ChainlinkApplication) ResumeJob(ctx context.Context, jobID int32, backfill bool) error {
+	if backfill {
+		jb, exists := app.jobSpawner.ActiveJobs()[jobID]
+		if exists && jb.Paused() && !jb.PausedAtBlock.Valid {
+			return job.ErrJobNotBackfillable
+		}
+	}
+
+	paused, err := app.jobSpawner.ResumeJob(jobID, pg.WithParentCtx(ctx))
+	if err != nil {
+		return err
+	}
+
+	if backfill {
+		chain, err := app.ChainSet.Get(paused.EVMChainID().ToInt())
+		if err != nil {
+			return err
+		}
+		chain.LogBroadcaster().ReplayFromBlockForJob(jobID, paused.PausedAtBlock.Int64)
+	}
+	return nil
+}
+
 func (app *ChainlinkApplication) RunWebhookJobV2(ctx context.Context, jobUUID uuid.UUID, requestBody string, meta pipeline.JSONSerializable) (int64, error) {
 	return app.webhookJobRunner.RunJob(ctx, jobUUID, requestBody, meta)
 }
@@ -664,6 +778,11 @@ func (app *ChainlinkApplication) ResumeJobV2(
 	return app.pipelineRunner.ResumeRun(taskID, result.Value, result.Error)
 }
 
+// CancelJobRun marks the pipeline run as cancelled and stops it if it is still executing.
+func (app *ChainlinkApplication) CancelJobRun(ctx context.Context, runID int64) error {
+	return app.pipelineRunner.CancelRun(runID)
+}
+
 func (app *ChainlinkApplication) GetFeedsService() feeds.Service {
 	return app.FeedsService
 }
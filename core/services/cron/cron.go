@@ -1,8 +1,13 @@
 package cron
 
 import (
+	"context"
 	"fmt"
+	mrand "math/rand"
+	"sync"
+	"time"
 
+	"github.com/pkg/errors"
 	"github.com/robfig/cron/v3"
 
 	"github.com/smartcontractkit/chainlink/core/logger"
@@ -11,24 +16,43 @@ import (
 	"github.com/smartcontractkit/chainlink/core/utils"
 )
 
+// parser parses schedules the same way as the cron runner
+var parser = cron.NewParser(cron.Second | cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow | cron.Descriptor)
+
 // Cron runs a cron jobSpec from a CronSpec
 type Cron struct {
 	cronRunner     *cron.Cron
 	logger         logger.Logger
 	jobSpec        job.Job
 	pipelineRunner pipeline.Runner
+	pipelineORM    pipeline.ORM
 	chStop         chan struct{}
+	wgDone         sync.WaitGroup
+	utils.StartStopOnce
+
+	runMu sync.Mutex
+	// activeRun is the run in progress, for the forbid and replace
+	// concurrency policies
+	activeRun *cronRun
+}
+
+type cronRun struct {
+	cancel context.CancelFunc
+	done   chan struct{}
 }
 
 // NewCronFromJobSpec instantiates a job that executes on a predefined schedule.
 func NewCronFromJobSpec(
 	jobSpec job.Job,
 	pipelineRunner pipeline.Runner,
+	pipelineORM pipeline.ORM,
 	logger logger.Logger,
 ) (*Cron, error) {
 	cronLogger := logger.Named("Cron").With(
 		"jobID", jobSpec.ID,
 		"schedule", jobSpec.CronSpec.CronSchedule,
+		"timezone", jobSpec.CronSpec.Timezone,
+		"concurrencyPolicy", jobSpec.CronSpec.ConcurrencyPolicy,
 	)
 
 	return &Cron{
@@ -36,35 +60,111 @@ func NewCronFromJobSpec(
 		logger:         cronLogger,
 		jobSpec:        jobSpec,
 		pipelineRunner: pipelineRunner,
+		pipelineORM:    pipelineORM,
 		chStop:         make(chan struct{}),
 	}, nil
 }
 
 // Start implements the job.Service interface.
 func (cr *Cron) Start() error {
-	cr.logger.Debug("Starting")
+	return cr.StartOnce("Cron", func() error {
+		cr.logger.Debug("Starting")
 
-	_, err := cr.cronRunner.AddFunc(cr.jobSpec.CronSpec.CronSchedule, cr.runPipeline)
-	if err != nil {
-		cr.logger.Errorw(fmt.Sprintf("Error running cron job %d", cr.jobSpec.ID), "error", err, "schedule", cr.jobSpec.CronSpec.CronSchedule, "jobID", cr.jobSpec.ID)
-		return err
-	}
-	cr.cronRunner.Start()
-	return nil
+		spec := cr.jobSpec.CronSpec
+		schedule := fullSchedule(*spec)
+		_, err := cr.cronRunner.AddFunc(schedule, cr.runPipeline)
+		if err != nil {
+			cr.logger.Errorw(fmt.Sprintf("Error running cron job %d", cr.jobSpec.ID), "error", err, "schedule", schedule, "jobID", cr.jobSpec.ID)
+			return err
+		}
+
+		if window := spec.CatchUpWindow.Duration(); window > 0 {
+			// Look for a missed tick before the runner starts, so that the
+			// last run is not one that was just scheduled
+			missed, err := cr.missedTick(schedule, time.Now(), window)
+			if err != nil {
+				cr.logger.Errorw("Error checking for missed cron run", "error", err)
+			} else if !missed.IsZero() {
+				cr.logger.Infow("Catching up on missed cron run", "missedAt", missed)
+				cr.wgDone.Add(1)
+				go func() {
+					defer cr.wgDone.Done()
+					cr.runPipeline()
+				}()
+			}
+		}
+
+		cr.cronRunner.Start()
+		return nil
+	})
 }
 
 // Close implements the job.Service interface. It stops this job from
 // running and cleans up resources.
 func (cr *Cron) Close() error {
-	cr.logger.Debug("Closing")
-	cr.cronRunner.Stop()
-	return nil
+	return cr.StopOnce("Cron", func() error {
+		cr.logger.Debug("Closing")
+		close(cr.chStop)
+		<-cr.cronRunner.Stop().Done()
+		cr.wgDone.Wait()
+		return nil
+	})
+}
+
+// missedTick returns the first time the schedule fired within window before
+// now and after the job's last run, or the zero time if it did not. A job
+// that has never run has not missed anything.
+func (cr *Cron) missedTick(schedule string, now time.Time, window time.Duration) (time.Time, error) {
+	sched, err := parser.Parse(schedule)
+	if err != nil {
+		return time.Time{}, errors.Wrapf(err, "invalid cron schedule '%v'", schedule)
+	}
+
+	ctx, cancel := utils.ContextFromChan(cr.chStop)
+	defer cancel()
+	lastRun, err := cr.pipelineORM.GetLastRunCreatedAt(ctx, cr.jobSpec.ID)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if !lastRun.Valid {
+		return time.Time{}, nil
+	}
+
+	from := lastRun.Time
+	if windowStart := now.Add(-window); from.Before(windowStart) {
+		from = windowStart
+	}
+	if next := sched.Next(from); next.Before(now) {
+		return next, nil
+	}
+	return time.Time{}, nil
 }
 
 func (cr *Cron) runPipeline() {
 	ctx, cancel := utils.ContextFromChan(cr.chStop)
 	defer cancel()
 
+	run, ok := cr.startRun(cancel)
+	if !ok {
+		return
+	}
+	if run != nil {
+		defer cr.finishRun(run)
+	}
+
+	if jitter := cr.jobSpec.CronSpec.Jitter.Duration(); jitter > 0 {
+		// #nosec
+		delay := time.Duration(mrand.Int63n(int64(jitter)))
+		select {
+		case <-time.After(delay):
+		case <-ctx.Done():
+			return
+		}
+	}
+	if ctx.Err() != nil {
+		return
+	}
+
 	vars := pipeline.NewVarsFrom(map[string]interface{}{
 		"jobSpec": map[string]interface{}{
 			"databaseID":    cr.jobSpec.ID,
@@ -76,14 +176,60 @@ func (cr *Cron) runPipeline() {
 		},
 	})
 
-	run := pipeline.NewRun(*cr.jobSpec.PipelineSpec, vars)
+	pipelineRun := pipeline.NewRun(*cr.jobSpec.PipelineSpec, vars)
 
-	_, err := cr.pipelineRunner.Run(ctx, &run, cr.logger, false, nil)
+	_, err := cr.pipelineRunner.Run(ctx, &pipelineRun, cr.logger, false, nil)
 	if err != nil {
 		cr.logger.Errorf("Error executing new run for jobSpec ID %v", cr.jobSpec.ID)
 	}
 }
 
+// startRun applies the concurrency policy of the job to a new run. It returns
+// false if the run must be skipped, and the run to finish once it is done if
+// it is tracked.
+func (cr *Cron) startRun(cancel context.CancelFunc) (*cronRun, bool) {
+	policy := cr.jobSpec.CronSpec.ConcurrencyPolicy
+	if policy != job.CronConcurrencyForbid && policy != job.CronConcurrencyReplace {
+		return nil, true
+	}
+
+	run := &cronRun{cancel: cancel, done: make(chan struct{})}
+
+	cr.runMu.Lock()
+	prev := cr.activeRun
+	if prev != nil && policy == job.CronConcurrencyForbid {
+		cr.runMu.Unlock()
+		cr.logger.Warn("Skipping cron run, previous run is still in progress")
+		return nil, false
+	}
+	cr.activeRun = run
+	cr.runMu.Unlock()
+
+	if prev != nil {
+		cr.logger.Warn("Cancelling previous cron run that is still in progress")
+		prev.cancel()
+		<-prev.done
+	}
+	return run, true
+}
+
+func (cr *Cron) finishRun(run *cronRun) {
+	cr.runMu.Lock()
+	if cr.activeRun == run {
+		cr.activeRun = nil
+	}
+	cr.runMu.Unlock()
+	close(run.done)
+}
+
+// fullSchedule returns the schedule of the spec in its time zone
+func fullSchedule(spec job.CronSpec) string {
+	if spec.Timezone == "" {
+		return spec.CronSchedule
+	}
+	return fmt.Sprintf("CRON_TZ=%s %s", spec.Timezone, spec.CronSchedule)
+}
+
 func cronRunner() *cron.Cron {
-	return cron.New(cron.WithSeconds())
+	return cron.New(cron.WithParser(parser))
 }
@@ -1,6 +1,9 @@
 package cron_test
 
 import (
+	"context"
+	"fmt"
+	"sync"
 	"testing"
 	"time"
 
@@ -13,11 +16,13 @@ import (
 	"github.com/smartcontractkit/chainlink/core/services/job"
 	"github.com/smartcontractkit/chainlink/core/services/pipeline"
 	pipelinemocks "github.com/smartcontractkit/chainlink/core/services/pipeline/mocks"
+	"github.com/smartcontractkit/chainlink/core/store/models"
 
 	uuid "github.com/satori/go.uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
 )
 
 func TestCronV2Pipeline(t *testing.T) {
@@ -38,7 +43,7 @@ func TestCronV2Pipeline(t *testing.T) {
 		PipelineSpec:  &pipeline.Spec{},
 		ExternalJobID: uuid.NewV4(),
 	}
-	delegate := cron.NewDelegate(runner, lggr)
+	delegate := cron.NewDelegate(runner, orm, lggr)
 
 	err := jobORM.CreateJob(jb)
 	require.NoError(t, err)
@@ -66,7 +71,7 @@ func TestCronV2Schedule(t *testing.T) {
 	runner.On("Run", mock.Anything, mock.AnythingOfType("*pipeline.Run"), mock.Anything, mock.Anything, mock.Anything).
 		Return(false, nil).Once()
 
-	service, err := cron.NewCronFromJobSpec(spec, runner, logger.TestLogger(t))
+	service, err := cron.NewCronFromJobSpec(spec, runner, new(pipelinemocks.ORM), logger.TestLogger(t))
 	require.NoError(t, err)
 	err = service.Start()
 	require.NoError(t, err)
@@ -74,3 +79,173 @@ func TestCronV2Schedule(t *testing.T) {
 
 	cltest.EventuallyExpectationsMet(t, runner, 10*time.Second, 1*time.Second)
 }
+
+func TestCronV2Schedule_CatchesUpMissedRun(t *testing.T) {
+	t.Parallel()
+
+	spec := job.Job{
+		ID:            1,
+		Type:          job.Cron,
+		SchemaVersion: 1,
+		CronSpec: &job.CronSpec{
+			CronSchedule:  "@every 1h",
+			CatchUpWindow: models.Interval(3 * time.Hour),
+		},
+		PipelineSpec: &pipeline.Spec{},
+	}
+	runner := new(pipelinemocks.Runner)
+	orm := new(pipelinemocks.ORM)
+
+	// The tick an hour after the last run was missed
+	orm.On("GetLastRunCreatedAt", mock.Anything, int32(1)).Return(null.TimeFrom(time.Now().Add(-2*time.Hour)), nil).Once()
+	runner.On("Run", mock.Anything, mock.AnythingOfType("*pipeline.Run"), mock.Anything, mock.Anything, mock.Anything).
+		Return(false, nil).Once()
+
+	service, err := cron.NewCronFromJobSpec(spec, runner, orm, logger.TestLogger(t))
+	require.NoError(t, err)
+	err = service.Start()
+	require.NoError(t, err)
+	defer service.Close()
+
+	cltest.EventuallyExpectationsMet(t, runner, 10*time.Second, 100*time.Millisecond)
+	orm.AssertExpectations(t)
+}
+
+func TestCronV2Schedule_CatchesUpMissedRunAfterUpdate(t *testing.T) {
+	cfg := configtest.NewTestGeneralConfig(t)
+	db := pgtest.NewSqlxDB(t)
+
+	keyStore := cltest.NewKeyStore(t, db, cfg)
+	cc := evmtest.NewChainSet(t, evmtest.TestChainOpts{DB: db, GeneralConfig: cfg, Client: cltest.NewEthClientMockWithDefaultChain(t)})
+	lggr := logger.TestLogger(t)
+	orm := pipeline.NewORM(db, lggr, cfg)
+	jobORM := job.NewORM(db, cc, orm, keyStore, lggr, cfg)
+
+	const spec = `
+type              = "cron"
+schemaVersion     = 1
+schedule          = "@every 1h"
+catchUpWindow     = "3h"
+observationSource = """
+ds [type=memo value="%s"];
+"""
+`
+	jb, err := cron.ValidatedCronSpec(fmt.Sprintf(spec, "original"))
+	require.NoError(t, err)
+	require.NoError(t, jobORM.CreateJob(&jb))
+
+	// The tick an hour after the last run of the original version was missed
+	_, err = db.Exec(`INSERT INTO pipeline_runs (state, pipeline_spec_id, created_at) VALUES ($1, $2, $3)`,
+		pipeline.RunStatusCompleted, jb.PipelineSpecID, time.Now().Add(-2*time.Hour))
+	require.NoError(t, err)
+
+	updated, err := cron.ValidatedCronSpec(fmt.Sprintf(spec, "updated"))
+	require.NoError(t, err)
+	updated.ID = jb.ID
+	require.NoError(t, jobORM.UpdateJob(&updated))
+	require.NotEqual(t, jb.PipelineSpecID, updated.PipelineSpecID)
+
+	runner := new(pipelinemocks.Runner)
+	runner.On("Run", mock.Anything, mock.AnythingOfType("*pipeline.Run"), mock.Anything, mock.Anything, mock.Anything).
+		Return(false, nil).Once()
+
+	services, err := cron.NewDelegate(runner, orm, lggr).ServicesForSpec(updated)
+	require.NoError(t, err)
+	require.Len(t, services, 1)
+	require.NoError(t, services[0].Start())
+	defer services[0].Close()
+
+	cltest.EventuallyExpectationsMet(t, runner, 10*time.Second, 100*time.Millisecond)
+}
+
+func TestCronV2Schedule_ConcurrencyPolicy(t *testing.T) {
+	t.Parallel()
+
+	// The schedule does not fire during the test, ticks are driven with
+	// cron.Tick instead
+	newService := func(t *testing.T, policy job.CronConcurrencyPolicy, runner *pipelinemocks.Runner) *cron.Cron {
+		spec := job.Job{
+			Type:          job.Cron,
+			SchemaVersion: 1,
+			CronSpec: &job.CronSpec{
+				CronSchedule:      "@yearly",
+				ConcurrencyPolicy: policy,
+			},
+			PipelineSpec: &pipeline.Spec{},
+		}
+		service, err := cron.NewCronFromJobSpec(spec, runner, new(pipelinemocks.ORM), logger.TestLogger(t))
+		require.NoError(t, err)
+		require.NoError(t, service.Start())
+		return service
+	}
+
+	t.Run("forbid skips ticks while a run is in progress", func(t *testing.T) {
+		runner := new(pipelinemocks.Runner)
+		started := make(chan struct{}, 10)
+		release := make(chan struct{})
+		runner.On("Run", mock.Anything, mock.AnythingOfType("*pipeline.Run"), mock.Anything, mock.Anything, mock.Anything).
+			Return(false, nil).
+			Run(func(args mock.Arguments) {
+				started <- struct{}{}
+				<-release
+			})
+
+		service := newService(t, job.CronConcurrencyForbid, runner)
+		defer service.Close()
+
+		firstDone := make(chan struct{})
+		go func() {
+			defer close(firstDone)
+			cron.Tick(service)
+		}()
+		<-started
+
+		// The tick returns without running while the first run is in progress
+		cron.Tick(service)
+		runner.AssertNumberOfCalls(t, "Run", 1)
+
+		close(release)
+		<-firstDone
+
+		// Once it is done, the next tick runs again
+		cron.Tick(service)
+		runner.AssertNumberOfCalls(t, "Run", 2)
+	})
+
+	t.Run("replace cancels the run in progress", func(t *testing.T) {
+		runner := new(pipelinemocks.Runner)
+		started := make(chan struct{}, 10)
+		cancelled := make(chan struct{}, 10)
+		runner.On("Run", mock.Anything, mock.AnythingOfType("*pipeline.Run"), mock.Anything, mock.Anything, mock.Anything).
+			Return(false, nil).
+			Run(func(args mock.Arguments) {
+				started <- struct{}{}
+				<-args.Get(0).(context.Context).Done()
+				cancelled <- struct{}{}
+			})
+
+		service := newService(t, job.CronConcurrencyReplace, runner)
+
+		var wg sync.WaitGroup
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			cron.Tick(service)
+		}()
+		<-started
+		go func() {
+			defer wg.Done()
+			cron.Tick(service)
+		}()
+
+		// The first run is cancelled before the second one starts
+		<-cancelled
+		<-started
+		runner.AssertNumberOfCalls(t, "Run", 2)
+
+		// Closing the service cancels the second run
+		require.NoError(t, service.Close())
+		wg.Wait()
+		assert.Len(t, cancelled, 1)
+	})
+}
@@ -10,14 +10,16 @@ import (
 
 type Delegate struct {
 	pipelineRunner pipeline.Runner
+	pipelineORM    pipeline.ORM
 	lggr           logger.Logger
 }
 
 var _ job.Delegate = (*Delegate)(nil)
 
-func NewDelegate(pipelineRunner pipeline.Runner, lggr logger.Logger) *Delegate {
+func NewDelegate(pipelineRunner pipeline.Runner, pipelineORM pipeline.ORM, lggr logger.Logger) *Delegate {
 	return &Delegate{
 		pipelineRunner: pipelineRunner,
+		pipelineORM:    pipelineORM,
 		lggr:           lggr,
 	}
 }
@@ -39,7 +41,7 @@ func (d *Delegate) ServicesForSpec(spec job.Job) (services []job.Service, err er
 		return nil, errors.Errorf("services.Delegate expects a *jobSpec.CronSpec to be present, got %v", spec)
 	}
 
-	cron, err := NewCronFromJobSpec(spec, d.pipelineRunner, d.lggr)
+	cron, err := NewCronFromJobSpec(spec, d.pipelineRunner, d.pipelineORM, d.lggr)
 	if err != nil {
 		return nil, err
 	}
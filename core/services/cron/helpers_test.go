@@ -0,0 +1,6 @@
+package cron
+
+// Tick runs the pipeline as if the schedule of the job had fired
+func Tick(cr *Cron) {
+	cr.runPipeline()
+}
@@ -1,6 +1,9 @@
 package cron
 
 import (
+	"strings"
+	"time"
+
 	"github.com/pelletier/go-toml"
 	"github.com/pkg/errors"
 	uuid "github.com/satori/go.uuid"
@@ -34,9 +37,31 @@ func ValidatedCronSpec(tomlString string) (job.Job, error) {
 	if jb.Type != job.Cron {
 		return jb, errors.Errorf("unsupported type %s", jb.Type)
 	}
-	if err := utils.ValidateCronSchedule(spec.CronSchedule); err != nil {
+	if spec.Timezone != "" {
+		if strings.HasPrefix(spec.CronSchedule, "CRON_TZ=") {
+			return jb, errors.New("timezone cannot be set if the schedule specifies CRON_TZ")
+		}
+		if _, err := time.LoadLocation(spec.Timezone); err != nil {
+			return jb, errors.Wrapf(err, "invalid timezone '%v'", spec.Timezone)
+		}
+	}
+	if err := utils.ValidateCronSchedule(fullSchedule(spec)); err != nil {
 		return jb, errors.Wrapf(err, "while validating cron schedule '%v'", spec.CronSchedule)
 	}
 
+	switch spec.ConcurrencyPolicy {
+	case "":
+		spec.ConcurrencyPolicy = job.CronConcurrencyAllow
+	case job.CronConcurrencyAllow, job.CronConcurrencyForbid, job.CronConcurrencyReplace:
+	default:
+		return jb, errors.Errorf("invalid concurrencyPolicy '%v', must be one of allow, forbid or replace", spec.ConcurrencyPolicy)
+	}
+	if spec.CatchUpWindow.Duration() < 0 {
+		return jb, errors.New("catchUpWindow cannot be negative")
+	}
+	if spec.Jitter.Duration() < 0 {
+		return jb, errors.New("jitter cannot be negative")
+	}
+
 	return jb, nil
 }
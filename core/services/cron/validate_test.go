@@ -3,6 +3,7 @@ package cron_test
 import (
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/manyminds/api2go/jsonapi"
 	"github.com/stretchr/testify/assert"
@@ -77,6 +78,90 @@ ds -> ds_parse -> ds_multiply;
 				assert.True(t, strings.Contains(err.Error(), "invalid cron schedule"))
 			},
 		},
+		{
+			name: "scheduling options",
+			toml: `
+type              = "cron"
+schemaVersion     = 1
+schedule          = "0 0 9 * * *"
+timezone          = "Europe/London"
+concurrencyPolicy = "forbid"
+catchUpWindow     = "1h"
+jitter            = "30s"
+observationSource   = """
+ds          [type=http method=GET url="https://chain.link/ETH-USD"];
+"""
+`,
+			assertion: func(t *testing.T, s job.Job, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, s.CronSpec)
+				assert.Equal(t, "Europe/London", s.CronSpec.Timezone)
+				assert.Equal(t, job.CronConcurrencyForbid, s.CronSpec.ConcurrencyPolicy)
+				assert.Equal(t, time.Hour, s.CronSpec.CatchUpWindow.Duration())
+				assert.Equal(t, 30*time.Second, s.CronSpec.Jitter.Duration())
+			},
+		},
+		{
+			name: "allows concurrent runs by default",
+			toml: `
+type            = "cron"
+schemaVersion   = 1
+schedule        = "@every 1m"
+observationSource   = """
+ds          [type=http method=GET url="https://chain.link/ETH-USD"];
+"""
+`,
+			assertion: func(t *testing.T, s job.Job, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, job.CronConcurrencyAllow, s.CronSpec.ConcurrencyPolicy)
+			},
+		},
+		{
+			name: "timezone and CRON_TZ",
+			toml: `
+type            = "cron"
+schemaVersion   = 1
+schedule        = "CRON_TZ=UTC 0 0 1 1 * *"
+timezone        = "Europe/London"
+observationSource   = """
+ds          [type=http method=GET url="https://chain.link/ETH-USD"];
+"""
+`,
+			assertion: func(t *testing.T, s job.Job, err error) {
+				require.EqualError(t, err, "timezone cannot be set if the schedule specifies CRON_TZ")
+			},
+		},
+		{
+			name: "invalid timezone",
+			toml: `
+type            = "cron"
+schemaVersion   = 1
+schedule        = "0 0 1 1 * *"
+timezone        = "Mars/Olympus_Mons"
+observationSource   = """
+ds          [type=http method=GET url="https://chain.link/ETH-USD"];
+"""
+`,
+			assertion: func(t *testing.T, s job.Job, err error) {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "invalid timezone 'Mars/Olympus_Mons'")
+			},
+		},
+		{
+			name: "invalid concurrency policy",
+			toml: `
+type              = "cron"
+schemaVersion     = 1
+schedule          = "@every 1m"
+concurrencyPolicy = "queue"
+observationSource   = """
+ds          [type=http method=GET url="https://chain.link/ETH-USD"];
+"""
+`,
+			assertion: func(t *testing.T, s job.Job, err error) {
+				require.EqualError(t, err, "invalid concurrencyPolicy 'queue', must be one of allow, forbid or replace")
+			},
+		},
 	}
 	for _, tc := range tt {
 		t.Run(tc.name, func(t *testing.T) {
@@ -26,6 +26,8 @@ type Client interface {
 	Dial(ctx context.Context) error
 	Close()
 	ChainID() *big.Int
+	// NodeStatuses returns the live status of every primary node
+	NodeStatuses() []NodeStatus
 
 	GetERC20Balance(address common.Address, contractAddress common.Address) (*big.Int, error)
 	GetLINKBalance(linkAddress common.Address, address common.Address) (*assets.Link, error)
@@ -92,8 +94,8 @@ var _ Client = (*client)(nil)
 
 // NewClientWithNodes instantiates a client from a list of nodes
 // Currently only supports one primary
-func NewClientWithNodes(logger logger.Logger, primaryNodes []Node, sendOnlyNodes []SendOnlyNode, chainID *big.Int) (*client, error) {
-	pool := NewPool(logger, primaryNodes, sendOnlyNodes, chainID)
+func NewClientWithNodes(logger logger.Logger, cfg PoolConfig, primaryNodes []Node, sendOnlyNodes []SendOnlyNode, chainID *big.Int) (*client, error) {
+	pool := NewPool(logger, cfg, primaryNodes, sendOnlyNodes, chainID)
 	return &client{
 		logger: logger,
 		pool:   pool,
@@ -171,6 +173,10 @@ func (client *client) ChainID() *big.Int {
 	return client.pool.chainID
 }
 
+func (client *client) NodeStatuses() []NodeStatus {
+	return client.pool.NodeStatuses()
+}
+
 func (client *client) HeaderByNumber(ctx context.Context, n *big.Int) (*types.Header, error) {
 	return client.pool.HeaderByNumber(ctx, n)
 }
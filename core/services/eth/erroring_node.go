@@ -31,6 +31,16 @@ func (e *erroringNode) Verify(ctx context.Context, expectedChainID *big.Int) (er
 	return errors.New(e.errMsg)
 }
 
+func (e *erroringNode) Name() string {
+	return ""
+}
+
+func (e *erroringNode) Stats() NodeStats {
+	return NodeStats{LatestBlockNumber: -1}
+}
+
+func (e *erroringNode) SetOutOfSync(outOfSync bool) {}
+
 func (e *erroringNode) CallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error {
 	return errors.New(e.errMsg)
 }
@@ -1,6 +1,7 @@
 package eth
 
 import (
+	"context"
 	"fmt"
 	"math/big"
 	"net/url"
@@ -14,6 +15,19 @@ func init() {
 	dialRetryInterval = 100 * time.Millisecond
 }
 
+type TestPoolConfig struct {
+	SelectionMode      string
+	PollInterval       time.Duration
+	OutOfSyncThreshold uint32
+}
+
+func (c TestPoolConfig) NodeSelectionMode() string       { return c.SelectionMode }
+func (c TestPoolConfig) NodePollInterval() time.Duration { return c.PollInterval }
+func (c TestPoolConfig) NodeOutOfSyncThreshold() uint32  { return c.OutOfSyncThreshold }
+
+// DefaultTestPoolConfig selects nodes round robin and never polls for heads
+var DefaultTestPoolConfig = TestPoolConfig{SelectionMode: NodeSelectionModeRoundRobin}
+
 func NewClient(lggr logger.Logger, rpcUrl string, rpcHTTPURL *url.URL, sendonlyRPCURLs []url.URL, chainID *big.Int) (*client, error) {
 	parsed, err := url.ParseRequestURI(rpcUrl)
 	if err != nil {
@@ -24,7 +38,7 @@ func NewClient(lggr logger.Logger, rpcUrl string, rpcHTTPURL *url.URL, sendonlyR
 		return nil, errors.Errorf("ethereum url scheme must be websocket: %s", parsed.String())
 	}
 
-	primaries := []Node{NewNode(lggr, *parsed, rpcHTTPURL, "eth-primary-0")}
+	primaries := []Node{NewNode(lggr, *parsed, rpcHTTPURL, "eth-primary-0", chainID)}
 
 	var sendonlys []SendOnlyNode
 	for i, url := range sendonlyRPCURLs {
@@ -35,10 +49,18 @@ func NewClient(lggr logger.Logger, rpcUrl string, rpcHTTPURL *url.URL, sendonlyR
 		sendonlys = append(sendonlys, s)
 	}
 
-	pool := NewPool(lggr, primaries, sendonlys, chainID)
+	pool := NewPool(lggr, DefaultTestPoolConfig, primaries, sendonlys, chainID)
 	return &client{logger: lggr, pool: pool}, nil
 }
 
+func (p *Pool) CheckSync(ctx context.Context) {
+	p.checkSync(ctx)
+}
+
+func (p *Pool) SelectNode() Node {
+	return p.selectNode()
+}
+
 func Wrap(err error, s string) error {
 	return wrap(err, s)
 }
@@ -361,6 +361,22 @@ func (_m *Client) HeaderByNumber(_a0 context.Context, _a1 *big.Int) (*types.Head
 	return r0, r1
 }
 
+// NodeStatuses provides a mock function with given fields:
+func (_m *Client) NodeStatuses() []eth.NodeStatus {
+	ret := _m.Called()
+
+	var r0 []eth.NodeStatus
+	if rf, ok := ret.Get(0).(func() []eth.NodeStatus); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]eth.NodeStatus)
+		}
+	}
+
+	return r0
+}
+
 // NonceAt provides a mock function with given fields: ctx, account, blockNumber
 func (_m *Client) NonceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (uint64, error) {
 	ret := _m.Called(ctx, account, blockNumber)
@@ -282,6 +282,20 @@ func (_m *Node) HeaderByNumber(_a0 context.Context, _a1 *big.Int) (*types.Header
 	return r0, r1
 }
 
+// Name provides a mock function with given fields:
+func (_m *Node) Name() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
 // NonceAt provides a mock function with given fields: ctx, account, blockNumber
 func (_m *Node) NonceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (uint64, error) {
 	ret := _m.Called(ctx, account, blockNumber)
@@ -361,6 +375,11 @@ func (_m *Node) SendTransaction(ctx context.Context, tx *types.Transaction) erro
 	return r0
 }
 
+// SetOutOfSync provides a mock function with given fields: outOfSync
+func (_m *Node) SetOutOfSync(outOfSync bool) {
+	_m.Called(outOfSync)
+}
+
 // State provides a mock function with given fields:
 func (_m *Node) State() eth.NodeState {
 	ret := _m.Called()
@@ -375,6 +394,20 @@ func (_m *Node) State() eth.NodeState {
 	return r0
 }
 
+// Stats provides a mock function with given fields:
+func (_m *Node) Stats() eth.NodeStats {
+	ret := _m.Called()
+
+	var r0 eth.NodeStats
+	if rf, ok := ret.Get(0).(func() eth.NodeStats); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(eth.NodeStats)
+	}
+
+	return r0
+}
+
 // String provides a mock function with given fields:
 func (_m *Node) String() string {
 	ret := _m.Called()
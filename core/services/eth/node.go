@@ -6,6 +6,7 @@ import (
 	"math/big"
 	"net/url"
 	"sync"
+	"time"
 
 	ethereum "github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
@@ -13,10 +14,38 @@ import (
 	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
 
 	"github.com/smartcontractkit/chainlink/core/logger"
 )
 
+var (
+	promNodeCalls = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "evm_pool_rpc_node_calls_total",
+		Help: "The total number of RPC calls made to the given primary node",
+	}, []string{"evmChainID", "nodeName", "method"})
+	promNodeErrors = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "evm_pool_rpc_node_errors_total",
+		Help: "The total number of RPC calls to the given primary node that failed due to a problem with the node, e.g. a timeout or connection error. Well-formed error responses such as reverts are not counted.",
+	}, []string{"evmChainID", "nodeName", "method"})
+	promNodeCallLatency = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name: "evm_pool_rpc_node_call_duration_seconds",
+		Help: "The duration of RPC calls made to the given primary node",
+		Buckets: []float64{
+			0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30,
+		},
+	}, []string{"evmChainID", "nodeName", "method"})
+	promNodeTransitions = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "evm_pool_rpc_node_state_transitions_total",
+		Help: "The total number of times the given primary node has transitioned into the given state",
+	}, []string{"evmChainID", "nodeName", "state"})
+	promNodeHighestSeenBlock = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "evm_pool_rpc_node_highest_seen_block",
+		Help: "The highest block number reported by the given primary node",
+	}, []string{"evmChainID", "nodeName"})
+)
+
 //go:generate mockery --name Node --output ./mocks/ --case=underscore
 type Node interface {
 	Dial(ctx context.Context) error
@@ -24,6 +53,12 @@ type Node interface {
 	Verify(ctx context.Context, expectedChainID *big.Int) (err error)
 
 	State() NodeState
+	Name() string
+	// Stats returns a snapshot of the node's recent health
+	Stats() NodeStats
+	// SetOutOfSync moves an alive node into NodeStateOutOfSync, or an out of
+	// sync node back to NodeStateAlive. It has no effect in any other state.
+	SetOutOfSync(outOfSync bool)
 
 	CallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error
 	BatchCallContext(ctx context.Context, b []rpc.BatchElem) error
@@ -63,28 +98,80 @@ const (
 	NodeStateAlive
 	NodeStateDead
 	NodeStateClosed
+	// NodeStateOutOfSync is an alive node that is lagging too far behind the
+	// highest head seen across the pool to be trusted for reads
+	NodeStateOutOfSync
 )
 
+func (s NodeState) String() string {
+	switch s {
+	case NodeStateUndialed:
+		return "Undialed"
+	case NodeStateDialed:
+		return "Dialed"
+	case NodeStateInvalidChainID:
+		return "InvalidChainID"
+	case NodeStateAlive:
+		return "Alive"
+	case NodeStateDead:
+		return "Dead"
+	case NodeStateClosed:
+		return "Closed"
+	case NodeStateOutOfSync:
+		return "OutOfSync"
+	default:
+		return fmt.Sprintf("NodeState(%d)", int(s))
+	}
+}
+
+// NodeStats is a point-in-time view of a node's health, used by the pool to
+// choose between nodes
+type NodeStats struct {
+	// LatestBlockNumber is the highest block number this node has reported,
+	// or -1 if it has not reported any
+	LatestBlockNumber int64
+	// Latency is a moving average of the time taken by RPC calls
+	Latency time.Duration
+	// ErrorRate is a moving average of the fraction of RPC calls that failed
+	// due to a problem with the node, between 0 and 1
+	ErrorRate float64
+	// Calls is the total number of RPC calls made to this node
+	Calls uint64
+	// Errors is the total number of RPC calls that failed due to a problem
+	// with the node
+	Errors uint64
+}
+
+// statsWeight is the weight given to each new sample when updating the moving
+// averages in NodeStats
+const statsWeight = 0.1
+
 // Node represents one ethereum node.
 // It must have a ws url and may have a http url
 type node struct {
-	ws   rawclient
-	http *rawclient
-	log  logger.Logger
-	name string
+	ws      rawclient
+	http    *rawclient
+	log     logger.Logger
+	name    string
+	chainID *big.Int
 
 	state  NodeState
 	mu     sync.RWMutex
 	closed bool
+
+	statsMu sync.RWMutex
+	stats   NodeStats
 }
 
-func NewNode(lggr logger.Logger, wsuri url.URL, httpuri *url.URL, name string) Node {
+func NewNode(lggr logger.Logger, wsuri url.URL, httpuri *url.URL, name string, chainID *big.Int) Node {
 	n := new(node)
 	n.name = name
+	n.chainID = chainID
 	n.log = lggr.Named("Node").Named(name).With(
 		"nodeTier", "primary",
 	)
 	n.ws.uri = wsuri
+	n.stats.LatestBlockNumber = -1
 	if httpuri != nil {
 		n.http = &rawclient{uri: *httpuri}
 	}
@@ -97,7 +184,7 @@ func NewNode(lggr logger.Logger, wsuri url.URL, httpuri *url.URL, name string) N
 func (n *node) Dial(ctx context.Context) error {
 	n.mu.Lock()
 	defer n.mu.Unlock()
-	if n.state == NodeStateAlive || n.state == NodeStateDialed {
+	if n.state == NodeStateAlive || n.state == NodeStateDialed || n.state == NodeStateOutOfSync {
 		return nil
 	} else if n.state == NodeStateClosed {
 		return errors.New("cannot dial closed node")
@@ -114,7 +201,7 @@ func (n *node) Dial(ctx context.Context) error {
 	uri := n.ws.uri.String()
 	wsrpc, err := rpc.DialWebsocket(ctx, uri, "")
 	if err != nil {
-		n.state = NodeStateDead
+		n.setState(NodeStateDead)
 		return errors.Wrapf(err, "error while dialing websocket: %v", uri)
 	}
 
@@ -123,12 +210,12 @@ func (n *node) Dial(ctx context.Context) error {
 		uri := n.http.uri.String()
 		httprpc, err = rpc.DialHTTP(uri)
 		if err != nil {
-			n.state = NodeStateDead
+			n.setState(NodeStateDead)
 			return errors.Wrapf(err, "error while dialing HTTP: %v", uri)
 		}
 	}
 
-	n.state = NodeStateDialed
+	n.setState(NodeStateDialed)
 	n.ws.rpc = wsrpc
 	n.ws.geth = ethclient.NewClient(wsrpc)
 
@@ -143,7 +230,7 @@ func (n *node) Dial(ctx context.Context) error {
 func (n *node) Close() {
 	n.mu.Lock()
 	defer n.mu.Unlock()
-	n.state = NodeStateClosed
+	n.setState(NodeStateClosed)
 	if n.ws.rpc != nil {
 		n.ws.rpc.Close()
 	}
@@ -162,10 +249,10 @@ func (n *node) Verify(ctx context.Context, expectedChainID *big.Int) (err error)
 
 	var chainID *big.Int
 	if chainID, err = n.ws.geth.ChainID(ctx); err != nil {
-		n.state = NodeStateInvalidChainID
+		n.setState(NodeStateInvalidChainID)
 		return errors.Wrapf(err, "failed to verify chain ID for node %s", n.name)
 	} else if chainID.Cmp(expectedChainID) != 0 {
-		n.state = NodeStateInvalidChainID
+		n.setState(NodeStateInvalidChainID)
 		return errors.Errorf(
 			"websocket rpc ChainID doesn't match local chain ID: RPC ID=%s, local ID=%s, node name=%s",
 			chainID.String(),
@@ -175,10 +262,10 @@ func (n *node) Verify(ctx context.Context, expectedChainID *big.Int) (err error)
 	}
 	if n.http != nil {
 		if chainID, err = n.http.geth.ChainID(ctx); err != nil {
-			n.state = NodeStateInvalidChainID
+			n.setState(NodeStateInvalidChainID)
 			return errors.Wrapf(err, "failed to verify chain ID for node %s", n.name)
 		} else if chainID.Cmp(expectedChainID) != 0 {
-			n.state = NodeStateInvalidChainID
+			n.setState(NodeStateInvalidChainID)
 			return errors.Errorf(
 				"http rpc ChainID doesn't match local chain ID: RPC ID=%s, local ID=%s, node name=%s",
 				chainID.String(),
@@ -187,22 +274,102 @@ func (n *node) Verify(ctx context.Context, expectedChainID *big.Int) (err error)
 			)
 		}
 	}
-	n.state = NodeStateAlive
+	n.setState(NodeStateAlive)
 	return nil
 }
 
+// setState must be called with the lock held
+func (n *node) setState(state NodeState) {
+	if n.state == state {
+		return
+	}
+	n.state = state
+	promNodeTransitions.WithLabelValues(n.chainID.String(), n.name, state.String()).Inc()
+}
+
 func (n *node) State() NodeState {
 	n.mu.RLock()
 	defer n.mu.RUnlock()
 	return n.state
 }
 
+func (n *node) SetOutOfSync(outOfSync bool) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if outOfSync && n.state == NodeStateAlive {
+		n.log.Warnw("Node is out of sync", "latestBlockNumber", n.Stats().LatestBlockNumber)
+		n.setState(NodeStateOutOfSync)
+	} else if !outOfSync && n.state == NodeStateOutOfSync {
+		n.log.Infow("Node is back in sync", "latestBlockNumber", n.Stats().LatestBlockNumber)
+		n.setState(NodeStateAlive)
+	}
+}
+
+func (n *node) Stats() NodeStats {
+	n.statsMu.RLock()
+	defer n.statsMu.RUnlock()
+	return n.stats
+}
+
+// observe records the latency and outcome of an RPC call that was started at
+// the given time
+func (n *node) observe(method string, start time.Time, err *error) {
+	elapsed := time.Since(start)
+	chainID := n.chainID.String()
+	promNodeCalls.WithLabelValues(chainID, n.name, method).Inc()
+	promNodeCallLatency.WithLabelValues(chainID, n.name, method).Observe(elapsed.Seconds())
+	var failed float64
+	if isNodeError(*err) {
+		failed = 1
+		promNodeErrors.WithLabelValues(chainID, n.name, method).Inc()
+	}
+	n.statsMu.Lock()
+	defer n.statsMu.Unlock()
+	n.stats.Calls++
+	if failed > 0 {
+		n.stats.Errors++
+	}
+	if n.stats.Latency == 0 {
+		n.stats.Latency = elapsed
+	} else {
+		n.stats.Latency = time.Duration(statsWeight*float64(elapsed) + (1-statsWeight)*float64(n.stats.Latency))
+	}
+	n.stats.ErrorRate = statsWeight*failed + (1-statsWeight)*n.stats.ErrorRate
+}
+
+func (n *node) observeHead(header *types.Header) {
+	if header == nil || header.Number == nil {
+		return
+	}
+	n.statsMu.Lock()
+	defer n.statsMu.Unlock()
+	if num := header.Number.Int64(); num > n.stats.LatestBlockNumber {
+		n.stats.LatestBlockNumber = num
+		promNodeHighestSeenBlock.WithLabelValues(n.chainID.String(), n.name).Set(float64(num))
+	}
+}
+
+// isNodeError returns true if the error indicates that the node itself is
+// misbehaving, as opposed to a well-formed error response such as a revert,
+// a nonce error or a missing receipt
+func isNodeError(err error) bool {
+	if err == nil {
+		return false
+	}
+	if errors.Is(err, context.Canceled) || errors.Is(err, ethereum.NotFound) {
+		return false
+	}
+	var jsonErr rpc.Error
+	return !errors.As(err, &jsonErr)
+}
+
 // RPC wrappers
 
 // TODO: Handle state below
 // e.g. need a way to mark a node as "dead" if it fails more than 3 calls in a row
 // see: https://app.shortcut.com/chainlinklabs/story/8403/multiple-primary-geth-nodes-with-failover-load-balancer-part-2
-func (n *node) CallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error {
+func (n *node) CallContext(ctx context.Context, result interface{}, method string, args ...interface{}) (err error) {
+	defer n.observe(method, time.Now(), &err)
 	n.log.Debugw("eth.Client#Call(...)",
 		"method", method,
 		"args", args,
@@ -214,7 +381,8 @@ func (n *node) CallContext(ctx context.Context, result interface{}, method strin
 	return n.wrapWS(n.ws.rpc.CallContext(ctx, result, method, args...))
 }
 
-func (n *node) BatchCallContext(ctx context.Context, b []rpc.BatchElem) error {
+func (n *node) BatchCallContext(ctx context.Context, b []rpc.BatchElem) (err error) {
+	defer n.observe("BatchCallContext", time.Now(), &err)
 	n.log.Debugw("eth.Client#BatchCall(...)",
 		"nBatchElems", len(b),
 		"mode", switching(n),
@@ -225,7 +393,8 @@ func (n *node) BatchCallContext(ctx context.Context, b []rpc.BatchElem) error {
 	return n.wrapWS(n.ws.rpc.BatchCallContext(ctx, b))
 }
 
-func (n *node) EthSubscribe(ctx context.Context, channel interface{}, args ...interface{}) (ethereum.Subscription, error) {
+func (n *node) EthSubscribe(ctx context.Context, channel interface{}, args ...interface{}) (sub ethereum.Subscription, err error) {
+	defer n.observe("EthSubscribe", time.Now(), &err)
 	n.log.Debugw("eth.Client#EthSubscribe", "mode", "websocket")
 	return n.ws.rpc.EthSubscribe(ctx, channel, args...)
 }
@@ -233,6 +402,7 @@ func (n *node) EthSubscribe(ctx context.Context, channel interface{}, args ...in
 // GethClient wrappers
 
 func (n *node) TransactionReceipt(ctx context.Context, txHash common.Hash) (receipt *types.Receipt, err error) {
+	defer n.observe("TransactionReceipt", time.Now(), &err)
 	n.log.Debugw("eth.Client#TransactionReceipt(...)",
 		"txHash", txHash,
 		"mode", switching(n),
@@ -250,6 +420,7 @@ func (n *node) TransactionReceipt(ctx context.Context, txHash common.Hash) (rece
 }
 
 func (n *node) HeaderByNumber(ctx context.Context, number *big.Int) (header *types.Header, err error) {
+	defer n.observe("HeaderByNumber", time.Now(), &err)
 	n.log.Debugw("eth.Client#HeaderByNumber(...)",
 		"number", n,
 		"mode", switching(n),
@@ -261,10 +432,14 @@ func (n *node) HeaderByNumber(ctx context.Context, number *big.Int) (header *typ
 		header, err = n.ws.geth.HeaderByNumber(ctx, number)
 		err = n.wrapWS(err)
 	}
+	if err == nil {
+		n.observeHead(header)
+	}
 	return
 }
 
-func (n *node) SendTransaction(ctx context.Context, tx *types.Transaction) error {
+func (n *node) SendTransaction(ctx context.Context, tx *types.Transaction) (err error) {
+	defer n.observe("SendTransaction", time.Now(), &err)
 	n.log.Debugw("eth.Client#SendTransaction(...)",
 		"tx", tx,
 		"mode", switching(n),
@@ -276,6 +451,7 @@ func (n *node) SendTransaction(ctx context.Context, tx *types.Transaction) error
 }
 
 func (n *node) PendingNonceAt(ctx context.Context, account common.Address) (nonce uint64, err error) {
+	defer n.observe("PendingNonceAt", time.Now(), &err)
 	n.log.Debugw("eth.Client#PendingNonceAt(...)",
 		"account", account,
 		"mode", switching(n),
@@ -291,6 +467,7 @@ func (n *node) PendingNonceAt(ctx context.Context, account common.Address) (nonc
 }
 
 func (n *node) NonceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (nonce uint64, err error) {
+	defer n.observe("NonceAt", time.Now(), &err)
 	n.log.Debugw("eth.Client#NonceAt(...)",
 		"account", account,
 		"blockNumber", blockNumber,
@@ -307,6 +484,7 @@ func (n *node) NonceAt(ctx context.Context, account common.Address, blockNumber
 }
 
 func (n *node) PendingCodeAt(ctx context.Context, account common.Address) (code []byte, err error) {
+	defer n.observe("PendingCodeAt", time.Now(), &err)
 	n.log.Debugw("eth.Client#PendingCodeAt(...)",
 		"account", account,
 		"mode", switching(n),
@@ -322,6 +500,7 @@ func (n *node) PendingCodeAt(ctx context.Context, account common.Address) (code
 }
 
 func (n *node) CodeAt(ctx context.Context, account common.Address, blockNumber *big.Int) (code []byte, err error) {
+	defer n.observe("CodeAt", time.Now(), &err)
 	n.log.Debugw("eth.Client#CodeAt(...)",
 		"account", account,
 		"blockNumber", blockNumber,
@@ -338,6 +517,7 @@ func (n *node) CodeAt(ctx context.Context, account common.Address, blockNumber *
 }
 
 func (n *node) EstimateGas(ctx context.Context, call ethereum.CallMsg) (gas uint64, err error) {
+	defer n.observe("EstimateGas", time.Now(), &err)
 	n.log.Debugw("eth.Client#EstimateGas(...)",
 		"call", call,
 		"mode", switching(n),
@@ -353,6 +533,7 @@ func (n *node) EstimateGas(ctx context.Context, call ethereum.CallMsg) (gas uint
 }
 
 func (n *node) SuggestGasPrice(ctx context.Context) (price *big.Int, err error) {
+	defer n.observe("SuggestGasPrice", time.Now(), &err)
 	n.log.Debugw("eth.Client#SuggestGasPrice()", "mode", "websocket")
 	price, err = n.ws.geth.SuggestGasPrice(ctx)
 	err = n.wrapWS(err)
@@ -360,6 +541,7 @@ func (n *node) SuggestGasPrice(ctx context.Context) (price *big.Int, err error)
 }
 
 func (n *node) CallContract(ctx context.Context, msg ethereum.CallMsg, blockNumber *big.Int) (val []byte, err error) {
+	defer n.observe("CallContract", time.Now(), &err)
 	n.log.Debugw("eth.Client#CallContract()",
 		"mode", switching(n),
 	)
@@ -375,6 +557,7 @@ func (n *node) CallContract(ctx context.Context, msg ethereum.CallMsg, blockNumb
 }
 
 func (n *node) BlockByNumber(ctx context.Context, number *big.Int) (b *types.Block, err error) {
+	defer n.observe("BlockByNumber", time.Now(), &err)
 	n.log.Debugw("eth.Client#BlockByNumber(...)",
 		"number", number,
 		"mode", switching(n),
@@ -390,6 +573,7 @@ func (n *node) BlockByNumber(ctx context.Context, number *big.Int) (b *types.Blo
 }
 
 func (n *node) BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (balance *big.Int, err error) {
+	defer n.observe("BalanceAt", time.Now(), &err)
 	n.log.Debugw("eth.Client#BalanceAt(...)",
 		"account", account,
 		"blockNumber", blockNumber,
@@ -406,6 +590,7 @@ func (n *node) BalanceAt(ctx context.Context, account common.Address, blockNumbe
 }
 
 func (n *node) FilterLogs(ctx context.Context, q ethereum.FilterQuery) (l []types.Log, err error) {
+	defer n.observe("FilterLogs", time.Now(), &err)
 	n.log.Debugw("eth.Client#FilterLogs(...)",
 		"q", q,
 		"mode", switching(n),
@@ -421,6 +606,7 @@ func (n *node) FilterLogs(ctx context.Context, q ethereum.FilterQuery) (l []type
 }
 
 func (n *node) SubscribeFilterLogs(ctx context.Context, q ethereum.FilterQuery, ch chan<- types.Log) (sub ethereum.Subscription, err error) {
+	defer n.observe("SubscribeFilterLogs", time.Now(), &err)
 	n.log.Debugw("eth.Client#SubscribeFilterLogs(...)", "q", q, "mode", "websocket")
 	sub, err = n.ws.geth.SubscribeFilterLogs(ctx, q, ch)
 	err = n.wrapWS(err)
@@ -428,6 +614,7 @@ func (n *node) SubscribeFilterLogs(ctx context.Context, q ethereum.FilterQuery,
 }
 
 func (n *node) SuggestGasTipCap(ctx context.Context) (tipCap *big.Int, err error) {
+	defer n.observe("SuggestGasTipCap", time.Now(), &err)
 	n.log.Debugw("eth.Client#SuggestGasTipCap(...)",
 		"mode", switching(n),
 	)
@@ -442,6 +629,7 @@ func (n *node) SuggestGasTipCap(ctx context.Context) (tipCap *big.Int, err error
 }
 
 func (n *node) ChainID(ctx context.Context) (chainID *big.Int, err error) {
+	defer n.observe("ChainID", time.Now(), &err)
 	n.log.Debugw("eth.Client#ChainID(...)")
 	if n.http != nil {
 		chainID, err = n.http.geth.ChainID(ctx)
@@ -478,6 +666,10 @@ func switching(n *node) string {
 	return "websocket"
 }
 
+func (n *node) Name() string {
+	return n.name
+}
+
 func (n *node) String() string {
 	s := fmt.Sprintf("(primary)%s:%s", n.name, n.ws.uri.String())
 	if n.http != nil {
@@ -0,0 +1,103 @@
+package eth
+
+import (
+	"fmt"
+
+	"go.uber.org/atomic"
+)
+
+const (
+	NodeSelectionModeRoundRobin    = "RoundRobin"
+	NodeSelectionModeHighestHead   = "HighestHead"
+	NodeSelectionModeLowestLatency = "LowestLatency"
+	NodeSelectionModePriorityLevel = "PriorityLevel"
+)
+
+// NodeSelector chooses which of the given candidate nodes should serve the
+// next call. Candidates are always non-empty and in configured priority order.
+type NodeSelector interface {
+	Select(nodes []Node) Node
+	Name() string
+}
+
+func newNodeSelector(selectionMode string) NodeSelector {
+	switch selectionMode {
+	case NodeSelectionModeRoundRobin:
+		return &roundRobinSelector{}
+	case NodeSelectionModeHighestHead:
+		return highestHeadSelector{}
+	case NodeSelectionModeLowestLatency:
+		return lowestLatencySelector{}
+	case NodeSelectionModePriorityLevel:
+		return priorityLevelSelector{}
+	default:
+		panic(fmt.Sprintf("unsupported NodeSelectionMode: %s", selectionMode))
+	}
+}
+
+type roundRobinSelector struct {
+	roundRobinCount atomic.Uint32
+}
+
+func (s *roundRobinSelector) Select(nodes []Node) Node {
+	// NOTE: Inc returns the number after addition, so we must -1 to get the "current" counter
+	count := s.roundRobinCount.Inc() - 1
+	idx := int(count % uint32(len(nodes)))
+
+	return nodes[idx]
+}
+
+func (s *roundRobinSelector) Name() string { return NodeSelectionModeRoundRobin }
+
+// highestHeadSelector picks the node that has reported the highest block,
+// preferring the healthier node on a tie
+type highestHeadSelector struct{}
+
+func (highestHeadSelector) Select(nodes []Node) Node {
+	best, bestStats := nodes[0], nodes[0].Stats()
+	for _, n := range nodes[1:] {
+		stats := n.Stats()
+		if stats.LatestBlockNumber > bestStats.LatestBlockNumber ||
+			(stats.LatestBlockNumber == bestStats.LatestBlockNumber && healthier(stats, bestStats)) {
+			best, bestStats = n, stats
+		}
+	}
+	return best
+}
+
+func (highestHeadSelector) Name() string { return NodeSelectionModeHighestHead }
+
+// lowestLatencySelector picks the node with the lowest average call latency,
+// preferring the node with the lower error rate on a tie
+type lowestLatencySelector struct{}
+
+func (lowestLatencySelector) Select(nodes []Node) Node {
+	best, bestStats := nodes[0], nodes[0].Stats()
+	for _, n := range nodes[1:] {
+		stats := n.Stats()
+		if stats.Latency < bestStats.Latency ||
+			(stats.Latency == bestStats.Latency && stats.ErrorRate < bestStats.ErrorRate) {
+			best, bestStats = n, stats
+		}
+	}
+	return best
+}
+
+func (lowestLatencySelector) Name() string { return NodeSelectionModeLowestLatency }
+
+// priorityLevelSelector always picks the first candidate, so calls go to the
+// earliest configured node that is usable and only fail over when it is not
+type priorityLevelSelector struct{}
+
+func (priorityLevelSelector) Select(nodes []Node) Node {
+	return nodes[0]
+}
+
+func (priorityLevelSelector) Name() string { return NodeSelectionModePriorityLevel }
+
+func healthier(a, b NodeStats) bool {
+	if a.ErrorRate != b.ErrorRate {
+		return a.ErrorRate < b.ErrorRate
+	}
+	return a.Latency < b.Latency
+}
@@ -33,12 +33,12 @@ func Test_NodeWrapError(t *testing.T) {
 }
 
 func Test_NodeStateTransitions(t *testing.T) {
-	nInvalid := eth.NewNode(logger.TestLogger(t), *cltest.MustParseURL(t, "ws://example.invalid"), nil, "test node")
+	nInvalid := eth.NewNode(logger.TestLogger(t), *cltest.MustParseURL(t, "ws://example.invalid"), nil, "test node", &cltest.FixtureChainID)
 	wsURL := cltest.NewWSServer(t, &cltest.FixtureChainID, func(method string, params gjson.Result) (string, string) {
 		return "", ""
 	})
 
-	nValid := eth.NewNode(logger.TestLogger(t), *cltest.MustParseURL(t, wsURL), nil, "test node")
+	nValid := eth.NewNode(logger.TestLogger(t), *cltest.MustParseURL(t, wsURL), nil, "test node", &cltest.FixtureChainID)
 
 	assert.Equal(t, eth.NodeStateUndialed, nInvalid.State())
 	assert.Equal(t, eth.NodeStateUndialed, nValid.State())
@@ -39,6 +39,11 @@ func (nc *NullClient) Close() {
 	nc.lggr.Debug("Close")
 }
 
+func (nc *NullClient) NodeStatuses() []NodeStatus {
+	nc.lggr.Debug("NodeStatuses")
+	return nil
+}
+
 func (nc *NullClient) GetERC20Balance(address common.Address, contractAddress common.Address) (*big.Int, error) {
 	nc.lggr.Debug("GetERC20Balance")
 	return big.NewInt(0), nil
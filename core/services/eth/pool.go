@@ -11,27 +11,38 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/rpc"
-	"go.uber.org/atomic"
 
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/utils"
 )
 
+// PoolConfig controls how the pool checks node health and selects nodes
+type PoolConfig interface {
+	NodeSelectionMode() string
+	NodePollInterval() time.Duration
+	NodeOutOfSyncThreshold() uint32
+}
+
+// maxNodeErrorRate is the error rate above which a live node is only used if
+// no healthier node is available
+const maxNodeErrorRate = 0.5
+
 // Pool represents an abstraction over one or more primary nodes
 // It is responsible for liveness checking and balancing queries across live nodes
 type Pool struct {
 	utils.StartStopOnce
-	nodes           []Node
-	sendonlys       []SendOnlyNode
-	chainID         *big.Int
-	roundRobinCount atomic.Uint32
-	logger          logger.Logger
+	nodes     []Node
+	sendonlys []SendOnlyNode
+	chainID   *big.Int
+	logger    logger.Logger
+	config    PoolConfig
+	selector  NodeSelector
 
 	chStop chan struct{}
 	wg     sync.WaitGroup
 }
 
-func NewPool(logger logger.Logger, nodes []Node, sendonlys []SendOnlyNode, chainID *big.Int) *Pool {
+func NewPool(logger logger.Logger, cfg PoolConfig, nodes []Node, sendonlys []SendOnlyNode, chainID *big.Int) *Pool {
 	if len(nodes) == 0 {
 		panic("must provide at least one node")
 	}
@@ -43,8 +54,9 @@ func NewPool(logger logger.Logger, nodes []Node, sendonlys []SendOnlyNode, chain
 		nodes,
 		sendonlys,
 		chainID,
-		atomic.Uint32{},
 		logger.Named("Pool").With("evmChainID", chainID.String()),
+		cfg,
+		newNodeSelector(cfg.NodeSelectionMode()),
 		make(chan struct{}),
 		sync.WaitGroup{},
 	}
@@ -81,11 +93,27 @@ var dialRetryInterval = 5 * time.Second
 func (p *Pool) runLoop() {
 	defer p.wg.Done()
 	ticker := time.NewTicker(dialRetryInterval)
+	defer ticker.Stop()
+
+	var pollCh <-chan time.Time
+	if pollInterval := p.config.NodePollInterval(); pollInterval > 0 {
+		pollTicker := time.NewTicker(pollInterval)
+		defer pollTicker.Stop()
+		pollCh = pollTicker.C
+	}
 
 	for {
 		select {
 		case <-p.chStop:
 			return
+		case <-pollCh:
+			func() {
+				ctx, cancel := utils.ContextFromChan(p.chStop)
+				defer cancel()
+				ctx, cancel = context.WithTimeout(ctx, p.config.NodePollInterval())
+				defer cancel()
+				p.checkSync(ctx)
+			}()
 		case <-ticker.C:
 			// re-dial all dead nodes
 			func() {
@@ -115,6 +143,40 @@ func (p *Pool) redialDeadNodes(ctx context.Context) {
 	}
 }
 
+// checkSync fetches the latest head from every live node and marks those
+// lagging too far behind the highest head as out of sync
+func (p *Pool) checkSync(ctx context.Context) {
+	var nodes []Node
+	for _, n := range p.nodes {
+		if s := n.State(); s == NodeStateAlive || s == NodeStateOutOfSync {
+			nodes = append(nodes, n)
+		}
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(len(nodes))
+	for _, n := range nodes {
+		go func(n Node) {
+			defer wg.Done()
+			if _, err := n.HeaderByNumber(ctx, nil); err != nil {
+				p.logger.Warnw("Failed to fetch latest head from eth node", "err", err, "node", n.String())
+			}
+		}(n)
+	}
+	wg.Wait()
+
+	threshold := int64(p.config.NodeOutOfSyncThreshold())
+	var highest int64 = -1
+	for _, n := range nodes {
+		if num := n.Stats().LatestBlockNumber; num > highest {
+			highest = num
+		}
+	}
+	for _, n := range nodes {
+		n.SetOutOfSync(threshold > 0 && highest-n.Stats().LatestBlockNumber > threshold)
+	}
+}
+
 func (p *Pool) Close() {
 	//nolint:errcheck
 	p.StopOnce("Pool", func() error {
@@ -131,35 +193,63 @@ func (p *Pool) ChainID() *big.Int {
 	return p.chainID
 }
 
-func (p *Pool) getRoundRobin() Node {
-	nodes := p.liveNodes()
-	nNodes := len(nodes)
-	if nNodes == 0 {
-		return &erroringNode{errMsg: fmt.Sprintf("no live nodes available for chain %s", p.chainID.String())}
+// NodeStatus is a snapshot of a primary node's live state
+type NodeStatus struct {
+	Name  string
+	State NodeState
+	NodeStats
+}
+
+// NodeStatuses returns the live status of every primary node in the pool
+func (p *Pool) NodeStatuses() []NodeStatus {
+	statuses := make([]NodeStatus, len(p.nodes))
+	for i, n := range p.nodes {
+		statuses[i] = NodeStatus{Name: n.Name(), State: n.State(), NodeStats: n.Stats()}
+	}
+	return statuses
+}
+
+// selectNode returns the node that should serve the next call. Out of sync
+// nodes are only used when no node is alive, and nodes with a high error rate
+// only when no healthier node is available.
+func (p *Pool) selectNode() Node {
+	nodes := p.nodesInState(NodeStateAlive)
+	if len(nodes) == 0 {
+		nodes = p.nodesInState(NodeStateOutOfSync)
+		if len(nodes) == 0 {
+			return &erroringNode{errMsg: fmt.Sprintf("no live nodes available for chain %s", p.chainID.String())}
+		}
+		p.logger.Warnw("No in-sync nodes available, falling back to out of sync nodes", "selectionMode", p.selector.Name())
 	}
 
-	// NOTE: Inc returns the number after addition, so we must -1 to get the "current" counter
-	count := p.roundRobinCount.Inc() - 1
-	idx := int(count % uint32(nNodes))
+	var healthy []Node
+	for _, n := range nodes {
+		if n.Stats().ErrorRate < maxNodeErrorRate {
+			healthy = append(healthy, n)
+		}
+	}
+	if len(healthy) > 0 {
+		nodes = healthy
+	}
 
-	return nodes[idx]
+	return p.selector.Select(nodes)
 }
 
-func (p *Pool) liveNodes() (liveNodes []Node) {
+func (p *Pool) nodesInState(state NodeState) (nodes []Node) {
 	for _, n := range p.nodes {
-		if n.State() == NodeStateAlive {
-			liveNodes = append(liveNodes, n)
+		if n.State() == state {
+			nodes = append(nodes, n)
 		}
 	}
 	return
 }
 
 func (p *Pool) CallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error {
-	return p.getRoundRobin().CallContext(ctx, result, method, args...)
+	return p.selectNode().CallContext(ctx, result, method, args...)
 }
 
 func (p *Pool) BatchCallContext(ctx context.Context, b []rpc.BatchElem) error {
-	return p.getRoundRobin().BatchCallContext(ctx, b)
+	return p.selectNode().BatchCallContext(ctx, b)
 }
 
 // Wrapped Geth client methods
@@ -167,7 +257,7 @@ func (p *Pool) SendTransaction(ctx context.Context, tx *types.Transaction) error
 	var wg sync.WaitGroup
 	defer wg.Wait()
 
-	main := p.getRoundRobin()
+	main := p.selectNode()
 	var all []SendOnlyNode
 	for _, n := range p.nodes {
 		all = append(all, n)
@@ -196,62 +286,62 @@ func (p *Pool) SendTransaction(ctx context.Context, tx *types.Transaction) error
 }
 
 func (p *Pool) PendingCodeAt(ctx context.Context, account common.Address) ([]byte, error) {
-	return p.getRoundRobin().PendingCodeAt(ctx, account)
+	return p.selectNode().PendingCodeAt(ctx, account)
 }
 
 func (p *Pool) PendingNonceAt(ctx context.Context, account common.Address) (uint64, error) {
-	return p.getRoundRobin().PendingNonceAt(ctx, account)
+	return p.selectNode().PendingNonceAt(ctx, account)
 }
 
 func (p *Pool) NonceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (uint64, error) {
-	return p.getRoundRobin().NonceAt(ctx, account, blockNumber)
+	return p.selectNode().NonceAt(ctx, account, blockNumber)
 }
 
 func (p *Pool) TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
-	return p.getRoundRobin().TransactionReceipt(ctx, txHash)
+	return p.selectNode().TransactionReceipt(ctx, txHash)
 }
 
 func (p *Pool) BlockByNumber(ctx context.Context, number *big.Int) (*types.Block, error) {
-	return p.getRoundRobin().BlockByNumber(ctx, number)
+	return p.selectNode().BlockByNumber(ctx, number)
 }
 
 func (p *Pool) BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error) {
-	return p.getRoundRobin().BalanceAt(ctx, account, blockNumber)
+	return p.selectNode().BalanceAt(ctx, account, blockNumber)
 }
 
 func (p *Pool) FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error) {
-	return p.getRoundRobin().FilterLogs(ctx, q)
+	return p.selectNode().FilterLogs(ctx, q)
 }
 
 func (p *Pool) SubscribeFilterLogs(ctx context.Context, q ethereum.FilterQuery, ch chan<- types.Log) (ethereum.Subscription, error) {
-	return p.getRoundRobin().SubscribeFilterLogs(ctx, q, ch)
+	return p.selectNode().SubscribeFilterLogs(ctx, q, ch)
 }
 
 func (p *Pool) EstimateGas(ctx context.Context, call ethereum.CallMsg) (uint64, error) {
-	return p.getRoundRobin().EstimateGas(ctx, call)
+	return p.selectNode().EstimateGas(ctx, call)
 }
 
 func (p *Pool) SuggestGasPrice(ctx context.Context) (*big.Int, error) {
-	return p.getRoundRobin().SuggestGasPrice(ctx)
+	return p.selectNode().SuggestGasPrice(ctx)
 }
 
 func (p *Pool) CallContract(ctx context.Context, msg ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
-	return p.getRoundRobin().CallContract(ctx, msg, blockNumber)
+	return p.selectNode().CallContract(ctx, msg, blockNumber)
 }
 
 func (p *Pool) CodeAt(ctx context.Context, account common.Address, blockNumber *big.Int) ([]byte, error) {
-	return p.getRoundRobin().CodeAt(ctx, account, blockNumber)
+	return p.selectNode().CodeAt(ctx, account, blockNumber)
 }
 
 // bind.ContractBackend methods
 func (p *Pool) HeaderByNumber(ctx context.Context, n *big.Int) (*types.Header, error) {
-	return p.getRoundRobin().HeaderByNumber(ctx, n)
+	return p.selectNode().HeaderByNumber(ctx, n)
 }
 
 func (p *Pool) SuggestGasTipCap(ctx context.Context) (*big.Int, error) {
-	return p.getRoundRobin().SuggestGasTipCap(ctx)
+	return p.selectNode().SuggestGasTipCap(ctx)
 }
 
 func (p *Pool) EthSubscribe(ctx context.Context, channel interface{}, args ...interface{}) (ethereum.Subscription, error) {
-	return p.getRoundRobin().EthSubscribe(ctx, channel, args...)
+	return p.selectNode().EthSubscribe(ctx, channel, args...)
 }
@@ -63,7 +63,7 @@ func TestPool_Dial(t *testing.T) {
 			for i, n := range test.sendNodes {
 				sendNodes[i] = n.newSendOnlyNode(t)
 			}
-			p := eth.NewPool(logger.TestLogger(t), nodes, sendNodes, test.presetID)
+			p := eth.NewPool(logger.TestLogger(t), eth.DefaultTestPoolConfig, nodes, sendNodes, test.presetID)
 			err := p.Dial(ctx)
 			require.NoError(t, err)
 		})
@@ -149,7 +149,7 @@ func (r *chainIDResps) newNode(t *testing.T) eth.Node {
 		httpURL = r.http.newHTTPServer(t)
 	}
 
-	return eth.NewNode(logger.TestLogger(t), *wsURL, httpURL, t.Name())
+	return eth.NewNode(logger.TestLogger(t), *wsURL, httpURL, t.Name(), big.NewInt(r.ws.chainID))
 }
 
 type chainIDService struct {
@@ -164,7 +164,7 @@ func (x *chainIDService) ChainId(ctx context.Context) (*hexutil.Big, error) {
 }
 
 func newPool(t *testing.T, nodes []eth.Node) *eth.Pool {
-	return eth.NewPool(logger.TestLogger(t), nodes, []eth.SendOnlyNode{}, &cltest.FixtureChainID)
+	return eth.NewPool(logger.TestLogger(t), eth.DefaultTestPoolConfig, nodes, []eth.SendOnlyNode{}, &cltest.FixtureChainID)
 }
 
 func TestPool_RunLoop(t *testing.T) {
@@ -220,3 +220,98 @@ func TestPool_RunLoop(t *testing.T) {
 	})
 
 }
+
+func newMockNode(t *testing.T, name string, state eth.NodeState, stats eth.NodeStats) *ethmocks.Node {
+	n := new(ethmocks.Node)
+	n.Test(t)
+	n.On("String").Maybe().Return(name)
+	n.On("State").Maybe().Return(state)
+	n.On("Stats").Maybe().Return(stats)
+	return n
+}
+
+func TestPool_SelectNode(t *testing.T) {
+	t.Run("skips out of sync nodes", func(t *testing.T) {
+		n1 := newMockNode(t, "n1", eth.NodeStateOutOfSync, eth.NodeStats{LatestBlockNumber: 10})
+		n2 := newMockNode(t, "n2", eth.NodeStateAlive, eth.NodeStats{LatestBlockNumber: 20})
+		p := eth.NewPool(logger.TestLogger(t), eth.DefaultTestPoolConfig, []eth.Node{n1, n2}, nil, &cltest.FixtureChainID)
+
+		for i := 0; i < 3; i++ {
+			require.Equal(t, n2, p.SelectNode())
+		}
+	})
+
+	t.Run("falls back to out of sync nodes if none are alive", func(t *testing.T) {
+		n1 := newMockNode(t, "n1", eth.NodeStateDead, eth.NodeStats{LatestBlockNumber: -1})
+		n2 := newMockNode(t, "n2", eth.NodeStateOutOfSync, eth.NodeStats{LatestBlockNumber: 10})
+		p := eth.NewPool(logger.TestLogger(t), eth.DefaultTestPoolConfig, []eth.Node{n1, n2}, nil, &cltest.FixtureChainID)
+
+		require.Equal(t, n2, p.SelectNode())
+	})
+
+	t.Run("prefers nodes with a low error rate", func(t *testing.T) {
+		n1 := newMockNode(t, "n1", eth.NodeStateAlive, eth.NodeStats{ErrorRate: 0.9})
+		n2 := newMockNode(t, "n2", eth.NodeStateAlive, eth.NodeStats{ErrorRate: 0.1})
+		p := eth.NewPool(logger.TestLogger(t), eth.DefaultTestPoolConfig, []eth.Node{n1, n2}, nil, &cltest.FixtureChainID)
+
+		for i := 0; i < 3; i++ {
+			require.Equal(t, n2, p.SelectNode())
+		}
+	})
+
+	t.Run("returns an erroring node if no nodes are usable", func(t *testing.T) {
+		n1 := newMockNode(t, "n1", eth.NodeStateDead, eth.NodeStats{})
+		p := eth.NewPool(logger.TestLogger(t), eth.DefaultTestPoolConfig, []eth.Node{n1}, nil, &cltest.FixtureChainID)
+
+		_, err := p.SelectNode().ChainID(context.Background())
+		require.EqualError(t, err, "no live nodes available for chain 0")
+	})
+
+	tests := []struct {
+		mode     string
+		expected int
+	}{
+		{eth.NodeSelectionModeHighestHead, 1},
+		{eth.NodeSelectionModeLowestLatency, 2},
+		{eth.NodeSelectionModePriorityLevel, 0},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.mode, func(t *testing.T) {
+			nodes := []eth.Node{
+				newMockNode(t, "n0", eth.NodeStateAlive, eth.NodeStats{LatestBlockNumber: 99, Latency: 200 * time.Millisecond}),
+				newMockNode(t, "n1", eth.NodeStateAlive, eth.NodeStats{LatestBlockNumber: 100, Latency: 300 * time.Millisecond}),
+				newMockNode(t, "n2", eth.NodeStateAlive, eth.NodeStats{LatestBlockNumber: 98, Latency: 100 * time.Millisecond}),
+			}
+			cfg := eth.TestPoolConfig{SelectionMode: test.mode}
+			p := eth.NewPool(logger.TestLogger(t), cfg, nodes, nil, &cltest.FixtureChainID)
+
+			for i := 0; i < 3; i++ {
+				require.Equal(t, nodes[test.expected], p.SelectNode())
+			}
+		})
+	}
+}
+
+func TestPool_CheckSync(t *testing.T) {
+	n1 := newMockNode(t, "n1", eth.NodeStateAlive, eth.NodeStats{LatestBlockNumber: 100})
+	n2 := newMockNode(t, "n2", eth.NodeStateOutOfSync, eth.NodeStats{LatestBlockNumber: 97})
+	n3 := newMockNode(t, "n3", eth.NodeStateAlive, eth.NodeStats{LatestBlockNumber: 90})
+	n4 := newMockNode(t, "n4", eth.NodeStateDead, eth.NodeStats{LatestBlockNumber: -1})
+	cfg := eth.TestPoolConfig{SelectionMode: eth.NodeSelectionModeRoundRobin, OutOfSyncThreshold: 5}
+	p := eth.NewPool(logger.TestLogger(t), cfg, []eth.Node{n1, n2, n3, n4}, nil, &cltest.FixtureChainID)
+
+	for _, n := range []*ethmocks.Node{n1, n2, n3} {
+		n.On("HeaderByNumber", mock.Anything, (*big.Int)(nil)).Return(nil, nil).Once()
+	}
+	n1.On("SetOutOfSync", false).Once()
+	n2.On("SetOutOfSync", false).Once()
+	n3.On("SetOutOfSync", true).Once()
+
+	p.CheckSync(context.Background())
+
+	n1.AssertExpectations(t)
+	n2.AssertExpectations(t)
+	n3.AssertExpectations(t)
+	n4.AssertExpectations(t)
+}
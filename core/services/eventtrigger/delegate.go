@@ -0,0 +1,243 @@
+package eventtrigger
+
+import (
+	"sync"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/chains/evm"
+	"github.com/smartcontractkit/chainlink/core/internal/gethwrappers/generated"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/job"
+	"github.com/smartcontractkit/chainlink/core/services/log"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+type Delegate struct {
+	logger         logger.Logger
+	pipelineRunner pipeline.Runner
+	chainSet       evm.ChainSet
+}
+
+var _ job.Delegate = (*Delegate)(nil)
+
+func NewDelegate(
+	logger logger.Logger,
+	pipelineRunner pipeline.Runner,
+	chainSet evm.ChainSet,
+) *Delegate {
+	return &Delegate{
+		logger.Named("EventTrigger"),
+		pipelineRunner,
+		chainSet,
+	}
+}
+
+func (d *Delegate) JobType() job.Type {
+	return job.EventTrigger
+}
+
+func (Delegate) AfterJobCreated(spec job.Job)  {}
+func (Delegate) BeforeJobDeleted(spec job.Job) {}
+
+// ServicesForSpec returns the log listener service for an event trigger job
+func (d *Delegate) ServicesForSpec(jb job.Job) ([]job.Service, error) {
+	if jb.EventTriggerSpec == nil {
+		return nil, errors.Errorf("EventTrigger: eventtrigger.Delegate expects a *job.EventTriggerSpec to be present, got %v", jb)
+	}
+	spec := *jb.EventTriggerSpec
+	chain, err := d.chainSet.Get(spec.EVMChainID.ToInt())
+	if err != nil {
+		return nil, err
+	}
+
+	event, err := ParseEventSignature(spec.EventABI)
+	if err != nil {
+		return nil, errors.Wrap(err, "EventTrigger: invalid eventABI")
+	}
+	topics, err := FilterTopics(event, spec.IndexedFilters)
+	if err != nil {
+		return nil, errors.Wrap(err, "EventTrigger: invalid indexedFilters")
+	}
+	minIncomingConfirmations := chain.Config().MinIncomingConfirmations()
+	if spec.MinIncomingConfirmations.Valid {
+		minIncomingConfirmations = spec.MinIncomingConfirmations.Uint32
+	}
+
+	svcLogger := d.logger.
+		With(
+			"contract", spec.ContractAddress.Address().String(),
+			"event", event.Sig,
+			"jobName", jb.PipelineSpec.JobName,
+			"jobID", jb.PipelineSpec.JobID,
+			"externalJobID", jb.ExternalJobID,
+		)
+
+	logListener := &listener{
+		logger:                   svcLogger,
+		logBroadcaster:           chain.LogBroadcaster(),
+		pipelineRunner:           d.pipelineRunner,
+		job:                      jb,
+		contract:                 spec.ContractAddress.Address(),
+		event:                    event,
+		topics:                   topics,
+		minIncomingConfirmations: minIncomingConfirmations,
+		mbLogs:                   utils.NewHighCapacityMailbox(),
+		chStop:                   make(chan struct{}),
+	}
+	return []job.Service{logListener}, nil
+}
+
+var (
+	_ log.Listener = &listener{}
+	_ job.Service  = &listener{}
+)
+
+type listener struct {
+	logger                   logger.Logger
+	logBroadcaster           log.Broadcaster
+	pipelineRunner           pipeline.Runner
+	job                      job.Job
+	contract                 common.Address
+	event                    abi.Event
+	topics                   [][]log.Topic
+	minIncomingConfirmations uint32
+	mbLogs                   *utils.Mailbox
+	chStop                   chan struct{}
+	wg                       sync.WaitGroup
+	utils.StartStopOnce
+}
+
+// Start complies with job.Service
+func (l *listener) Start() error {
+	return l.StartOnce("EventTriggerListener", func() error {
+		unsubscribeLogs := l.logBroadcaster.Register(l, log.ListenerOpts{
+			Contract: l.contract,
+			ParseLog: l.parseLog,
+			LogsWithTopics: map[common.Hash][][]log.Topic{
+				l.event.ID: l.topics,
+			},
+			MinIncomingConfirmations: l.minIncomingConfirmations,
+		})
+		l.wg.Add(2)
+		go l.processLogs()
+
+		go func() {
+			<-l.chStop
+			unsubscribeLogs()
+			l.wg.Done()
+		}()
+
+		return nil
+	})
+}
+
+// Close complies with job.Service
+func (l *listener) Close() error {
+	return l.StopOnce("EventTriggerListener", func() error {
+		close(l.chStop)
+		l.wg.Wait()
+		return nil
+	})
+}
+
+func (l *listener) parseLog(raw types.Log) (generated.AbigenLog, error) {
+	return decodeEventLog(l.event, raw)
+}
+
+// HandleLog complies with log.Listener
+func (l *listener) HandleLog(lb log.Broadcast) {
+	wasOverCapacity := l.mbLogs.Deliver(lb)
+	if wasOverCapacity {
+		l.logger.Error("Event log mailbox is over capacity - dropped the oldest log")
+	}
+}
+
+// JobID complies with log.Listener
+func (l *listener) JobID() int32 {
+	return l.job.ID
+}
+
+func (l *listener) processLogs() {
+	defer l.wg.Done()
+	for {
+		select {
+		case <-l.chStop:
+			return
+		case <-l.mbLogs.Notify():
+			l.handleReceivedLogs()
+		}
+	}
+}
+
+func (l *listener) handleReceivedLogs() {
+	for {
+		i, exists := l.mbLogs.Retrieve()
+		if !exists {
+			return
+		}
+		lb, ok := i.(log.Broadcast)
+		if !ok {
+			panic(errors.Errorf("EventTrigger: invariant violation, expected log.Broadcast but got %T", i))
+		}
+		was, err := l.logBroadcaster.WasAlreadyConsumed(lb)
+		if err != nil {
+			l.logger.Errorw("Could not determine if log was already consumed", "error", err)
+			return
+		} else if was {
+			continue
+		}
+
+		decoded, ok := lb.DecodedLog().(*eventLog)
+		if !ok || decoded == nil {
+			l.logger.Errorw("Ignoring log that could not be decoded", "log", lb.String())
+			l.markLogConsumed(lb)
+			continue
+		}
+		l.handleEventLog(decoded, lb)
+	}
+}
+
+func (l *listener) handleEventLog(decoded *eventLog, lb log.Broadcast) {
+	l.logger.Debugw("Event log received", "txHash", decoded.raw.TxHash, "blockNumber", decoded.raw.BlockNumber, "logIndex", decoded.raw.Index)
+
+	ctx, cancel := utils.ContextFromChan(l.chStop)
+	defer cancel()
+
+	vars := pipeline.NewVarsFrom(map[string]interface{}{
+		"jobSpec": map[string]interface{}{
+			"databaseID":    l.job.ID,
+			"externalJobID": l.job.ExternalJobID,
+			"name":          l.job.Name.ValueOrZero(),
+		},
+		"jobRun": map[string]interface{}{
+			"logBlockHash":   decoded.raw.BlockHash,
+			"logBlockNumber": decoded.raw.BlockNumber,
+			"logTxHash":      decoded.raw.TxHash,
+			"logAddress":     decoded.raw.Address,
+			"logTopics":      decoded.raw.Topics,
+			"logData":        decoded.fields,
+		},
+	})
+	run := pipeline.NewRun(*l.job.PipelineSpec, vars)
+	_, err := l.pipelineRunner.Run(ctx, &run, l.logger, true, func(tx pg.Queryer) error {
+		l.markLogConsumed(lb, pg.WithQueryer(tx))
+		return nil
+	})
+	if ctx.Err() != nil {
+		return
+	} else if err != nil {
+		l.logger.Errorw("Failed executing run", "err", err)
+	}
+}
+
+func (l *listener) markLogConsumed(lb log.Broadcast, qopts ...pg.QOpt) {
+	if err := l.logBroadcaster.MarkConsumed(lb, qopts...); err != nil {
+		l.logger.Errorw("Unable to mark log consumed", "err", err, "log", lb.String())
+	}
+}
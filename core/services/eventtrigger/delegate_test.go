@@ -0,0 +1,159 @@
+package eventtrigger_test
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	null "gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/configtest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/evmtest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/eventtrigger"
+	"github.com/smartcontractkit/chainlink/core/services/job"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
+	"github.com/smartcontractkit/chainlink/core/services/log"
+	log_mocks "github.com/smartcontractkit/chainlink/core/services/log/mocks"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+	pipeline_mocks "github.com/smartcontractkit/chainlink/core/services/pipeline/mocks"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+const transferEventABI = "Transfer(address indexed from, address indexed to, uint256 value)"
+
+func newEventTriggerJob(t *testing.T) job.Job {
+	return job.Job{
+		ID:   1,
+		Type: job.EventTrigger,
+		EventTriggerSpec: &job.EventTriggerSpec{
+			ContractAddress: ethkey.EIP55AddressFromAddress(cltest.NewAddress()),
+			EventABI:        transferEventABI,
+			IndexedFilters:  job.EventTriggerFilters{"to": {"0x3cCad4715152693fE3BC4460591e3D3Fbd071b42"}},
+		},
+		PipelineSpec: &pipeline.Spec{},
+	}
+}
+
+func TestDelegate_ServicesForSpec(t *testing.T) {
+	ethClient := cltest.NewEthClientMockWithDefaultChain(t)
+	runner := new(pipeline_mocks.Runner)
+	db := pgtest.NewSqlxDB(t)
+	cfg := configtest.NewTestGeneralConfig(t)
+	cc := evmtest.NewChainSet(t, evmtest.TestChainOpts{DB: db, GeneralConfig: cfg, Client: ethClient})
+
+	delegate := eventtrigger.NewDelegate(logger.TestLogger(t), runner, cc)
+
+	t.Run("Spec without EventTriggerSpec", func(t *testing.T) {
+		_, err := delegate.ServicesForSpec(job.Job{})
+		assert.Error(t, err, "expects a *job.EventTriggerSpec to be present")
+	})
+
+	t.Run("Spec with invalid event", func(t *testing.T) {
+		jb := newEventTriggerJob(t)
+		jb.EventTriggerSpec.EventABI = "Transfer"
+		_, err := delegate.ServicesForSpec(jb)
+		assert.Error(t, err)
+	})
+
+	t.Run("Spec with EventTriggerSpec", func(t *testing.T) {
+		services, err := delegate.ServicesForSpec(newEventTriggerJob(t))
+		require.NoError(t, err)
+		assert.Len(t, services, 1)
+	})
+}
+
+func TestDelegate_ServicesListenerHandleLog(t *testing.T) {
+	ethClient := cltest.NewEthClientMockWithDefaultChain(t)
+	broadcaster := new(log_mocks.Broadcaster)
+	broadcaster.Test(t)
+	broadcaster.On("AddDependents", 1)
+	runner := new(pipeline_mocks.Runner)
+	db := pgtest.NewSqlxDB(t)
+	cfg := configtest.NewTestGeneralConfig(t)
+	cfg.Overrides.GlobalMinIncomingConfirmations = null.IntFrom(1)
+	cc := evmtest.NewChainSet(t, evmtest.TestChainOpts{DB: db, GeneralConfig: cfg, Client: ethClient, LogBroadcaster: broadcaster})
+
+	jb := newEventTriggerJob(t)
+	jb.EventTriggerSpec.MinIncomingConfirmations.SetValid(5)
+	event, err := eventtrigger.ParseEventSignature(transferEventABI)
+	require.NoError(t, err)
+
+	delegate := eventtrigger.NewDelegate(logger.TestLogger(t), runner, cc)
+	services, err := delegate.ServicesForSpec(jb)
+	require.NoError(t, err)
+	require.Len(t, services, 1)
+	service := services[0]
+
+	var listener log.Listener
+	var opts log.ListenerOpts
+	broadcaster.On("Register", mock.Anything, mock.Anything).Return(func() {}).Run(func(args mock.Arguments) {
+		listener = args.Get(0).(log.Listener)
+		opts = args.Get(1).(log.ListenerOpts)
+	})
+
+	require.NoError(t, service.Start())
+	defer service.Close()
+
+	require.NotNil(t, listener, "listener was nil; expected broadcaster.Register to have been called")
+	assert.Equal(t, jb.ID, listener.JobID())
+	assert.Equal(t, jb.EventTriggerSpec.ContractAddress.Address(), opts.Contract)
+	assert.Equal(t, uint32(5), opts.MinIncomingConfirmations)
+	assert.Equal(t, map[common.Hash][][]log.Topic{
+		event.ID: {nil, {log.Topic(common.HexToHash("0x3cCad4715152693fE3BC4460591e3D3Fbd071b42"))}},
+	}, opts.LogsWithTopics)
+
+	from := cltest.NewAddress()
+	to := common.HexToAddress("0x3cCad4715152693fE3BC4460591e3D3Fbd071b42")
+	data, err := event.Inputs.NonIndexed().Pack(big.NewInt(42))
+	require.NoError(t, err)
+	raw := types.Log{
+		Address:     jb.EventTriggerSpec.ContractAddress.Address(),
+		Topics:      []common.Hash{event.ID, from.Hash(), to.Hash()},
+		Data:        data,
+		BlockNumber: 10,
+		BlockHash:   utils.NewHash(),
+		TxHash:      utils.NewHash(),
+	}
+	decoded, err := opts.ParseLog(raw)
+	require.NoError(t, err)
+
+	lb := new(log_mocks.Broadcast)
+	lb.On("DecodedLog").Return(decoded)
+	broadcaster.On("WasAlreadyConsumed", mock.Anything, mock.Anything).Return(false, nil)
+	broadcaster.On("MarkConsumed", mock.Anything, mock.Anything).Return(nil)
+
+	runBeganAwaiter := cltest.NewAwaiter()
+	runner.On("Run", mock.Anything, mock.AnythingOfType("*pipeline.Run"), mock.Anything, mock.Anything, mock.Anything).
+		Return(false, nil).
+		Run(func(args mock.Arguments) {
+			run := args.Get(1).(*pipeline.Run)
+			jobRun := run.Inputs.Val.(map[string]interface{})["jobRun"].(map[string]interface{})
+			assert.Equal(t, raw.TxHash, jobRun["logTxHash"])
+			assert.Equal(t, raw.BlockNumber, jobRun["logBlockNumber"])
+			assert.Equal(t, map[string]interface{}{
+				"from":  from,
+				"to":    to,
+				"value": big.NewInt(42),
+			}, jobRun["logData"])
+
+			fn := args.Get(4).(func(pg.Queryer) error)
+			require.NoError(t, fn(nil))
+			runBeganAwaiter.ItHappened()
+		}).Once()
+
+	listener.HandleLog(lb)
+
+	runBeganAwaiter.AwaitOrFail(t, 5*time.Second)
+
+	broadcaster.AssertExpectations(t)
+	runner.AssertExpectations(t)
+}
@@ -0,0 +1,225 @@
+package eventtrigger
+
+import (
+	"math/big"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/services/job"
+	"github.com/smartcontractkit/chainlink/core/services/log"
+)
+
+var eventSignatureRegexp = regexp.MustCompile(`^\s*(?:event\s+)?([A-Za-z_][A-Za-z0-9_]*)\s*\((.*)\)\s*;?\s*$`)
+
+// ParseEventSignature parses a human readable event signature, e.g.
+// "Transfer(address indexed from, address indexed to, uint256 value)".
+// Arguments without a name are named arg0, arg1 etc. by their position.
+// Tuple arguments are not supported.
+func ParseEventSignature(signature string) (abi.Event, error) {
+	matches := eventSignatureRegexp.FindStringSubmatch(signature)
+	if matches == nil {
+		return abi.Event{}, errors.Errorf("invalid event signature %q", signature)
+	}
+	name, argList := matches[1], strings.TrimSpace(matches[2])
+
+	var inputs abi.Arguments
+	if argList != "" {
+		for i, rawArg := range strings.Split(argList, ",") {
+			fields := strings.Fields(rawArg)
+			if len(fields) == 0 || len(fields) > 3 {
+				return abi.Event{}, errors.Errorf("invalid argument %q in event signature", strings.TrimSpace(rawArg))
+			}
+			typ, err := abi.NewType(fields[0], "", nil)
+			if err != nil {
+				return abi.Event{}, errors.Wrapf(err, "invalid type of argument %q in event signature", strings.TrimSpace(rawArg))
+			}
+			arg := abi.Argument{Name: "arg" + strconv.Itoa(i), Type: typ}
+			rest := fields[1:]
+			if len(rest) > 0 && rest[0] == "indexed" {
+				arg.Indexed = true
+				rest = rest[1:]
+			}
+			switch len(rest) {
+			case 0:
+			case 1:
+				arg.Name = rest[0]
+			default:
+				return abi.Event{}, errors.Errorf("invalid argument %q in event signature", strings.TrimSpace(rawArg))
+			}
+			inputs = append(inputs, arg)
+		}
+	}
+
+	names := make(map[string]struct{})
+	var nIndexed int
+	for _, arg := range inputs {
+		if _, exists := names[arg.Name]; exists {
+			return abi.Event{}, errors.Errorf("duplicate argument %q in event signature", arg.Name)
+		}
+		names[arg.Name] = struct{}{}
+		if arg.Indexed {
+			nIndexed++
+		}
+	}
+	if nIndexed > 3 {
+		return abi.Event{}, errors.New("event signature may have at most 3 indexed arguments")
+	}
+
+	return abi.NewEvent(name, name, false, inputs), nil
+}
+
+// FilterTopics returns the topic filters for the indexed arguments of the
+// event, in the form expected by log.ListenerOpts
+func FilterTopics(event abi.Event, filters job.EventTriggerFilters) ([][]log.Topic, error) {
+	var topics [][]log.Topic
+	var lastFiltered int
+	filtered := make(map[string]struct{})
+	for _, arg := range event.Inputs {
+		if !arg.Indexed {
+			continue
+		}
+		var argTopics []log.Topic
+		for _, value := range filters[arg.Name] {
+			topic, err := filterTopic(arg, value)
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid filter value %q for argument %s", value, arg.Name)
+			}
+			argTopics = append(argTopics, log.Topic(topic))
+		}
+		if _, exists := filters[arg.Name]; exists {
+			filtered[arg.Name] = struct{}{}
+			lastFiltered = len(topics) + 1
+		}
+		topics = append(topics, argTopics)
+	}
+	for name := range filters {
+		if _, exists := filtered[name]; !exists {
+			return nil, errors.Errorf("filtered argument %s is not an indexed argument of the event", name)
+		}
+	}
+	if lastFiltered == 0 {
+		return nil, nil
+	}
+	// Trailing positions without a filter accept all values
+	return topics[:lastFiltered], nil
+}
+
+func filterTopic(arg abi.Argument, value string) (topic common.Hash, err error) {
+	switch arg.Type.T {
+	case abi.AddressTy:
+		if !common.IsHexAddress(value) {
+			return topic, errors.New("not an address")
+		}
+		return common.HexToAddress(value).Hash(), nil
+	case abi.BoolTy:
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return topic, err
+		}
+		if b {
+			topic[common.HashLength-1] = 1
+		}
+		return topic, nil
+	case abi.IntTy, abi.UintTy:
+		n, ok := new(big.Int).SetString(value, 0)
+		if !ok {
+			return topic, errors.New("not an integer")
+		}
+		if arg.Type.T == abi.UintTy && n.Sign() < 0 {
+			return topic, errors.New("negative value for unsigned integer")
+		}
+		return common.BytesToHash(math.U256Bytes(n)), nil
+	case abi.FixedBytesTy:
+		b, err := hexutil.Decode(value)
+		if err != nil {
+			return topic, err
+		}
+		if len(b) > arg.Type.Size {
+			return topic, errors.Errorf("longer than %d bytes", arg.Type.Size)
+		}
+		copy(topic[:], b)
+		return topic, nil
+	case abi.StringTy:
+		return crypto.Keccak256Hash([]byte(value)), nil
+	case abi.BytesTy:
+		b, err := hexutil.Decode(value)
+		if err != nil {
+			return topic, err
+		}
+		return crypto.Keccak256Hash(b), nil
+	default:
+		// Indexed arrays and structs are stored as the hash of their encoding,
+		// which has to be given as is
+		b, err := hexutil.Decode(value)
+		if err != nil {
+			return topic, err
+		}
+		if len(b) != common.HashLength {
+			return topic, errors.New("must be a 32 byte topic")
+		}
+		return common.BytesToHash(b), nil
+	}
+}
+
+// eventLog is an event log decoded with the ABI of the job's event
+type eventLog struct {
+	topic  common.Hash
+	fields map[string]interface{}
+	raw    types.Log
+}
+
+// Topic complies with generated.AbigenLog
+func (l *eventLog) Topic() common.Hash {
+	return l.topic
+}
+
+// decodeEventLog decodes the arguments of the event from the given log.
+// Indexed arguments of dynamic types are returned as the hash in their topic.
+func decodeEventLog(event abi.Event, raw types.Log) (*eventLog, error) {
+	if len(raw.Topics) == 0 || raw.Topics[0] != event.ID {
+		return nil, errors.Errorf("log is not a %s event", event.Name)
+	}
+
+	var indexed abi.Arguments
+	for _, arg := range event.Inputs {
+		if arg.Indexed {
+			indexed = append(indexed, arg)
+		}
+	}
+	fields := make(map[string]interface{})
+	if err := event.Inputs.NonIndexed().UnpackIntoMap(fields, raw.Data); err != nil {
+		return nil, errors.Wrapf(err, "failed to decode data of %s event", event.Name)
+	}
+	if err := abi.ParseTopicsIntoMap(fields, indexed, raw.Topics[1:]); err != nil {
+		return nil, errors.Wrapf(err, "failed to decode topics of %s event", event.Name)
+	}
+	for name, value := range fields {
+		fields[name] = pipelineValue(value)
+	}
+	return &eventLog{topic: event.ID, fields: fields, raw: raw}, nil
+}
+
+// pipelineValue encodes fixed size byte arrays (e.g. bytes32) as hex strings,
+// since pipeline variables do not support arrays of bytes
+func pipelineValue(value interface{}) interface{} {
+	if _, isAddress := value.(common.Address); isAddress {
+		return value
+	}
+	v := reflect.ValueOf(value)
+	if v.Kind() != reflect.Array || v.Type().Elem().Kind() != reflect.Uint8 {
+		return value
+	}
+	b := make([]byte, v.Len())
+	reflect.Copy(reflect.ValueOf(b), v)
+	return hexutil.Encode(b)
+}
@@ -0,0 +1,155 @@
+package eventtrigger
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/services/job"
+	"github.com/smartcontractkit/chainlink/core/services/log"
+)
+
+func TestParseEventSignature(t *testing.T) {
+	t.Run("parses named and unnamed arguments", func(t *testing.T) {
+		event, err := ParseEventSignature("event Transfer(address indexed from, address indexed, uint256 value);")
+		require.NoError(t, err)
+
+		assert.Equal(t, "Transfer", event.Name)
+		assert.Equal(t, "Transfer(address,address,uint256)", event.Sig)
+		assert.Equal(t, crypto.Keccak256Hash([]byte("Transfer(address,address,uint256)")), event.ID)
+		require.Len(t, event.Inputs, 3)
+		assert.Equal(t, "from", event.Inputs[0].Name)
+		assert.True(t, event.Inputs[0].Indexed)
+		assert.Equal(t, "arg1", event.Inputs[1].Name)
+		assert.True(t, event.Inputs[1].Indexed)
+		assert.Equal(t, "value", event.Inputs[2].Name)
+		assert.False(t, event.Inputs[2].Indexed)
+	})
+
+	t.Run("parses events without arguments", func(t *testing.T) {
+		event, err := ParseEventSignature("Paused()")
+		require.NoError(t, err)
+		assert.Equal(t, "Paused()", event.Sig)
+		assert.Len(t, event.Inputs, 0)
+	})
+
+	for _, signature := range []string{
+		"",
+		"Transfer",
+		"Transfer(address indexed from,)",
+		"Transfer(foo value)",
+		"Transfer(address from to value)",
+		"Transfer(address from, address from)",
+		"Transfer(uint8 indexed a, uint8 indexed b, uint8 indexed c, uint8 indexed d)",
+	} {
+		_, err := ParseEventSignature(signature)
+		assert.Error(t, err, "expected %q to be invalid", signature)
+	}
+}
+
+func TestFilterTopics(t *testing.T) {
+	event, err := ParseEventSignature("Request(bytes32 indexed id, address indexed requester, string indexed name, uint256 amount)")
+	require.NoError(t, err)
+	requester := common.HexToAddress("0x3cCad4715152693fE3BC4460591e3D3Fbd071b42")
+
+	t.Run("returns nil without filters", func(t *testing.T) {
+		topics, err := FilterTopics(event, nil)
+		require.NoError(t, err)
+		assert.Nil(t, topics)
+	})
+
+	t.Run("leaves unfiltered positions empty and trims trailing ones", func(t *testing.T) {
+		topics, err := FilterTopics(event, job.EventTriggerFilters{"requester": {requester.Hex()}})
+		require.NoError(t, err)
+		assert.Equal(t, [][]log.Topic{nil, {log.Topic(requester.Hash())}}, topics)
+	})
+
+	t.Run("encodes values by their type", func(t *testing.T) {
+		topics, err := FilterTopics(event, job.EventTriggerFilters{
+			"id":   {"0x01", "0x02"},
+			"name": {"foo"},
+		})
+		require.NoError(t, err)
+		require.Len(t, topics, 3)
+		assert.Equal(t, []log.Topic{
+			log.Topic(common.HexToHash("0x0100000000000000000000000000000000000000000000000000000000000000")),
+			log.Topic(common.HexToHash("0x0200000000000000000000000000000000000000000000000000000000000000")),
+		}, topics[0])
+		assert.Nil(t, topics[1])
+		assert.Equal(t, []log.Topic{log.Topic(crypto.Keccak256Hash([]byte("foo")))}, topics[2])
+	})
+
+	t.Run("encodes integers", func(t *testing.T) {
+		event, err := ParseEventSignature("Update(int256 indexed delta, uint8 indexed round, bool indexed final)")
+		require.NoError(t, err)
+
+		topics, err := FilterTopics(event, job.EventTriggerFilters{
+			"delta": {"-1"},
+			"round": {"0x10"},
+			"final": {"true"},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, [][]log.Topic{
+			{log.Topic(common.HexToHash("0xffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff"))},
+			{log.Topic(common.BigToHash(big.NewInt(16)))},
+			{log.Topic(common.BigToHash(big.NewInt(1)))},
+		}, topics)
+	})
+
+	t.Run("rejects invalid filters", func(t *testing.T) {
+		for _, filters := range []job.EventTriggerFilters{
+			{"amount": {"1"}},
+			{"unknown": {"1"}},
+			{"requester": {"0x01"}},
+			{"id": {"0x" + common.Bytes2Hex(make([]byte, 33))}},
+		} {
+			_, err := FilterTopics(event, filters)
+			assert.Error(t, err, "expected %v to be invalid", filters)
+		}
+	})
+}
+
+func TestDecodeEventLog(t *testing.T) {
+	event, err := ParseEventSignature("Request(bytes32 indexed id, address indexed requester, uint256 amount, string name)")
+	require.NoError(t, err)
+	requester := common.HexToAddress("0x3cCad4715152693fE3BC4460591e3D3Fbd071b42")
+	id := common.HexToHash("0x42")
+
+	data, err := event.Inputs.NonIndexed().Pack(big.NewInt(100), "foo")
+	require.NoError(t, err)
+	raw := types.Log{
+		Address: common.HexToAddress("0x613a38AC1659769640aaE063C651F48E0250454C"),
+		Topics:  []common.Hash{event.ID, id, requester.Hash()},
+		Data:    data,
+	}
+
+	decoded, err := decodeEventLog(event, raw)
+	require.NoError(t, err)
+	assert.Equal(t, event.ID, decoded.Topic())
+	assert.Equal(t, raw, decoded.raw)
+	assert.Equal(t, map[string]interface{}{
+		"id":        id.Hex(),
+		"requester": requester,
+		"amount":    big.NewInt(100),
+		"name":      "foo",
+	}, decoded.fields)
+
+	t.Run("rejects logs of other events", func(t *testing.T) {
+		other := raw
+		other.Topics = []common.Hash{crypto.Keccak256Hash([]byte("Other()"))}
+		_, err := decodeEventLog(event, other)
+		assert.Error(t, err)
+	})
+
+	t.Run("rejects logs with malformed data", func(t *testing.T) {
+		malformed := raw
+		malformed.Data = []byte{1}
+		_, err := decodeEventLog(event, malformed)
+		assert.Error(t, err)
+	})
+}
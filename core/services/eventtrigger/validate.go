@@ -0,0 +1,48 @@
+package eventtrigger
+
+import (
+	"github.com/pelletier/go-toml"
+	"github.com/pkg/errors"
+	uuid "github.com/satori/go.uuid"
+
+	"github.com/smartcontractkit/chainlink/core/services/job"
+)
+
+func ValidatedEventTriggerSpec(tomlString string) (job.Job, error) {
+	var jb = job.Job{
+		ExternalJobID: uuid.NewV4(), // Default to generating a uuid, can be overwritten by the specified one in tomlString.
+	}
+
+	tree, err := toml.Load(tomlString)
+	if err != nil {
+		return jb, errors.Wrap(err, "toml error on load")
+	}
+
+	err = tree.Unmarshal(&jb)
+	if err != nil {
+		return jb, errors.Wrap(err, "toml unmarshal error on job")
+	}
+
+	var spec job.EventTriggerSpec
+	err = tree.Unmarshal(&spec)
+	if err != nil {
+		return jb, errors.Wrap(err, "toml unmarshal error on spec")
+	}
+
+	jb.EventTriggerSpec = &spec
+	if jb.Type != job.EventTrigger {
+		return jb, errors.Errorf("unsupported type %s", jb.Type)
+	}
+	if spec.ContractAddress == "" {
+		return jb, errors.New("contractAddress must be set")
+	}
+	event, err := ParseEventSignature(spec.EventABI)
+	if err != nil {
+		return jb, errors.Wrap(err, "invalid eventABI")
+	}
+	if _, err = FilterTopics(event, spec.IndexedFilters); err != nil {
+		return jb, errors.Wrap(err, "invalid indexedFilters")
+	}
+
+	return jb, nil
+}
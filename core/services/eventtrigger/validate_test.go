@@ -0,0 +1,103 @@
+package eventtrigger_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/services/eventtrigger"
+	"github.com/smartcontractkit/chainlink/core/services/job"
+)
+
+func TestValidatedEventTriggerSpec(t *testing.T) {
+	var tt = []struct {
+		name      string
+		toml      string
+		assertion func(t *testing.T, jb job.Job, err error)
+	}{
+		{
+			name: "valid spec",
+			toml: `
+type                     = "eventtrigger"
+schemaVersion            = 1
+contractAddress          = "0x613a38AC1659769640aaE063C651F48E0250454C"
+eventABI                 = "Transfer(address indexed from, address indexed to, uint256 value)"
+minIncomingConfirmations = 3
+evmChainID               = 42
+observationSource        = """
+ds [type=http method=POST url="https://example.com" requestData="{\\"value\\": $(jobRun.logData.value)}"];
+"""
+
+[indexedFilters]
+to = ["0x3cCad4715152693fE3BC4460591e3D3Fbd071b42"]
+`,
+			assertion: func(t *testing.T, jb job.Job, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, jb.EventTriggerSpec)
+				assert.Equal(t, "0x613a38AC1659769640aaE063C651F48E0250454C", jb.EventTriggerSpec.ContractAddress.String())
+				assert.Equal(t, "Transfer(address indexed from, address indexed to, uint256 value)", jb.EventTriggerSpec.EventABI)
+				assert.Equal(t, job.EventTriggerFilters{"to": {"0x3cCad4715152693fE3BC4460591e3D3Fbd071b42"}}, jb.EventTriggerSpec.IndexedFilters)
+				assert.Equal(t, uint32(3), jb.EventTriggerSpec.MinIncomingConfirmations.Uint32)
+				assert.Equal(t, "42", jb.EventTriggerSpec.EVMChainID.String())
+			},
+		},
+		{
+			name: "missing contract address",
+			toml: `
+type              = "eventtrigger"
+schemaVersion     = 1
+eventABI          = "Transfer(address indexed from, address indexed to, uint256 value)"
+observationSource = """
+ds [type=http method=GET url="https://example.com"];
+"""
+`,
+			assertion: func(t *testing.T, jb job.Job, err error) {
+				require.EqualError(t, err, "contractAddress must be set")
+			},
+		},
+		{
+			name: "invalid event signature",
+			toml: `
+type              = "eventtrigger"
+schemaVersion     = 1
+contractAddress   = "0x613a38AC1659769640aaE063C651F48E0250454C"
+eventABI          = "Transfer(address indexed from, address indexed to, foo value)"
+observationSource = """
+ds [type=http method=GET url="https://example.com"];
+"""
+`,
+			assertion: func(t *testing.T, jb job.Job, err error) {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "invalid eventABI")
+			},
+		},
+		{
+			name: "filter on a non-indexed argument",
+			toml: `
+type              = "eventtrigger"
+schemaVersion     = 1
+contractAddress   = "0x613a38AC1659769640aaE063C651F48E0250454C"
+eventABI          = "Transfer(address indexed from, address indexed to, uint256 value)"
+observationSource = """
+ds [type=http method=GET url="https://example.com"];
+"""
+
+[indexedFilters]
+value = ["1"]
+`,
+			assertion: func(t *testing.T, jb job.Job, err error) {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "invalid indexedFilters")
+			},
+		},
+	}
+
+	for _, tc := range tt {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			s, err := eventtrigger.ValidatedEventTriggerSpec(tc.toml)
+			tc.assertion(t, s, err)
+		})
+	}
+}
@@ -32,17 +32,21 @@ type orm struct {
 	q        pg.Q
 	txm      transmitter
 	strategy bulletprooftxmanager.TxStrategy
-	logger   logger.Logger
+	// jobID is recorded in the meta of submissions, so that their gas spend is
+	// credited to the job
+	jobID  int32
+	logger logger.Logger
 }
 
 // NewORM initializes a new ORM
-func NewORM(db *sqlx.DB, lggr logger.Logger, cfg pg.LogConfig, txm transmitter, strategy bulletprooftxmanager.TxStrategy) ORM {
+func NewORM(db *sqlx.DB, lggr logger.Logger, cfg pg.LogConfig, txm transmitter, strategy bulletprooftxmanager.TxStrategy, jobID int32) ORM {
 	namedLogger := lggr.Named("FluxMonitorORM")
 	q := pg.NewQ(db, namedLogger, cfg)
 	return &orm{
 		q,
 		txm,
 		strategy,
+		jobID,
 		namedLogger,
 	}
 }
@@ -121,7 +125,7 @@ func (o *orm) CreateEthTransaction(
 		ToAddress:      toAddress,
 		EncodedPayload: payload,
 		GasLimit:       gasLimit,
-		Meta:           nil,
+		Meta:           &bulletprooftxmanager.EthTxMeta{JobID: o.jobID},
 		Strategy:       o.strategy,
 	}, qopts...)
 	return errors.Wrap(err, "Skipped Flux Monitor submission")
@@ -170,7 +170,7 @@ func TestORM_CreateEthTransaction(t *testing.T) {
 
 	var (
 		txm = new(bptxmmocks.TxManager)
-		orm = fluxmonitorv2.NewORM(db, logger.TestLogger(t), cfg, txm, strategy)
+		orm = fluxmonitorv2.NewORM(db, logger.TestLogger(t), cfg, txm, strategy, 42)
 
 		_, from  = cltest.MustInsertRandomKey(t, ethKeyStore, 0)
 		to       = cltest.NewAddress()
@@ -183,7 +183,7 @@ func TestORM_CreateEthTransaction(t *testing.T) {
 		ToAddress:      to,
 		EncodedPayload: payload,
 		GasLimit:       gasLimit,
-		Meta:           nil,
+		Meta:           &bulletprooftxmanager.EthTxMeta{JobID: 42},
 		Strategy:       strategy,
 	}).Return(bulletprooftxmanager.EthTx{}, nil).Once()
 
@@ -0,0 +1,277 @@
+package gas
+
+import (
+	"context"
+	"math/big"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/eth"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+var (
+	// ArbGasInfoAddress is the address of Arbitrum's ArbGasInfo precompile
+	ArbGasInfoAddress = common.HexToAddress("0x000000000000000000000000000000000000006C")
+	// NodeInterfaceAddress is the address of Arbitrum's NodeInterface, a
+	// virtual contract that is only available to eth_call and eth_estimateGas
+	NodeInterfaceAddress = common.HexToAddress("0x00000000000000000000000000000000000000C8")
+
+	arbGasInfoABI    = mustParseABI(`[{"inputs":[],"name":"getPricesInWei","outputs":[{"name":"perL2Tx","type":"uint256"},{"name":"perL1CalldataByte","type":"uint256"},{"name":"perStorageAllocation","type":"uint256"},{"name":"perArbGasBase","type":"uint256"},{"name":"perArbGasCongestion","type":"uint256"},{"name":"perArbGasTotal","type":"uint256"}],"stateMutability":"view","type":"function"}]`)
+	nodeInterfaceABI = mustParseABI(`[{"inputs":[{"name":"to","type":"address"},{"name":"contractCreation","type":"bool"},{"name":"data","type":"bytes"}],"name":"gasEstimateL1Component","outputs":[{"name":"gasEstimateForL1","type":"uint64"},{"name":"baseFee","type":"uint256"},{"name":"l1BaseFeeEstimate","type":"uint256"}],"stateMutability":"payable","type":"function"}]`)
+)
+
+func mustParseABI(s string) abi.ABI {
+	a, err := abi.JSON(strings.NewReader(s))
+	if err != nil {
+		panic(err)
+	}
+	return a
+}
+
+var _ Estimator = &arbitrumEstimator{}
+
+//go:generate mockery --name arbitrumClient --output ./mocks/ --case=underscore --structname ArbitrumClient
+type arbitrumClient interface {
+	CallContract(ctx context.Context, msg ethereum.CallMsg, blockNumber *big.Int) ([]byte, error)
+}
+
+// ArbitrumPrices are the prices returned by ArbGasInfo.getPricesInWei
+type ArbitrumPrices struct {
+	// PerL2Tx is the fixed cost of a transaction in Wei
+	PerL2Tx *big.Int
+	// PerL1CalldataByte is the cost of posting a byte of calldata to L1 in Wei
+	PerL1CalldataByte *big.Int
+	// PerArbGasTotal is the L2 gas price in Wei
+	PerArbGasTotal *big.Int
+}
+
+// arbitrumEstimator prices transactions on Arbitrum, where the gas limit
+// must cover both L2 execution and posting the transaction's calldata to L1.
+// The L2 gas price is polled from the ArbGasInfo precompile, and the L1
+// component of each transaction's gas limit comes from the NodeInterface.
+type arbitrumEstimator struct {
+	utils.StartStopOnce
+
+	config     Config
+	client     arbitrumClient
+	pollPeriod time.Duration
+	logger     logger.Logger
+
+	pricesMu sync.RWMutex
+	prices   *ArbitrumPrices
+
+	chForceRefetch chan (chan struct{})
+	chInitialised  chan struct{}
+	chStop         chan struct{}
+	chDone         chan struct{}
+}
+
+// NewArbitrumEstimator returns a new Arbitrum estimator
+func NewArbitrumEstimator(lggr logger.Logger, config Config, client arbitrumClient) Estimator {
+	return &arbitrumEstimator{
+		config:         config,
+		client:         client,
+		pollPeriod:     10 * time.Second,
+		logger:         lggr.Named("ArbitrumEstimator"),
+		chForceRefetch: make(chan (chan struct{})),
+		chInitialised:  make(chan struct{}),
+		chStop:         make(chan struct{}),
+		chDone:         make(chan struct{}),
+	}
+}
+
+func (a *arbitrumEstimator) Start() error {
+	return a.StartOnce("ArbitrumEstimator", func() error {
+		go a.run()
+		<-a.chInitialised
+		return nil
+	})
+}
+
+func (a *arbitrumEstimator) Close() error {
+	return a.StopOnce("ArbitrumEstimator", func() error {
+		close(a.chStop)
+		<-a.chDone
+		return nil
+	})
+}
+
+func (a *arbitrumEstimator) run() {
+	defer close(a.chDone)
+
+	t := a.refreshPrices()
+	close(a.chInitialised)
+
+	for {
+		select {
+		case <-a.chStop:
+			return
+		case ch := <-a.chForceRefetch:
+			t.Stop()
+			t = a.refreshPrices()
+			close(ch)
+		case <-t.C:
+			t = a.refreshPrices()
+		}
+	}
+}
+
+func (a *arbitrumEstimator) refreshPrices() (t *time.Timer) {
+	t = time.NewTimer(utils.WithJitter(a.pollPeriod))
+
+	ctx, cancel := utils.ContextFromChanWithDeadline(a.chStop, maxEthNodeRequestTime)
+	defer cancel()
+
+	prices, err := a.fetchPrices(ctx)
+	if err != nil {
+		a.logger.Warnf("ArbitrumEstimator: Failed to refresh prices, got error: %s", err)
+		return
+	}
+
+	a.logger.Debugw("ArbitrumEstimator#refreshPrices", "perL2Tx", prices.PerL2Tx, "perL1CalldataByte", prices.PerL1CalldataByte, "perArbGasTotal", prices.PerArbGasTotal)
+
+	a.pricesMu.Lock()
+	defer a.pricesMu.Unlock()
+	a.prices = &prices
+	return
+}
+
+func (a *arbitrumEstimator) fetchPrices(ctx context.Context) (prices ArbitrumPrices, err error) {
+	data, err := arbGasInfoABI.Pack("getPricesInWei")
+	if err != nil {
+		return prices, err
+	}
+	b, err := a.client.CallContract(ctx, ethereum.CallMsg{To: &ArbGasInfoAddress, Data: data}, nil)
+	if err != nil {
+		return prices, errors.Wrap(err, "failed to call ArbGasInfo.getPricesInWei")
+	}
+	out, err := arbGasInfoABI.Unpack("getPricesInWei", b)
+	if err != nil {
+		return prices, errors.Wrap(err, "failed to unpack ArbGasInfo.getPricesInWei")
+	}
+	prices.PerL2Tx = abi.ConvertType(out[0], new(big.Int)).(*big.Int)
+	prices.PerL1CalldataByte = abi.ConvertType(out[1], new(big.Int)).(*big.Int)
+	prices.PerArbGasTotal = abi.ConvertType(out[5], new(big.Int)).(*big.Int)
+	if prices.PerArbGasTotal.Sign() <= 0 {
+		return prices, errors.Errorf("ArbGasInfo.getPricesInWei returned invalid L2 gas price %s", prices.PerArbGasTotal)
+	}
+	return prices, nil
+}
+
+func (a *arbitrumEstimator) getPrices() *ArbitrumPrices {
+	a.pricesMu.RLock()
+	defer a.pricesMu.RUnlock()
+	return a.prices
+}
+
+// GetLegacyGas returns the L2 gas price, capped at ETH_MAX_GAS_PRICE_WEI, and
+// a gas limit that adds the L1 component for the calldata to the L2 gas limit
+func (a *arbitrumEstimator) GetLegacyGas(calldata []byte, gasLimit uint64, opts ...Opt) (gasPrice *big.Int, chainSpecificGasLimit uint64, err error) {
+	var prices *ArbitrumPrices
+	ok := a.IfStarted(func() {
+		var forceRefetch bool
+		for _, opt := range opts {
+			if opt == OptForceRefetch {
+				forceRefetch = true
+			}
+		}
+		if forceRefetch {
+			ch := make(chan struct{})
+			a.chForceRefetch <- ch
+			select {
+			case <-ch:
+			case <-a.chStop:
+				err = errors.New("estimator stopped")
+				return
+			}
+		}
+		prices = a.getPrices()
+	})
+	if !ok {
+		return nil, 0, errors.New("estimator is not started")
+	} else if err != nil {
+		return nil, 0, err
+	} else if prices == nil {
+		return nil, 0, errors.New("failed to estimate arbitrum gas; gas prices not set")
+	}
+	// The L1 component is fetched outside of IfStarted, so that a slow node
+	// can't hold up Close
+	ctx, cancel := context.WithTimeout(context.Background(), maxEthNodeRequestTime)
+	defer cancel()
+	return a.calcGas(ctx, *prices, calldata, gasLimit)
+}
+
+func (a *arbitrumEstimator) calcGas(ctx context.Context, prices ArbitrumPrices, calldata []byte, l2GasLimit uint64) (gasPrice *big.Int, chainSpecificGasLimit uint64, err error) {
+	gasPrice = prices.PerArbGasTotal
+	if max := a.config.EvmMaxGasPriceWei(); gasPrice.Cmp(max) > 0 {
+		a.logger.Warnw("Arbitrum L2 gas price exceeds ETH_MAX_GAS_PRICE_WEI, capping gas price", "gasPriceWei", gasPrice, "maxGasPriceWei", max)
+		gasPrice = max
+	}
+
+	l1GasLimit, err := a.estimateL1Component(ctx, calldata)
+	if err != nil {
+		a.logger.Warnw("Failed to estimate L1 gas component with NodeInterface, falling back to ArbGasInfo prices", "err", err)
+		l1GasLimit = l1ComponentFromPrices(prices, calldata)
+	}
+
+	l2GasLimit = applyMultiplier(l2GasLimit, a.config.EvmGasLimitMultiplier())
+	chainSpecificGasLimit = l2GasLimit + l1GasLimit
+	if chainSpecificGasLimit < l2GasLimit {
+		return nil, 0, errors.New("gas limit overflows uint64")
+	}
+	return gasPrice, chainSpecificGasLimit, nil
+}
+
+// estimateL1Component asks the NodeInterface for the L2 gas needed to pay
+// for posting the calldata to L1. The destination only affects the size of
+// the transaction by a few bytes, so the zero address is used.
+func (a *arbitrumEstimator) estimateL1Component(ctx context.Context, calldata []byte) (uint64, error) {
+	data, err := nodeInterfaceABI.Pack("gasEstimateL1Component", common.Address{}, false, calldata)
+	if err != nil {
+		return 0, err
+	}
+	b, err := a.client.CallContract(ctx, ethereum.CallMsg{To: &NodeInterfaceAddress, Data: data}, nil)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to call NodeInterface.gasEstimateL1Component")
+	}
+	out, err := nodeInterfaceABI.Unpack("gasEstimateL1Component", b)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to unpack NodeInterface.gasEstimateL1Component")
+	}
+	return *abi.ConvertType(out[0], new(uint64)).(*uint64), nil
+}
+
+// l1ComponentFromPrices converts the cost of the transaction's calldata,
+// without accounting for compression, into L2 gas
+func l1ComponentFromPrices(prices ArbitrumPrices, calldata []byte) uint64 {
+	cost := new(big.Int).Mul(prices.PerL1CalldataByte, big.NewInt(int64(len(calldata))))
+	cost.Add(cost, prices.PerL2Tx)
+	// Round up, so that the L1 cost is always covered
+	l1Gas := new(big.Int).Add(cost, new(big.Int).Sub(prices.PerArbGasTotal, big.NewInt(1)))
+	l1Gas.Div(l1Gas, prices.PerArbGasTotal)
+	return l1Gas.Uint64()
+}
+
+func (a *arbitrumEstimator) BumpLegacyGas(originalGasPrice *big.Int, originalGasLimit uint64) (gasPrice *big.Int, gasLimit uint64, err error) {
+	return nil, 0, errors.New("bump gas is not supported for arbitrum")
+}
+
+func (a *arbitrumEstimator) OnNewLongestChain(_ context.Context, _ *eth.Head) {}
+
+func (*arbitrumEstimator) GetDynamicFee(gasLimit uint64) (fee DynamicFee, chainSpecificGasLimit uint64, err error) {
+	err = errors.New("dynamic fees are not implemented for Arbitrum")
+	return
+}
+
+func (a *arbitrumEstimator) BumpDynamicFee(original DynamicFee, gasLimit uint64) (bumped DynamicFee, chainSpecificGasLimit uint64, err error) {
+	err = errors.New("dynamic fees are not implemented for Arbitrum")
+	return
+}
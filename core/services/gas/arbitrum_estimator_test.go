@@ -0,0 +1,130 @@
+package gas_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/backends"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/gas"
+	gumocks "github.com/smartcontractkit/chainlink/core/services/gas/mocks"
+)
+
+// stubReturnCode returns the runtime bytecode of a contract that returns the
+// given words for any call
+func stubReturnCode(words ...*big.Int) []byte {
+	var code []byte
+	for i, word := range words {
+		code = append(code, byte(vm.PUSH32))
+		code = append(code, common.LeftPadBytes(word.Bytes(), 32)...)
+		code = append(code, byte(vm.PUSH2), 0, byte(i*32), byte(vm.MSTORE))
+	}
+	code = append(code, byte(vm.PUSH2), 0, byte(len(words)*32), byte(vm.PUSH1), 0, byte(vm.RETURN))
+	return code
+}
+
+func newArbitrumBackend(t *testing.T, nodeInterfaceCode []byte) *backends.SimulatedBackend {
+	alloc := core.GenesisAlloc{
+		// perL2Tx, perL1CalldataByte, perStorageAllocation, perArbGasBase, perArbGasCongestion, perArbGasTotal
+		gas.ArbGasInfoAddress: {Code: stubReturnCode(big.NewInt(40000), big.NewInt(1000), big.NewInt(0), big.NewInt(90), big.NewInt(10), big.NewInt(100)), Balance: big.NewInt(0)},
+	}
+	if nodeInterfaceCode != nil {
+		alloc[gas.NodeInterfaceAddress] = core.GenesisAccount{Code: nodeInterfaceCode, Balance: big.NewInt(0)}
+	}
+	backend := backends.NewSimulatedBackend(alloc, 10000000)
+	t.Cleanup(func() { backend.Close() })
+	return backend
+}
+
+func newArbitrumConfig(t *testing.T, maxGasPrice int64) *gumocks.Config {
+	config := new(gumocks.Config)
+	config.Test(t)
+	config.On("EvmGasLimitMultiplier").Maybe().Return(float32(1.5))
+	config.On("EvmMaxGasPriceWei").Maybe().Return(big.NewInt(maxGasPrice))
+	return config
+}
+
+func TestArbitrumEstimator(t *testing.T) {
+	t.Parallel()
+
+	calldata := []byte{0x00, 0x01, 0x02, 0x03}
+
+	t.Run("prices L2 execution and L1 calldata with the precompiles", func(t *testing.T) {
+		// gasEstimateForL1, baseFee, l1BaseFeeEstimate
+		backend := newArbitrumBackend(t, stubReturnCode(big.NewInt(1234), big.NewInt(100), big.NewInt(30)))
+		est := gas.NewArbitrumEstimator(logger.TestLogger(t), newArbitrumConfig(t, 1000), backend)
+		require.NoError(t, est.Start())
+		defer est.Close()
+
+		gasPrice, gasLimit, err := est.GetLegacyGas(calldata, 100000)
+		require.NoError(t, err)
+		assert.Equal(t, big.NewInt(100), gasPrice)
+		assert.Equal(t, uint64(150000+1234), gasLimit)
+
+		gasPrice, gasLimit, err = est.GetLegacyGas(calldata, 100000, gas.OptForceRefetch)
+		require.NoError(t, err)
+		assert.Equal(t, big.NewInt(100), gasPrice)
+		assert.Equal(t, uint64(150000+1234), gasLimit)
+	})
+
+	t.Run("falls back to ArbGasInfo prices without the NodeInterface", func(t *testing.T) {
+		backend := newArbitrumBackend(t, nil)
+		est := gas.NewArbitrumEstimator(logger.TestLogger(t), newArbitrumConfig(t, 1000), backend)
+		require.NoError(t, est.Start())
+		defer est.Close()
+
+		_, gasLimit, err := est.GetLegacyGas(calldata, 100000)
+		require.NoError(t, err)
+		// (40000 + 4 * 1000) / 100
+		assert.Equal(t, uint64(150000+440), gasLimit)
+	})
+
+	t.Run("caps the gas price at the max gas price", func(t *testing.T) {
+		backend := newArbitrumBackend(t, nil)
+		est := gas.NewArbitrumEstimator(logger.TestLogger(t), newArbitrumConfig(t, 50), backend)
+		require.NoError(t, est.Start())
+		defer est.Close()
+
+		gasPrice, _, err := est.GetLegacyGas(calldata, 100000)
+		require.NoError(t, err)
+		assert.Equal(t, big.NewInt(50), gasPrice)
+	})
+
+	t.Run("errors if prices could not be fetched", func(t *testing.T) {
+		client := new(gumocks.ArbitrumClient)
+		client.Test(t)
+		client.On("CallContract", mock.Anything, mock.Anything, (*big.Int)(nil)).Return(nil, errors.New("kaboom"))
+		est := gas.NewArbitrumEstimator(logger.TestLogger(t), newArbitrumConfig(t, 1000), client)
+		require.NoError(t, est.Start())
+		defer est.Close()
+
+		_, _, err := est.GetLegacyGas(calldata, 100000)
+		require.EqualError(t, err, "failed to estimate arbitrum gas; gas prices not set")
+	})
+
+	t.Run("errors if not started", func(t *testing.T) {
+		est := gas.NewArbitrumEstimator(logger.TestLogger(t), newArbitrumConfig(t, 1000), newArbitrumBackend(t, nil))
+
+		_, _, err := est.GetLegacyGas(calldata, 100000)
+		require.EqualError(t, err, "estimator is not started")
+	})
+
+	t.Run("does not support bumping or dynamic fees", func(t *testing.T) {
+		est := gas.NewArbitrumEstimator(logger.TestLogger(t), newArbitrumConfig(t, 1000), newArbitrumBackend(t, nil))
+
+		_, _, err := est.BumpLegacyGas(big.NewInt(100), 100000)
+		assert.EqualError(t, err, "bump gas is not supported for arbitrum")
+		_, _, err = est.GetDynamicFee(100000)
+		assert.EqualError(t, err, "dynamic fees are not implemented for Arbitrum")
+		_, _, err = est.BumpDynamicFee(gas.DynamicFee{}, 100000)
+		assert.EqualError(t, err, "dynamic fees are not implemented for Arbitrum")
+	})
+}
@@ -0,0 +1,334 @@
+package gas
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sort"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/eth"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+var (
+	promFeeHistoryEstimatorBaseFee = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "gas_updater_fee_history_base_fee",
+		Help: "Base fee of the next block reported by eth_feeHistory (in Wei)",
+	},
+		[]string{"evmChainID"},
+	)
+
+	promFeeHistoryEstimatorSetGasPrice = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "gas_updater_fee_history_set_gas_price",
+		Help: "Fee history estimator set gas price (in Wei)",
+	},
+		[]string{"percentile", "evmChainID"},
+	)
+
+	promFeeHistoryEstimatorSetTipCap = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "gas_updater_fee_history_set_tip_cap",
+		Help: "Fee history estimator set gas tip cap (in Wei)",
+	},
+		[]string{"percentile", "evmChainID"},
+	)
+)
+
+// FeeHistory is the result of an eth_feeHistory call.
+//
+// BaseFeePerGas has one more entry than the other fields: the last one is the
+// base fee of the block after the newest block in the range. Reward holds the
+// requested percentiles of the priority fees paid in each block, weighted by
+// gas used. Empty blocks have a gas used ratio of 0 and zero rewards.
+type FeeHistory struct {
+	OldestBlock   *hexutil.Big     `json:"oldestBlock"`
+	BaseFeePerGas []*hexutil.Big   `json:"baseFeePerGas"`
+	GasUsedRatio  []float64        `json:"gasUsedRatio"`
+	Reward        [][]*hexutil.Big `json:"reward"`
+}
+
+var _ Estimator = &FeeHistoryEstimator{}
+
+// FeeHistoryEstimator estimates gas prices from the priority fees and base
+// fees returned by eth_feeHistory. It needs a single small RPC call per head,
+// rather than downloading whole blocks like the BlockHistoryEstimator, at the
+// cost of not being able to filter out unusable transactions.
+type FeeHistoryEstimator struct {
+	utils.StartStopOnce
+	ethClient eth.Client
+	chainID   big.Int
+	config    Config
+	mb        *utils.Mailbox
+	wg        *sync.WaitGroup
+	ctx       context.Context
+	ctxCancel context.CancelFunc
+
+	gasPrice *big.Int
+	tipCap   *big.Int
+	baseFee  *big.Int
+	mu       sync.RWMutex
+
+	logger logger.Logger
+}
+
+// NewFeeHistoryEstimator returns a new FeeHistoryEstimator that listens for
+// new heads and updates the gas price and tip cap from the fee history of the
+// configured number of blocks up to each head
+func NewFeeHistoryEstimator(lggr logger.Logger, ethClient eth.Client, config Config, chainID big.Int) Estimator {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &FeeHistoryEstimator{
+		ethClient: ethClient,
+		chainID:   chainID,
+		config:    config,
+		mb:        utils.NewMailbox(1),
+		wg:        new(sync.WaitGroup),
+		ctx:       ctx,
+		ctxCancel: cancel,
+		logger:    lggr.Named("FeeHistoryEstimator"),
+	}
+}
+
+// OnNewLongestChain recalculates the gas price and tip cap when a new head
+// comes in and we are not currently fetching
+func (f *FeeHistoryEstimator) OnNewLongestChain(_ context.Context, head *eth.Head) {
+	f.mb.Deliver(head)
+}
+
+func (f *FeeHistoryEstimator) Start() error {
+	return f.StartOnce("FeeHistoryEstimator", func() error {
+		f.logger.Debugw("starting")
+
+		ctx, cancel := context.WithTimeout(f.ctx, maxStartTime)
+		defer cancel()
+		if err := f.FetchFeeHistoryAndRecalculate(ctx, nil); err != nil {
+			f.logger.Warnw("Initial fee history fetch failed", "err", err)
+		}
+		f.wg.Add(1)
+		go f.runLoop()
+		f.logger.Debugw("started")
+		return nil
+	})
+}
+
+func (f *FeeHistoryEstimator) Close() error {
+	return f.StopOnce("FeeHistoryEstimator", func() error {
+		f.ctxCancel()
+		f.wg.Wait()
+		return nil
+	})
+}
+
+func (f *FeeHistoryEstimator) runLoop() {
+	defer f.wg.Done()
+	for {
+		select {
+		case <-f.ctx.Done():
+			return
+		case <-f.mb.Notify():
+			head, exists := f.mb.Retrieve()
+			if !exists {
+				f.logger.Info("No head to retrieve. It might have been skipped")
+				continue
+			}
+			h := eth.AsHead(head)
+			if err := f.FetchFeeHistoryAndRecalculate(f.ctx, h); err != nil {
+				f.logger.Warnw("Error fetching fee history", "head", h, "err", err)
+			}
+		}
+	}
+}
+
+// FetchFeeHistoryAndRecalculate fetches the fee history up to the given head,
+// or up to the latest block if head is nil, and recalculates the prices
+func (f *FeeHistoryEstimator) FetchFeeHistoryAndRecalculate(ctx context.Context, head *eth.Head) error {
+	ctx, cancel := context.WithTimeout(ctx, maxEthNodeRequestTime)
+	defer cancel()
+
+	newest := "latest"
+	if head != nil {
+		newest = Int64ToHex(head.Number)
+	}
+	blockCount := f.config.FeeHistoryEstimatorBlockCount()
+	if blockCount == 0 {
+		return errors.New("FeeHistoryEstimator: block count must be > 0")
+	}
+	percentile := f.config.FeeHistoryEstimatorRewardPercentile()
+
+	var history FeeHistory
+	err := f.ethClient.CallContext(ctx, &history, "eth_feeHistory", hexutil.Uint(blockCount), newest, []float64{float64(percentile)})
+	if err != nil {
+		return errors.Wrap(err, "FeeHistoryEstimator#FetchFeeHistoryAndRecalculate error calling eth_feeHistory")
+	}
+	return f.Recalculate(history)
+}
+
+// Recalculate sets the gas price and tip cap from the given fee history.
+//
+// The tip cap is the configured percentile of the rewards of the non-empty
+// blocks in the history. The base fee is that of the next block, plus the
+// largest increase allowed in a single block (12.5%) if the history shows the
+// base fee trending up, i.e. blocks are on average more than half full. The
+// legacy gas price is the sum of the two.
+func (f *FeeHistoryEstimator) Recalculate(history FeeHistory) error {
+	if len(history.BaseFeePerGas) == 0 {
+		return errors.New("fee history is missing baseFeePerGas")
+	}
+	if len(history.Reward) != len(history.GasUsedRatio) {
+		return errors.Errorf("fee history has %d rewards for %d blocks", len(history.Reward), len(history.GasUsedRatio))
+	}
+	percentile := int(f.config.FeeHistoryEstimatorRewardPercentile())
+
+	var rewards []*big.Int
+	var totalGasUsedRatio float64
+	for i, ratio := range history.GasUsedRatio {
+		totalGasUsedRatio += ratio
+		if ratio == 0 || len(history.Reward[i]) == 0 || history.Reward[i][0] == nil {
+			continue
+		}
+		rewards = append(rewards, history.Reward[i][0].ToInt())
+	}
+	tipCap := big.NewInt(0)
+	if len(rewards) > 0 {
+		sort.Slice(rewards, func(i, j int) bool { return rewards[i].Cmp(rewards[j]) < 0 })
+		tipCap = rewards[((len(rewards)-1)*percentile)/100]
+	} else {
+		f.logger.Debug("No non-empty blocks in fee history, using the minimum tip cap")
+	}
+
+	nextBaseFee := history.BaseFeePerGas[len(history.BaseFeePerGas)-1]
+	if nextBaseFee == nil {
+		return errors.New("fee history is missing the next block's baseFeePerGas")
+	}
+	baseFee := new(big.Int).Set(nextBaseFee.ToInt())
+	if n := len(history.GasUsedRatio); n > 0 && totalGasUsedRatio/float64(n) > 0.5 {
+		baseFee.Add(baseFee, new(big.Int).Div(baseFee, big.NewInt(8)))
+	}
+
+	tipCap = f.setTipCap(tipCap)
+	gasPrice := f.setGasPrice(new(big.Int).Add(baseFee, tipCap), baseFee)
+
+	var oldestBlock *big.Int
+	if history.OldestBlock != nil {
+		oldestBlock = history.OldestBlock.ToInt()
+	}
+	f.logger.Debugw(fmt.Sprintf("Setting new default prices, GasPrice: %v Wei, TipCap: %v Wei", gasPrice, tipCap),
+		"gasPriceWei", gasPrice,
+		"tipCapWei", tipCap,
+		"baseFeeWei", baseFee,
+		"nextBaseFeeWei", nextBaseFee.ToInt(),
+		"maxGasPriceWei", f.config.EvmMaxGasPriceWei(),
+		"oldestBlock", oldestBlock,
+		"blocks", len(history.GasUsedRatio),
+	)
+	promFeeHistoryEstimatorBaseFee.WithLabelValues(f.chainID.String()).Set(float64(nextBaseFee.ToInt().Int64()))
+	promFeeHistoryEstimatorSetGasPrice.WithLabelValues(fmt.Sprintf("%v%%", percentile), f.chainID.String()).Set(float64(gasPrice.Int64()))
+	promFeeHistoryEstimatorSetTipCap.WithLabelValues(fmt.Sprintf("%v%%", percentile), f.chainID.String()).Set(float64(tipCap.Int64()))
+	return nil
+}
+
+func (f *FeeHistoryEstimator) setTipCap(tipCap *big.Int) *big.Int {
+	min := f.config.EvmGasTipCapMinimum()
+	max := f.config.EvmMaxGasPriceWei()
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if tipCap.Cmp(min) < 0 {
+		f.logger.Debugw(fmt.Sprintf("Calculated gas tip cap of %s Wei falls below EVM_GAS_TIP_CAP_MINIMUM=%[2]s, setting gas tip cap to the minimum allowed value of %[2]s Wei instead", tipCap.String(), min.String()), "tipCapWei", tipCap, "minTipCapWei", min)
+		f.tipCap = min
+	} else if tipCap.Cmp(max) > 0 {
+		f.logger.Warnw(fmt.Sprintf("Calculated gas tip cap of %s Wei exceeds ETH_MAX_GAS_PRICE_WEI=%[2]s, setting gas tip cap to the maximum allowed value of %[2]s Wei instead", tipCap.String(), max.String()), "tipCapWei", tipCap, "maxGasPriceWei", max)
+		f.tipCap = max
+	} else {
+		f.tipCap = tipCap
+	}
+	return f.tipCap
+}
+
+func (f *FeeHistoryEstimator) setGasPrice(gasPrice, baseFee *big.Int) *big.Int {
+	max := f.config.EvmMaxGasPriceWei()
+	min := f.config.EvmMinGasPriceWei()
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.baseFee = baseFee
+	if gasPrice.Cmp(max) > 0 {
+		f.logger.Warnw(fmt.Sprintf("Calculated gas price of %s Wei exceeds ETH_MAX_GAS_PRICE_WEI=%[2]s, setting gas price to the maximum allowed value of %[2]s Wei instead", gasPrice.String(), max.String()), "gasPriceWei", gasPrice, "maxGasPriceWei", max)
+		f.gasPrice = max
+	} else if gasPrice.Cmp(min) < 0 {
+		f.logger.Warnw(fmt.Sprintf("Calculated gas price of %s Wei falls below ETH_MIN_GAS_PRICE_WEI=%[2]s, setting gas price to the minimum allowed value of %[2]s Wei instead", gasPrice.String(), min.String()), "gasPriceWei", gasPrice, "minGasPriceWei", min)
+		f.gasPrice = min
+	} else {
+		f.gasPrice = gasPrice
+	}
+	return f.gasPrice
+}
+
+func (f *FeeHistoryEstimator) getGasPrice() *big.Int {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	return f.gasPrice
+}
+
+func (f *FeeHistoryEstimator) getTipCapAndBaseFee() (tipCap, baseFee *big.Int) {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	return f.tipCap, f.baseFee
+}
+
+func (f *FeeHistoryEstimator) GetLegacyGas(_ []byte, gasLimit uint64, _ ...Opt) (gasPrice *big.Int, chainSpecificGasLimit uint64, err error) {
+	ok := f.IfStarted(func() {
+		chainSpecificGasLimit = applyMultiplier(gasLimit, f.config.EvmGasLimitMultiplier())
+		gasPrice = f.getGasPrice()
+	})
+	if !ok {
+		return nil, 0, errors.New("FeeHistoryEstimator is not started; cannot estimate gas")
+	}
+	if gasPrice == nil {
+		return nil, 0, errors.New("FeeHistoryEstimator has not finished the first gas estimation yet, likely because a failure on start")
+	}
+	return
+}
+
+func (f *FeeHistoryEstimator) BumpLegacyGas(originalGasPrice *big.Int, gasLimit uint64) (bumpedGasPrice *big.Int, chainSpecificGasLimit uint64, err error) {
+	return BumpLegacyGasPriceOnly(f.config, f.logger, f.getGasPrice(), originalGasPrice, gasLimit)
+}
+
+// GetDynamicFee returns the estimated tip cap with a fee cap of twice the
+// estimated base fee plus the tip cap, which keeps the transaction includable
+// through several consecutive full blocks. The fee cap never exceeds
+// ETH_MAX_GAS_PRICE_WEI.
+func (f *FeeHistoryEstimator) GetDynamicFee(gasLimit uint64) (fee DynamicFee, chainSpecificGasLimit uint64, err error) {
+	if !f.config.EvmEIP1559DynamicFees() {
+		return fee, 0, errors.New("Can't get dynamic fee, EIP1559 is disabled")
+	}
+	var tipCap, baseFee *big.Int
+	ok := f.IfStarted(func() {
+		chainSpecificGasLimit = applyMultiplier(gasLimit, f.config.EvmGasLimitMultiplier())
+		tipCap, baseFee = f.getTipCapAndBaseFee()
+	})
+	if !ok {
+		return fee, 0, errors.New("FeeHistoryEstimator is not started; cannot estimate gas")
+	}
+	if tipCap == nil || baseFee == nil {
+		return fee, 0, errors.New("FeeHistoryEstimator has not finished the first gas estimation yet, likely because a failure on start")
+	}
+	feeCap := new(big.Int).Mul(baseFee, big.NewInt(2))
+	feeCap.Add(feeCap, tipCap)
+	if max := f.config.EvmMaxGasPriceWei(); feeCap.Cmp(max) > 0 {
+		feeCap = max
+	}
+	fee.FeeCap = feeCap
+	fee.TipCap = tipCap
+	return
+}
+
+func (f *FeeHistoryEstimator) BumpDynamicFee(originalFee DynamicFee, originalGasLimit uint64) (bumped DynamicFee, chainSpecificGasLimit uint64, err error) {
+	tipCap, _ := f.getTipCapAndBaseFee()
+	return BumpDynamicFeeOnly(f.config, f.logger, tipCap, originalFee, originalGasLimit)
+}
@@ -0,0 +1,328 @@
+package gas_test
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/eth"
+	"github.com/smartcontractkit/chainlink/core/services/gas"
+	gumocks "github.com/smartcontractkit/chainlink/core/services/gas/mocks"
+)
+
+func newFeeHistoryEstimator(t *testing.T, c eth.Client, cfg gas.Config) *gas.FeeHistoryEstimator {
+	iface := gas.NewFeeHistoryEstimator(logger.TestLogger(t), c, cfg, cltest.FixtureChainID)
+	return gas.FeeHistoryEstimatorFromInterface(iface)
+}
+
+func newFeeHistoryConfig(t *testing.T, eip1559 bool) *gumocks.Config {
+	config := new(gumocks.Config)
+	config.Test(t)
+	config.On("EvmEIP1559DynamicFees").Maybe().Return(eip1559)
+	config.On("FeeHistoryEstimatorBlockCount").Maybe().Return(uint16(4))
+	config.On("FeeHistoryEstimatorRewardPercentile").Maybe().Return(uint16(50))
+	config.On("EvmGasLimitMultiplier").Maybe().Return(float32(1))
+	config.On("EvmGasTipCapMinimum").Maybe().Return(big.NewInt(1))
+	config.On("EvmMinGasPriceWei").Maybe().Return(big.NewInt(10))
+	config.On("EvmMaxGasPriceWei").Maybe().Return(big.NewInt(100000))
+	return config
+}
+
+func hexBigs(vals ...int64) []*hexutil.Big {
+	bigs := make([]*hexutil.Big, len(vals))
+	for i, val := range vals {
+		bigs[i] = (*hexutil.Big)(big.NewInt(val))
+	}
+	return bigs
+}
+
+func TestFeeHistoryEstimator_Start(t *testing.T) {
+	t.Parallel()
+
+	config := newFeeHistoryConfig(t, true)
+
+	t.Run("loads initial state from the latest block", func(t *testing.T) {
+		ethClient := cltest.NewEthClientMockWithDefaultChain(t)
+		fhe := newFeeHistoryEstimator(t, ethClient, config)
+
+		ethClient.On("CallContext", mock.Anything, mock.AnythingOfType("*gas.FeeHistory"), "eth_feeHistory", hexutil.Uint(4), "latest", []float64{50}).
+			Return(nil).Run(func(args mock.Arguments) {
+			history := args.Get(1).(*gas.FeeHistory)
+			history.OldestBlock = (*hexutil.Big)(big.NewInt(39))
+			history.BaseFeePerGas = hexBigs(100, 100, 100, 100, 100)
+			history.GasUsedRatio = []float64{0.5, 0.5, 0.5, 0.5}
+			history.Reward = [][]*hexutil.Big{hexBigs(5), hexBigs(5), hexBigs(5), hexBigs(5)}
+		})
+
+		require.NoError(t, fhe.Start())
+		defer fhe.Close()
+
+		gasPrice, _, err := fhe.GetLegacyGas(nil, 21000)
+		require.NoError(t, err)
+		assert.Equal(t, big.NewInt(105), gasPrice)
+
+		ethClient.AssertExpectations(t)
+	})
+
+	t.Run("boots even if the initial fetch fails", func(t *testing.T) {
+		ethClient := cltest.NewEthClientMockWithDefaultChain(t)
+		fhe := newFeeHistoryEstimator(t, ethClient, config)
+
+		ethClient.On("CallContext", mock.Anything, mock.Anything, "eth_feeHistory", mock.Anything, mock.Anything, mock.Anything).
+			Return(errors.New("method not found"))
+
+		require.NoError(t, fhe.Start())
+		defer fhe.Close()
+
+		_, _, err := fhe.GetLegacyGas(nil, 21000)
+		require.EqualError(t, err, "FeeHistoryEstimator has not finished the first gas estimation yet, likely because a failure on start")
+		_, _, err = fhe.GetDynamicFee(21000)
+		require.EqualError(t, err, "FeeHistoryEstimator has not finished the first gas estimation yet, likely because a failure on start")
+
+		ethClient.AssertExpectations(t)
+	})
+}
+
+func TestFeeHistoryEstimator_FetchFeeHistoryAndRecalculate(t *testing.T) {
+	t.Parallel()
+
+	config := newFeeHistoryConfig(t, true)
+	ethClient := cltest.NewEthClientMockWithDefaultChain(t)
+	fhe := newFeeHistoryEstimator(t, ethClient, config)
+
+	ethClient.On("CallContext", mock.Anything, mock.AnythingOfType("*gas.FeeHistory"), "eth_feeHistory", hexutil.Uint(4), "0x2a", []float64{50}).
+		Return(nil).Run(func(args mock.Arguments) {
+		history := args.Get(1).(*gas.FeeHistory)
+		history.BaseFeePerGas = hexBigs(80, 90, 100, 110, 120)
+		history.GasUsedRatio = []float64{0.9, 0.9, 0.9, 0.9}
+		history.Reward = [][]*hexutil.Big{hexBigs(1), hexBigs(2), hexBigs(3), hexBigs(4)}
+	})
+
+	require.NoError(t, fhe.FetchFeeHistoryAndRecalculate(context.Background(), &eth.Head{Number: 42}))
+
+	gasPrice, tipCap, baseFee := gas.GetFeeHistoryPrices(fhe)
+	// Blocks are more than half full, so the next base fee of 120 is padded by 12.5%
+	assert.Equal(t, big.NewInt(135), baseFee)
+	assert.Equal(t, big.NewInt(2), tipCap)
+	assert.Equal(t, big.NewInt(137), gasPrice)
+
+	ethClient.AssertExpectations(t)
+}
+
+func TestFeeHistoryEstimator_Recalculate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name             string
+		history          gas.FeeHistory
+		expectedGasPrice *big.Int
+		expectedTipCap   *big.Int
+		expectedBaseFee  *big.Int
+		expectedErr      string
+	}{
+		{
+			name: "takes the percentile of the rewards",
+			history: gas.FeeHistory{
+				BaseFeePerGas: hexBigs(50, 50, 50, 50, 50),
+				GasUsedRatio:  []float64{0.5, 0.2, 0.3, 0.4},
+				Reward:        [][]*hexutil.Big{hexBigs(40), hexBigs(10), hexBigs(30), hexBigs(20)},
+			},
+			expectedGasPrice: big.NewInt(70),
+			expectedTipCap:   big.NewInt(20),
+			expectedBaseFee:  big.NewInt(50),
+		},
+		{
+			name: "ignores empty blocks",
+			history: gas.FeeHistory{
+				BaseFeePerGas: hexBigs(50, 50, 50, 50, 50),
+				GasUsedRatio:  []float64{0, 0.4, 0, 0.4},
+				Reward:        [][]*hexutil.Big{hexBigs(0), hexBigs(30), hexBigs(0), hexBigs(30)},
+			},
+			expectedGasPrice: big.NewInt(80),
+			expectedTipCap:   big.NewInt(30),
+			expectedBaseFee:  big.NewInt(50),
+		},
+		{
+			name: "uses the minimum tip cap if all blocks are empty",
+			history: gas.FeeHistory{
+				BaseFeePerGas: hexBigs(50, 50, 50),
+				GasUsedRatio:  []float64{0, 0},
+				Reward:        [][]*hexutil.Big{hexBigs(0), hexBigs(0)},
+			},
+			expectedGasPrice: big.NewInt(51),
+			expectedTipCap:   big.NewInt(1),
+			expectedBaseFee:  big.NewInt(50),
+		},
+		{
+			name: "clamps the gas price to the minimum",
+			history: gas.FeeHistory{
+				BaseFeePerGas: hexBigs(0, 0),
+				GasUsedRatio:  []float64{0.4},
+				Reward:        [][]*hexutil.Big{hexBigs(5)},
+			},
+			expectedGasPrice: big.NewInt(10),
+			expectedTipCap:   big.NewInt(5),
+			expectedBaseFee:  big.NewInt(0),
+		},
+		{
+			name: "clamps the gas price and tip cap to the maximum",
+			history: gas.FeeHistory{
+				BaseFeePerGas: hexBigs(50, 50),
+				GasUsedRatio:  []float64{0.4},
+				Reward:        [][]*hexutil.Big{hexBigs(200000)},
+			},
+			expectedGasPrice: big.NewInt(100000),
+			expectedTipCap:   big.NewInt(100000),
+			expectedBaseFee:  big.NewInt(50),
+		},
+		{
+			name:        "errors without base fees",
+			history:     gas.FeeHistory{},
+			expectedErr: "fee history is missing baseFeePerGas",
+		},
+		{
+			name: "errors on mismatched rewards",
+			history: gas.FeeHistory{
+				BaseFeePerGas: hexBigs(50, 50),
+				GasUsedRatio:  []float64{0.4},
+			},
+			expectedErr: "fee history has 0 rewards for 1 blocks",
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			config := newFeeHistoryConfig(t, true)
+			fhe := newFeeHistoryEstimator(t, cltest.NewEthClientMockWithDefaultChain(t), config)
+
+			err := fhe.Recalculate(test.history)
+			if test.expectedErr != "" {
+				require.EqualError(t, err, test.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+
+			gasPrice, tipCap, baseFee := gas.GetFeeHistoryPrices(fhe)
+			assert.Equal(t, test.expectedGasPrice, gasPrice)
+			assert.Equal(t, test.expectedTipCap, tipCap)
+			assert.Equal(t, test.expectedBaseFee, baseFee)
+		})
+	}
+}
+
+func TestFeeHistoryEstimator_GetDynamicFee(t *testing.T) {
+	t.Parallel()
+
+	history := gas.FeeHistory{
+		BaseFeePerGas: hexBigs(100, 100),
+		GasUsedRatio:  []float64{0.5},
+		Reward:        [][]*hexutil.Big{hexBigs(7)},
+	}
+
+	t.Run("caps the fee at twice the base fee plus the tip", func(t *testing.T) {
+		config := newFeeHistoryConfig(t, true)
+		ethClient := cltest.NewEthClientMockWithDefaultChain(t)
+		ethClient.On("CallContext", mock.Anything, mock.Anything, "eth_feeHistory", mock.Anything, mock.Anything, mock.Anything).Return(errors.New("not yet"))
+		fhe := newFeeHistoryEstimator(t, ethClient, config)
+		require.NoError(t, fhe.Start())
+		defer fhe.Close()
+		require.NoError(t, fhe.Recalculate(history))
+
+		fee, limit, err := fhe.GetDynamicFee(21000)
+		require.NoError(t, err)
+		assert.Equal(t, uint64(21000), limit)
+		assert.Equal(t, big.NewInt(7), fee.TipCap)
+		assert.Equal(t, big.NewInt(207), fee.FeeCap)
+	})
+
+	t.Run("fee cap never exceeds the max gas price", func(t *testing.T) {
+		config := new(gumocks.Config)
+		config.Test(t)
+		config.On("EvmEIP1559DynamicFees").Return(true)
+		config.On("FeeHistoryEstimatorRewardPercentile").Return(uint16(50))
+		config.On("FeeHistoryEstimatorBlockCount").Return(uint16(4))
+		config.On("EvmGasLimitMultiplier").Return(float32(1))
+		config.On("EvmGasTipCapMinimum").Return(big.NewInt(1))
+		config.On("EvmMinGasPriceWei").Return(big.NewInt(1))
+		config.On("EvmMaxGasPriceWei").Return(big.NewInt(150))
+		ethClient := cltest.NewEthClientMockWithDefaultChain(t)
+		ethClient.On("CallContext", mock.Anything, mock.Anything, "eth_feeHistory", mock.Anything, mock.Anything, mock.Anything).Return(errors.New("not yet"))
+		fhe := newFeeHistoryEstimator(t, ethClient, config)
+		require.NoError(t, fhe.Start())
+		defer fhe.Close()
+		require.NoError(t, fhe.Recalculate(history))
+
+		fee, _, err := fhe.GetDynamicFee(21000)
+		require.NoError(t, err)
+		assert.Equal(t, big.NewInt(150), fee.FeeCap)
+	})
+
+	t.Run("errors if EIP1559 is disabled", func(t *testing.T) {
+		config := newFeeHistoryConfig(t, false)
+		fhe := newFeeHistoryEstimator(t, cltest.NewEthClientMockWithDefaultChain(t), config)
+
+		_, _, err := fhe.GetDynamicFee(21000)
+		require.EqualError(t, err, "Can't get dynamic fee, EIP1559 is disabled")
+	})
+
+	t.Run("errors if not started", func(t *testing.T) {
+		config := newFeeHistoryConfig(t, true)
+		fhe := newFeeHistoryEstimator(t, cltest.NewEthClientMockWithDefaultChain(t), config)
+		require.NoError(t, fhe.Recalculate(history))
+
+		_, _, err := fhe.GetDynamicFee(21000)
+		require.EqualError(t, err, "FeeHistoryEstimator is not started; cannot estimate gas")
+	})
+}
+
+func TestFeeHistoryEstimator_Bumps(t *testing.T) {
+	t.Parallel()
+
+	config := newFeeHistoryConfig(t, true)
+	config.On("EvmGasBumpPercent").Return(uint16(10))
+	config.On("EvmGasBumpWei").Return(big.NewInt(150))
+	config.On("EvmGasTipCapDefault").Return(big.NewInt(0))
+	fhe := newFeeHistoryEstimator(t, cltest.NewEthClientMockWithDefaultChain(t), config)
+	require.NoError(t, fhe.Recalculate(gas.FeeHistory{
+		BaseFeePerGas: hexBigs(1000, 1000),
+		GasUsedRatio:  []float64{0.5},
+		Reward:        [][]*hexutil.Big{hexBigs(500)},
+	}))
+
+	t.Run("BumpLegacyGas uses the current gas price if it is higher than the bump", func(t *testing.T) {
+		bumped, limit, err := fhe.BumpLegacyGas(big.NewInt(1000), 21000)
+		require.NoError(t, err)
+		assert.Equal(t, uint64(21000), limit)
+		assert.Equal(t, big.NewInt(1500), bumped)
+	})
+
+	t.Run("BumpLegacyGas bumps the original gas price", func(t *testing.T) {
+		bumped, _, err := fhe.BumpLegacyGas(big.NewInt(10000), 21000)
+		require.NoError(t, err)
+		assert.Equal(t, big.NewInt(11000), bumped)
+	})
+
+	t.Run("BumpDynamicFee uses the current tip cap if it is higher than the bump", func(t *testing.T) {
+		bumped, _, err := fhe.BumpDynamicFee(gas.DynamicFee{TipCap: big.NewInt(100), FeeCap: big.NewInt(2100)}, 21000)
+		require.NoError(t, err)
+		assert.Equal(t, big.NewInt(500), bumped.TipCap)
+		assert.Equal(t, big.NewInt(100000), bumped.FeeCap)
+	})
+
+	t.Run("BumpDynamicFee errors if the bump exceeds the max gas price", func(t *testing.T) {
+		_, _, err := fhe.BumpDynamicFee(gas.DynamicFee{TipCap: big.NewInt(99999), FeeCap: big.NewInt(100000)}, 21000)
+		require.Error(t, err)
+		assert.True(t, gas.IsBumpErr(err))
+	})
+}
@@ -33,3 +33,13 @@ func GetTipCap(b *BlockHistoryEstimator) *big.Int {
 	defer b.mu.RUnlock()
 	return b.tipCap
 }
+
+func FeeHistoryEstimatorFromInterface(fhe Estimator) *FeeHistoryEstimator {
+	return fhe.(*FeeHistoryEstimator)
+}
+
+func GetFeeHistoryPrices(f *FeeHistoryEstimator) (gasPrice, tipCap, baseFee *big.Int) {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	return f.gasPrice, f.tipCap, f.baseFee
+}
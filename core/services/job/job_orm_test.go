@@ -3,12 +3,15 @@ package job_test
 import (
 	"context"
 	"database/sql"
+	"fmt"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/smartcontractkit/chainlink/core/bridges"
 
 	"github.com/pelletier/go-toml"
+	"github.com/pkg/errors"
 	uuid "github.com/satori/go.uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -18,10 +21,13 @@ import (
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/evmtest"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
 	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/blocktrigger"
 	"github.com/smartcontractkit/chainlink/core/services/directrequest"
+	"github.com/smartcontractkit/chainlink/core/services/eventtrigger"
 	"github.com/smartcontractkit/chainlink/core/services/job"
 	"github.com/smartcontractkit/chainlink/core/services/keeper"
 	"github.com/smartcontractkit/chainlink/core/services/offchainreporting"
+	"github.com/smartcontractkit/chainlink/core/services/offchainreporting2"
 	"github.com/smartcontractkit/chainlink/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/core/services/vrf"
 	"github.com/smartcontractkit/chainlink/core/services/webhook"
@@ -263,6 +269,69 @@ func TestORM_DeleteJob_DeletesAssociatedRecords(t *testing.T) {
 		cltest.AssertCount(t, db, "jobs", 0)
 	})
 
+	t.Run("it creates and deletes records for eventtrigger jobs", func(t *testing.T) {
+		jb, err := eventtrigger.ValidatedEventTriggerSpec(`
+type              = "eventtrigger"
+schemaVersion     = 1
+contractAddress   = "0x613a38AC1659769640aaE063C651F48E0250454C"
+eventABI          = "Transfer(address indexed from, address indexed to, uint256 value)"
+observationSource = """
+ds [type=http method=GET url="https://example.com"];
+"""
+
+[indexedFilters]
+to = ["0x3cCad4715152693fE3BC4460591e3D3Fbd071b42"]
+`)
+		require.NoError(t, err)
+
+		err = jobORM.CreateJob(&jb)
+		require.NoError(t, err)
+		cltest.AssertCount(t, db, "event_trigger_specs", 1)
+		cltest.AssertCount(t, db, "jobs", 1)
+
+		loaded, err := jobORM.FindJob(context.Background(), jb.ID)
+		require.NoError(t, err)
+		require.NotNil(t, loaded.EventTriggerSpec)
+		assert.Equal(t, jb.EventTriggerSpec.EventABI, loaded.EventTriggerSpec.EventABI)
+		assert.Equal(t, jb.EventTriggerSpec.IndexedFilters, loaded.EventTriggerSpec.IndexedFilters)
+
+		err = jobORM.DeleteJob(jb.ID)
+		require.NoError(t, err)
+		cltest.AssertCount(t, db, "event_trigger_specs", 0)
+		cltest.AssertCount(t, db, "jobs", 0)
+	})
+
+	t.Run("it creates and deletes records for blocktrigger jobs", func(t *testing.T) {
+		jb, err := blocktrigger.ValidatedBlockTriggerSpec(`
+type              = "blocktrigger"
+schemaVersion     = 1
+blockInterval     = 10
+confirmations     = 3
+runMissedBlocks   = true
+observationSource = """
+ds [type=http method=GET url="https://example.com/$(jobRun.blockNumber)"];
+"""
+`)
+		require.NoError(t, err)
+
+		err = jobORM.CreateJob(&jb)
+		require.NoError(t, err)
+		cltest.AssertCount(t, db, "block_trigger_specs", 1)
+		cltest.AssertCount(t, db, "jobs", 1)
+
+		loaded, err := jobORM.FindJob(context.Background(), jb.ID)
+		require.NoError(t, err)
+		require.NotNil(t, loaded.BlockTriggerSpec)
+		assert.Equal(t, uint32(10), loaded.BlockTriggerSpec.BlockInterval)
+		assert.Equal(t, uint32(3), loaded.BlockTriggerSpec.Confirmations)
+		assert.True(t, loaded.BlockTriggerSpec.RunMissedBlocks)
+
+		err = jobORM.DeleteJob(jb.ID)
+		require.NoError(t, err)
+		cltest.AssertCount(t, db, "block_trigger_specs", 0)
+		cltest.AssertCount(t, db, "jobs", 0)
+	})
+
 	t.Run("it deletes records for webhook jobs", func(t *testing.T) {
 		ei := cltest.MustInsertExternalInitiator(t, bridges.NewORM(db, logger.TestLogger(t), config))
 		jb, webhookSpec := cltest.MustInsertWebhookSpec(t, db)
@@ -331,6 +400,146 @@ func TestORM_CreateJob_VRFV2(t *testing.T) {
 	cltest.AssertCount(t, db, "jobs", 0)
 }
 
+func TestORM_CreateJob_Keeper(t *testing.T) {
+	config := evmtest.NewChainScopedConfig(t, cltest.NewTestGeneralConfig(t))
+	db := pgtest.NewSqlxDB(t)
+	keyStore := cltest.NewKeyStore(t, db, config)
+	_, fromAddress := cltest.MustInsertRandomKey(t, keyStore.Eth())
+
+	pipelineORM := pipeline.NewORM(db, logger.TestLogger(t), config)
+	cc := evmtest.NewChainSet(t, evmtest.TestChainOpts{DB: db, GeneralConfig: config})
+	jobORM := job.NewTestORM(t, db, cc, pipelineORM, keyStore, config)
+
+	jb, err := keeper.ValidatedKeeperSpec(testspecs.GenerateKeeperSpec(testspecs.KeeperSpecParams{
+		ContractAddress: cltest.NewEIP55Address().String(),
+		FromAddress:     fromAddress.Hex(),
+	}).Toml())
+	require.NoError(t, err)
+	confirmations := uint32(7)
+	jb.KeeperSpec.MinIncomingConfirmations = &confirmations
+
+	require.NoError(t, jobORM.CreateJob(&jb))
+
+	found, err := jobORM.FindJob(context.Background(), jb.ID)
+	require.NoError(t, err)
+	require.NotNil(t, found.KeeperSpec.MinIncomingConfirmations)
+	assert.Equal(t, uint32(7), *found.KeeperSpec.MinIncomingConfirmations)
+}
+
+func TestORM_CreateJob_OCR2(t *testing.T) {
+	config := evmtest.NewChainScopedConfig(t, cltest.NewTestGeneralConfig(t))
+	db := pgtest.NewSqlxDB(t)
+	keyStore := cltest.NewKeyStore(t, db, config)
+
+	pipelineORM := pipeline.NewORM(db, logger.TestLogger(t), config)
+	cc := evmtest.NewChainSet(t, evmtest.TestChainOpts{DB: db, GeneralConfig: config})
+	jobORM := job.NewTestORM(t, db, cc, pipelineORM, keyStore, config)
+
+	jb, err := offchainreporting2.ValidatedOracleSpecToml(cc, `
+type               = "offchainreporting2"
+schemaVersion      = 1
+name               = "boot"
+contractAddress    = "0x613a38AC1659769640aaE063C651F48E0250454C"
+isBootstrapPeer    = true
+monitoringEndpoint = "chain.link:4321"
+`)
+	require.NoError(t, err)
+
+	require.NoError(t, jobORM.CreateJob(&jb))
+
+	found, err := jobORM.FindJob(context.Background(), jb.ID)
+	require.NoError(t, err)
+	assert.Equal(t, null.StringFrom("chain.link:4321"), found.Offchainreporting2OracleSpec.MonitoringEndpoint)
+}
+
+func TestORM_CreateJob_PrivateRelay(t *testing.T) {
+	t.Run("rejects jobs enabling the private relay without a relay URL", func(t *testing.T) {
+		config := evmtest.NewChainScopedConfig(t, cltest.NewTestGeneralConfig(t))
+		db := pgtest.NewSqlxDB(t)
+		keyStore := cltest.NewKeyStore(t, db, config)
+
+		pipelineORM := pipeline.NewORM(db, logger.TestLogger(t), config)
+		cc := evmtest.NewChainSet(t, evmtest.TestChainOpts{DB: db, GeneralConfig: config})
+		jobORM := job.NewTestORM(t, db, cc, pipelineORM, keyStore, config)
+
+		jb, err := vrf.ValidatedVRFSpec(testspecs.GenerateVRFSpec(testspecs.VRFSpecParams{}).Toml())
+		require.NoError(t, err)
+		jb.PrivateRelay = null.BoolFrom(true)
+
+		err = jobORM.CreateJob(&jb)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "privateRelay is enabled but EVM_PRIVATE_RELAY_URL is not set")
+		cltest.AssertCount(t, db, "jobs", 0)
+
+		jb.PrivateRelay = null.BoolFrom(false)
+		require.NoError(t, jobORM.CreateJob(&jb))
+		cltest.AssertCount(t, db, "jobs", 1)
+	})
+
+	t.Run("accepts jobs enabling the private relay with a relay URL", func(t *testing.T) {
+		gcfg := cltest.NewTestGeneralConfig(t)
+		gcfg.Overrides.GlobalEvmPrivateRelayURL = null.StringFrom("http://relay.example.com")
+		config := evmtest.NewChainScopedConfig(t, gcfg)
+		db := pgtest.NewSqlxDB(t)
+		keyStore := cltest.NewKeyStore(t, db, config)
+
+		pipelineORM := pipeline.NewORM(db, logger.TestLogger(t), config)
+		cc := evmtest.NewChainSet(t, evmtest.TestChainOpts{DB: db, GeneralConfig: gcfg})
+		jobORM := job.NewTestORM(t, db, cc, pipelineORM, keyStore, config)
+
+		jb, err := vrf.ValidatedVRFSpec(testspecs.GenerateVRFSpec(testspecs.VRFSpecParams{}).Toml())
+		require.NoError(t, err)
+		jb.PrivateRelay = null.BoolFrom(true)
+
+		require.NoError(t, jobORM.CreateJob(&jb))
+		cltest.AssertCount(t, db, "jobs", 1)
+	})
+}
+
+func TestORM_CreateJob_GenerateAccessLists(t *testing.T) {
+	t.Run("rejects jobs generating access lists without EIP-1559 transactions", func(t *testing.T) {
+		config := evmtest.NewChainScopedConfig(t, cltest.NewTestGeneralConfig(t))
+		db := pgtest.NewSqlxDB(t)
+		keyStore := cltest.NewKeyStore(t, db, config)
+
+		pipelineORM := pipeline.NewORM(db, logger.TestLogger(t), config)
+		cc := evmtest.NewChainSet(t, evmtest.TestChainOpts{DB: db, GeneralConfig: config})
+		jobORM := job.NewTestORM(t, db, cc, pipelineORM, keyStore, config)
+
+		jb, err := vrf.ValidatedVRFSpec(testspecs.GenerateVRFSpec(testspecs.VRFSpecParams{}).Toml())
+		require.NoError(t, err)
+		jb.GenerateAccessLists = null.BoolFrom(true)
+
+		err = jobORM.CreateJob(&jb)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "generateAccessLists is enabled but EVM_EIP1559_DYNAMIC_FEES is not set")
+		cltest.AssertCount(t, db, "jobs", 0)
+
+		jb.GenerateAccessLists = null.BoolFrom(false)
+		require.NoError(t, jobORM.CreateJob(&jb))
+		cltest.AssertCount(t, db, "jobs", 1)
+	})
+
+	t.Run("accepts jobs generating access lists with EIP-1559 transactions", func(t *testing.T) {
+		gcfg := cltest.NewTestGeneralConfig(t)
+		gcfg.Overrides.GlobalEvmEIP1559DynamicFees = null.BoolFrom(true)
+		config := evmtest.NewChainScopedConfig(t, gcfg)
+		db := pgtest.NewSqlxDB(t)
+		keyStore := cltest.NewKeyStore(t, db, config)
+
+		pipelineORM := pipeline.NewORM(db, logger.TestLogger(t), config)
+		cc := evmtest.NewChainSet(t, evmtest.TestChainOpts{DB: db, GeneralConfig: gcfg})
+		jobORM := job.NewTestORM(t, db, cc, pipelineORM, keyStore, config)
+
+		jb, err := vrf.ValidatedVRFSpec(testspecs.GenerateVRFSpec(testspecs.VRFSpecParams{}).Toml())
+		require.NoError(t, err)
+		jb.GenerateAccessLists = null.BoolFrom(true)
+
+		require.NoError(t, jobORM.CreateJob(&jb))
+		cltest.AssertCount(t, db, "jobs", 1)
+	})
+}
+
 func Test_FindJobs(t *testing.T) {
 	t.Parallel()
 
@@ -495,6 +704,116 @@ func Test_FindJobsByPipelineSpecIDs(t *testing.T) {
 	})
 }
 
+func Test_UpdateJob(t *testing.T) {
+	t.Parallel()
+
+	config := cltest.NewTestGeneralConfig(t)
+	db := pgtest.NewSqlxDB(t)
+	keyStore := cltest.NewKeyStore(t, db, config)
+
+	pipelineORM := pipeline.NewORM(db, logger.TestLogger(t), config)
+	cc := evmtest.NewChainSet(t, evmtest.TestChainOpts{DB: db, GeneralConfig: config})
+	orm := job.NewTestORM(t, db, cc, pipelineORM, keyStore, config)
+
+	jb, err := directrequest.ValidatedDirectRequestSpec(testspecs.DirectRequestSpec)
+	require.NoError(t, err)
+
+	err = orm.CreateJob(&jb)
+	require.NoError(t, err)
+	originalSpecID := jb.PipelineSpecID
+
+	t.Run("stores the pipeline as a new version", func(t *testing.T) {
+		updated, err := directrequest.ValidatedDirectRequestSpec(fmt.Sprintf(testspecs.DirectRequestSpecNoExternalJobID, "updated"))
+		require.NoError(t, err)
+		updated.ID = jb.ID
+
+		err = orm.UpdateJob(&updated)
+		require.NoError(t, err)
+
+		assert.Equal(t, "updated", updated.Name.ValueOrZero())
+		assert.Equal(t, jb.ExternalJobID, updated.ExternalJobID)
+		assert.NotEqual(t, originalSpecID, updated.PipelineSpecID)
+		require.NotNil(t, updated.PipelineSpec)
+		assert.Equal(t, int32(2), updated.PipelineSpec.Version)
+
+		specs, err := orm.FindJobVersions(jb.ID)
+		require.NoError(t, err)
+		require.Len(t, specs, 2)
+		assert.Equal(t, int32(2), specs[0].Version)
+		assert.Equal(t, originalSpecID, specs[1].ID)
+
+		jbs, err := orm.FindJobsByPipelineSpecIDs([]int32{originalSpecID})
+		require.NoError(t, err)
+		require.Len(t, jbs, 1)
+		assert.Equal(t, jb.ID, jbs[0].ID)
+	})
+
+	t.Run("does not allow the job type to change", func(t *testing.T) {
+		updated, err := directrequest.ValidatedDirectRequestSpec(testspecs.DirectRequestSpec)
+		require.NoError(t, err)
+		updated.ID = jb.ID
+		updated.Type = job.Cron
+
+		err = orm.UpdateJob(&updated)
+		require.True(t, errors.Is(err, job.ErrJobTypeChanged))
+	})
+
+	t.Run("does not allow the external job ID to change", func(t *testing.T) {
+		updated, err := directrequest.ValidatedDirectRequestSpec(testspecs.DirectRequestSpec)
+		require.NoError(t, err)
+		updated.ID = jb.ID
+		updated.ExternalJobID = uuid.NewV4()
+
+		err = orm.UpdateJob(&updated)
+		require.True(t, errors.Is(err, job.ErrExternalJobIDChanged))
+	})
+
+	t.Run("does not allow other settings of the job to change", func(t *testing.T) {
+		updated, err := directrequest.ValidatedDirectRequestSpec(testspecs.DirectRequestSpec)
+		require.NoError(t, err)
+		updated.ID = jb.ID
+		updated.PrivateRelay = null.BoolFrom(false)
+
+		err = orm.UpdateJob(&updated)
+		require.True(t, errors.Is(err, job.ErrJobSettingChanged))
+		assert.Contains(t, err.Error(), "PrivateRelay differs from the current job")
+	})
+
+	t.Run("does not allow the type-specific spec to change", func(t *testing.T) {
+		updated, err := directrequest.ValidatedDirectRequestSpec(strings.Replace(testspecs.DirectRequestSpec, "0x613a38AC1659769640aaE063C651F48E0250454C", "0x3cCad4715152693fE3BC4460591e3D3Fbd071b42", 1))
+		require.NoError(t, err)
+		updated.ID = jb.ID
+
+		err = orm.UpdateJob(&updated)
+		require.True(t, errors.Is(err, job.ErrJobSettingChanged))
+		assert.Contains(t, err.Error(), "DirectRequestSpec.ContractAddress differs from the current job")
+
+		versions, err := orm.FindJobVersions(jb.ID)
+		require.NoError(t, err)
+		assert.Len(t, versions, 2)
+	})
+
+	t.Run("rolls back to a previous version", func(t *testing.T) {
+		rolledBack := job.Job{ID: jb.ID}
+		err := orm.RollbackJob(&rolledBack, 1)
+		require.NoError(t, err)
+
+		assert.Equal(t, originalSpecID, rolledBack.PipelineSpecID)
+		require.NotNil(t, rolledBack.PipelineSpec)
+		assert.Equal(t, int32(1), rolledBack.PipelineSpec.Version)
+
+		specs, err := orm.FindJobVersions(jb.ID)
+		require.NoError(t, err)
+		assert.Len(t, specs, 2)
+	})
+
+	t.Run("does not roll back to a version which does not exist", func(t *testing.T) {
+		rolledBack := job.Job{ID: jb.ID}
+		err := orm.RollbackJob(&rolledBack, 42)
+		require.True(t, errors.Is(err, job.ErrNoSuchJobVersion))
+	})
+}
+
 func Test_FindPipelineRuns(t *testing.T) {
 	t.Parallel()
 
@@ -204,6 +204,36 @@ func (_m *ORM) FindJobTx(id int32) (job.Job, error) {
 	return r0, r1
 }
 
+// FindJobVersions provides a mock function with given fields: jobID, qopts
+func (_m *ORM) FindJobVersions(jobID int32, qopts ...pg.QOpt) ([]pipeline.Spec, error) {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, jobID)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 []pipeline.Spec
+	if rf, ok := ret.Get(0).(func(int32, ...pg.QOpt) []pipeline.Spec); ok {
+		r0 = rf(jobID, qopts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]pipeline.Spec)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int32, ...pg.QOpt) error); ok {
+		r1 = rf(jobID, qopts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // FindJobs provides a mock function with given fields: offset, limit
 func (_m *ORM) FindJobs(offset int, limit int) ([]job.Job, int, error) {
 	ret := _m.Called(offset, limit)
@@ -394,6 +424,27 @@ func (_m *ORM) InsertWebhookSpec(webhookSpec *job.WebhookSpec, qopts ...pg.QOpt)
 	return r0
 }
 
+// PauseJob provides a mock function with given fields: jb, qopts
+func (_m *ORM) PauseJob(jb *job.Job, qopts ...pg.QOpt) error {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, jb)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*job.Job, ...pg.QOpt) error); ok {
+		r0 = rf(jb, qopts...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // PipelineRuns provides a mock function with given fields: jobID, offset, size
 func (_m *ORM) PipelineRuns(jobID *int32, offset int, size int) ([]pipeline.Run, int, error) {
 	ret := _m.Called(jobID, offset, size)
@@ -445,6 +496,69 @@ func (_m *ORM) RecordError(jobID int32, description string, qopts ...pg.QOpt) er
 	return r0
 }
 
+// RestoreJob provides a mock function with given fields: jb, qopts
+func (_m *ORM) RestoreJob(jb *job.Job, qopts ...pg.QOpt) error {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, jb)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*job.Job, ...pg.QOpt) error); ok {
+		r0 = rf(jb, qopts...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ResumeJob provides a mock function with given fields: jb, qopts
+func (_m *ORM) ResumeJob(jb *job.Job, qopts ...pg.QOpt) error {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, jb)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*job.Job, ...pg.QOpt) error); ok {
+		r0 = rf(jb, qopts...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RollbackJob provides a mock function with given fields: jb, version, qopts
+func (_m *ORM) RollbackJob(jb *job.Job, version int32, qopts ...pg.QOpt) error {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, jb, version)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*job.Job, int32, ...pg.QOpt) error); ok {
+		r0 = rf(jb, version, qopts...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // TryRecordError provides a mock function with given fields: jobID, description, qopts
 func (_m *ORM) TryRecordError(jobID int32, description string, qopts ...pg.QOpt) {
 	_va := make([]interface{}, len(qopts))
@@ -456,3 +570,24 @@ func (_m *ORM) TryRecordError(jobID int32, description string, qopts ...pg.QOpt)
 	_ca = append(_ca, _va...)
 	_m.Called(_ca...)
 }
+
+// UpdateJob provides a mock function with given fields: jb, qopts
+func (_m *ORM) UpdateJob(jb *job.Job, qopts ...pg.QOpt) error {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, jb)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*job.Job, ...pg.QOpt) error); ok {
+		r0 = rf(jb, qopts...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
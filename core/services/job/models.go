@@ -1,6 +1,8 @@
 package job
 
 import (
+	"database/sql/driver"
+	"encoding/json"
 	"fmt"
 	"strconv"
 	"strings"
@@ -8,6 +10,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/lib/pq"
+	"github.com/pkg/errors"
 	uuid "github.com/satori/go.uuid"
 	"gopkg.in/guregu/null.v4"
 
@@ -23,8 +26,10 @@ import (
 )
 
 const (
+	BlockTrigger       Type = "blocktrigger"
 	Cron               Type = "cron"
 	DirectRequest      Type = "directrequest"
+	EventTrigger       Type = "eventtrigger"
 	FluxMonitor        Type = "fluxmonitor"
 	OffchainReporting  Type = "offchainreporting"
 	OffchainReporting2 Type = "offchainreporting2"
@@ -52,10 +57,18 @@ func (t Type) SchemaVersion() uint32 {
 	return schemaVersions[t]
 }
 
+// ConsumesLogs returns whether jobs of the type are driven by logs from the
+// log broadcaster, so that logs missed while paused can be backfilled
+func (t Type) ConsumesLogs() bool {
+	return consumesLogs[t]
+}
+
 var (
 	requiresPipelineSpec = map[Type]bool{
+		BlockTrigger:       true,
 		Cron:               true,
 		DirectRequest:      true,
+		EventTrigger:       true,
 		FluxMonitor:        true,
 		OffchainReporting:  false, // bootstrap jobs do not require it
 		OffchainReporting2: false, // bootstrap jobs do not require it
@@ -64,8 +77,10 @@ var (
 		Webhook:            true,
 	}
 	supportsAsync = map[Type]bool{
+		BlockTrigger:       true,
 		Cron:               true,
 		DirectRequest:      true,
+		EventTrigger:       true,
 		FluxMonitor:        false,
 		OffchainReporting:  false,
 		OffchainReporting2: false,
@@ -74,8 +89,10 @@ var (
 		Webhook:            true,
 	}
 	schemaVersions = map[Type]uint32{
+		BlockTrigger:       1,
 		Cron:               1,
 		DirectRequest:      1,
+		EventTrigger:       1,
 		FluxMonitor:        1,
 		OffchainReporting:  1,
 		OffchainReporting2: 1,
@@ -83,6 +100,18 @@ var (
 		VRF:                1,
 		Webhook:            1,
 	}
+	consumesLogs = map[Type]bool{
+		BlockTrigger:       false,
+		Cron:               false,
+		DirectRequest:      true,
+		EventTrigger:       true,
+		FluxMonitor:        true,
+		OffchainReporting:  true,
+		OffchainReporting2: true,
+		Keeper:             true,
+		VRF:                true,
+		Webhook:            false,
+	}
 )
 
 type Job struct {
@@ -96,6 +125,10 @@ type Job struct {
 	CronSpec                       *CronSpec
 	DirectRequestSpecID            *int32
 	DirectRequestSpec              *DirectRequestSpec
+	EventTriggerSpecID             *int32
+	EventTriggerSpec               *EventTriggerSpec
+	BlockTriggerSpecID             *int32
+	BlockTriggerSpec               *BlockTriggerSpec
 	FluxMonitorSpecID              *int32
 	FluxMonitorSpec                *FluxMonitorSpec
 	KeeperSpecID                   *int32
@@ -111,8 +144,28 @@ type Job struct {
 	SchemaVersion                  uint32
 	Name                           null.String
 	MaxTaskDuration                models.Interval
-	Pipeline                       pipeline.Pipeline `toml:"observationSource"`
-	CreatedAt                      time.Time
+	// GasSpendBudgetWei optionally limits the fees the job may spend on
+	// transactions within each GasSpendBudgetWindow
+	GasSpendBudgetWei    *assets.Eth     `toml:"gasSpendBudgetWei"`
+	GasSpendBudgetWindow models.Interval `toml:"gasSpendBudgetWindow"`
+	// KeyPool optionally names the key pool the job sends its transactions
+	// from
+	KeyPool null.String `toml:"keyPool"`
+	// GenerateAccessLists optionally overrides EVM_GENERATE_ACCESS_LISTS for
+	// the transactions of the job
+	GenerateAccessLists null.Bool `toml:"generateAccessLists"`
+	// PrivateRelay optionally overrides EVM_PRIVATE_RELAY_ENABLED for the
+	// transactions of the job
+	PrivateRelay null.Bool         `toml:"privateRelay"`
+	Pipeline     pipeline.Pipeline `toml:"observationSource"`
+	CreatedAt    time.Time
+	// PausedAt is set while the job is paused. Its services are not running
+	// then.
+	PausedAt null.Time `toml:"-"`
+	// PausedAtBlock is the latest head of the job's chain when it was paused,
+	// for job types that consume logs. Logs from this block on can be
+	// backfilled when the job is resumed.
+	PausedAtBlock clnull.Int64 `toml:"-"`
 }
 
 func ExternalJobIDEncodeStringToTopic(id uuid.UUID) common.Hash {
@@ -140,6 +193,35 @@ func (j Job) TableName() string {
 	return "jobs"
 }
 
+// EVMChainID returns the chain ID of the job's spec, or nil if the job type
+// is not chain specific
+func (j Job) EVMChainID() *utils.Big {
+	switch {
+	case j.OffchainreportingOracleSpec != nil:
+		return j.OffchainreportingOracleSpec.EVMChainID
+	case j.Offchainreporting2OracleSpec != nil:
+		return j.Offchainreporting2OracleSpec.EVMChainID
+	case j.DirectRequestSpec != nil:
+		return j.DirectRequestSpec.EVMChainID
+	case j.EventTriggerSpec != nil:
+		return j.EventTriggerSpec.EVMChainID
+	case j.BlockTriggerSpec != nil:
+		return j.BlockTriggerSpec.EVMChainID
+	case j.FluxMonitorSpec != nil:
+		return j.FluxMonitorSpec.EVMChainID
+	case j.KeeperSpec != nil:
+		return j.KeeperSpec.EVMChainID
+	case j.VRFSpec != nil:
+		return j.VRFSpec.EVMChainID
+	}
+	return nil
+}
+
+// Paused returns whether the job is paused
+func (j Job) Paused() bool {
+	return j.PausedAt.Valid
+}
+
 // SetID takes the id as a string and attempts to convert it to an int32. If
 // it succeeds, it will set it as the id on the job
 func (j *Job) SetID(value string) error {
@@ -322,11 +404,97 @@ func (DirectRequestSpec) TableName() string {
 	return "direct_request_specs"
 }
 
+// EventTriggerSpec runs the pipeline of the job for each log of an event that
+// a contract emits
+type EventTriggerSpec struct {
+	ID              int32               `toml:"-"`
+	ContractAddress ethkey.EIP55Address `toml:"contractAddress"`
+	// EventABI is the signature of the event, e.g.
+	// "Transfer(address indexed from, address indexed to, uint256 value)"
+	EventABI                 string              `toml:"eventABI"`
+	IndexedFilters           EventTriggerFilters `toml:"indexedFilters"`
+	MinIncomingConfirmations clnull.Uint32       `toml:"minIncomingConfirmations"`
+	EVMChainID               *utils.Big          `toml:"evmChainID"`
+	CreatedAt                time.Time           `toml:"-"`
+	UpdatedAt                time.Time           `toml:"-"`
+}
+
+func (EventTriggerSpec) TableName() string {
+	return "event_trigger_specs"
+}
+
+// EventTriggerFilters maps the names of indexed event arguments to the values
+// they are filtered by. An event log matches if every filtered argument has
+// one of the values.
+type EventTriggerFilters map[string][]string
+
+func (f EventTriggerFilters) Value() (driver.Value, error) {
+	if len(f) == 0 {
+		return nil, nil
+	}
+	return json.Marshal(f)
+}
+
+func (f *EventTriggerFilters) Scan(value interface{}) error {
+	switch v := value.(type) {
+	case nil:
+		*f = nil
+		return nil
+	case []byte:
+		return json.Unmarshal(v, f)
+	case string:
+		return json.Unmarshal([]byte(v), f)
+	default:
+		return errors.Errorf("unable to convert %v of %T to EventTriggerFilters", value, value)
+	}
+}
+
+// BlockTriggerSpec runs the pipeline of the job every BlockInterval blocks,
+// once the block has the given number of confirmations
+type BlockTriggerSpec struct {
+	ID            int32  `toml:"-"`
+	BlockInterval uint32 `toml:"blockInterval"`
+	Confirmations uint32 `toml:"confirmations"`
+	// RunMissedBlocks runs the pipeline for every due block that was skipped
+	// while the job was running, e.g. because heads were missed or a run took
+	// longer than the interval. Otherwise only the latest one is run.
+	RunMissedBlocks bool       `toml:"runMissedBlocks"`
+	EVMChainID      *utils.Big `toml:"evmChainID"`
+	CreatedAt       time.Time  `toml:"-"`
+	UpdatedAt       time.Time  `toml:"-"`
+}
+
+func (BlockTriggerSpec) TableName() string {
+	return "block_trigger_specs"
+}
+
+// CronConcurrencyPolicy determines what a cron job does when its schedule
+// fires while the previous run is still executing
+type CronConcurrencyPolicy string
+
+const (
+	// CronConcurrencyAllow starts the new run alongside the previous one
+	CronConcurrencyAllow CronConcurrencyPolicy = "allow"
+	// CronConcurrencyForbid skips the new run
+	CronConcurrencyForbid CronConcurrencyPolicy = "forbid"
+	// CronConcurrencyReplace cancels the previous run and starts the new one
+	CronConcurrencyReplace CronConcurrencyPolicy = "replace"
+)
+
 type CronSpec struct {
-	ID           int32     `toml:"-"`
-	CronSchedule string    `toml:"schedule"`
-	CreatedAt    time.Time `toml:"-"`
-	UpdatedAt    time.Time `toml:"-"`
+	ID                int32                 `toml:"-"`
+	CronSchedule      string                `toml:"schedule"`
+	ConcurrencyPolicy CronConcurrencyPolicy `toml:"concurrencyPolicy"`
+	// CatchUpWindow is how far back the job looks on start for a tick that
+	// was missed since its last run. If there is one, it is run once.
+	CatchUpWindow models.Interval `toml:"catchUpWindow"`
+	// Jitter delays each run by a random duration of up to this long
+	Jitter models.Interval `toml:"jitter"`
+	// Timezone is the IANA time zone that the schedule is evaluated in, for
+	// schedules that do not set CRON_TZ
+	Timezone  string    `toml:"timezone"`
+	CreatedAt time.Time `toml:"-"`
+	UpdatedAt time.Time `toml:"-"`
 }
 
 func (s CronSpec) GetID() string {
@@ -3,7 +3,10 @@ package job
 import (
 	"context"
 	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
 	"fmt"
+	"math/big"
 	"reflect"
 	"time"
 
@@ -13,6 +16,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/config"
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/null"
+	"github.com/smartcontractkit/chainlink/core/services/bulletprooftxmanager"
 	"github.com/smartcontractkit/chainlink/core/services/keystore"
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/p2pkey"
@@ -30,6 +34,13 @@ var (
 	ErrNoSuchKeyBundle          = errors.New("no such key bundle exists")
 	ErrNoSuchTransmitterAddress = errors.New("no such transmitter address exists")
 	ErrNoSuchPublicKey          = errors.New("no such public key exists")
+	ErrJobPaused                = errors.New("job is paused")
+	ErrJobNotPaused             = errors.New("job is not paused")
+	ErrJobNotBackfillable       = errors.New("job does not consume logs, or the latest head was unknown when it was paused")
+	ErrNoSuchJobVersion         = errors.New("no such job version exists")
+	ErrJobTypeChanged           = errors.New("job type cannot be changed")
+	ErrExternalJobIDChanged     = errors.New("external job ID cannot be changed")
+	ErrJobSettingChanged        = errors.New("only the observationSource, name and maxTaskDuration of a job can be changed")
 )
 
 //go:generate mockery --name ORM --output ./mocks/ --case=underscore
@@ -38,12 +49,24 @@ type ORM interface {
 	InsertWebhookSpec(webhookSpec *WebhookSpec, qopts ...pg.QOpt) error
 	InsertJob(job *Job, qopts ...pg.QOpt) error
 	CreateJob(jb *Job, qopts ...pg.QOpt) error
+	// UpdateJob stores the pipeline of jb as a new version of the job with
+	// the same ID, and makes it the current version.
+	UpdateJob(jb *Job, qopts ...pg.QOpt) error
+	// RollbackJob makes a previous version of the job's pipeline current again.
+	RollbackJob(jb *Job, version int32, qopts ...pg.QOpt) error
+	// RestoreJob stores the state of the job in jb, a previous state of the
+	// job, as its current state.
+	RestoreJob(jb *Job, qopts ...pg.QOpt) error
+	// FindJobVersions returns every version of the job's pipeline, latest first.
+	FindJobVersions(jobID int32, qopts ...pg.QOpt) ([]pipeline.Spec, error)
 	FindJobs(offset, limit int) ([]Job, int, error)
 	FindJobTx(id int32) (Job, error)
 	FindJob(ctx context.Context, id int32) (Job, error)
 	FindJobByExternalJobID(uuid uuid.UUID, qopts ...pg.QOpt) (Job, error)
 	FindJobIDsWithBridge(name string) ([]int32, error)
 	DeleteJob(id int32, qopts ...pg.QOpt) error
+	PauseJob(jb *Job, qopts ...pg.QOpt) error
+	ResumeJob(jb *Job, qopts ...pg.QOpt) error
 	RecordError(jobID int32, description string, qopts ...pg.QOpt) error
 	// TryRecordError is a helper which calls RecordError and logs the returned error if present.
 	TryRecordError(jobID int32, description string, qopts ...pg.QOpt)
@@ -97,23 +120,26 @@ func (o *orm) Close() error {
 func (o *orm) CreateJob(jb *Job, qopts ...pg.QOpt) error {
 	q := o.q.WithOpts(qopts...)
 	p := jb.Pipeline
-	for _, task := range p.Tasks {
-		if task.Type() == pipeline.TaskTypeBridge {
-			// Bridge must exist
-			name := task.(*pipeline.BridgeTask).Name
+	if err := checkBridgesExist(q, p); err != nil {
+		return errors.Wrap(err, "CreateJob failed")
+	}
 
-			sql := `SELECT EXISTS(SELECT 1 FROM bridge_types WHERE name = $1);`
-			var exists bool
-			err := q.Get(&exists, sql, name)
-			if err != nil {
-				return errors.Wrap(err, "CreateJob failed to check bridge")
-			}
-			if !exists {
-				return errors.Wrap(pipeline.ErrNoSuchBridge, name)
-			}
+	if jb.KeyPool.Valid {
+		// Key pool must exist
+		var exists bool
+		err := q.Get(&exists, `SELECT EXISTS(SELECT 1 FROM eth_key_pools WHERE name = $1);`, jb.KeyPool.String)
+		if err != nil {
+			return errors.Wrap(err, "CreateJob failed to check key pool")
+		}
+		if !exists {
+			return errors.Wrap(bulletprooftxmanager.ErrKeyPoolNotFound, jb.KeyPool.String)
 		}
 	}
 
+	if err := o.checkTransactionSettings(jb); err != nil {
+		return errors.Wrap(err, "CreateJob failed")
+	}
+
 	var jobID int32
 	err := q.Transaction(func(tx pg.Queryer) error {
 		// Autogenerate a job ID if not specified
@@ -131,6 +157,24 @@ func (o *orm) CreateJob(jb *Job, qopts ...pg.QOpt) error {
 				return errors.Wrap(err, "failed to create DirectRequestSpec")
 			}
 			jb.DirectRequestSpecID = &specID
+		case EventTrigger:
+			var specID int32
+			sql := `INSERT INTO event_trigger_specs (contract_address, event_abi, indexed_filters, min_incoming_confirmations, evm_chain_id, created_at, updated_at)
+			VALUES (:contract_address, :event_abi, :indexed_filters, :min_incoming_confirmations, :evm_chain_id, NOW(), NOW())
+			RETURNING id;`
+			if err := pg.PrepareQueryRowx(tx, sql, &specID, jb.EventTriggerSpec); err != nil {
+				return errors.Wrap(err, "failed to create EventTriggerSpec")
+			}
+			jb.EventTriggerSpecID = &specID
+		case BlockTrigger:
+			var specID int32
+			sql := `INSERT INTO block_trigger_specs (block_interval, confirmations, run_missed_blocks, evm_chain_id, created_at, updated_at)
+			VALUES (:block_interval, :confirmations, :run_missed_blocks, :evm_chain_id, NOW(), NOW())
+			RETURNING id;`
+			if err := pg.PrepareQueryRowx(tx, sql, &specID, jb.BlockTriggerSpec); err != nil {
+				return errors.Wrap(err, "failed to create BlockTriggerSpec")
+			}
+			jb.BlockTriggerSpecID = &specID
 		case FluxMonitor:
 			var specID int32
 			sql := `INSERT INTO flux_monitor_specs (contract_address, threshold, absolute_threshold, poll_timer_period, poll_timer_disabled, idle_timer_period, idle_timer_disabled,
@@ -184,10 +228,10 @@ func (o *orm) CreateJob(jb *Job, qopts ...pg.QOpt) error {
 				}
 			}
 
-			sql := `INSERT INTO offchainreporting2_oracle_specs (contract_address, p2p_bootstrap_peers, is_bootstrap_peer, encrypted_ocr_key_bundle_id, transmitter_address,
+			sql := `INSERT INTO offchainreporting2_oracle_specs (contract_address, p2p_bootstrap_peers, is_bootstrap_peer, encrypted_ocr_key_bundle_id, monitoring_endpoint, transmitter_address,
 					blockchain_timeout, contract_config_tracker_subscribe_interval, contract_config_tracker_poll_interval, contract_config_confirmations, evm_chain_id, juels_per_fee_coin_pipeline,
 					created_at, updated_at)
-			VALUES (:contract_address, :p2p_bootstrap_peers, :is_bootstrap_peer, :encrypted_ocr_key_bundle_id, :transmitter_address,
+			VALUES (:contract_address, :p2p_bootstrap_peers, :is_bootstrap_peer, :encrypted_ocr_key_bundle_id, :monitoring_endpoint, :transmitter_address,
 					 :blockchain_timeout, :contract_config_tracker_subscribe_interval, :contract_config_tracker_poll_interval, :contract_config_confirmations, :evm_chain_id, :juels_per_fee_coin_pipeline,
 					NOW(), NOW())
 			RETURNING id;`
@@ -198,8 +242,8 @@ func (o *orm) CreateJob(jb *Job, qopts ...pg.QOpt) error {
 			jb.Offchainreporting2OracleSpecID = &specID
 		case Keeper:
 			var specID int32
-			sql := `INSERT INTO keeper_specs (contract_address, from_address, evm_chain_id, created_at, updated_at)
-			VALUES (:contract_address, :from_address, :evm_chain_id, NOW(), NOW())
+			sql := `INSERT INTO keeper_specs (contract_address, from_address, min_incoming_confirmations, evm_chain_id, created_at, updated_at)
+			VALUES (:contract_address, :from_address, :min_incoming_confirmations, :evm_chain_id, NOW(), NOW())
 			RETURNING id;`
 			if err := pg.PrepareQueryRowx(tx, sql, &specID, jb.KeeperSpec); err != nil {
 				return errors.Wrap(err, "failed to create KeeperSpec")
@@ -207,8 +251,8 @@ func (o *orm) CreateJob(jb *Job, qopts ...pg.QOpt) error {
 			jb.KeeperSpecID = &specID
 		case Cron:
 			var specID int32
-			sql := `INSERT INTO cron_specs (cron_schedule, created_at, updated_at)
-			VALUES (:cron_schedule, NOW(), NOW())
+			sql := `INSERT INTO cron_specs (cron_schedule, concurrency_policy, catch_up_window, jitter, timezone, created_at, updated_at)
+			VALUES (:cron_schedule, :concurrency_policy, :catch_up_window, :jitter, :timezone, NOW(), NOW())
 			RETURNING id;`
 			if err := pg.PrepareQueryRowx(tx, sql, &specID, jb.CronSpec); err != nil {
 				return errors.Wrap(err, "failed to create CronSpec")
@@ -272,6 +316,329 @@ func (o *orm) CreateJob(jb *Job, qopts ...pg.QOpt) error {
 	return o.findJob(jb, "id", jobID, qopts...)
 }
 
+func checkBridgesExist(q pg.Q, p pipeline.Pipeline) error {
+	for _, task := range p.Tasks {
+		if task.Type() == pipeline.TaskTypeBridge {
+			// Bridge must exist
+			name := task.(*pipeline.BridgeTask).Name
+
+			sql := `SELECT EXISTS(SELECT 1 FROM bridge_types WHERE name = $1);`
+			var exists bool
+			err := q.Get(&exists, sql, name)
+			if err != nil {
+				return errors.Wrap(err, "failed to check bridge")
+			}
+			if !exists {
+				return errors.Wrap(pipeline.ErrNoSuchBridge, name)
+			}
+		}
+	}
+	return nil
+}
+
+// UpdateJob stores the pipeline of jb as a new, immutable version of the
+// existing job with the same ID and makes it the current version. The name
+// and maxTaskDuration of the job are updated as well. Its type and external
+// job ID must not change, and neither must any other setting of the job or
+// its type-specific spec.
+// Expects an unmarshalled job spec as the jb argument i.e. output from ValidatedXX.
+// Scans all persisted records back into jb
+func (o *orm) UpdateJob(jb *Job, qopts ...pg.QOpt) error {
+	q := o.q.WithOpts(qopts...)
+	if err := checkBridgesExist(q, jb.Pipeline); err != nil {
+		return errors.Wrap(err, "UpdateJob failed")
+	}
+
+	err := q.Transaction(func(tx pg.Queryer) error {
+		var current Job
+		if err := tx.Get(&current, `SELECT * FROM jobs WHERE id = $1 FOR UPDATE`, jb.ID); err != nil {
+			return errors.Wrap(err, "failed to load job")
+		}
+		if jb.Type != current.Type {
+			return ErrJobTypeChanged
+		}
+		if jb.ExternalJobID != (uuid.UUID{}) && jb.ExternalJobID != current.ExternalJobID {
+			return ErrExternalJobIDChanged
+		}
+		if err := LoadAllJobTypes(tx, &current); err != nil {
+			return errors.Wrap(err, "failed to load job spec")
+		}
+		if current.WebhookSpec != nil {
+			if err := tx.Select(&current.WebhookSpec.ExternalInitiatorWebhookSpecs, `SELECT * FROM external_initiator_webhook_specs WHERE webhook_spec_id = $1`, current.WebhookSpec.ID); err != nil {
+				return errors.Wrap(err, "failed to load external initiators")
+			}
+		}
+		if setting := changedSetting(current, *jb); setting != "" {
+			return errors.Wrapf(ErrJobSettingChanged, "%s differs from the current job", setting)
+		}
+
+		pipelineSpecID, err := o.pipelineORM.CreateSpec(jb.Pipeline, jb.MaxTaskDuration, pg.WithQueryer(tx))
+		if err != nil {
+			return errors.Wrap(err, "failed to create pipeline spec")
+		}
+		sql := `UPDATE pipeline_specs SET version = (
+			SELECT MAX(pipeline_specs.version) + 1 FROM pipeline_specs
+			JOIN job_pipeline_specs ON job_pipeline_specs.pipeline_spec_id = pipeline_specs.id
+			WHERE job_pipeline_specs.job_id = $1
+		) WHERE id = $2`
+		if _, err = tx.Exec(sql, jb.ID, pipelineSpecID); err != nil {
+			return errors.Wrap(err, "failed to set pipeline spec version")
+		}
+		if _, err = tx.Exec(`INSERT INTO job_pipeline_specs (pipeline_spec_id, job_id) VALUES ($1, $2)`, pipelineSpecID, jb.ID); err != nil {
+			return errors.Wrap(err, "failed to link pipeline spec to job")
+		}
+
+		sql = `UPDATE jobs SET pipeline_spec_id = $2, name = $3, max_task_duration = $4 WHERE id = $1`
+		_, err = tx.Exec(sql, jb.ID, pipelineSpecID, jb.Name, jb.MaxTaskDuration)
+		return errors.Wrap(err, "failed to update job")
+	})
+	if err != nil {
+		return errors.Wrap(err, "UpdateJob failed")
+	}
+
+	var updated Job
+	if err = o.findJob(&updated, "id", jb.ID, qopts...); err != nil {
+		return err
+	}
+	*jb = updated
+	return nil
+}
+
+// updatableJobFields are the fields of Job that UpdateJob either stores or
+// checks itself, or that are not settings of the job. Every other field of
+// the job must not change.
+var updatableJobFields = map[string]bool{
+	"ID":                             true,
+	"ExternalJobID":                  true,
+	"OffchainreportingOracleSpecID":  true,
+	"Offchainreporting2OracleSpecID": true,
+	"CronSpecID":                     true,
+	"DirectRequestSpecID":            true,
+	"EventTriggerSpecID":             true,
+	"BlockTriggerSpecID":             true,
+	"FluxMonitorSpecID":              true,
+	"KeeperSpecID":                   true,
+	"VRFSpecID":                      true,
+	"WebhookSpecID":                  true,
+	"PipelineSpecID":                 true,
+	"PipelineSpec":                   true,
+	"JobSpecErrors":                  true,
+	"Type":                           true,
+	"Name":                           true,
+	"MaxTaskDuration":                true,
+	"Pipeline":                       true,
+	"CreatedAt":                      true,
+	"PausedAt":                       true,
+	"PausedAtBlock":                  true,
+}
+
+// typeSpec is the type-specific spec of a job type
+type typeSpec struct {
+	// field is the field of Job that holds the spec
+	field string
+	// ignored are the fields of the spec that are not settings of the job:
+	// its ID and timestamps, and the fields that are derived from the
+	// environment when the job is loaded. Every other field of the spec
+	// must not change.
+	ignored map[string]bool
+}
+
+func newTypeSpec(field string, ignored ...string) typeSpec {
+	spec := typeSpec{field: field, ignored: map[string]bool{"ID": true, "CreatedAt": true, "UpdatedAt": true}}
+	for _, name := range ignored {
+		spec.ignored[name] = true
+	}
+	return spec
+}
+
+// typeSpecs are the type-specific specs of each job type
+var typeSpecs = map[Type]typeSpec{
+	BlockTrigger:  newTypeSpec("BlockTriggerSpec"),
+	Cron:          newTypeSpec("CronSpec"),
+	DirectRequest: newTypeSpec("DirectRequestSpec", "MinIncomingConfirmationsEnv"),
+	EventTrigger:  newTypeSpec("EventTriggerSpec"),
+	FluxMonitor:   newTypeSpec("FluxMonitorSpec"),
+	OffchainReporting: newTypeSpec("OffchainreportingOracleSpec",
+		"EncryptedOCRKeyBundleIDEnv", "TransmitterAddressEnv", "ObservationTimeoutEnv", "BlockchainTimeoutEnv",
+		"ContractConfigTrackerSubscribeIntervalEnv", "ContractConfigTrackerPollIntervalEnv", "ContractConfigConfirmationsEnv",
+		"DatabaseTimeoutEnv", "ObservationGracePeriodEnv", "ContractTransmitterTransmitTimeoutEnv"),
+	OffchainReporting2: newTypeSpec("Offchainreporting2OracleSpec"),
+	Keeper:             newTypeSpec("KeeperSpec"),
+	VRF:                newTypeSpec("VRFSpec", "ConfirmationsEnv", "PollPeriodEnv"),
+	Webhook:            newTypeSpec("WebhookSpec"),
+}
+
+// changedSetting returns the name of the first setting of the job, or of its
+// type-specific spec, that differs between current and updated, or "" if
+// only the settings that UpdateJob stores differ. Settings are compared by
+// the values they are stored as, as current was loaded from the database.
+func changedSetting(current, updated Job) string {
+	specFields := make(map[string]Type, len(typeSpecs))
+	for jobType, spec := range typeSpecs {
+		specFields[spec.field] = jobType
+	}
+
+	cv, uv := reflect.ValueOf(current), reflect.ValueOf(updated)
+	t := cv.Type()
+	for i := 0; i < t.NumField(); i++ {
+		name := t.Field(i).Name
+		if updatableJobFields[name] {
+			continue
+		}
+		if jobType, isSpec := specFields[name]; isSpec {
+			if jobType != current.Type {
+				// Only the spec of the job's own type is set
+				if cv.Field(i).IsNil() != uv.Field(i).IsNil() {
+					return name
+				}
+				continue
+			}
+			if field := changedSpecField(cv.Field(i), uv.Field(i), typeSpecs[jobType].ignored); field != "" {
+				return name + "." + field
+			}
+			continue
+		}
+		if !sameSetting(cv.Field(i).Interface(), uv.Field(i).Interface()) {
+			return name
+		}
+	}
+	return ""
+}
+
+// changedSpecField returns the name of the first field that differs between
+// two type-specific specs, other than the ignored fields
+func changedSpecField(current, updated reflect.Value, ignored map[string]bool) string {
+	if current.IsNil() || updated.IsNil() {
+		if current.IsNil() != updated.IsNil() {
+			return "type"
+		}
+		return ""
+	}
+	cv, uv := current.Elem(), updated.Elem()
+	t := cv.Type()
+	for i := 0; i < t.NumField(); i++ {
+		name := t.Field(i).Name
+		switch {
+		case ignored[name]:
+			continue
+		case name == "ExternalInitiatorWebhookSpecs":
+			if !sameExternalInitiators(cv.Field(i).Interface().([]ExternalInitiatorWebhookSpec), uv.Field(i).Interface().([]ExternalInitiatorWebhookSpec)) {
+				return name
+			}
+		case !sameSetting(cv.Field(i).Interface(), uv.Field(i).Interface()):
+			return name
+		}
+	}
+	return ""
+}
+
+// sameSetting returns whether a and b are stored as the same value
+func sameSetting(a, b interface{}) bool {
+	// A NULL and an empty array column are the same setting
+	va, vb := reflect.ValueOf(a), reflect.ValueOf(b)
+	if va.Kind() == reflect.Slice && vb.Kind() == reflect.Slice && va.Len() == 0 && vb.Len() == 0 {
+		return true
+	}
+	return reflect.DeepEqual(settingValue(a), settingValue(b))
+}
+
+func settingValue(v interface{}) interface{} {
+	val, err := driver.DefaultParameterConverter.ConvertValue(v)
+	if err != nil {
+		b, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Sprintf("%v", v)
+		}
+		return string(b)
+	}
+	if b, ok := val.([]byte); ok {
+		return string(b)
+	}
+	return val
+}
+
+// sameExternalInitiators returns whether a and b trigger the job for the
+// same external initiators, with equal specs
+func sameExternalInitiators(a, b []ExternalInitiatorWebhookSpec) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	specs := make(map[int64]interface{}, len(a))
+	for _, eiWS := range a {
+		specs[eiWS.ExternalInitiatorID] = parsedJSON(eiWS.Spec)
+	}
+	for _, eiWS := range b {
+		spec, exists := specs[eiWS.ExternalInitiatorID]
+		if !exists || !reflect.DeepEqual(spec, parsedJSON(eiWS.Spec)) {
+			return false
+		}
+	}
+	return true
+}
+
+// parsedJSON returns j parsed, so that it can be compared regardless of how
+// it is formatted
+func parsedJSON(j models.JSON) (v interface{}) {
+	if err := json.Unmarshal(j.Bytes(), &v); err != nil {
+		return j.String()
+	}
+	return v
+}
+
+// RollbackJob makes a previous version of the job's pipeline spec the
+// current version again, without creating a new version.
+// Scans all persisted records back into jb
+func (o *orm) RollbackJob(jb *Job, version int32, qopts ...pg.QOpt) error {
+	q := o.q.WithOpts(qopts...)
+	err := q.Transaction(func(tx pg.Queryer) error {
+		var spec pipeline.Spec
+		stmt := `SELECT pipeline_specs.* FROM pipeline_specs
+		JOIN job_pipeline_specs ON job_pipeline_specs.pipeline_spec_id = pipeline_specs.id
+		WHERE job_pipeline_specs.job_id = $1 AND pipeline_specs.version = $2`
+		err := tx.Get(&spec, stmt, jb.ID, version)
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrNoSuchJobVersion
+		} else if err != nil {
+			return errors.Wrap(err, "failed to load pipeline spec")
+		}
+
+		_, err = tx.Exec(`UPDATE jobs SET pipeline_spec_id = $2, max_task_duration = $3 WHERE id = $1`, jb.ID, spec.ID, spec.MaxTaskDuration)
+		return errors.Wrap(err, "failed to update job")
+	})
+	if err != nil {
+		return errors.Wrap(err, "RollbackJob failed")
+	}
+
+	var rolledBack Job
+	if err = o.findJob(&rolledBack, "id", jb.ID, qopts...); err != nil {
+		return err
+	}
+	*jb = rolledBack
+	return nil
+}
+
+// RestoreJob stores the pipeline spec version, name, maxTaskDuration and
+// paused state of jb, a previous state of the job, as its current state. It
+// reverts a change to the job whose services could not be started; the
+// versions created by the change are kept.
+func (o *orm) RestoreJob(jb *Job, qopts ...pg.QOpt) error {
+	q := o.q.WithOpts(qopts...)
+	sql := `UPDATE jobs SET pipeline_spec_id = $2, name = $3, max_task_duration = $4, paused_at = $5, paused_at_block = $6 WHERE id = $1`
+	err := q.ExecQ(sql, jb.ID, jb.PipelineSpecID, jb.Name, jb.MaxTaskDuration, jb.PausedAt, jb.PausedAtBlock)
+	return errors.Wrap(err, "RestoreJob failed")
+}
+
+// FindJobVersions returns every version of the job's pipeline spec, latest first
+func (o *orm) FindJobVersions(jobID int32, qopts ...pg.QOpt) (specs []pipeline.Spec, err error) {
+	q := o.q.WithOpts(qopts...)
+	sql := `SELECT pipeline_specs.*, job_pipeline_specs.job_id FROM pipeline_specs
+	JOIN job_pipeline_specs ON job_pipeline_specs.pipeline_spec_id = pipeline_specs.id
+	WHERE job_pipeline_specs.job_id = $1
+	ORDER BY pipeline_specs.version DESC`
+	err = q.Select(&specs, sql, jobID)
+	return specs, errors.Wrap(err, "FindJobVersions failed")
+}
+
 func (o *orm) InsertWebhookSpec(webhookSpec *WebhookSpec, qopts ...pg.QOpt) error {
 	q := o.q.WithOpts(qopts...)
 	query := `INSERT INTO webhook_specs (created_at, updated_at)
@@ -280,14 +647,22 @@ func (o *orm) InsertWebhookSpec(webhookSpec *WebhookSpec, qopts ...pg.QOpt) erro
 	return q.GetNamed(query, webhookSpec, webhookSpec)
 }
 
+// InsertJob inserts the job and links its pipeline spec to it as the first
+// version
 func (o *orm) InsertJob(job *Job, qopts ...pg.QOpt) error {
 	q := o.q.WithOpts(qopts...)
 	query := `INSERT INTO jobs (pipeline_spec_id, name, schema_version, type, max_task_duration, offchainreporting_oracle_spec_id, offchainreporting2_oracle_spec_id, direct_request_spec_id, flux_monitor_spec_id,
-				keeper_spec_id, cron_spec_id, vrf_spec_id, webhook_spec_id, external_job_id, created_at)
+				keeper_spec_id, cron_spec_id, vrf_spec_id, webhook_spec_id, event_trigger_spec_id, block_trigger_spec_id, external_job_id, gas_spend_budget_wei, gas_spend_budget_window, key_pool, generate_access_lists, private_relay, created_at)
 		VALUES (:pipeline_spec_id, :name, :schema_version, :type, :max_task_duration, :offchainreporting_oracle_spec_id, :offchainreporting2_oracle_spec_id, :direct_request_spec_id, :flux_monitor_spec_id,
-				:keeper_spec_id, :cron_spec_id, :vrf_spec_id, :webhook_spec_id, :external_job_id, NOW())
+				:keeper_spec_id, :cron_spec_id, :vrf_spec_id, :webhook_spec_id, :event_trigger_spec_id, :block_trigger_spec_id, :external_job_id, :gas_spend_budget_wei, :gas_spend_budget_window, :key_pool, :generate_access_lists, :private_relay, NOW())
 		RETURNING *;`
-	return q.GetNamed(query, job, job)
+	return q.Transaction(func(tx pg.Queryer) error {
+		if err := q.WithOpts(pg.WithQueryer(tx)).GetNamed(query, job, job); err != nil {
+			return err
+		}
+		_, err := tx.Exec(`INSERT INTO job_pipeline_specs (pipeline_spec_id, job_id) VALUES ($1, $2)`, job.PipelineSpecID, job.ID)
+		return errors.Wrap(err, "failed to link pipeline spec to job")
+	})
 }
 
 // DeleteJob removes a job
@@ -304,7 +679,9 @@ func (o *orm) DeleteJob(id int32, qopts ...pg.QOpt) error {
 				flux_monitor_spec_id,
 				vrf_spec_id,
 				webhook_spec_id,
-				direct_request_spec_id
+				direct_request_spec_id,
+				event_trigger_spec_id,
+				block_trigger_spec_id
 		),
 		deleted_oracle_specs AS (
 			DELETE FROM offchainreporting_oracle_specs WHERE id IN (SELECT offchainreporting_oracle_spec_id FROM deleted_jobs)
@@ -329,8 +706,15 @@ func (o *orm) DeleteJob(id int32, qopts ...pg.QOpt) error {
 		),
 		deleted_dr_specs AS (
 			DELETE FROM direct_request_specs WHERE id IN (SELECT direct_request_spec_id FROM deleted_jobs)
+		),
+		deleted_event_trigger_specs AS (
+			DELETE FROM event_trigger_specs WHERE id IN (SELECT event_trigger_spec_id FROM deleted_jobs)
+		),
+		deleted_block_trigger_specs AS (
+			DELETE FROM block_trigger_specs WHERE id IN (SELECT block_trigger_spec_id FROM deleted_jobs)
 		)
-		DELETE FROM pipeline_specs WHERE id IN (SELECT pipeline_spec_id FROM deleted_jobs)`
+		DELETE FROM pipeline_specs WHERE id IN (SELECT pipeline_spec_id FROM deleted_jobs)
+			OR id IN (SELECT pipeline_spec_id FROM job_pipeline_specs WHERE job_id = $1)`
 	res, cancel, err := q.ExecQIter(query, id)
 	defer cancel()
 	if err != nil {
@@ -346,6 +730,73 @@ func (o *orm) DeleteJob(id int32, qopts ...pg.QOpt) error {
 	return nil
 }
 
+// checkTransactionSettings rejects jobs that enable transaction settings
+// their chain cannot honour: the private relay on a chain without
+// EVM_PRIVATE_RELAY_URL, as their transactions would silently go to the
+// public mempool instead, and access lists on a chain without EIP-1559
+// transactions, as none would be generated.
+func (o *orm) checkTransactionSettings(jb *Job) error {
+	privateRelay := jb.PrivateRelay.Valid && jb.PrivateRelay.Bool
+	generateAccessLists := jb.GenerateAccessLists.Valid && jb.GenerateAccessLists.Bool
+	if !privateRelay && !generateAccessLists {
+		return nil
+	}
+	var chainID *big.Int
+	if id := jb.EVMChainID(); id != nil {
+		chainID = id.ToInt()
+	}
+	ch, err := o.chainSet.Get(chainID)
+	if err != nil {
+		return err
+	}
+	if privateRelay && ch.Config().EvmPrivateRelayURL() == "" {
+		return errors.Errorf("privateRelay is enabled but EVM_PRIVATE_RELAY_URL is not set for chain %s", ch.ID())
+	}
+	if generateAccessLists && !ch.Config().EvmEIP1559DynamicFees() {
+		return errors.Errorf("generateAccessLists is enabled but EVM_EIP1559_DYNAMIC_FEES is not set for chain %s", ch.ID())
+	}
+	return nil
+}
+
+// PauseJob marks the job as paused. For job types that consume logs, it also
+// records the latest head of the job's chain so that the logs missed while
+// paused can be backfilled.
+func (o *orm) PauseJob(jb *Job, qopts ...pg.QOpt) error {
+	q := o.q.WithOpts(qopts...)
+	var pausedAtBlock null.Int64
+	if chainID := jb.EVMChainID(); chainID != nil && jb.Type.ConsumesLogs() {
+		ch, err := o.chainSet.Get(chainID.ToInt())
+		if err != nil {
+			return err
+		}
+		ctx, cancel := q.Context()
+		defer cancel()
+		head, err := ch.HeadTracker().HighestSeenHeadFromDB(ctx)
+		if err != nil {
+			return errors.Wrap(err, "PauseJob failed to load latest head")
+		}
+		if head != nil {
+			pausedAtBlock = null.Int64From(head.Number)
+		}
+	}
+
+	err := q.Get(jb, `UPDATE jobs SET paused_at = NOW(), paused_at_block = $2 WHERE id = $1 AND paused_at IS NULL RETURNING *`, jb.ID, pausedAtBlock)
+	if errors.Is(err, sql.ErrNoRows) {
+		return ErrJobPaused
+	}
+	return errors.Wrap(err, "PauseJob failed")
+}
+
+// ResumeJob clears the paused state of the job
+func (o *orm) ResumeJob(jb *Job, qopts ...pg.QOpt) error {
+	q := o.q.WithOpts(qopts...)
+	err := q.Get(jb, `UPDATE jobs SET paused_at = NULL, paused_at_block = NULL WHERE id = $1 AND paused_at IS NOT NULL RETURNING *`, jb.ID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return ErrJobNotPaused
+	}
+	return errors.Wrap(err, "ResumeJob failed")
+}
+
 func (o *orm) RecordError(jobID int32, description string, qopts ...pg.QOpt) error {
 	q := o.q.WithOpts(qopts...)
 	sql := `INSERT INTO job_spec_errors (job_id, description, occurrences, created_at, updated_at)
@@ -641,7 +1092,7 @@ func (o *orm) FindJobIDsWithBridge(name string) (jids []int32, err error) {
 // PipelineRunsByJobsIDs returns pipeline runs for multiple jobs, not preloading data
 func (o *orm) PipelineRunsByJobsIDs(ids []int32) (runs []pipeline.Run, err error) {
 	err = o.q.Transaction(func(tx pg.Queryer) error {
-		stmt := `SELECT pipeline_runs.* FROM pipeline_runs INNER JOIN jobs ON pipeline_runs.pipeline_spec_id = jobs.pipeline_spec_id WHERE jobs.id = ANY($1)
+		stmt := `SELECT pipeline_runs.* FROM pipeline_runs INNER JOIN job_pipeline_specs ON pipeline_runs.pipeline_spec_id = job_pipeline_specs.pipeline_spec_id WHERE job_pipeline_specs.job_id = ANY($1)
 		ORDER BY pipeline_runs.created_at DESC, pipeline_runs.id DESC;`
 		if err = tx.Select(&runs, stmt, ids); err != nil {
 			return errors.Wrap(err, "error loading runs")
@@ -661,7 +1112,7 @@ func (o *orm) FindPipelineRunIDsByJobID(jobID int32, offset, limit int) (ids []i
 		stmt := `
 SELECT pipeline_runs.id
 FROM pipeline_runs
-WHERE pipeline_runs.pipeline_spec_id = (SELECT jobs.pipeline_spec_id FROM JOBS WHERE jobs.id = $1)
+WHERE pipeline_runs.pipeline_spec_id IN (SELECT pipeline_spec_id FROM job_pipeline_specs WHERE job_id = $1)
 ORDER BY pipeline_runs.created_at DESC, pipeline_runs.id DESC
 OFFSET $2
 LIMIT $3
@@ -728,7 +1179,7 @@ func (o *orm) CountPipelineRunsByJobID(jobID int32) (count int32, err error) {
 		stmt := `
 SELECT COUNT(*)
 FROM pipeline_runs
-WHERE pipeline_runs.pipeline_spec_id = (SELECT jobs.pipeline_spec_id FROM JOBS WHERE jobs.id = $1)
+WHERE pipeline_runs.pipeline_spec_id IN (SELECT pipeline_spec_id FROM job_pipeline_specs WHERE job_id = $1)
 `
 		if err = tx.Get(&count, stmt, jobID); err != nil {
 			return errors.Wrap(err, "error counting runs")
@@ -740,15 +1191,40 @@ WHERE pipeline_runs.pipeline_spec_id = (SELECT jobs.pipeline_spec_id FROM JOBS W
 	return count, errors.Wrap(err, "PipelineRunsByJobsIDs failed")
 }
 
+// FindJobsByPipelineSpecIDs returns the jobs owning the given pipeline specs,
+// which may be previous versions. A job is returned once per spec, with
+// PipelineSpecID and PipelineSpec set to that spec.
 func (o *orm) FindJobsByPipelineSpecIDs(ids []int32) ([]Job, error) {
 	var jbs []Job
 
 	err := o.q.Transaction(func(tx pg.Queryer) error {
-		stmt := `SELECT * FROM jobs WHERE jobs.pipeline_spec_id = ANY($1) ORDER BY id ASC
+		var versions []struct {
+			PipelineSpecID int32
+			JobID          int32
+		}
+		stmt := `SELECT pipeline_spec_id, job_id FROM job_pipeline_specs WHERE pipeline_spec_id = ANY($1)`
+		if err := tx.Select(&versions, stmt, ids); err != nil {
+			return errors.Wrap(err, "error fetching pipeline spec versions")
+		}
+		jobIDs := make([]int32, len(versions))
+		for i, v := range versions {
+			jobIDs[i] = v.JobID
+		}
+
+		var owners []Job
+		stmt = `SELECT * FROM jobs WHERE jobs.id = ANY($1) ORDER BY id ASC
 `
-		if err := tx.Select(&jbs, stmt, ids); err != nil {
+		if err := tx.Select(&owners, stmt, jobIDs); err != nil {
 			return errors.Wrap(err, "error fetching jobs by pipeline spec IDs")
 		}
+		for _, jb := range owners {
+			for _, v := range versions {
+				if v.JobID == jb.ID {
+					jb.PipelineSpecID = v.PipelineSpecID
+					jbs = append(jbs, jb)
+				}
+			}
+		}
 
 		err := LoadAllJobsTypes(tx, jbs)
 		if err != nil {
@@ -774,15 +1250,15 @@ func (o *orm) PipelineRuns(jobID *int32, offset, size int) (runs []pipeline.Run,
 		var args []interface{}
 		var where string
 		if jobID != nil {
-			where = " WHERE jobs.id = $1"
+			where = " WHERE job_pipeline_specs.job_id = $1"
 			args = append(args, *jobID)
 		}
-		sql := fmt.Sprintf(`SELECT count(*) FROM pipeline_runs INNER JOIN jobs ON pipeline_runs.pipeline_spec_id = jobs.pipeline_spec_id%s`, where)
+		sql := fmt.Sprintf(`SELECT count(*) FROM pipeline_runs INNER JOIN job_pipeline_specs ON pipeline_runs.pipeline_spec_id = job_pipeline_specs.pipeline_spec_id%s`, where)
 		if err = tx.QueryRowx(sql, args...).Scan(&count); err != nil {
 			return errors.Wrap(err, "error counting runs")
 		}
 
-		sql = fmt.Sprintf(`SELECT pipeline_runs.* FROM pipeline_runs INNER JOIN jobs ON pipeline_runs.pipeline_spec_id = jobs.pipeline_spec_id%s
+		sql = fmt.Sprintf(`SELECT pipeline_runs.* FROM pipeline_runs INNER JOIN job_pipeline_specs ON pipeline_runs.pipeline_spec_id = job_pipeline_specs.pipeline_spec_id%s
 		ORDER BY pipeline_runs.created_at DESC, pipeline_runs.id DESC
 		OFFSET $%d LIMIT $%d
 		;`, where, len(args)+1, len(args)+2)
@@ -812,7 +1288,7 @@ func (o *orm) loadPipelineRunsRelations(runs []pipeline.Run, tx pg.Queryer) ([]p
 	for specID := range specM {
 		specIDs = append(specIDs, specID)
 	}
-	stmt := `SELECT pipeline_specs.*, jobs.id AS job_id FROM pipeline_specs JOIN jobs ON pipeline_specs.id = jobs.pipeline_spec_id WHERE pipeline_specs.id = ANY($1);`
+	stmt := `SELECT pipeline_specs.*, job_pipeline_specs.job_id FROM pipeline_specs JOIN job_pipeline_specs ON pipeline_specs.id = job_pipeline_specs.pipeline_spec_id WHERE pipeline_specs.id = ANY($1);`
 	var specs []pipeline.Spec
 	if err := o.q.Select(&specs, stmt, specIDs); err != nil {
 		return nil, errors.Wrap(err, "error loading specs")
@@ -862,6 +1338,8 @@ func LoadAllJobTypes(tx pg.Queryer, job *Job) error {
 		loadJobType(tx, job, "PipelineSpec", "pipeline_specs", &job.PipelineSpecID),
 		loadJobType(tx, job, "FluxMonitorSpec", "flux_monitor_specs", job.FluxMonitorSpecID),
 		loadJobType(tx, job, "DirectRequestSpec", "direct_request_specs", job.DirectRequestSpecID),
+		loadJobType(tx, job, "EventTriggerSpec", "event_trigger_specs", job.EventTriggerSpecID),
+		loadJobType(tx, job, "BlockTriggerSpec", "block_trigger_specs", job.BlockTriggerSpecID),
 		loadJobType(tx, job, "OffchainreportingOracleSpec", "offchainreporting_oracle_specs", job.OffchainreportingOracleSpecID),
 		loadJobType(tx, job, "Offchainreporting2OracleSpec", "offchainreporting2_oracle_specs", job.Offchainreporting2OracleSpecID),
 		loadJobType(tx, job, "KeeperSpec", "keeper_specs", job.KeeperSpecID),
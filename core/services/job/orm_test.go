@@ -1,9 +1,13 @@
 package job
 
 import (
+	"reflect"
 	"testing"
+	"time"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/chainlink/core/chains/evm"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/configtest"
@@ -12,6 +16,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/services/keystore"
 	"github.com/smartcontractkit/chainlink/core/services/pg"
 	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/core/store/models"
 	"github.com/smartcontractkit/sqlx"
 )
 
@@ -37,3 +42,75 @@ func TestLoadEnvConfigVarsLocalOCR(t *testing.T) {
 	require.True(t, jobSpec.ObservationGracePeriodEnv)
 	require.True(t, jobSpec.ContractTransmitterTransmitTimeoutEnv)
 }
+
+func TestTypeSpecs(t *testing.T) {
+	jobFields := reflect.TypeOf(Job{})
+	for name := range updatableJobFields {
+		_, exists := jobFields.FieldByName(name)
+		assert.True(t, exists, "Job has no field %s", name)
+	}
+	for jobType := range schemaVersions {
+		spec, exists := typeSpecs[jobType]
+		require.True(t, exists, "no type-specific spec for %s jobs", jobType)
+		field, exists := jobFields.FieldByName(spec.field)
+		require.True(t, exists, "Job has no field %s", spec.field)
+		for name := range spec.ignored {
+			_, exists = field.Type.Elem().FieldByName(name)
+			assert.True(t, exists, "%s has no field %s", spec.field, name)
+		}
+	}
+}
+
+func TestChangedSetting(t *testing.T) {
+	newCronJob := func() Job {
+		return Job{
+			ID:            1,
+			Type:          Cron,
+			SchemaVersion: 1,
+			KeyPool:       null.StringFrom("pool"),
+			CronSpec:      &CronSpec{ID: 1, CronSchedule: "@every 1h", Jitter: models.Interval(time.Second), CreatedAt: time.Now()},
+		}
+	}
+	newWebhookJob := func(spec string) Job {
+		eiSpec, err := models.ParseJSON([]byte(spec))
+		require.NoError(t, err)
+		return Job{
+			Type:          Webhook,
+			SchemaVersion: 1,
+			WebhookSpec: &WebhookSpec{
+				ExternalInitiatorWebhookSpecs: []ExternalInitiatorWebhookSpec{{ExternalInitiatorID: 1, Spec: eiSpec}},
+			},
+		}
+	}
+
+	t.Run("ignores the settings that UpdateJob stores, IDs and timestamps", func(t *testing.T) {
+		updated := newCronJob()
+		updated.Name = null.StringFrom("updated")
+		updated.MaxTaskDuration = models.Interval(time.Minute)
+		updated.CronSpec.ID = 0
+		updated.CronSpec.CreatedAt = time.Time{}
+
+		assert.Equal(t, "", changedSetting(newCronJob(), updated))
+	})
+
+	t.Run("returns a changed setting of the job", func(t *testing.T) {
+		updated := newCronJob()
+		updated.KeyPool = null.String{}
+
+		assert.Equal(t, "KeyPool", changedSetting(newCronJob(), updated))
+	})
+
+	t.Run("returns a changed setting of the type-specific spec", func(t *testing.T) {
+		updated := newCronJob()
+		updated.CronSpec.Jitter = models.Interval(2 * time.Second)
+
+		assert.Equal(t, "CronSpec.Jitter", changedSetting(newCronJob(), updated))
+	})
+
+	t.Run("compares external initiator specs regardless of formatting", func(t *testing.T) {
+		current := newWebhookJob(`{"a": 1, "b": 2}`)
+
+		assert.Equal(t, "", changedSetting(current, newWebhookJob(`{"b":2,"a":1}`)))
+		assert.Equal(t, "WebhookSpec.ExternalInitiatorWebhookSpecs", changedSetting(current, newWebhookJob(`{"b":3,"a":1}`)))
+	})
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/services/pg"
 	"github.com/smartcontractkit/chainlink/core/utils"
 	"github.com/smartcontractkit/sqlx"
+	"go.uber.org/multierr"
 )
 
 //go:generate mockery --name Spawner --output ./mocks/ --case=underscore
@@ -26,6 +27,22 @@ type (
 		service.Service
 		CreateJob(jb *Job, qopts ...pg.QOpt) error
 		DeleteJob(jobID int32, qopts ...pg.QOpt) error
+		// PauseJob stops the services of the job and persists it as paused,
+		// so that they are not started again until it is resumed.
+		PauseJob(jobID int32, qopts ...pg.QOpt) (Job, error)
+		// ResumeJob clears the paused state of the job and starts its
+		// services again. If they fail to start, the job stays paused. It
+		// returns the job as it was while paused.
+		ResumeJob(jobID int32, qopts ...pg.QOpt) (Job, error)
+		// UpdateJob stores the pipeline of jb as a new version of the job with
+		// the same ID, and replaces the running services of the job with
+		// services for the new version. If they fail to start, the old
+		// version is restored as the current one and its services are
+		// restarted.
+		UpdateJob(jb *Job, qopts ...pg.QOpt) error
+		// RollbackJob makes a previous version of the job current again, and
+		// replaces the running services of the job with services for it.
+		RollbackJob(jobID int32, version int32, qopts ...pg.QOpt) (Job, error)
 		ActiveJobs() map[int32]Job
 
 		// NOTE: Prefer to use CreateJob, this is only publicly exposed for use in tests
@@ -131,8 +148,13 @@ func (js *spawner) stopService(jobID int32) {
 	js.activeJobsMu.Lock()
 	defer js.activeJobsMu.Unlock()
 
-	aj := js.activeJobs[jobID]
+	js.closeServices(jobID, js.activeJobs[jobID])
+	delete(js.activeJobs, jobID)
+}
 
+// closeServices stops the services of the active job in reverse order.
+// activeJobsMu must be held.
+func (js *spawner) closeServices(jobID int32, aj activeJob) {
 	for i := len(aj.services) - 1; i >= 0; i-- {
 		service := aj.services[i]
 		err := service.Close()
@@ -142,14 +164,18 @@ func (js *spawner) stopService(jobID int32) {
 			js.lggr.Infow("Stopped job service", "jobID", jobID, "subservice", i, "serviceType", reflect.TypeOf(service))
 		}
 	}
-
-	delete(js.activeJobs, jobID)
 }
 
 func (js *spawner) StartService(spec Job) error {
 	js.activeJobsMu.Lock()
 	defer js.activeJobsMu.Unlock()
 
+	return js.startService(spec)
+}
+
+// startService starts the services of the job and adds it to the active jobs.
+// activeJobsMu must be held.
+func (js *spawner) startService(spec Job) error {
 	delegate, exists := js.jobTypeDelegates[spec.Type]
 	if !exists {
 		js.lggr.Errorw("Job type has not been registered with job.Spawner", "type", spec.Type, "jobID", spec.ID)
@@ -161,6 +187,14 @@ func (js *spawner) StartService(spec Job) error {
 	// that it was able to start without an error.
 	aj := activeJob{delegate: delegate, spec: spec}
 
+	// Paused jobs are active so that they can be resumed or deleted, but
+	// their services are not started.
+	if spec.Paused() {
+		js.lggr.Infow("JobSpawner: Not starting services for paused job", "jobID", spec.ID)
+		js.activeJobs[spec.ID] = aj
+		return nil
+	}
+
 	services, err := delegate.ServicesForSpec(spec)
 	if err != nil {
 		js.lggr.Errorw("Error creating services for job", "jobID", spec.ID, "error", err)
@@ -244,12 +278,180 @@ func (js *spawner) DeleteJob(jobID int32, qopts ...pg.QOpt) error {
 
 	aj.delegate.BeforeJobDeleted(aj.spec)
 
-	var cancel context.CancelFunc
-	defer func() {
-		if cancel != nil {
-			cancel()
+	mergeCtx, cancel := js.stopCtx()
+	defer cancel()
+	err := js.orm.DeleteJob(jobID, append(qopts, mergeCtx)...)
+	if err != nil {
+		js.lggr.Errorw("Error deleting job", "jobID", jobID, "error", err)
+		return err
+	}
+
+	js.lggr.Infow("Deleted job", "jobID", jobID)
+
+	return nil
+}
+
+// Should not get called before Start()
+func (js *spawner) PauseJob(jobID int32, qopts ...pg.QOpt) (Job, error) {
+	js.activeJobsMu.Lock()
+	defer js.activeJobsMu.Unlock()
+
+	aj, exists := js.activeJobs[jobID]
+	if !exists {
+		return Job{}, errors.Errorf("job not found (id: %v)", jobID)
+	}
+	if aj.spec.Paused() {
+		return aj.spec, ErrJobPaused
+	}
+
+	mergeCtx, cancel := js.stopCtx()
+	defer cancel()
+	err := js.orm.PauseJob(&aj.spec, append(qopts, mergeCtx)...)
+	if err != nil {
+		js.lggr.Errorw("Error pausing job", "jobID", jobID, "error", err)
+		return aj.spec, err
+	}
+
+	js.closeServices(jobID, aj)
+	aj.services = nil
+	js.activeJobs[jobID] = aj
+
+	js.lggr.Infow("Paused job", "jobID", jobID)
+	return aj.spec, nil
+}
+
+// Should not get called before Start()
+func (js *spawner) ResumeJob(jobID int32, qopts ...pg.QOpt) (Job, error) {
+	js.activeJobsMu.Lock()
+	defer js.activeJobsMu.Unlock()
+
+	aj, exists := js.activeJobs[jobID]
+	if !exists {
+		return Job{}, errors.Errorf("job not found (id: %v)", jobID)
+	}
+	if !aj.spec.Paused() {
+		return aj.spec, ErrJobNotPaused
+	}
+
+	jb := aj.spec
+	err := js.updateJob(aj, &jb, qopts, func(qopts ...pg.QOpt) error {
+		return js.orm.ResumeJob(&jb, qopts...)
+	})
+	if err != nil {
+		js.lggr.Errorw("Error resuming job", "jobID", jobID, "error", err)
+		return aj.spec, err
+	}
+
+	js.lggr.Infow("Resumed job", "jobID", jobID)
+	return aj.spec, nil
+}
+
+// Should not get called before Start()
+func (js *spawner) UpdateJob(jb *Job, qopts ...pg.QOpt) error {
+	js.activeJobsMu.Lock()
+	defer js.activeJobsMu.Unlock()
+
+	aj, exists := js.activeJobs[jb.ID]
+	if !exists {
+		return errors.Errorf("job not found (id: %v)", jb.ID)
+	}
+
+	err := js.updateJob(aj, jb, qopts, func(qopts ...pg.QOpt) error {
+		return js.orm.UpdateJob(jb, qopts...)
+	})
+	if err != nil {
+		js.lggr.Errorw("Error updating job", "jobID", jb.ID, "error", err)
+		return err
+	}
+
+	js.lggr.Infow("Updated job", "jobID", jb.ID, "version", jb.PipelineSpec.Version)
+	return nil
+}
+
+// Should not get called before Start()
+func (js *spawner) RollbackJob(jobID int32, version int32, qopts ...pg.QOpt) (Job, error) {
+	js.activeJobsMu.Lock()
+	defer js.activeJobsMu.Unlock()
+
+	aj, exists := js.activeJobs[jobID]
+	if !exists {
+		return Job{}, errors.Errorf("job not found (id: %v)", jobID)
+	}
+
+	jb := aj.spec
+	err := js.updateJob(aj, &jb, qopts, func(qopts ...pg.QOpt) error {
+		return js.orm.RollbackJob(&jb, version, qopts...)
+	})
+	if err != nil {
+		js.lggr.Errorw("Error rolling back job", "jobID", jobID, "version", version, "error", err)
+		return aj.spec, err
+	}
+
+	js.lggr.Infow("Rolled back job", "jobID", jobID, "version", version)
+	return jb, nil
+}
+
+// updateJob stores a change to the active job's spec with update, and then
+// replaces the job's services with those for the changed spec. The change is
+// committed before the services are swapped, so that no transaction is held
+// open while they start. If the new services cannot be started, the job's
+// old spec is restored as its current one, and the job keeps running with it.
+// activeJobsMu must be held.
+func (js *spawner) updateJob(aj activeJob, jb *Job, qopts []pg.QOpt, update func(qopts ...pg.QOpt) error) error {
+	mergeCtx, cancel := js.stopCtx()
+	defer cancel()
+	qopts = append(qopts, mergeCtx)
+
+	if err := update(qopts...); err != nil {
+		return err
+	}
+	err := js.replaceServices(aj, *jb)
+	if err != nil {
+		if rerr := js.orm.RestoreJob(&aj.spec, qopts...); rerr != nil {
+			js.lggr.Errorw("Error restoring job", "jobID", jb.ID, "error", rerr)
+			return multierr.Combine(err, errors.Wrap(rerr, "failed to restore job"))
 		}
-	}()
+	}
+	return err
+}
+
+// replaceServices stops the services of the active job and starts services
+// for its new spec in their place. The new services are created before the
+// old ones are stopped, and if any of them fails to start, the old spec's
+// services are started again and the error is returned. Holding activeJobsMu
+// throughout makes the swap atomic to other callers of the spawner.
+// activeJobsMu must be held.
+func (js *spawner) replaceServices(aj activeJob, spec Job) error {
+	var services []Service
+	if !spec.Paused() {
+		var err error
+		services, err = aj.delegate.ServicesForSpec(spec)
+		if err != nil {
+			return errors.Wrap(err, "failed to create services for job")
+		}
+	}
+
+	js.closeServices(spec.ID, aj)
+	delete(js.activeJobs, spec.ID)
+
+	for i, service := range services {
+		if err := service.Start(); err != nil {
+			js.closeServices(spec.ID, activeJob{services: services[:i]})
+			if serr := js.startService(aj.spec); serr != nil {
+				js.lggr.Errorw("Error restarting job services", "jobID", spec.ID, "error", serr)
+			}
+			return errors.Wrap(err, "failed to start service for job")
+		}
+	}
+	js.lggr.Debugw("JobSpawner: Started services for job", "jobID", spec.ID, "count", len(services))
+	js.activeJobs[spec.ID] = activeJob{delegate: aj.delegate, spec: spec, services: services}
+	return nil
+}
+
+// stopCtx returns a QOpt that cancels the query's context when the spawner
+// is stopped, and the func to release it
+func (js *spawner) stopCtx() (pg.QOpt, context.CancelFunc) {
+	var cancel context.CancelFunc
 	setCtx := func(parentCtx context.Context) (ctx context.Context) {
 		if parentCtx == nil {
 			ctx, cancel = utils.ContextFromChan(js.chStop)
@@ -258,15 +460,11 @@ func (js *spawner) DeleteJob(jobID int32, qopts ...pg.QOpt) error {
 		}
 		return ctx
 	}
-	err := js.orm.DeleteJob(jobID, append(qopts, pg.MergeCtx(setCtx))...)
-	if err != nil {
-		js.lggr.Errorw("Error deleting job", "jobID", jobID, "error", err)
-		return err
+	return pg.MergeCtx(setCtx), func() {
+		if cancel != nil {
+			cancel()
+		}
 	}
-
-	js.lggr.Infow("Deleted job", "jobID", jobID)
-
-	return nil
 }
 
 func (js *spawner) ActiveJobs() map[int32]Job {
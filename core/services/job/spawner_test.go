@@ -1,13 +1,16 @@
 package job_test
 
 import (
+	"context"
 	"testing"
 	"time"
 
 	"github.com/onsi/gomega"
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/evmtest"
@@ -41,6 +44,21 @@ func (d delegate) ServicesForSpec(js job.Job) ([]job.Service, error) {
 	return d.services, nil
 }
 
+// funcDelegate creates the services of a job with servicesForSpec
+type funcDelegate struct {
+	jobType         job.Type
+	servicesForSpec func(job.Job) ([]job.Service, error)
+	job.Delegate
+}
+
+func (d funcDelegate) JobType() job.Type {
+	return d.jobType
+}
+
+func (d funcDelegate) ServicesForSpec(js job.Job) ([]job.Service, error) {
+	return d.servicesForSpec(js)
+}
+
 func clearDB(t *testing.T, db *sqlx.DB) {
 	_, err := db.Exec(`TRUNCATE jobs, pipeline_runs, pipeline_specs, pipeline_task_runs CASCADE`)
 	require.NoError(t, err)
@@ -239,3 +257,133 @@ func TestSpawner_CreateJobDeleteJob(t *testing.T) {
 		mock.AssertExpectationsForObjects(t, serviceA1, serviceA2)
 	})
 }
+
+func TestSpawner_UpdateJob(t *testing.T) {
+	config := cltest.NewTestGeneralConfig(t)
+	db := pgtest.NewSqlxDB(t)
+	keyStore := cltest.NewKeyStore(t, db, config)
+	lggr := logger.TestLogger(t)
+	cc := evmtest.NewChainSet(t, evmtest.TestChainOpts{DB: db, GeneralConfig: config})
+	orm := job.NewTestORM(t, db, cc, pipeline.NewORM(db, lggr, config), keyStore, config)
+
+	t.Run("restores the old version when the new services fail to start", func(t *testing.T) {
+		jb := cltest.MakeDirectRequestJobSpec(t)
+		jb.Name = null.StringFrom("original")
+
+		oldService := new(mocks.Service)
+		oldService.On("Start").Return(nil).Twice()
+		oldService.On("Close").Return(nil)
+		newService := new(mocks.Service)
+		newService.On("Start").Return(errors.New("failed to start")).Once()
+
+		d := &funcDelegate{jobType: jb.Type, servicesForSpec: func(spec job.Job) ([]job.Service, error) {
+			if spec.Name.ValueOrZero() == "updated" {
+				return []job.Service{newService}, nil
+			}
+			return []job.Service{oldService}, nil
+		}}
+		spawner := job.NewSpawner(orm, config, map[job.Type]job.Delegate{jb.Type: d}, db, lggr, nil)
+		require.NoError(t, spawner.Start())
+		defer spawner.Close()
+		require.NoError(t, spawner.CreateJob(jb))
+
+		updated := *jb
+		updated.Name = null.StringFrom("updated")
+		err := spawner.UpdateJob(&updated)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to start")
+
+		stored, err := orm.FindJob(context.Background(), jb.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "original", stored.Name.ValueOrZero())
+		assert.Equal(t, jb.PipelineSpecID, stored.PipelineSpecID)
+		// The failed version is kept in the job's history
+		versions, err := orm.FindJobVersions(jb.ID)
+		require.NoError(t, err)
+		assert.Len(t, versions, 2)
+		assert.Equal(t, "original", spawner.ActiveJobs()[jb.ID].Name.ValueOrZero())
+
+		mock.AssertExpectationsForObjects(t, oldService, newService)
+	})
+
+	t.Run("returns the error when the new services cannot be created", func(t *testing.T) {
+		jb := cltest.MakeDirectRequestJobSpec(t)
+
+		d := &funcDelegate{jobType: jb.Type, servicesForSpec: func(spec job.Job) ([]job.Service, error) {
+			if spec.Name.ValueOrZero() == "updated" {
+				return nil, errors.New("invalid spec")
+			}
+			return nil, nil
+		}}
+		spawner := job.NewSpawner(orm, config, map[job.Type]job.Delegate{jb.Type: d}, db, lggr, nil)
+		require.NoError(t, spawner.Start())
+		defer spawner.Close()
+		require.NoError(t, spawner.CreateJob(jb))
+
+		updated := *jb
+		updated.Name = null.StringFrom("updated")
+		err := spawner.UpdateJob(&updated)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid spec")
+
+		stored, err := orm.FindJob(context.Background(), jb.ID)
+		require.NoError(t, err)
+		assert.Equal(t, jb.PipelineSpecID, stored.PipelineSpecID)
+		versions, err := orm.FindJobVersions(jb.ID)
+		require.NoError(t, err)
+		assert.Len(t, versions, 2)
+	})
+}
+
+func TestSpawner_ResumeJob(t *testing.T) {
+	config := cltest.NewTestGeneralConfig(t)
+	db := pgtest.NewSqlxDB(t)
+	keyStore := cltest.NewKeyStore(t, db, config)
+	lggr := logger.TestLogger(t)
+	cc := evmtest.NewChainSet(t, evmtest.TestChainOpts{DB: db, GeneralConfig: config})
+	orm := job.NewTestORM(t, db, cc, pipeline.NewORM(db, lggr, config), keyStore, config)
+
+	jb := cltest.MakeDirectRequestJobSpec(t)
+	service := new(mocks.Service)
+	service.On("Start").Return(nil).Once()
+	service.On("Close").Return(nil).Once()
+	d := &funcDelegate{jobType: jb.Type, servicesForSpec: func(job.Job) ([]job.Service, error) {
+		return []job.Service{service}, nil
+	}}
+	spawner := job.NewSpawner(orm, config, map[job.Type]job.Delegate{jb.Type: d}, db, lggr, nil)
+	require.NoError(t, spawner.Start())
+	defer spawner.Close()
+	require.NoError(t, spawner.CreateJob(jb))
+
+	_, err := spawner.PauseJob(jb.ID)
+	require.NoError(t, err)
+	mock.AssertExpectationsForObjects(t, service)
+
+	t.Run("keeps the job paused when its services fail to start", func(t *testing.T) {
+		service.On("Start").Return(errors.New("failed to start")).Once()
+
+		_, err := spawner.ResumeJob(jb.ID)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to start")
+
+		stored, err := orm.FindJob(context.Background(), jb.ID)
+		require.NoError(t, err)
+		assert.True(t, stored.Paused())
+		assert.True(t, spawner.ActiveJobs()[jb.ID].Paused())
+		mock.AssertExpectationsForObjects(t, service)
+	})
+
+	t.Run("resumes the job once its services start", func(t *testing.T) {
+		service.On("Start").Return(nil).Once()
+		service.On("Close").Return(nil).Once()
+
+		paused, err := spawner.ResumeJob(jb.ID)
+		require.NoError(t, err)
+		assert.True(t, paused.Paused())
+
+		stored, err := orm.FindJob(context.Background(), jb.ID)
+		require.NoError(t, err)
+		assert.False(t, stored.Paused())
+		assert.False(t, spawner.ActiveJobs()[jb.ID].Paused())
+	})
+}
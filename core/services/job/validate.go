@@ -12,8 +12,10 @@ var (
 	ErrInvalidJobType       = errors.New("invalid job type")
 	ErrInvalidSchemaVersion = errors.New("invalid schema version")
 	jobTypes                = map[Type]struct{}{
+		BlockTrigger:       {},
 		Cron:               {},
 		DirectRequest:      {},
+		EventTrigger:       {},
 		FluxMonitor:        {},
 		OffchainReporting:  {},
 		OffchainReporting2: {},
@@ -57,6 +59,9 @@ func ValidateSpec(ts string) (Type, error) {
 	if jb.Pipeline.RequiresPreInsert() && !jb.Type.SupportsAsync() {
 		return "", errors.Errorf("async=true tasks are not supported for %v", jb.Type)
 	}
+	if err = validateGasSpendBudget(jb); err != nil {
+		return "", err
+	}
 
 	if strings.Contains(ts, "<{}>") {
 		return "", errors.Errorf("'<{}>' syntax is not supported. Please use \"{}\" instead")
@@ -64,3 +69,19 @@ func ValidateSpec(ts string) (Type, error) {
 
 	return jb.Type, nil
 }
+
+func validateGasSpendBudget(jb Job) error {
+	if jb.GasSpendBudgetWei == nil {
+		if jb.GasSpendBudgetWindow.Duration() != 0 {
+			return errors.New("gasSpendBudgetWindow requires gasSpendBudgetWei to be set")
+		}
+		return nil
+	}
+	if jb.GasSpendBudgetWei.ToInt().Sign() < 0 {
+		return errors.New("gasSpendBudgetWei must not be negative")
+	}
+	if jb.GasSpendBudgetWindow.Duration() <= 0 {
+		return errors.New("gasSpendBudgetWei requires a positive gasSpendBudgetWindow")
+	}
+	return nil
+}
@@ -91,6 +91,63 @@ ds [type=bridge async=true]
 				require.Error(t, err)
 			},
 		},
+		{
+			name: "gas spend budget without window",
+			spec: `
+type="vrf"
+schemaVersion=1
+gasSpendBudgetWei="1000000000000000000"
+observationSource="""
+ds [type=http]
+"""
+`,
+			assertion: func(t *testing.T, err error) {
+				require.EqualError(t, err, "gasSpendBudgetWei requires a positive gasSpendBudgetWindow")
+			},
+		},
+		{
+			name: "gas spend budget window without budget",
+			spec: `
+type="vrf"
+schemaVersion=1
+gasSpendBudgetWindow="24h"
+observationSource="""
+ds [type=http]
+"""
+`,
+			assertion: func(t *testing.T, err error) {
+				require.EqualError(t, err, "gasSpendBudgetWindow requires gasSpendBudgetWei to be set")
+			},
+		},
+		{
+			name: "gas spend budget",
+			spec: `
+type="vrf"
+schemaVersion=1
+gasSpendBudgetWei="1000000000000000000"
+gasSpendBudgetWindow="24h"
+observationSource="""
+ds [type=http]
+"""
+`,
+			assertion: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "key pool",
+			spec: `
+type="vrf"
+schemaVersion=1
+keyPool="vrf"
+observationSource="""
+ds [type=http]
+"""
+`,
+			assertion: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
 		{
 			name: "happy path",
 			spec: `
@@ -0,0 +1,311 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	gethCommon "github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/smartcontractkit/sqlx"
+
+	"github.com/smartcontractkit/chainlink/core/assets"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/service"
+	"github.com/smartcontractkit/chainlink/core/services/bulletprooftxmanager"
+	"github.com/smartcontractkit/chainlink/core/services/eth"
+	httypes "github.com/smartcontractkit/chainlink/core/services/headtracker/types"
+	"github.com/smartcontractkit/chainlink/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// keyFunderWindow is the window over which KeyFunderMaxDailyWei applies
+const keyFunderWindow = 24 * time.Hour
+
+var (
+	promKeyFunderFundingKeyBalance = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "key_funder_funding_key_balance",
+		Help: "The balance of the funding key that tops up sending keys, in ETH",
+	}, []string{"evmChainID", "fundingAddress"})
+	promKeyFunderFundingKeyLow = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "key_funder_funding_key_low",
+		Help: "Set to 1 if the balance of the funding key is below KEY_FUNDER_FUNDING_KEY_MIN_BALANCE_WEI",
+	}, []string{"evmChainID", "fundingAddress"})
+	promKeyFunderTopUpWei = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "key_funder_top_up_wei",
+		Help: "The total amount sent by the key funder to each sending key, in wei",
+	}, []string{"evmChainID", "fundingAddress", "address"})
+)
+
+type (
+	// KeyFunder tops up sending keys that run low from the chain's funding key
+	KeyFunder interface {
+		httypes.HeadTrackable
+		service.Service
+	}
+
+	// KeyFunderConfig is the config used by the KeyFunder
+	KeyFunderConfig interface {
+		pg.LogConfig
+		EvmGasLimitTransfer() uint64
+		KeyFunderFundingKeyMinBalanceWei() *big.Int
+		KeyFunderMaxDailyWei() *big.Int
+		KeyFunderMinBalanceWei() *big.Int
+		KeyFunderMinInterval() time.Duration
+		KeyFunderTargetBalanceWei() *big.Int
+	}
+
+	keyFunder struct {
+		utils.StartStopOnce
+		q           pg.Q
+		ethClient   eth.Client
+		ethKeyStore keystore.Eth
+		config      KeyFunderConfig
+		chainID     big.Int
+		logger      logger.Logger
+		sleeperTask utils.SleeperTask
+		chStop      chan struct{}
+
+		healthMu sync.RWMutex
+		health   error
+	}
+)
+
+// NewKeyFunder returns a new KeyFunder
+func NewKeyFunder(db *sqlx.DB, ethClient eth.Client, ethKeyStore keystore.Eth, config KeyFunderConfig, lggr logger.Logger) KeyFunder {
+	lggr = lggr.Named("KeyFunder")
+	kf := &keyFunder{
+		q:           pg.NewQ(db, lggr, config),
+		ethClient:   ethClient,
+		ethKeyStore: ethKeyStore,
+		config:      config,
+		chainID:     *ethClient.ChainID(),
+		logger:      lggr,
+		chStop:      make(chan struct{}),
+	}
+	kf.sleeperTask = utils.NewSleeperTask(&keyFunderWorker{kf})
+	return kf
+}
+
+func (kf *keyFunder) Start() error {
+	return kf.StartOnce("KeyFunder", func() error {
+		kf.logger.Debugw("KeyFunder: starting", "minBalanceWei", kf.config.KeyFunderMinBalanceWei(), "targetBalanceWei", kf.config.KeyFunderTargetBalanceWei(), "maxDailyWei", kf.config.KeyFunderMaxDailyWei(), "minInterval", kf.config.KeyFunderMinInterval())
+		return nil
+	})
+}
+
+// Close shuts down the KeyFunder, should not be used after this
+func (kf *keyFunder) Close() error {
+	return kf.StopOnce("KeyFunder", func() error {
+		close(kf.chStop)
+		return kf.sleeperTask.Stop()
+	})
+}
+
+func (kf *keyFunder) Ready() error {
+	return kf.StartStopOnce.Ready()
+}
+
+// Healthy returns an error if the funding key was missing or running low as
+// of the last check
+func (kf *keyFunder) Healthy() error {
+	if err := kf.StartStopOnce.Healthy(); err != nil {
+		return err
+	}
+	kf.healthMu.RLock()
+	defer kf.healthMu.RUnlock()
+	return kf.health
+}
+
+func (kf *keyFunder) setHealth(err error) {
+	kf.healthMu.Lock()
+	defer kf.healthMu.Unlock()
+	kf.health = err
+}
+
+// OnNewLongestChain checks the balance of each sending key
+func (kf *keyFunder) OnNewLongestChain(_ context.Context, _ *eth.Head) {
+	ok := kf.IfStarted(func() {
+		kf.sleeperTask.WakeUp()
+	})
+	if !ok {
+		kf.logger.Debugw("KeyFunder: ignoring OnNewLongestChain call, key funder is not started", "state", kf.State())
+	}
+}
+
+type keyFunderWorker struct {
+	kf *keyFunder
+}
+
+func (*keyFunderWorker) Name() string {
+	return "KeyFunderWorker"
+}
+
+func (w *keyFunderWorker) Work() {
+	ctx, cancel := utils.ContextFromChan(w.kf.chStop)
+	defer cancel()
+	if err := w.kf.FundAll(ctx); err != nil {
+		w.kf.logger.Errorw("KeyFunder: failed to top up sending keys", "err", err)
+	}
+}
+
+// FundAll tops up each sending key on the chain whose balance is below
+// KeyFunderMinBalanceWei to KeyFunderTargetBalanceWei. Keys that were topped
+// up within KeyFunderMinInterval, or that have a top-up in flight, are
+// skipped. No more than KeyFunderMaxDailyWei is sent from the funding key in
+// any 24 hours.
+func (kf *keyFunder) FundAll(ctx context.Context) error {
+	states, err := kf.ethKeyStore.GetStatesForChain(&kf.chainID)
+	if err != nil {
+		return errors.Wrap(err, "failed to get key states")
+	}
+	var fundingKey *ethkey.State
+	var sendingKeys []ethkey.State
+	for i, state := range states {
+		if !state.IsFunding {
+			sendingKeys = append(sendingKeys, state)
+		} else if fundingKey == nil {
+			fundingKey = &states[i]
+		}
+	}
+	if fundingKey == nil {
+		err = errors.Errorf("chain %s has no funding key to top up sending keys from", kf.chainID.String())
+		kf.setHealth(err)
+		return err
+	}
+	from := fundingKey.Address.Address()
+
+	fundingBalance, err := kf.balance(ctx, from)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get balance of funding key %s", from.Hex())
+	}
+	kf.checkFundingBalance(from, fundingBalance)
+
+	sent, err := kf.sentWithinWindow(from)
+	if err != nil {
+		return err
+	}
+	maxDaily := kf.config.KeyFunderMaxDailyWei()
+	for _, state := range sendingKeys {
+		to := state.Address.Address()
+		lggr := kf.logger.With("fundingAddress", from, "address", to)
+
+		skip, err := kf.recentlyToppedUp(from, to)
+		if err != nil {
+			return err
+		} else if skip {
+			continue
+		}
+		balance, err := kf.balance(ctx, to)
+		if err != nil {
+			lggr.Errorw("KeyFunder: failed to get balance of sending key", "err", err)
+			continue
+		}
+		if balance.Cmp(kf.config.KeyFunderMinBalanceWei()) >= 0 {
+			continue
+		}
+
+		amount := new(big.Int).Sub(kf.config.KeyFunderTargetBalanceWei(), balance)
+		if remaining := new(big.Int).Sub(maxDaily, sent); amount.Cmp(remaining) > 0 {
+			amount = remaining
+		}
+		if amount.Sign() <= 0 {
+			lggr.Errorw(fmt.Sprintf("KeyFunder: cannot top up sending key %s, %s wei were already sent within the last %s", to.Hex(), sent, keyFunderWindow), "balance", balance, "maxDailyWei", maxDaily)
+			continue
+		}
+		if amount.Cmp(fundingBalance) > 0 {
+			lggr.Errorw(fmt.Sprintf("KeyFunder: cannot top up sending key %s, funding key %s has insufficient funds", to.Hex(), from.Hex()), "balance", balance, "amountWei", amount, "fundingBalanceWei", fundingBalance)
+			continue
+		}
+
+		etx, err := bulletprooftxmanager.SendEther(kf.q, &kf.chainID, from, to, assets.Eth(*amount), kf.config.EvmGasLimitTransfer())
+		if err != nil {
+			return errors.Wrapf(err, "failed to top up sending key %s", to.Hex())
+		}
+		lggr.Infow(fmt.Sprintf("KeyFunder: topping up sending key %s with %s wei", to.Hex(), amount), "balance", balance, "amountWei", amount, "etxID", etx.ID)
+		amountFloat, _ := new(big.Float).SetInt(amount).Float64()
+		promKeyFunderTopUpWei.WithLabelValues(kf.chainID.String(), from.Hex(), to.Hex()).Add(amountFloat)
+
+		sent.Add(sent, amount)
+		fundingBalance.Sub(fundingBalance, amount)
+	}
+	return nil
+}
+
+func (kf *keyFunder) balance(ctx context.Context, address gethCommon.Address) (*big.Int, error) {
+	ctx, cancel := eth.DefaultQueryCtx(ctx)
+	defer cancel()
+	balance, err := kf.ethClient.BalanceAt(ctx, address, nil)
+	if err != nil {
+		return nil, err
+	} else if balance == nil {
+		return nil, errors.New("invariant violation, balance may not be nil")
+	}
+	return balance, nil
+}
+
+// checkFundingBalance reports the funding key as running low if its balance
+// is below KeyFunderFundingKeyMinBalanceWei or, if that is not set, if it
+// cannot pay for a full top-up
+func (kf *keyFunder) checkFundingBalance(address gethCommon.Address, balance *big.Int) {
+	threshold := kf.config.KeyFunderFundingKeyMinBalanceWei()
+	if threshold.Sign() == 0 {
+		threshold = kf.config.KeyFunderTargetBalanceWei()
+	}
+	ethBalance := assets.Eth(*balance)
+	if balanceFloat, err := ApproximateFloat64(&ethBalance); err == nil {
+		promKeyFunderFundingKeyBalance.WithLabelValues(kf.chainID.String(), address.Hex()).Set(balanceFloat)
+	}
+	if balance.Cmp(threshold) < 0 {
+		err := errors.Errorf("funding key %s is running low: balance is %s wei, below %s wei", address.Hex(), balance, threshold)
+		kf.logger.Errorw(fmt.Sprintf("KeyFunder: %v", err), "fundingAddress", address, "balanceWei", balance, "thresholdWei", threshold)
+		promKeyFunderFundingKeyLow.WithLabelValues(kf.chainID.String(), address.Hex()).Set(1)
+		kf.setHealth(err)
+		return
+	}
+	promKeyFunderFundingKeyLow.WithLabelValues(kf.chainID.String(), address.Hex()).Set(0)
+	kf.setHealth(nil)
+}
+
+// sentWithinWindow returns the total value of the plain transfers sent from
+// the funding key within keyFunderWindow. Manual transfers count too.
+func (kf *keyFunder) sentWithinWindow(from gethCommon.Address) (*big.Int, error) {
+	var sent utils.Big
+	err := kf.q.Get(&sent, `
+SELECT COALESCE(SUM(value), 0) FROM eth_txes
+WHERE from_address = $1 AND to_address <> $1 AND evm_chain_id = $2 AND octet_length(encoded_payload) = 0
+AND state <> 'fatal_error' AND created_at > $3
+`, from, kf.chainID.String(), time.Now().Add(-keyFunderWindow))
+	return sent.ToInt(), errors.Wrap(err, "failed to sum transfers from funding key")
+}
+
+// recentlyToppedUp returns true if a top-up of the given key is still in
+// flight, or was created within KeyFunderMinInterval
+func (kf *keyFunder) recentlyToppedUp(from, to gethCommon.Address) (bool, error) {
+	var last struct {
+		CreatedAt time.Time
+		State     bulletprooftxmanager.EthTxState
+	}
+	err := kf.q.Get(&last, `
+SELECT created_at, state FROM eth_txes
+WHERE from_address = $1 AND to_address = $2 AND evm_chain_id = $3 AND octet_length(encoded_payload) = 0 AND state <> 'fatal_error'
+ORDER BY created_at DESC, id DESC LIMIT 1
+`, from, to, kf.chainID.String())
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, nil
+	} else if err != nil {
+		return false, errors.Wrap(err, "failed to load last top-up")
+	}
+	switch last.State {
+	case bulletprooftxmanager.EthTxUnstarted, bulletprooftxmanager.EthTxInProgress, bulletprooftxmanager.EthTxUnconfirmed:
+		return true, nil
+	}
+	return time.Since(last.CreatedAt) < kf.config.KeyFunderMinInterval(), nil
+}
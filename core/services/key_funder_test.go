@@ -0,0 +1,157 @@
+package services_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/onsi/gomega"
+	"github.com/smartcontractkit/sqlx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/assets"
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/evmtest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services"
+	"github.com/smartcontractkit/chainlink/core/services/bulletprooftxmanager"
+	"github.com/smartcontractkit/chainlink/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+)
+
+func newKeyFunderTest(t *testing.T) (*sqlx.DB, keystore.Eth, services.KeyFunderConfig) {
+	t.Helper()
+
+	cfg := cltest.NewTestGeneralConfig(t)
+	cfg.Overrides.GlobalKeyFunderEnabled = null.BoolFrom(true)
+	cfg.Overrides.GlobalKeyFunderMinBalanceWei = big.NewInt(10)
+	cfg.Overrides.GlobalKeyFunderTargetBalanceWei = big.NewInt(100)
+	cfg.Overrides.GlobalKeyFunderMaxDailyWei = big.NewInt(1000)
+	cfg.Overrides.GlobalKeyFunderFundingKeyMinBalanceWei = big.NewInt(500)
+	db := pgtest.NewSqlxDB(t)
+	ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
+	return db, ethKeyStore, evmtest.NewChainScopedConfig(t, cfg)
+}
+
+func startKeyFunder(t *testing.T, kf services.KeyFunder) {
+	t.Helper()
+
+	require.NoError(t, kf.Start())
+	t.Cleanup(func() { assert.NoError(t, kf.Close()) })
+	kf.OnNewLongestChain(context.Background(), cltest.Head(0))
+}
+
+func assertTopUp(t *testing.T, db *sqlx.DB, from, to common.Address, value int64) {
+	t.Helper()
+
+	var etx bulletprooftxmanager.EthTx
+	require.NoError(t, db.Get(&etx, `SELECT * FROM eth_txes WHERE from_address = $1 AND to_address = $2 ORDER BY id DESC LIMIT 1`, from, to))
+	assert.Equal(t, bulletprooftxmanager.EthTxUnstarted, etx.State)
+	assert.Equal(t, big.NewInt(value).String(), etx.Value.ToInt().String())
+	assert.Len(t, etx.EncodedPayload, 0)
+}
+
+func TestKeyFunder_OnNewLongestChain(t *testing.T) {
+	t.Parallel()
+
+	t.Run("tops up sending keys below the minimum balance to the target balance", func(t *testing.T) {
+		db, ethKeyStore, cfg := newKeyFunderTest(t)
+		ethClient := NewEthClientMock(t)
+
+		_, fundingAddr := cltest.MustInsertRandomKey(t, ethKeyStore, true)
+		_, lowAddr := cltest.MustInsertRandomKey(t, ethKeyStore, false)
+		_, okAddr := cltest.MustInsertRandomKey(t, ethKeyStore, false)
+
+		ethClient.On("BalanceAt", mock.Anything, fundingAddr, nilBigInt).Return(big.NewInt(1000), nil)
+		ethClient.On("BalanceAt", mock.Anything, lowAddr, nilBigInt).Return(big.NewInt(9), nil)
+		ethClient.On("BalanceAt", mock.Anything, okAddr, nilBigInt).Return(big.NewInt(10), nil)
+
+		kf := services.NewKeyFunder(db, ethClient, ethKeyStore, cfg, logger.TestLogger(t))
+		startKeyFunder(t, kf)
+
+		cltest.WaitForCount(t, db, "eth_txes", 1)
+		assertTopUp(t, db, fundingAddr, lowAddr, 91)
+		cltest.AssertCountStays(t, db, "eth_txes", 1)
+		assert.NoError(t, kf.Healthy())
+	})
+
+	t.Run("does not top up keys with a top-up in flight", func(t *testing.T) {
+		db, ethKeyStore, cfg := newKeyFunderTest(t)
+		ethClient := NewEthClientMock(t)
+
+		_, fundingAddr := cltest.MustInsertRandomKey(t, ethKeyStore, true)
+		_, lowAddr := cltest.MustInsertRandomKey(t, ethKeyStore, false)
+
+		q := pg.NewQ(db, logger.TestLogger(t), cfg)
+		_, err := bulletprooftxmanager.SendEther(q, &cltest.FixtureChainID, fundingAddr, lowAddr, assets.NewEthValue(0), 21000)
+		require.NoError(t, err)
+
+		ethClient.On("BalanceAt", mock.Anything, fundingAddr, nilBigInt).Return(big.NewInt(1000), nil)
+
+		kf := services.NewKeyFunder(db, ethClient, ethKeyStore, cfg, logger.TestLogger(t))
+		startKeyFunder(t, kf)
+
+		cltest.AssertCountStays(t, db, "eth_txes", 1)
+	})
+
+	t.Run("does not send more than the daily maximum", func(t *testing.T) {
+		db, ethKeyStore, cfg := newKeyFunderTest(t)
+		ethClient := NewEthClientMock(t)
+
+		_, fundingAddr := cltest.MustInsertRandomKey(t, ethKeyStore, true)
+		_, lowAddr := cltest.MustInsertRandomKey(t, ethKeyStore, false)
+
+		// A manual transfer from the funding key counts towards the maximum
+		q := pg.NewQ(db, logger.TestLogger(t), cfg)
+		_, err := bulletprooftxmanager.SendEther(q, &cltest.FixtureChainID, fundingAddr, cltest.NewAddress(), assets.NewEthValue(950), 21000)
+		require.NoError(t, err)
+
+		ethClient.On("BalanceAt", mock.Anything, fundingAddr, nilBigInt).Return(big.NewInt(1000), nil)
+		ethClient.On("BalanceAt", mock.Anything, lowAddr, nilBigInt).Return(big.NewInt(0), nil)
+
+		kf := services.NewKeyFunder(db, ethClient, ethKeyStore, cfg, logger.TestLogger(t))
+		startKeyFunder(t, kf)
+
+		cltest.WaitForCount(t, db, "eth_txes", 2)
+		assertTopUp(t, db, fundingAddr, lowAddr, 50)
+	})
+
+	t.Run("reports the funding key running low", func(t *testing.T) {
+		db, ethKeyStore, cfg := newKeyFunderTest(t)
+		ethClient := NewEthClientMock(t)
+
+		_, fundingAddr := cltest.MustInsertRandomKey(t, ethKeyStore, true)
+		_, lowAddr := cltest.MustInsertRandomKey(t, ethKeyStore, false)
+
+		ethClient.On("BalanceAt", mock.Anything, fundingAddr, nilBigInt).Return(big.NewInt(499), nil)
+		ethClient.On("BalanceAt", mock.Anything, lowAddr, nilBigInt).Return(big.NewInt(0), nil)
+
+		kf := services.NewKeyFunder(db, ethClient, ethKeyStore, cfg, logger.TestLogger(t))
+		startKeyFunder(t, kf)
+
+		gomega.NewWithT(t).Eventually(kf.Healthy).Should(gomega.HaveOccurred())
+		assert.Contains(t, kf.Healthy().Error(), "is running low")
+		// Top-ups continue while the funding key can pay for them
+		cltest.WaitForCount(t, db, "eth_txes", 1)
+		assertTopUp(t, db, fundingAddr, lowAddr, 100)
+	})
+
+	t.Run("reports a missing funding key", func(t *testing.T) {
+		db, ethKeyStore, cfg := newKeyFunderTest(t)
+		ethClient := NewEthClientMock(t)
+
+		cltest.MustInsertRandomKey(t, ethKeyStore, false)
+
+		kf := services.NewKeyFunder(db, ethClient, ethKeyStore, cfg, logger.TestLogger(t))
+		startKeyFunder(t, kf)
+
+		gomega.NewWithT(t).Eventually(kf.Healthy).Should(gomega.HaveOccurred())
+		assert.Contains(t, kf.Healthy().Error(), "has no funding key")
+		cltest.AssertCountStays(t, db, "eth_txes", 0)
+	})
+}
@@ -47,6 +47,11 @@ type (
 		service.Service
 		httypes.HeadTrackable
 		ReplayFromBlock(number int64)
+		// ReplayFromBlockForJob replays the logs from the given block number
+		// for the contracts and topics the listeners of the job are registered
+		// for. Listeners of other jobs on the same contracts and topics receive
+		// these logs too, and skip those they have already consumed.
+		ReplayFromBlockForJob(jobID int32, number int64)
 
 		IsConnected() bool
 		Register(listener Listener, opts ListenerOpts) (unsubscribe func())
@@ -75,6 +80,9 @@ type (
 
 		// a block number to start backfill from
 		backfillBlockNumber null.Int64
+		// the job whose contracts and topics the backfill is limited to, or 0
+		// to backfill those of all jobs
+		backfillJobID int32
 
 		ethSubscriber *ethSubscriber
 		registrations *registrations
@@ -90,7 +98,7 @@ type (
 		chStop                chan struct{}
 		wgDone                sync.WaitGroup
 		trackedAddressesCount atomic.Uint32
-		replayChannel         chan int64
+		replayChannel         chan replayRequest
 		highestSavedHead      *eth.Head
 		lastSeenHeadNumber    atomic.Int64
 		logger                logger.Logger
@@ -127,6 +135,12 @@ type (
 		opts     ListenerOpts
 	}
 
+	replayRequest struct {
+		fromBlock int64
+		// jobID is the job to replay the logs for, or 0 for all jobs
+		jobID int32
+	}
+
 	Topic common.Hash
 )
 
@@ -150,7 +164,7 @@ func NewBroadcaster(orm ORM, ethClient eth.Client, config Config, lggr logger.Lo
 		DependentAwaiter: utils.NewDependentAwaiter(),
 		chStop:           chStop,
 		highestSavedHead: highestSavedHead,
-		replayChannel:    make(chan int64, 1),
+		replayChannel:    make(chan replayRequest, 1),
 	}
 }
 
@@ -165,7 +179,15 @@ func (b *broadcaster) Start() error {
 func (b *broadcaster) ReplayFromBlock(number int64) {
 	b.logger.Infof("Replay requested from block number: %v", number)
 	select {
-	case b.replayChannel <- number:
+	case b.replayChannel <- replayRequest{fromBlock: number}:
+	default:
+	}
+}
+
+func (b *broadcaster) ReplayFromBlockForJob(jobID int32, number int64) {
+	b.logger.Infow("Replay requested for job", "jobID", jobID, "blockNumber", number)
+	select {
+	case b.replayChannel <- replayRequest{fromBlock: number, jobID: jobID}:
 	default:
 	}
 }
@@ -289,12 +311,17 @@ func (b *broadcaster) startResubscribeLoop() {
 			)
 		}
 
-		chBackfilledLogs, abort := b.ethSubscriber.backfillLogs(b.backfillBlockNumber, addresses, topics)
+		backfillAddresses, backfillTopics := addresses, topics
+		if b.backfillJobID != 0 {
+			backfillAddresses, backfillTopics = b.registrations.addressesAndTopicsForJob(b.backfillJobID)
+		}
+		chBackfilledLogs, abort := b.ethSubscriber.backfillLogs(b.backfillBlockNumber, backfillAddresses, backfillTopics)
 		if abort {
 			return
 		}
 
 		b.backfillBlockNumber.Valid = false
+		b.backfillJobID = 0
 
 		// Each time this loop runs, chRawLogs is reconstituted as:
 		// "remaining logs from last subscription <- backfilled logs <- logs from new subscription"
@@ -374,9 +401,13 @@ func (b *broadcaster) eventLoop(chRawLogs <-chan types.Log, chErr <-chan error)
 		case <-b.rmSubscriber.Notify():
 			needsResubscribe = b.onRmSubscribers() || needsResubscribe
 
-		case blockNumber := <-b.replayChannel:
-			b.backfillBlockNumber.SetValid(blockNumber)
-			b.logger.Debugw("Returning from the event loop to replay logs from specific block number", "blockNumber", blockNumber)
+		case req := <-b.replayChannel:
+			// The listeners of a job are registered before a replay for it
+			// is requested, but may not have been added yet
+			b.onAddSubscribers()
+			b.backfillBlockNumber.SetValid(req.fromBlock)
+			b.backfillJobID = req.jobID
+			b.logger.Debugw("Returning from the event loop to replay logs from specific block number", "blockNumber", req.fromBlock, "jobID", req.jobID)
 			return true, nil
 
 		case <-debounceResubscribe.C:
@@ -627,6 +658,8 @@ func (n *NullBroadcaster) Register(listener Listener, opts ListenerOpts) (unsubs
 
 func (n *NullBroadcaster) ReplayFromBlock(number int64) {}
 
+func (n *NullBroadcaster) ReplayFromBlockForJob(jobID int32, number int64) {}
+
 func (n *NullBroadcaster) BackfillBlockNumber() null.Int64 {
 	return null.NewInt64(0, false)
 }
@@ -187,6 +187,58 @@ func TestBroadcaster_BackfillOnNodeStartAndOnReplay(t *testing.T) {
 	helper.mockEth.assertExpectations(t)
 }
 
+func TestBroadcaster_ReplayFromBlockForJob(t *testing.T) {
+	const (
+		lastStoredBlockHeight       = 100
+		blockHeight           int64 = 125
+		replayFrom            int64 = 40
+	)
+
+	expectedCalls := mockEthClientExpectedCalls{
+		SubscribeFilterLogs: 2,
+		HeaderByNumber:      2,
+		FilterLogs:          2,
+	}
+
+	chchRawLogs := make(chan chan<- types.Log, 2)
+	mockEth := newMockEthClient(t, chchRawLogs, blockHeight, expectedCalls)
+	helper := newBroadcasterHelperWithEthClient(t, mockEth.ethClient, cltest.Head(lastStoredBlockHeight))
+	helper.mockEth = mockEth
+
+	contract1 := newMockContract()
+	listener1 := helper.newLogListenerWithJob("one")
+	helper.register(listener1, contract1, 1)
+
+	contract2 := newMockContract()
+	listener2 := helper.newLogListenerWithJob("two")
+	helper.register(listener2, contract2, 1)
+
+	var backfillCount atomic.Int64
+	mockEth.checkFilterQuery = func(q ethereum.FilterQuery) {
+		times := backfillCount.Inc() - 1
+		if times == 0 {
+			assert.ElementsMatch(t, []common.Address{contract1.Address(), contract2.Address()}, q.Addresses)
+		} else if times == 1 {
+			// Only the contracts of the replayed job are backfilled
+			assert.Equal(t, replayFrom, q.FromBlock.Int64())
+			assert.Equal(t, []common.Address{contract2.Address()}, q.Addresses)
+		}
+	}
+
+	func() {
+		helper.start()
+		defer helper.stop()
+
+		require.Eventually(t, func() bool { return backfillCount.Load() == 1 }, cltest.WaitTimeout(t), time.Second)
+
+		helper.lb.ReplayFromBlockForJob(listener2.JobID(), replayFrom)
+
+		require.Eventually(t, func() bool { return backfillCount.Load() >= 2 }, cltest.WaitTimeout(t), time.Second)
+	}()
+
+	helper.mockEth.assertExpectations(t)
+}
+
 func TestBroadcaster_BackfillUnconsumedAfterCrash(t *testing.T) {
 	db := pgtest.NewSqlxDB(t)
 	lggr := logger.TestLogger(t)
@@ -365,6 +365,7 @@ type mockEth struct {
 	subscribeCalls   atomic.Int32
 	unsubscribeCalls atomic.Int32
 	checkFilterLogs  func(int64, int64)
+	checkFilterQuery func(ethereum.FilterQuery)
 }
 
 func (mock *mockEth) assertExpectations(t *testing.T) {
@@ -417,6 +418,9 @@ func newMockEthClient(t *testing.T, chchRawLogs chan<- chan<- types.Log, blockHe
 				if mockEth.checkFilterLogs != nil {
 					mockEth.checkFilterLogs(fromBlock, toBlock)
 				}
+				if mockEth.checkFilterQuery != nil {
+					mockEth.checkFilterQuery(filterQuery)
+				}
 			}).
 			Return(expectedCalls.FilterLogsResult, nil).
 			Times(expectedCalls.FilterLogs)
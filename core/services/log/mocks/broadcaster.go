@@ -147,6 +147,11 @@ func (_m *Broadcaster) ReplayFromBlock(number int64) {
 	_m.Called(number)
 }
 
+// ReplayFromBlockForJob provides a mock function with given fields: jobID, number
+func (_m *Broadcaster) ReplayFromBlockForJob(jobID int32, number int64) {
+	_m.Called(jobID, number)
+}
+
 // Start provides a mock function with given fields:
 func (_m *Broadcaster) Start() error {
 	ret := _m.Called()
@@ -126,6 +126,19 @@ func (r *registrations) addressesAndTopics() ([]common.Address, []common.Hash) {
 	return addresses, topics
 }
 
+// addressesAndTopicsForJob returns the contracts and topics the listeners of
+// the job are registered for
+func (r *registrations) addressesAndTopicsForJob(jobID int32) ([]common.Address, []common.Hash) {
+	var addresses []common.Address
+	var topics []common.Hash
+	for _, sub := range r.subscribers {
+		add, t := sub.addressesAndTopicsForJob(jobID)
+		addresses = append(addresses, add...)
+		topics = append(topics, t...)
+	}
+	return addresses, topics
+}
+
 func (r *registrations) isAddressRegistered(address common.Address) bool {
 	for _, sub := range r.subscribers {
 		if sub.isAddressRegistered(address) {
@@ -252,6 +265,27 @@ func (r *subscribers) addressesAndTopics() ([]common.Address, []common.Hash) {
 	return addresses, topics
 }
 
+func (r *subscribers) addressesAndTopicsForJob(jobID int32) ([]common.Address, []common.Hash) {
+	var addresses []common.Address
+	var topics []common.Hash
+	for addr, topicMap := range r.handlers {
+		var registered bool
+		for topic, listeners := range topicMap {
+			for listener := range listeners {
+				if listener.JobID() == jobID {
+					topics = append(topics, topic)
+					registered = true
+					break
+				}
+			}
+		}
+		if registered {
+			addresses = append(addresses, addr)
+		}
+	}
+	return addresses, topics
+}
+
 func (r *subscribers) isAddressRegistered(address common.Address) bool {
 	_, exists := r.handlers[address]
 	return exists
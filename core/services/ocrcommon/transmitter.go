@@ -5,6 +5,8 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/pkg/errors"
+	"gopkg.in/guregu/null.v4"
+
 	"github.com/smartcontractkit/chainlink/core/services/bulletprooftxmanager"
 	"github.com/smartcontractkit/chainlink/core/services/pg"
 )
@@ -23,26 +25,34 @@ type transmitter struct {
 	fromAddress common.Address
 	gasLimit    uint64
 	strategy    bulletprooftxmanager.TxStrategy
+	// generateAccessList is the job's override of EVM_GENERATE_ACCESS_LISTS
+	generateAccessList null.Bool
+	// jobID is recorded in the meta of transmissions, so that their gas spend
+	// is credited to the job
+	jobID int32
 }
 
 // NewTransmitter creates a new eth transmitter
-func NewTransmitter(txm txManager, fromAddress common.Address, gasLimit uint64, strategy bulletprooftxmanager.TxStrategy) Transmitter {
+func NewTransmitter(txm txManager, fromAddress common.Address, gasLimit uint64, strategy bulletprooftxmanager.TxStrategy, generateAccessList null.Bool, jobID int32) Transmitter {
 	return &transmitter{
-		txm:         txm,
-		fromAddress: fromAddress,
-		gasLimit:    gasLimit,
-		strategy:    strategy,
+		txm:                txm,
+		fromAddress:        fromAddress,
+		gasLimit:           gasLimit,
+		strategy:           strategy,
+		generateAccessList: generateAccessList,
+		jobID:              jobID,
 	}
 }
 
 func (t *transmitter) CreateEthTransaction(ctx context.Context, toAddress common.Address, payload []byte) error {
 	_, err := t.txm.CreateEthTransaction(bulletprooftxmanager.NewTx{
-		FromAddress:    t.fromAddress,
-		ToAddress:      toAddress,
-		EncodedPayload: payload,
-		GasLimit:       t.gasLimit,
-		Meta:           nil,
-		Strategy:       t.strategy,
+		FromAddress:        t.fromAddress,
+		ToAddress:          toAddress,
+		EncodedPayload:     payload,
+		GasLimit:           t.gasLimit,
+		Meta:               &bulletprooftxmanager.EthTxMeta{JobID: t.jobID},
+		GenerateAccessList: t.generateAccessList,
+		Strategy:           t.strategy,
 	}, pg.WithParentCtx(ctx))
 	return errors.Wrap(err, "Skipped OCR transmission")
 }
@@ -12,6 +12,7 @@ import (
 	bptxmmocks "github.com/smartcontractkit/chainlink/core/services/bulletprooftxmanager/mocks"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
 )
 
 func Test_Transmitter_CreateEthTransaction(t *testing.T) {
@@ -27,15 +28,16 @@ func Test_Transmitter_CreateEthTransaction(t *testing.T) {
 	txm := new(bptxmmocks.TxManager)
 	strategy := new(bptxmmocks.TxStrategy)
 
-	transmitter := ocrcommon.NewTransmitter(txm, fromAddress, gasLimit, strategy)
+	transmitter := ocrcommon.NewTransmitter(txm, fromAddress, gasLimit, strategy, null.BoolFrom(true), 42)
 
 	txm.On("CreateEthTransaction", bulletprooftxmanager.NewTx{
-		FromAddress:    fromAddress,
-		ToAddress:      toAddress,
-		EncodedPayload: payload,
-		GasLimit:       gasLimit,
-		Meta:           nil,
-		Strategy:       strategy,
+		FromAddress:        fromAddress,
+		ToAddress:          toAddress,
+		EncodedPayload:     payload,
+		GasLimit:           gasLimit,
+		Meta:               &bulletprooftxmanager.EthTxMeta{JobID: 42},
+		GenerateAccessList: null.BoolFrom(true),
+		Strategy:           strategy,
 	}, mock.Anything).Return(bulletprooftxmanager.EthTx{}, nil).Once()
 	require.NoError(t, transmitter.CreateEthTransaction(context.Background(), toAddress, payload))
 
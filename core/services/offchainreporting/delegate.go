@@ -183,7 +183,7 @@ func (d Delegate) ServicesForSpec(jobSpec job.Job) (services []job.Service, err
 			concreteSpec.ContractAddress.Address(),
 			contractCaller,
 			contractABI,
-			ocrcommon.NewTransmitter(chain.TxManager(), concreteSpec.TransmitterAddress.Address(), chain.Config().EvmGasLimitDefault(), strategy),
+			ocrcommon.NewTransmitter(chain.TxManager(), concreteSpec.TransmitterAddress.Address(), chain.Config().EvmGasLimitDefault(), strategy, jobSpec.GenerateAccessLists, jobSpec.ID),
 			chain.LogBroadcaster(),
 			tracker,
 			chain.ID(),
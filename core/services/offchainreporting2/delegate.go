@@ -205,7 +205,7 @@ func (d Delegate) ServicesForSpec(jobSpec job.Job) (services []job.Service, err
 			contract.Address(),
 			contractCaller,
 			contractABI,
-			ocrcommon.NewTransmitter(chain.TxManager(), ta.Address(), chain.Config().EvmGasLimitDefault(), strategy),
+			ocrcommon.NewTransmitter(chain.TxManager(), ta.Address(), chain.Config().EvmGasLimitDefault(), strategy, jobSpec.GenerateAccessLists, jobSpec.ID),
 			chain.LogBroadcaster(),
 			tracker,
 			d.lggr,
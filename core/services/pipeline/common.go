@@ -67,6 +67,7 @@ var (
 	ErrTimeout               = errors.New("timeout")
 	ErrTaskRunFailed         = errors.New("task run failed")
 	ErrCancelled             = errors.New("task run cancelled (fail early)")
+	ErrRunCancelled          = errors.New("pipeline run cancelled")
 )
 
 const (
@@ -77,6 +78,9 @@ const (
 type RunInfo struct {
 	IsRetryable bool
 	IsPending   bool
+	// Meta is recorded on the task run, e.g. whether a bridge response came
+	// from the cache
+	Meta map[string]interface{}
 }
 
 // retryableMeta should be returned if the error is non-deterministic; i.e. a
@@ -168,6 +172,10 @@ type TaskRunResult struct {
 	Attempts   uint
 	CreatedAt  time.Time
 	FinishedAt null.Time
+	// Skipped is set when the task never ran because its branch was not taken
+	Skipped bool
+	// Meta is taken from the task's RunInfo
+	Meta JSONSerializable
 	// runInfo is never persisted
 	runInfo RunInfo
 }
@@ -180,6 +188,20 @@ func (result *TaskRunResult) IsTerminal() bool {
 	return len(result.Task.Outputs()) == 0
 }
 
+// SkipsOutputs returns true if the tasks depending on this one must be skipped,
+// either because this task was skipped itself or because it is a conditional
+// that evaluated to false
+func (result *TaskRunResult) SkipsOutputs() bool {
+	if result.Skipped {
+		return true
+	}
+	if result.Task.Type() != TaskTypeConditional || result.Result.Error != nil {
+		return false
+	}
+	taken, ok := result.Result.Value.(bool)
+	return ok && !taken
+}
+
 // TaskRunResults represents a collection of results for all task runs for one pipeline run
 type TaskRunResults []TaskRunResult
 
@@ -304,6 +326,9 @@ const (
 	TaskTypeETHABIDecode     TaskType = "ethabidecode"
 	TaskTypeETHABIDecodeLog  TaskType = "ethabidecodelog"
 	TaskTypeMerge            TaskType = "merge"
+	TaskTypeConditional      TaskType = "conditional"
+	TaskTypeExpr             TaskType = "expr"
+	TaskTypeScript           TaskType = "script"
 
 	// Testing only.
 	TaskTypePanic TaskType = "panic"
@@ -380,6 +405,12 @@ func UnmarshalTaskFromMap(taskType TaskType, taskMap interface{}, ID int, dotID
 		task = &FailTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeMerge:
 		task = &MergeTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeConditional:
+		task = &ConditionalTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeExpr:
+		task = &ExprTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeScript:
+		task = &ScriptTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	default:
 		return nil, errors.Errorf(`unknown task type: "%v"`, taskType)
 	}
@@ -410,6 +441,18 @@ func UnmarshalTaskFromMap(taskType TaskType, taskMap interface{}, ID int, dotID
 	if err != nil {
 		return nil, err
 	}
+
+	switch taskType {
+	case TaskTypeExpr:
+		if err = task.(*ExprTask).Validate(); err != nil {
+			return nil, errors.Wrapf(err, "task %q", dotID)
+		}
+	case TaskTypeScript:
+		if err = task.(*ScriptTask).Validate(); err != nil {
+			return nil, errors.Wrapf(err, "task %q", dotID)
+		}
+	default:
+	}
 	return task, nil
 }
 
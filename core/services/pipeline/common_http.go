@@ -21,6 +21,7 @@ func makeHTTPRequest(
 	url URLParam,
 	requestData MapParam,
 	allowUnrestrictedNetworkAccess BoolParam,
+	timeout time.Duration,
 	cfg Config,
 ) ([]byte, int, http.Header, time.Duration, error) {
 
@@ -33,7 +34,7 @@ func makeHTTPRequest(
 		bodyReader = bytes.NewReader(bodyBytes)
 	}
 
-	timeoutCtx, cancel := context.WithTimeout(ctx, cfg.DefaultHTTPTimeout().Duration())
+	timeoutCtx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
 	request, err := http.NewRequestWithContext(timeoutCtx, string(method), url.String(), bodyReader)
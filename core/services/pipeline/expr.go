@@ -0,0 +1,868 @@
+package pipeline
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// The expression language used by the `expr` task. Expressions can only read
+// pipeline variables and call the builtin functions below, there's no other
+// way to interact with the node. Evaluation always terminates since there are
+// no loops, and the size and nesting depth of an expression are bounded.
+//
+// Numbers are arbitrary precision decimals. Strings holding a number are
+// converted automatically where a number is expected.
+//
+//    literals:    1, 1.5, "foo", 'foo', true, false, null
+//    variables:   foo, foo.bar, $(foo.bar)
+//    arithmetic:  + - * / % and unary -
+//    comparison:  == != < <= > >=
+//    logic:       && || ! and cond ? a : b
+//    functions:   min, max, abs, round, floor, ceil, concat, len,
+//                 bytes, hexEncode, hexDecode
+
+const (
+	maxExprLength = 4096
+	maxExprDepth  = 64
+	// maxExprPlaces bounds the decimal places of round() and of division, since
+	// the decimal library computes 10^places. 78 digits is enough for a uint256.
+	maxExprPlaces = 78
+)
+
+// ExprSyntaxError is returned when an expression can't be parsed. Pos is the
+// 1-based position of the offending token within the expression.
+type ExprSyntaxError struct {
+	Pos int
+	Msg string
+}
+
+func (e ExprSyntaxError) Error() string {
+	return fmt.Sprintf("%s at position %d", e.Msg, e.Pos)
+}
+
+type exprTokenKind int
+
+const (
+	exprTokenEOF exprTokenKind = iota
+	exprTokenNumber
+	exprTokenString
+	exprTokenIdent
+	exprTokenOperator
+)
+
+type exprToken struct {
+	kind exprTokenKind
+	text string
+	// value holds the unquoted string or the parsed number
+	value interface{}
+	pos   int
+}
+
+func (t exprToken) String() string {
+	if t.kind == exprTokenEOF {
+		return "end of expression"
+	}
+	return strconv.Quote(t.text)
+}
+
+var exprOperators = []string{
+	"==", "!=", "<=", ">=", "&&", "||",
+	"+", "-", "*", "/", "%", "<", ">", "!", "?", ":", "(", ")", ",",
+}
+
+func lexExpr(s string) ([]exprToken, error) {
+	runes := []rune(s)
+	var tokens []exprToken
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		pos := i + 1
+		switch {
+		case unicode.IsSpace(r):
+			i++
+
+		case isExprDigit(r):
+			start := i
+			for i < len(runes) && isExprDigit(runes[i]) {
+				i++
+			}
+			if i < len(runes) && runes[i] == '.' {
+				i++
+				if i >= len(runes) || !isExprDigit(runes[i]) {
+					return nil, ExprSyntaxError{Pos: i + 1, Msg: "expected digit after decimal point"}
+				}
+				for i < len(runes) && isExprDigit(runes[i]) {
+					i++
+				}
+			}
+			if i < len(runes) && isExprIdentStart(runes[i]) {
+				return nil, ExprSyntaxError{Pos: i + 1, Msg: fmt.Sprintf("unexpected character %q in number", runes[i])}
+			}
+			text := string(runes[start:i])
+			d, err := decimal.NewFromString(text)
+			if err != nil {
+				return nil, ExprSyntaxError{Pos: pos, Msg: fmt.Sprintf("invalid number %q", text)}
+			}
+			tokens = append(tokens, exprToken{kind: exprTokenNumber, text: text, value: d, pos: pos})
+
+		case r == '"' || r == '\'':
+			quote := r
+			var sb strings.Builder
+			i++
+			for {
+				if i >= len(runes) {
+					return nil, ExprSyntaxError{Pos: pos, Msg: "unterminated string"}
+				}
+				c := runes[i]
+				if c == quote {
+					i++
+					break
+				}
+				if c == '\\' {
+					if i+1 >= len(runes) {
+						return nil, ExprSyntaxError{Pos: pos, Msg: "unterminated string"}
+					}
+					switch esc := runes[i+1]; esc {
+					case '\\', '"', '\'':
+						sb.WriteRune(esc)
+					case 'n':
+						sb.WriteRune('\n')
+					case 't':
+						sb.WriteRune('\t')
+					default:
+						return nil, ExprSyntaxError{Pos: i + 1, Msg: fmt.Sprintf("invalid escape sequence \\%c", esc)}
+					}
+					i += 2
+					continue
+				}
+				sb.WriteRune(c)
+				i++
+			}
+			tokens = append(tokens, exprToken{kind: exprTokenString, text: string(runes[pos-1 : i]), value: sb.String(), pos: pos})
+
+		case r == '$':
+			// $(keypath) is accepted for consistency with task attributes
+			if i+1 >= len(runes) || runes[i+1] != '(' {
+				return nil, ExprSyntaxError{Pos: pos, Msg: `expected "(" after "$"`}
+			}
+			i += 2
+			for i < len(runes) && unicode.IsSpace(runes[i]) {
+				i++
+			}
+			start := i
+			i = scanExprIdent(runes, i)
+			if start == i {
+				return nil, ExprSyntaxError{Pos: i + 1, Msg: "expected variable name"}
+			}
+			text := string(runes[start:i])
+			for i < len(runes) && unicode.IsSpace(runes[i]) {
+				i++
+			}
+			if i >= len(runes) || runes[i] != ')' {
+				return nil, ExprSyntaxError{Pos: i + 1, Msg: `expected ")"`}
+			}
+			i++
+			tokens = append(tokens, exprToken{kind: exprTokenIdent, text: text, pos: pos})
+
+		case isExprIdentStart(r):
+			start := i
+			i = scanExprIdent(runes, i)
+			tokens = append(tokens, exprToken{kind: exprTokenIdent, text: string(runes[start:i]), pos: pos})
+
+		default:
+			var op string
+			end := i + 2
+			if end > len(runes) {
+				end = len(runes)
+			}
+			for _, candidate := range exprOperators {
+				if strings.HasPrefix(string(runes[i:end]), candidate) {
+					op = candidate
+					break
+				}
+			}
+			if op == "" {
+				return nil, ExprSyntaxError{Pos: pos, Msg: fmt.Sprintf("unexpected character %q", r)}
+			}
+			i += utf8.RuneCountInString(op)
+			tokens = append(tokens, exprToken{kind: exprTokenOperator, text: op, pos: pos})
+		}
+	}
+	return append(tokens, exprToken{kind: exprTokenEOF, pos: len(runes) + 1}), nil
+}
+
+func isExprDigit(r rune) bool {
+	return r >= '0' && r <= '9'
+}
+
+func isExprIdentStart(r rune) bool {
+	return r == '_' || (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z')
+}
+
+// scanExprIdent returns the end of the identifier or keypath starting at i
+func scanExprIdent(runes []rune, i int) int {
+	for i < len(runes) && (isExprIdentStart(runes[i]) || isExprDigit(runes[i]) || runes[i] == '.') {
+		i++
+	}
+	return i
+}
+
+type exprNode interface {
+	eval(env exprEnv) (interface{}, error)
+}
+
+type exprEnv struct {
+	vars      Vars
+	precision int32
+}
+
+type exprParser struct {
+	tokens []exprToken
+	next   int
+	depth  int
+}
+
+// parseExpr parses the expression, returning an ExprSyntaxError pointing at
+// the offending token if it is invalid
+func parseExpr(s string) (exprNode, error) {
+	if strings.TrimSpace(s) == "" {
+		return nil, ErrParameterEmpty
+	}
+	if len(s) > maxExprLength {
+		return nil, errors.Errorf("expression is too long (maximum %d characters)", maxExprLength)
+	}
+	tokens, err := lexExpr(s)
+	if err != nil {
+		return nil, err
+	}
+	p := &exprParser{tokens: tokens}
+	node, err := p.parseTernary()
+	if err != nil {
+		return nil, err
+	}
+	if tok := p.peek(); tok.kind != exprTokenEOF {
+		return nil, p.unexpected(tok)
+	}
+	return node, nil
+}
+
+func (p *exprParser) peek() exprToken {
+	return p.tokens[p.next]
+}
+
+func (p *exprParser) advance() exprToken {
+	tok := p.tokens[p.next]
+	if tok.kind != exprTokenEOF {
+		p.next++
+	}
+	return tok
+}
+
+func (p *exprParser) acceptOperator(ops ...string) (exprToken, bool) {
+	tok := p.peek()
+	if tok.kind != exprTokenOperator {
+		return tok, false
+	}
+	for _, op := range ops {
+		if tok.text == op {
+			return p.advance(), true
+		}
+	}
+	return tok, false
+}
+
+func (p *exprParser) expectOperator(op string) (exprToken, error) {
+	tok, ok := p.acceptOperator(op)
+	if !ok {
+		return tok, ExprSyntaxError{Pos: tok.pos, Msg: fmt.Sprintf("expected %q, got %s", op, tok)}
+	}
+	return tok, nil
+}
+
+func (p *exprParser) unexpected(tok exprToken) error {
+	return ExprSyntaxError{Pos: tok.pos, Msg: fmt.Sprintf("unexpected %s", tok)}
+}
+
+func (p *exprParser) enter(tok exprToken) error {
+	p.depth++
+	if p.depth > maxExprDepth {
+		return ExprSyntaxError{Pos: tok.pos, Msg: fmt.Sprintf("expression is nested too deeply (maximum %d levels)", maxExprDepth)}
+	}
+	return nil
+}
+
+func (p *exprParser) leave() {
+	p.depth--
+}
+
+func (p *exprParser) parseTernary() (exprNode, error) {
+	if err := p.enter(p.peek()); err != nil {
+		return nil, err
+	}
+	defer p.leave()
+
+	cond, err := p.parseBinary(0)
+	if err != nil {
+		return nil, err
+	}
+	tok, ok := p.acceptOperator("?")
+	if !ok {
+		return cond, nil
+	}
+	a, err := p.parseTernary()
+	if err != nil {
+		return nil, err
+	}
+	if _, err = p.expectOperator(":"); err != nil {
+		return nil, err
+	}
+	b, err := p.parseTernary()
+	if err != nil {
+		return nil, err
+	}
+	return &exprTernary{cond: cond, a: a, b: b, pos: tok.pos}, nil
+}
+
+// exprPrecedence lists binary operators from lowest to highest precedence
+var exprPrecedence = [][]string{
+	{"||"},
+	{"&&"},
+	{"==", "!="},
+	{"<", "<=", ">", ">="},
+	{"+", "-"},
+	{"*", "/", "%"},
+}
+
+func (p *exprParser) parseBinary(level int) (exprNode, error) {
+	if level == len(exprPrecedence) {
+		return p.parseUnary()
+	}
+	x, err := p.parseBinary(level + 1)
+	if err != nil {
+		return nil, err
+	}
+	for {
+		tok, ok := p.acceptOperator(exprPrecedence[level]...)
+		if !ok {
+			return x, nil
+		}
+		y, err := p.parseBinary(level + 1)
+		if err != nil {
+			return nil, err
+		}
+		x = &exprBinary{op: tok.text, x: x, y: y, pos: tok.pos}
+	}
+}
+
+func (p *exprParser) parseUnary() (exprNode, error) {
+	tok, ok := p.acceptOperator("-", "!")
+	if !ok {
+		return p.parsePrimary()
+	}
+	if err := p.enter(tok); err != nil {
+		return nil, err
+	}
+	defer p.leave()
+
+	x, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	return &exprUnary{op: tok.text, x: x, pos: tok.pos}, nil
+}
+
+func (p *exprParser) parsePrimary() (exprNode, error) {
+	tok := p.advance()
+	switch tok.kind {
+	case exprTokenNumber, exprTokenString:
+		return &exprLiteral{value: tok.value}, nil
+
+	case exprTokenIdent:
+		switch tok.text {
+		case "true":
+			return &exprLiteral{value: true}, nil
+		case "false":
+			return &exprLiteral{value: false}, nil
+		case "null":
+			return &exprLiteral{value: nil}, nil
+		}
+		if _, ok := p.acceptOperator("("); ok {
+			return p.parseCall(tok)
+		}
+		if _, err := newKeypathFromString(tok.text); err != nil || strings.HasPrefix(tok.text, ".") || strings.HasSuffix(tok.text, ".") {
+			return nil, ExprSyntaxError{Pos: tok.pos, Msg: fmt.Sprintf("invalid variable %s", tok)}
+		}
+		return &exprVar{keypath: tok.text, pos: tok.pos}, nil
+
+	case exprTokenOperator:
+		if tok.text == "(" {
+			x, err := p.parseTernary()
+			if err != nil {
+				return nil, err
+			}
+			if _, err = p.expectOperator(")"); err != nil {
+				return nil, err
+			}
+			return x, nil
+		}
+	}
+	return nil, p.unexpected(tok)
+}
+
+func (p *exprParser) parseCall(name exprToken) (exprNode, error) {
+	fn, exists := exprFuncs[name.text]
+	if !exists {
+		return nil, ExprSyntaxError{Pos: name.pos, Msg: fmt.Sprintf("unknown function %s", name)}
+	}
+	call := &exprCall{name: name.text, fn: fn, pos: name.pos}
+	if _, ok := p.acceptOperator(")"); !ok {
+		for {
+			arg, err := p.parseTernary()
+			if err != nil {
+				return nil, err
+			}
+			call.args = append(call.args, arg)
+			if _, ok := p.acceptOperator(","); !ok {
+				break
+			}
+		}
+		if _, err := p.expectOperator(")"); err != nil {
+			return nil, err
+		}
+	}
+	if len(call.args) < fn.minArgs || (fn.maxArgs >= 0 && len(call.args) > fn.maxArgs) {
+		return nil, ExprSyntaxError{Pos: name.pos, Msg: fmt.Sprintf("wrong number of arguments for %s: %s", name.text, fn.arity())}
+	}
+	return call, nil
+}
+
+type exprLiteral struct {
+	value interface{}
+}
+
+func (n *exprLiteral) eval(exprEnv) (interface{}, error) {
+	return n.value, nil
+}
+
+type exprVar struct {
+	keypath string
+	pos     int
+}
+
+func (n *exprVar) eval(env exprEnv) (interface{}, error) {
+	val, err := env.vars.Get(n.keypath)
+	if err != nil {
+		return nil, exprRuntimeError(n.pos, err, "variable %q", n.keypath)
+	} else if as, is := val.(error); is {
+		return nil, exprRuntimeError(n.pos, ErrTooManyErrors, "variable %q: %v", n.keypath, as)
+	}
+	return exprValue(val), nil
+}
+
+type exprUnary struct {
+	op  string
+	x   exprNode
+	pos int
+}
+
+func (n *exprUnary) eval(env exprEnv) (interface{}, error) {
+	x, err := n.x.eval(env)
+	if err != nil {
+		return nil, err
+	}
+	switch n.op {
+	case "-":
+		d, err := exprDecimal(x, n.pos)
+		if err != nil {
+			return nil, err
+		}
+		return d.Neg(), nil
+	default:
+		b, err := exprBool(x, n.pos)
+		if err != nil {
+			return nil, err
+		}
+		return !b, nil
+	}
+}
+
+type exprBinary struct {
+	op   string
+	x, y exprNode
+	pos  int
+}
+
+func (n *exprBinary) eval(env exprEnv) (interface{}, error) {
+	x, err := n.x.eval(env)
+	if err != nil {
+		return nil, err
+	}
+
+	// logical operators short circuit
+	if n.op == "&&" || n.op == "||" {
+		a, err := exprBool(x, n.pos)
+		if err != nil {
+			return nil, err
+		}
+		if (n.op == "&&" && !a) || (n.op == "||" && a) {
+			return a, nil
+		}
+		y, err := n.y.eval(env)
+		if err != nil {
+			return nil, err
+		}
+		return exprBool(y, n.pos)
+	}
+
+	y, err := n.y.eval(env)
+	if err != nil {
+		return nil, err
+	}
+
+	switch n.op {
+	case "==", "!=":
+		equal, err := exprEqual(x, y, n.pos)
+		if err != nil {
+			return nil, err
+		}
+		return equal == (n.op == "=="), nil
+	}
+
+	a, err := exprDecimal(x, n.pos)
+	if err != nil {
+		return nil, err
+	}
+	b, err := exprDecimal(y, n.pos)
+	if err != nil {
+		return nil, err
+	}
+	switch n.op {
+	case "<":
+		return a.LessThan(b), nil
+	case "<=":
+		return a.LessThanOrEqual(b), nil
+	case ">":
+		return a.GreaterThan(b), nil
+	case ">=":
+		return a.GreaterThanOrEqual(b), nil
+	case "+":
+		return a.Add(b), nil
+	case "-":
+		return a.Sub(b), nil
+	case "*":
+		return a.Mul(b), nil
+	case "/":
+		if b.IsZero() {
+			return nil, exprRuntimeError(n.pos, ErrBadInput, "division by zero")
+		}
+		return a.DivRound(b, env.precision), nil
+	case "%":
+		if b.IsZero() {
+			return nil, exprRuntimeError(n.pos, ErrBadInput, "division by zero")
+		}
+		return a.Mod(b), nil
+	default:
+		panic("unreachable")
+	}
+}
+
+type exprTernary struct {
+	cond, a, b exprNode
+	pos        int
+}
+
+func (n *exprTernary) eval(env exprEnv) (interface{}, error) {
+	c, err := n.cond.eval(env)
+	if err != nil {
+		return nil, err
+	}
+	cond, err := exprBool(c, n.pos)
+	if err != nil {
+		return nil, err
+	}
+	if cond {
+		return n.a.eval(env)
+	}
+	return n.b.eval(env)
+}
+
+type exprCall struct {
+	name string
+	fn   exprFunc
+	args []exprNode
+	pos  int
+}
+
+func (n *exprCall) eval(env exprEnv) (interface{}, error) {
+	args := make([]interface{}, len(n.args))
+	for i, arg := range n.args {
+		val, err := arg.eval(env)
+		if err != nil {
+			return nil, err
+		}
+		args[i] = val
+	}
+	val, err := n.fn.call(args, n.pos)
+	return val, errors.Wrap(err, n.name)
+}
+
+type exprFunc struct {
+	minArgs int
+	// maxArgs is -1 for variadic functions
+	maxArgs int
+	call    func(args []interface{}, pos int) (interface{}, error)
+}
+
+func (f exprFunc) arity() string {
+	switch {
+	case f.minArgs == f.maxArgs:
+		return fmt.Sprintf("expected %d", f.minArgs)
+	case f.maxArgs < 0:
+		return fmt.Sprintf("expected at least %d", f.minArgs)
+	default:
+		return fmt.Sprintf("expected %d to %d", f.minArgs, f.maxArgs)
+	}
+}
+
+var exprFuncs map[string]exprFunc
+
+func init() {
+	exprFuncs = map[string]exprFunc{
+		"min": {1, -1, func(args []interface{}, pos int) (interface{}, error) {
+			return exprReduceDecimals(args, pos, func(a, b decimal.Decimal) bool { return b.LessThan(a) })
+		}},
+		"max": {1, -1, func(args []interface{}, pos int) (interface{}, error) {
+			return exprReduceDecimals(args, pos, func(a, b decimal.Decimal) bool { return b.GreaterThan(a) })
+		}},
+		"abs": {1, 1, func(args []interface{}, pos int) (interface{}, error) {
+			d, err := exprDecimal(args[0], pos)
+			return d.Abs(), err
+		}},
+		"round": {1, 2, func(args []interface{}, pos int) (interface{}, error) {
+			d, err := exprDecimal(args[0], pos)
+			if err != nil {
+				return nil, err
+			}
+			var places int32
+			if len(args) == 2 {
+				if places, err = exprInt32(args[1], pos); err != nil {
+					return nil, err
+				}
+				if places < 0 || places > maxExprPlaces {
+					return nil, exprRuntimeError(pos, ErrBadInput, "decimal places must be between 0 and %d, got %d", maxExprPlaces, places)
+				}
+			}
+			return d.Round(places), nil
+		}},
+		"floor": {1, 1, func(args []interface{}, pos int) (interface{}, error) {
+			d, err := exprDecimal(args[0], pos)
+			return d.Floor(), err
+		}},
+		"ceil": {1, 1, func(args []interface{}, pos int) (interface{}, error) {
+			d, err := exprDecimal(args[0], pos)
+			return d.Ceil(), err
+		}},
+		"concat": {1, -1, func(args []interface{}, pos int) (interface{}, error) {
+			var sb strings.Builder
+			for _, arg := range args {
+				s, err := exprString(arg, pos)
+				if err != nil {
+					return nil, err
+				}
+				sb.WriteString(s)
+			}
+			return sb.String(), nil
+		}},
+		"len": {1, 1, func(args []interface{}, pos int) (interface{}, error) {
+			switch v := args[0].(type) {
+			case string:
+				return decimal.NewFromInt(int64(utf8.RuneCountInString(v))), nil
+			case []byte:
+				return decimal.NewFromInt(int64(len(v))), nil
+			default:
+				return nil, exprRuntimeError(pos, ErrBadInput, "expected a string or bytes, got %T", v)
+			}
+		}},
+		"bytes": {1, 1, func(args []interface{}, pos int) (interface{}, error) {
+			switch v := args[0].(type) {
+			case string:
+				return []byte(v), nil
+			case []byte:
+				return v, nil
+			default:
+				return nil, exprRuntimeError(pos, ErrBadInput, "expected a string or bytes, got %T", v)
+			}
+		}},
+		"hexEncode": {1, 1, func(args []interface{}, pos int) (interface{}, error) {
+			switch v := args[0].(type) {
+			case string:
+				return "0x" + hex.EncodeToString([]byte(v)), nil
+			case []byte:
+				return "0x" + hex.EncodeToString(v), nil
+			case decimal.Decimal:
+				if !v.IsInteger() || v.IsNegative() {
+					return nil, exprRuntimeError(pos, ErrBadInput, "can only encode non-negative integers, got %v", v)
+				}
+				return "0x" + v.BigInt().Text(16), nil
+			default:
+				return nil, exprRuntimeError(pos, ErrBadInput, "expected a string, bytes or a number, got %T", v)
+			}
+		}},
+		"hexDecode": {1, 1, func(args []interface{}, pos int) (interface{}, error) {
+			s, ok := args[0].(string)
+			if !ok {
+				return nil, exprRuntimeError(pos, ErrBadInput, "expected a string, got %T", args[0])
+			}
+			b, err := hex.DecodeString(utils.RemoveHexPrefix(s))
+			if err != nil {
+				return nil, exprRuntimeError(pos, ErrBadInput, "%v", err)
+			}
+			return b, nil
+		}},
+	}
+}
+
+func exprRuntimeError(pos int, cause error, format string, args ...interface{}) error {
+	return errors.Wrapf(cause, "%s at position %d", fmt.Sprintf(format, args...), pos)
+}
+
+// exprValue normalizes a pipeline variable into one of the types the
+// expression language works with. Numbers become decimals, anything else that
+// isn't a string, bool, bytes or null is passed through as is.
+func exprValue(val interface{}) interface{} {
+	switch v := val.(type) {
+	case nil, string, bool, []byte, decimal.Decimal:
+		return v
+	case ObjectParam:
+		return exprObjectValue(&v)
+	case *ObjectParam:
+		return exprObjectValue(v)
+	}
+	if d, err := utils.ToDecimal(val); err == nil {
+		return d
+	}
+	return val
+}
+
+func exprObjectValue(o *ObjectParam) interface{} {
+	switch o.Type {
+	case NilType:
+		return nil
+	case BoolType:
+		return bool(o.BoolValue)
+	case DecimalType:
+		return o.DecimalValue.Decimal()
+	case StringType:
+		return string(o.StringValue)
+	case SliceType:
+		return []interface{}(o.SliceValue)
+	case MapType:
+		return map[string]interface{}(o.MapValue)
+	}
+	return o
+}
+
+func exprDecimal(val interface{}, pos int) (decimal.Decimal, error) {
+	switch v := val.(type) {
+	case decimal.Decimal:
+		return v, nil
+	case string:
+		d, err := decimal.NewFromString(strings.TrimSpace(v))
+		if err != nil {
+			return decimal.Decimal{}, exprRuntimeError(pos, ErrBadInput, "expected a number, got %q", v)
+		}
+		return d, nil
+	default:
+		return decimal.Decimal{}, exprRuntimeError(pos, ErrBadInput, "expected a number, got %T", val)
+	}
+}
+
+func exprInt32(val interface{}, pos int) (int32, error) {
+	d, err := exprDecimal(val, pos)
+	if err != nil {
+		return 0, err
+	}
+	if !d.IsInteger() || d.GreaterThan(decimal.NewFromInt32(1<<31-1)) || d.LessThan(decimal.NewFromInt32(-1<<31)) {
+		return 0, exprRuntimeError(pos, ErrBadInput, "expected an integer, got %v", d)
+	}
+	return int32(d.IntPart()), nil
+}
+
+func exprBool(val interface{}, pos int) (bool, error) {
+	b, ok := val.(bool)
+	if !ok {
+		return false, exprRuntimeError(pos, ErrBadInput, "expected true or false, got %T", val)
+	}
+	return b, nil
+}
+
+func exprString(val interface{}, pos int) (string, error) {
+	switch v := val.(type) {
+	case string:
+		return v, nil
+	case decimal.Decimal:
+		return v.String(), nil
+	case bool:
+		return strconv.FormatBool(v), nil
+	case []byte:
+		return "0x" + hex.EncodeToString(v), nil
+	default:
+		return "", exprRuntimeError(pos, ErrBadInput, "cannot convert %T to a string", val)
+	}
+}
+
+// exprEqual compares two values. Values of the same type are compared
+// directly, numbers are compared with strings by parsing the string.
+func exprEqual(x, y interface{}, pos int) (bool, error) {
+	switch a := x.(type) {
+	case nil:
+		return y == nil, nil
+	case bool:
+		if b, ok := y.(bool); ok {
+			return a == b, nil
+		}
+	case string:
+		if b, ok := y.(string); ok {
+			return a == b, nil
+		}
+	case []byte:
+		if b, ok := y.([]byte); ok {
+			return bytes.Equal(a, b), nil
+		}
+	}
+	if y == nil {
+		return false, nil
+	}
+	a, err := exprDecimal(x, pos)
+	if err != nil {
+		return false, exprRuntimeError(pos, ErrBadInput, "cannot compare %T with %T", x, y)
+	}
+	b, err := exprDecimal(y, pos)
+	if err != nil {
+		return false, exprRuntimeError(pos, ErrBadInput, "cannot compare %T with %T", x, y)
+	}
+	return a.Equal(b), nil
+}
+
+func exprReduceDecimals(args []interface{}, pos int, replace func(a, b decimal.Decimal) bool) (interface{}, error) {
+	var result decimal.Decimal
+	for i, arg := range args {
+		d, err := exprDecimal(arg, pos)
+		if err != nil {
+			return nil, err
+		}
+		if i == 0 || replace(result, d) {
+			result = d
+		}
+	}
+	return result, nil
+}
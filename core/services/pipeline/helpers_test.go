@@ -3,14 +3,23 @@ package pipeline
 import (
 	uuid "github.com/satori/go.uuid"
 
+	"github.com/smartcontractkit/chainlink/core/bridges"
 	"github.com/smartcontractkit/chainlink/core/chains/evm"
+	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/sqlx"
 )
 
 var (
 	NewKeypathFromString = newKeypathFromString
+	NewScriptWorkerPool  = newScriptWorkerPool
 )
 
+func init() {
+	// The script workers started by tests are copies of the test binary,
+	// which can't initialize without a database URL
+	scriptWorkerEnvAllowList = []string{"DATABASE_URL"}
+}
+
 const (
 	DotStr = `
         // data source 1
@@ -35,6 +44,16 @@ func (t *BridgeTask) HelperSetDependencies(config Config, db *sqlx.DB, id uuid.U
 	t.config = config
 	t.queryer = db
 	t.uuid = id
+	t.breakers = bridges.NewBreakers(logger.NullLogger)
+	t.cache = bridges.NewResponseCache()
+}
+
+func (t *BridgeTask) HelperSetBreakers(breakers bridges.Breakers) {
+	t.breakers = breakers
+}
+
+func (t *BridgeTask) HelperSetCache(cache bridges.ResponseCache) {
+	t.cache = cache
 }
 
 func (t *HTTPTask) HelperSetDependencies(config Config) {
@@ -46,7 +65,27 @@ func (t *ETHCallTask) HelperSetDependencies(cc evm.ChainSet, config Config) {
 	t.config = config
 }
 
-func (t *ETHTxTask) HelperSetDependencies(cc evm.ChainSet, keyStore ETHKeyStore) {
+func (t *ETHTxTask) HelperSetDependencies(cc evm.ChainSet, keyStore ETHKeyStore, db *sqlx.DB) {
 	t.chainSet = cc
 	t.keyStore = keyStore
+	t.queryer = db
+}
+
+func (t *ScriptTask) HelperSetDependencies(workers *scriptWorkerPool) {
+	t.workers = workers
+}
+
+// ScriptWorkerPoolIdlePIDs returns the process IDs of the idle workers
+func ScriptWorkerPoolIdlePIDs(p *scriptWorkerPool) []int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	var pids []int
+	for _, w := range p.idle {
+		pids = append(pids, w.cmd.Process.Pid)
+	}
+	return pids
+}
+
+func CloseScriptWorkerPool(p *scriptWorkerPool) {
+	p.close()
 }
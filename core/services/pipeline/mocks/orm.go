@@ -8,6 +8,8 @@ import (
 	models "github.com/smartcontractkit/chainlink/core/store/models"
 	mock "github.com/stretchr/testify/mock"
 
+	null "gopkg.in/guregu/null.v4"
+
 	pg "github.com/smartcontractkit/chainlink/core/services/pg"
 
 	pipeline "github.com/smartcontractkit/chainlink/core/services/pipeline"
@@ -22,6 +24,27 @@ type ORM struct {
 	mock.Mock
 }
 
+// CancelRun provides a mock function with given fields: id, qopts
+func (_m *ORM) CancelRun(id int64, qopts ...pg.QOpt) error {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, id)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int64, ...pg.QOpt) error); ok {
+		r0 = rf(id, qopts...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // CreateRun provides a mock function with given fields: run, qopts
 func (_m *ORM) CreateRun(run *pipeline.Run, qopts ...pg.QOpt) error {
 	_va := make([]interface{}, len(qopts))
@@ -143,6 +166,48 @@ func (_m *ORM) GetAllRuns() ([]pipeline.Run, error) {
 	return r0, r1
 }
 
+// GetLastRunBlockNumber provides a mock function with given fields: ctx, jobID
+func (_m *ORM) GetLastRunBlockNumber(ctx context.Context, jobID int32) (null.Int, error) {
+	ret := _m.Called(ctx, jobID)
+
+	var r0 null.Int
+	if rf, ok := ret.Get(0).(func(context.Context, int32) null.Int); ok {
+		r0 = rf(ctx, jobID)
+	} else {
+		r0 = ret.Get(0).(null.Int)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int32) error); ok {
+		r1 = rf(ctx, jobID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetLastRunCreatedAt provides a mock function with given fields: ctx, jobID
+func (_m *ORM) GetLastRunCreatedAt(ctx context.Context, jobID int32) (null.Time, error) {
+	ret := _m.Called(ctx, jobID)
+
+	var r0 null.Time
+	if rf, ok := ret.Get(0).(func(context.Context, int32) null.Time); ok {
+		r0 = rf(ctx, jobID)
+	} else {
+		r0 = ret.Get(0).(null.Time)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int32) error); ok {
+		r1 = rf(ctx, jobID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetQ provides a mock function with given fields:
 func (_m *ORM) GetQ() pg.Q {
 	ret := _m.Called()
@@ -20,6 +20,20 @@ type Runner struct {
 	mock.Mock
 }
 
+// CancelRun provides a mock function with given fields: runID
+func (_m *Runner) CancelRun(runID int64) error {
+	ret := _m.Called(runID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int64) error); ok {
+		r0 = rf(runID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Close provides a mock function with given fields:
 func (_m *Runner) Close() error {
 	ret := _m.Called()
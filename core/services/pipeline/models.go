@@ -23,6 +23,7 @@ type Spec struct {
 	DotDagSource    string          `json:"dotDagSource"`
 	CreatedAt       time.Time       `json:"-"`
 	MaxTaskDuration models.Interval `json:"-"`
+	Version         int32           `json:"-"`
 
 	JobID   int32  `json:"-"`
 	JobName string `json:"-"`
@@ -258,6 +259,8 @@ type TaskRun struct {
 	FinishedAt    null.Time        `json:"finishedAt"`
 	Index         int32            `json:"index"`
 	DotID         string           `json:"dotId"`
+	Skipped       bool             `json:"skipped"`
+	Meta          JSONSerializable `json:"meta"`
 
 	// Used internally for sorting completed results
 	task Task
@@ -312,6 +315,8 @@ const (
 	RunStatusErrored RunStatus = "errored"
 	// RunStatusCompleted is used for when a run has successfully completed execution.
 	RunStatusCompleted RunStatus = "completed"
+	// RunStatusCancelled is used for when a run was cancelled by an operator before it finished.
+	RunStatusCancelled RunStatus = "cancelled"
 )
 
 // Completed returns true if the status is RunStatusCompleted.
@@ -324,7 +329,12 @@ func (s RunStatus) Errored() bool {
 	return s == RunStatusErrored
 }
 
+// Cancelled returns true if the status is RunStatusCancelled.
+func (s RunStatus) Cancelled() bool {
+	return s == RunStatusCancelled
+}
+
 // Finished returns true if the status is final and can't be changed.
 func (s RunStatus) Finished() bool {
-	return s.Completed() || s.Errored()
+	return s.Completed() || s.Errored() || s.Cancelled()
 }
@@ -8,6 +8,7 @@ import (
 	"github.com/pkg/errors"
 	uuid "github.com/satori/go.uuid"
 	"github.com/smartcontractkit/sqlx"
+	"gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/services/pg"
@@ -16,6 +17,7 @@ import (
 
 var (
 	ErrNoSuchBridge = errors.New("no such bridge exists")
+	ErrRunFinished  = errors.New("run has already finished")
 )
 
 //go:generate mockery --name ORM --output ./mocks/ --case=underscore
@@ -27,11 +29,19 @@ type ORM interface {
 	DeleteRun(id int64) error
 	StoreRun(run *Run, qopts ...pg.QOpt) (restart bool, err error)
 	UpdateTaskRunResult(taskID uuid.UUID, result Result) (run Run, start bool, err error)
+	CancelRun(id int64, qopts ...pg.QOpt) error
 	InsertFinishedRun(run *Run, saveSuccessfulTaskRuns bool, qopts ...pg.QOpt) (err error)
 	DeleteRunsOlderThan(context.Context, time.Duration) error
 	FindRun(id int64) (Run, error)
 	GetAllRuns() ([]Run, error)
 	GetUnfinishedRuns(context.Context, time.Time, func(run Run) error) error
+	// GetLastRunCreatedAt returns when the latest run of any version of the
+	// job's pipeline was created, or a null time if it has never been run.
+	GetLastRunCreatedAt(ctx context.Context, jobID int32) (null.Time, error)
+	// GetLastRunBlockNumber returns the highest jobRun.blockNumber input of
+	// the runs of any version of the job's pipeline, or a null int if it has
+	// never been run.
+	GetLastRunBlockNumber(ctx context.Context, jobID int32) (null.Int, error)
 	GetQ() pg.Q
 }
 
@@ -100,14 +110,22 @@ func (o *orm) InsertRun(run *Run, qopts ...pg.QOpt) error {
 func (o *orm) StoreRun(run *Run, qopts ...pg.QOpt) (restart bool, err error) {
 	q := o.q.WithOpts(qopts...)
 	err = q.Transaction(func(tx pg.Queryer) error {
-		finished := run.FinishedAt.Valid
-		if !finished {
-			// Lock the current run. This prevents races with /v2/resume
-			sql := `SELECT id FROM pipeline_runs WHERE id = $1 FOR UPDATE;`
-			if _, err = tx.Exec(sql, run.ID); err != nil {
-				return errors.Wrap(err, "StoreRun")
-			}
+		// Lock the current run. This prevents races with /v2/resume and run cancellation
+		var state RunStatus
+		if err = tx.Get(&state, `SELECT state FROM pipeline_runs WHERE id = $1 FOR UPDATE;`, run.ID); err != nil {
+			return errors.Wrap(err, "StoreRun")
+		}
+		cancelled := state == RunStatusCancelled
+		if cancelled {
+			// The run got cancelled while it was executing. It must stay cancelled and is
+			// never restarted, only its task runs are still stored
+			run.State = RunStatusCancelled
+			run.Pending = false
+		}
 
+		finished := run.FinishedAt.Valid
+		// A cancelled run can still have pending tasks, they mustn't overwrite results that arrived in the meantime
+		if !finished || cancelled {
 			taskRuns := []TaskRun{}
 			// Reload task runs, we want to check for any changes while the run was ongoing
 			if err = sqlx.Select(tx, &taskRuns, `SELECT * FROM pipeline_task_runs WHERE pipeline_run_id = $1`, run.ID); err != nil {
@@ -127,21 +145,17 @@ func (o *orm) StoreRun(run *Run, qopts ...pg.QOpt) (restart bool, err error) {
 				if taskRun := tempRun.ByDotID(tr.DotID); taskRun != nil && !taskRun.IsPending() {
 					// Swap in the latest state
 					run.PipelineTaskRuns[i] = *taskRun
-					restart = true
+					restart = !cancelled
 				}
 			}
 
 			if restart {
 				return nil
 			}
+		}
 
-			// Suspend the run
-			run.State = RunStatusSuspended
-			if _, err = sqlx.NamedExec(tx, `UPDATE pipeline_runs SET state = :state WHERE id = :id`, run); err != nil {
-				return errors.Wrap(err, "StoreRun")
-			}
-		} else {
-			// Simply finish the run, no need to do any sort of locking
+		if finished {
+			// Simply finish the run
 			if run.Outputs.Val == nil || len(run.FatalErrors) == 0 {
 				return errors.Errorf("run must have both Outputs and Errors, got Outputs: %#v, Errors: %#v", run.Outputs.Val, run.FatalErrors)
 			}
@@ -149,13 +163,19 @@ func (o *orm) StoreRun(run *Run, qopts ...pg.QOpt) (restart bool, err error) {
 			if _, err = sqlx.NamedExec(tx, sql, run); err != nil {
 				return errors.Wrap(err, "StoreRun")
 			}
+		} else if !cancelled {
+			// Suspend the run
+			run.State = RunStatusSuspended
+			if _, err = sqlx.NamedExec(tx, `UPDATE pipeline_runs SET state = :state WHERE id = :id`, run); err != nil {
+				return errors.Wrap(err, "StoreRun")
+			}
 		}
 
 		sql := `
-		INSERT INTO pipeline_task_runs (pipeline_run_id, id, type, index, output, error, dot_id, created_at, finished_at)
-		VALUES (:pipeline_run_id, :id, :type, :index, :output, :error, :dot_id, :created_at, :finished_at)
+		INSERT INTO pipeline_task_runs (pipeline_run_id, id, type, index, output, error, dot_id, created_at, finished_at, skipped, meta)
+		VALUES (:pipeline_run_id, :id, :type, :index, :output, :error, :dot_id, :created_at, :finished_at, :skipped, :meta)
 		ON CONFLICT (pipeline_run_id, dot_id) DO UPDATE SET
-		output = EXCLUDED.output, error = EXCLUDED.error, finished_at = EXCLUDED.finished_at
+		output = EXCLUDED.output, error = EXCLUDED.error, finished_at = EXCLUDED.finished_at, skipped = EXCLUDED.skipped, meta = EXCLUDED.meta
 		RETURNING *;
 		`
 
@@ -191,7 +211,7 @@ func (o *orm) UpdateTaskRunResult(taskID uuid.UUID, result Result) (run Run, sta
 		FROM pipeline_runs
 		JOIN pipeline_task_runs ON (pipeline_task_runs.pipeline_run_id = pipeline_runs.id)
 		JOIN pipeline_specs ON (pipeline_specs.id = pipeline_runs.pipeline_spec_id)
-		WHERE pipeline_task_runs.id = $1 AND pipeline_runs.state in ('running', 'suspended')
+		WHERE pipeline_task_runs.id = $1 AND pipeline_runs.state in ('running', 'suspended', 'cancelled')
 		FOR UPDATE`
 		if err = tx.Get(&run, sql, taskID); err != nil {
 			return err
@@ -203,6 +223,7 @@ func (o *orm) UpdateTaskRunResult(taskID uuid.UUID, result Result) (run Run, sta
 			return errors.Wrap(err, "UpdateTaskRunResult")
 		}
 
+		// Results for cancelled runs are still recorded, but the run is never restarted
 		if run.State == RunStatusSuspended {
 			start = true
 			run.State = RunStatusRunning
@@ -225,6 +246,25 @@ func (o *orm) UpdateTaskRunResult(taskID uuid.UUID, result Result) (run Run, sta
 	return run, start, err
 }
 
+// CancelRun marks a run that hasn't finished yet as cancelled. Its pending task
+// runs are left untouched, so that results arriving later can still be recorded.
+func (o *orm) CancelRun(id int64, qopts ...pg.QOpt) error {
+	q := o.q.WithOpts(qopts...)
+	err := q.Transaction(func(tx pg.Queryer) error {
+		var state RunStatus
+		if err := tx.Get(&state, `SELECT state FROM pipeline_runs WHERE id = $1 FOR UPDATE`, id); err != nil {
+			return err
+		}
+		if state.Finished() {
+			return errors.Wrapf(ErrRunFinished, "run is %s", state)
+		}
+
+		_, err := tx.Exec(`UPDATE pipeline_runs SET state = $2, finished_at = $3 WHERE id = $1`, id, RunStatusCancelled, time.Now())
+		return err
+	})
+	return errors.Wrap(err, "CancelRun failed")
+}
+
 // If saveSuccessfulTaskRuns = false, we only save errored runs.
 // That way if the job is run frequently (such as OCR) we avoid saving a large number of successful task runs
 // which do not provide much value.
@@ -267,8 +307,8 @@ func (o *orm) InsertFinishedRun(run *Run, saveSuccessfulTaskRuns bool, qopts ...
 		}
 
 		sql = `
-		INSERT INTO pipeline_task_runs (pipeline_run_id, id, type, index, output, error, dot_id, created_at, finished_at)
-		VALUES (:pipeline_run_id, :id, :type, :index, :output, :error, :dot_id, :created_at, :finished_at);`
+		INSERT INTO pipeline_task_runs (pipeline_run_id, id, type, index, output, error, dot_id, created_at, finished_at, skipped, meta)
+		VALUES (:pipeline_run_id, :id, :type, :index, :output, :error, :dot_id, :created_at, :finished_at, :skipped, :meta);`
 		_, err = tx.NamedExec(sql, run.PipelineTaskRuns)
 		return errors.Wrap(err, "failed to insert pipeline_task_runs")
 	})
@@ -307,6 +347,24 @@ func (o *orm) GetAllRuns() (runs []Run, err error) {
 	return runs, err
 }
 
+func (o *orm) GetLastRunCreatedAt(ctx context.Context, jobID int32) (createdAt null.Time, err error) {
+	q := o.q.WithOpts(pg.WithParentCtx(ctx))
+	err = q.Get(&createdAt, `
+SELECT max(pipeline_runs.created_at) FROM pipeline_runs
+JOIN job_pipeline_specs ON job_pipeline_specs.pipeline_spec_id = pipeline_runs.pipeline_spec_id
+WHERE job_pipeline_specs.job_id = $1`, jobID)
+	return createdAt, errors.Wrap(err, "GetLastRunCreatedAt failed")
+}
+
+func (o *orm) GetLastRunBlockNumber(ctx context.Context, jobID int32) (blockNumber null.Int, err error) {
+	q := o.q.WithOpts(pg.WithParentCtx(ctx))
+	err = q.Get(&blockNumber, `
+SELECT max((pipeline_runs.inputs->'jobRun'->>'blockNumber')::bigint) FROM pipeline_runs
+JOIN job_pipeline_specs ON job_pipeline_specs.pipeline_spec_id = pipeline_runs.pipeline_spec_id
+WHERE job_pipeline_specs.job_id = $1`, jobID)
+	return blockNumber, errors.Wrap(err, "GetLastRunBlockNumber failed")
+}
+
 func (o *orm) GetUnfinishedRuns(ctx context.Context, now time.Time, fn func(run Run) error) error {
 	q := o.q.WithOpts(pg.WithParentCtx(ctx))
 	return pg.Batch(func(offset, limit uint) (count uint, err error) {
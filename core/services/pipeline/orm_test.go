@@ -1,9 +1,11 @@
 package pipeline_test
 
 import (
+	"database/sql"
 	"testing"
 	"time"
 
+	"github.com/pkg/errors"
 	uuid "github.com/satori/go.uuid"
 	"github.com/smartcontractkit/sqlx"
 	"github.com/stretchr/testify/assert"
@@ -308,6 +310,82 @@ func Test_PipelineORM_StoreRun_UpdateTaskRunResult(t *testing.T) {
 	require.Equal(t, pipeline.JSONSerializable{Val: "foo", Valid: true}, task.Output)
 }
 
+func Test_PipelineORM_CancelRun(t *testing.T) {
+	_, orm := setupORM(t)
+
+	run := mustInsertAsyncRun(t, orm)
+
+	now := time.Now()
+
+	ds1_id := uuid.NewV4()
+	run.PipelineTaskRuns = []pipeline.TaskRun{
+		// pending task
+		{
+			ID:            ds1_id,
+			PipelineRunID: run.ID,
+			Type:          "bridge",
+			DotID:         "ds1",
+			CreatedAt:     now,
+			FinishedAt:    null.Time{},
+		},
+	}
+	restart, err := orm.StoreRun(run)
+	require.NoError(t, err)
+	require.False(t, restart)
+	require.Equal(t, pipeline.RunStatusSuspended, run.State)
+
+	t.Run("cancels a suspended run", func(t *testing.T) {
+		require.NoError(t, orm.CancelRun(run.ID))
+
+		r, err := orm.FindRun(run.ID)
+		require.NoError(t, err)
+		assert.Equal(t, pipeline.RunStatusCancelled, r.State)
+		assert.True(t, r.FinishedAt.Valid)
+	})
+
+	t.Run("refuses to cancel a finished run", func(t *testing.T) {
+		err := orm.CancelRun(run.ID)
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, pipeline.ErrRunFinished))
+	})
+
+	t.Run("returns not found for a missing run", func(t *testing.T) {
+		err := orm.CancelRun(run.ID + 1)
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, sql.ErrNoRows))
+	})
+
+	t.Run("records late results without restarting the run", func(t *testing.T) {
+		r, start, err := orm.UpdateTaskRunResult(ds1_id, pipeline.Result{Value: "foo"})
+		require.NoError(t, err)
+		assert.False(t, start)
+		assert.Equal(t, pipeline.RunStatusCancelled, r.State)
+
+		r, err = orm.FindRun(run.ID)
+		require.NoError(t, err)
+		task := r.ByDotID("ds1")
+		require.NotNil(t, task)
+		assert.True(t, task.FinishedAt.Valid)
+		assert.Equal(t, pipeline.JSONSerializable{Val: "foo", Valid: true}, task.Output)
+	})
+
+	t.Run("StoreRun keeps a run that was cancelled while executing cancelled", func(t *testing.T) {
+		run.State = pipeline.RunStatusRunning
+		run.Pending = true
+		restart, err := orm.StoreRun(run)
+		require.NoError(t, err)
+		assert.False(t, restart)
+		assert.False(t, run.Pending)
+		assert.Equal(t, pipeline.RunStatusCancelled, run.State)
+
+		r, err := orm.FindRun(run.ID)
+		require.NoError(t, err)
+		assert.Equal(t, pipeline.RunStatusCancelled, r.State)
+		// the late result was not overwritten by the stale pending task run
+		assert.Equal(t, pipeline.JSONSerializable{Val: "foo", Valid: true}, r.ByDotID("ds1").Output)
+	})
+}
+
 func Test_PipelineORM_DeleteRun(t *testing.T) {
 	_, orm := setupORM(t)
 
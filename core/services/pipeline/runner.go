@@ -16,6 +16,7 @@ import (
 	uuid "github.com/satori/go.uuid"
 	"gopkg.in/guregu/null.v4"
 
+	"github.com/smartcontractkit/chainlink/core/bridges"
 	"github.com/smartcontractkit/chainlink/core/chains/evm"
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/service"
@@ -33,6 +34,9 @@ type Runner interface {
 	// Note that `saveSuccessfulTaskRuns` value is ignored if the run contains async tasks.
 	Run(ctx context.Context, run *Run, l logger.Logger, saveSuccessfulTaskRuns bool, fn func(tx pg.Queryer) error) (incomplete bool, err error)
 	ResumeRun(taskID uuid.UUID, value interface{}, err error) error
+	// CancelRun marks an unfinished run as cancelled and stops it if it is currently executing.
+	// Results for its async tasks that arrive afterwards are still recorded, but the run is never resumed.
+	CancelRun(runID int64) error
 
 	// We expect spec.JobID and spec.JobName to be set for logging/prometheus.
 	// ExecuteRun executes a new run in-memory according to a spec and returns the results.
@@ -54,12 +58,19 @@ type runner struct {
 	chainSet        evm.ChainSet
 	ethKeyStore     ETHKeyStore
 	vrfKeyStore     VRFKeyStore
+	bridgeBreakers  bridges.Breakers
+	bridgeCache     bridges.ResponseCache
+	scriptWorkers   *scriptWorkerPool
 	runReaperWorker utils.SleeperTask
 	lggr            logger.Logger
 
 	// test helper
 	runFinished func(*Run)
 
+	// cancel funcs of the runs that are currently executing, keyed by run ID
+	runsMu sync.Mutex
+	runs   map[int64]context.CancelFunc
+
 	utils.StartStopOnce
 	chStop chan struct{}
 	wgDone sync.WaitGroup
@@ -95,17 +106,21 @@ var (
 	)
 )
 
-func NewRunner(orm ORM, config Config, chainSet evm.ChainSet, ethks ETHKeyStore, vrfks VRFKeyStore, lggr logger.Logger) *runner {
+func NewRunner(orm ORM, config Config, chainSet evm.ChainSet, ethks ETHKeyStore, vrfks VRFKeyStore, breakers bridges.Breakers, cache bridges.ResponseCache, lggr logger.Logger) *runner {
 	r := &runner{
-		orm:         orm,
-		config:      config,
-		chainSet:    chainSet,
-		ethKeyStore: ethks,
-		vrfKeyStore: vrfks,
-		chStop:      make(chan struct{}),
-		wgDone:      sync.WaitGroup{},
-		runFinished: func(*Run) {},
-		lggr:        lggr.Named("PipelineRunner"),
+		orm:            orm,
+		config:         config,
+		chainSet:       chainSet,
+		ethKeyStore:    ethks,
+		vrfKeyStore:    vrfks,
+		bridgeBreakers: breakers,
+		bridgeCache:    cache,
+		scriptWorkers:  newScriptWorkerPool(),
+		chStop:         make(chan struct{}),
+		wgDone:         sync.WaitGroup{},
+		runFinished:    func(*Run) {},
+		runs:           make(map[int64]context.CancelFunc),
+		lggr:           lggr.Named("PipelineRunner"),
 	}
 	r.runReaperWorker = utils.NewSleeperTask(
 		utils.SleeperFuncTask(r.runReaper, "PipelineRunnerReaper"),
@@ -126,6 +141,7 @@ func (r *runner) Close() error {
 	return r.StopOnce("PipelineRunner", func() error {
 		close(r.chStop)
 		r.wgDone.Wait()
+		r.scriptWorkers.close()
 		return nil
 	})
 }
@@ -158,6 +174,8 @@ type memoryTaskRun struct {
 	inputs   []Result // sorted by input index
 	vars     Vars
 	attempts uint
+	// skipped is set by the scheduler when the task is on a branch that was not taken
+	skipped bool
 }
 
 // When a task panics, we catch the panic and wrap it in an error for reporting to the scheduler.
@@ -228,6 +246,8 @@ func (r *runner) initializePipeline(run *Run) (*Pipeline, error) {
 		case TaskTypeBridge:
 			task.(*BridgeTask).config = r.config
 			task.(*BridgeTask).queryer = r.orm.GetQ()
+			task.(*BridgeTask).breakers = r.bridgeBreakers
+			task.(*BridgeTask).cache = r.bridgeCache
 		case TaskTypeETHCall:
 			task.(*ETHCallTask).chainSet = r.chainSet
 			task.(*ETHCallTask).config = r.config
@@ -240,6 +260,9 @@ func (r *runner) initializePipeline(run *Run) (*Pipeline, error) {
 		case TaskTypeETHTx:
 			task.(*ETHTxTask).keyStore = r.ethKeyStore
 			task.(*ETHTxTask).chainSet = r.chainSet
+			task.(*ETHTxTask).queryer = r.orm.GetQ()
+		case TaskTypeScript:
+			task.(*ScriptTask).workers = r.scriptWorkers
 		default:
 		}
 	}
@@ -263,6 +286,19 @@ func (r *runner) run(
 	l.Debugw("Initiating tasks for pipeline run of spec", "job ID", run.PipelineSpec.JobID, "job name", run.PipelineSpec.JobName)
 
 	scheduler := newScheduler(pipeline, run, vars, l)
+
+	// Only persisted runs can be cancelled, in-memory runs have no ID yet
+	if run.ID != 0 {
+		var cancelTasks context.CancelFunc
+		ctx, cancelTasks = context.WithCancel(ctx)
+		defer cancelTasks()
+		r.trackRun(run.ID, func() {
+			scheduler.cancel()
+			cancelTasks()
+		})
+		defer r.untrackRun(run.ID)
+	}
+
 	go scheduler.Run()
 
 	// This is "just in case" for cleaning up any stray reports.
@@ -297,11 +333,12 @@ func (r *runner) run(
 	}
 
 	// if the run is suspended, awaiting resumption
-	run.Pending = scheduler.pending
-	run.FailEarly = scheduler.exiting
+	// a cancelled run is never resumed and is always stored, even if it had pending tasks
+	run.Pending = scheduler.pending && !scheduler.cancelled
+	run.FailEarly = scheduler.exiting && !scheduler.cancelled
 	run.State = RunStatusSuspended
 
-	if !scheduler.pending {
+	if !run.Pending {
 		run.FinishedAt = null.TimeFrom(time.Now())
 
 		// NOTE: runTime can be very long now because it'll include suspend
@@ -324,6 +361,8 @@ func (r *runner) run(
 			DotID:         result.Task.DotID(),
 			CreatedAt:     result.CreatedAt,
 			FinishedAt:    result.FinishedAt,
+			Skipped:       result.Skipped,
+			Meta:          result.Meta,
 			task:          result.Task,
 		})
 
@@ -352,7 +391,9 @@ func (r *runner) run(
 		run.FatalErrors = fatalErrors
 		run.Outputs = JSONSerializable{Val: outputs, Valid: true}
 
-		if run.HasFatalErrors() {
+		if scheduler.cancelled {
+			run.State = RunStatusCancelled
+		} else if run.HasFatalErrors() {
 			run.State = RunStatusErrored
 			PromPipelineRunErrors.WithLabelValues(fmt.Sprintf("%d", run.PipelineSpec.JobID), run.PipelineSpec.JobName).Inc()
 		} else {
@@ -385,6 +426,19 @@ func (r *runner) executeTaskRun(ctx context.Context, spec Spec, taskRun *memoryT
 	// below. It has already been changed several times trying to "fix" a bug,
 	// but actually introducing new ones. Please leave it as-is unless you have
 	// an extremely good reason to change it.
+	if skipped, err := taskRun.shouldSkip(); err != nil || skipped {
+		l.Debugw("Pipeline task skipped", "err", err)
+		now := time.Now()
+		return TaskRunResult{
+			ID:         taskRun.task.Base().uuid,
+			Task:       taskRun.task,
+			Result:     Result{Error: err},
+			CreatedAt:  start,
+			FinishedAt: null.TimeFrom(now),
+			Skipped:    err == nil,
+		}
+	}
+
 	ctx, cancel := utils.CombinedContext(ctx, r.chStop)
 	defer cancel()
 	if taskTimeout, isSet := taskRun.task.TaskTimeout(); isSet {
@@ -415,16 +469,38 @@ func (r *runner) executeTaskRun(ctx context.Context, spec Spec, taskRun *memoryT
 	if !runInfo.IsPending {
 		finishedAt = null.TimeFrom(now)
 	}
+	var meta JSONSerializable
+	if len(runInfo.Meta) > 0 {
+		meta = JSONSerializable{Val: runInfo.Meta, Valid: true}
+	}
 	return TaskRunResult{
 		ID:         taskRun.task.Base().uuid,
 		Task:       taskRun.task,
 		Result:     result,
 		CreatedAt:  start,
 		FinishedAt: finishedAt,
+		Meta:       meta,
 		runInfo:    runInfo,
 	}
 }
 
+// shouldSkip returns true if the task is on a branch that was not taken, or
+// its `when` attribute evaluates to false
+func (tr *memoryTaskRun) shouldSkip() (bool, error) {
+	if tr.skipped {
+		return true, nil
+	}
+	when := tr.task.Base().When
+	if when == "" {
+		return false, nil
+	}
+	var run BoolParam
+	if err := ResolveParam(&run, From(VarExpr(when, tr.vars), NonemptyString(when))); err != nil {
+		return false, errors.Wrap(err, "when")
+	}
+	return !bool(run), nil
+}
+
 func logTaskRunToPrometheus(trr TaskRunResult, spec Spec) {
 	elapsed := trr.FinishedAt.Time.Sub(trr.CreatedAt)
 
@@ -432,6 +508,8 @@ func logTaskRunToPrometheus(trr TaskRunResult, spec Spec) {
 	var status string
 	if trr.Result.Error != nil {
 		status = "error"
+	} else if trr.Skipped {
+		status = "skipped"
 	} else {
 		status = "completed"
 	}
@@ -569,6 +647,34 @@ func (r *runner) ResumeRun(taskID uuid.UUID, value interface{}, err error) error
 	return nil
 }
 
+func (r *runner) CancelRun(runID int64) error {
+	if err := r.orm.CancelRun(runID); err != nil {
+		return err
+	}
+
+	r.runsMu.Lock()
+	cancel, running := r.runs[runID]
+	r.runsMu.Unlock()
+	// a suspended run isn't executing, marking it cancelled is enough to
+	// prevent it from being resumed
+	if running {
+		cancel()
+	}
+	return nil
+}
+
+func (r *runner) trackRun(runID int64, cancel context.CancelFunc) {
+	r.runsMu.Lock()
+	defer r.runsMu.Unlock()
+	r.runs[runID] = cancel
+}
+
+func (r *runner) untrackRun(runID int64) {
+	r.runsMu.Lock()
+	defer r.runsMu.Unlock()
+	delete(r.runs, runID)
+}
+
 func (r *runner) InsertFinishedRun(run *Run, saveSuccessfulTaskRuns bool, qopts ...pg.QOpt) error {
 	return r.orm.InsertFinishedRun(run, saveSuccessfulTaskRuns, qopts...)
 }
@@ -19,6 +19,7 @@ import (
 	"gopkg.in/guregu/null.v4"
 
 	"github.com/shopspring/decimal"
+	"github.com/smartcontractkit/chainlink/core/bridges"
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/configtest"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/evmtest"
@@ -39,7 +40,7 @@ func newRunner(t testing.TB, db *sqlx.DB, cfg *configtest.TestGeneralConfig) (pi
 
 	orm.On("GetQ").Return(q)
 	ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
-	r := pipeline.NewRunner(orm, cfg, cc, ethKeyStore, nil, logger.TestLogger(t))
+	r := pipeline.NewRunner(orm, cfg, cc, ethKeyStore, nil, bridges.NewBreakers(logger.TestLogger(t)), bridges.NewResponseCache(), logger.TestLogger(t))
 	return r, orm
 }
 
@@ -438,7 +439,7 @@ func Test_PipelineRunner_HandleFaultsPersistRun(t *testing.T) {
 	cc := evmtest.NewChainSet(t, evmtest.TestChainOpts{DB: db, GeneralConfig: cfg})
 	ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
 	lggr := logger.TestLogger(t)
-	r := pipeline.NewRunner(orm, cfg, cc, ethKeyStore, nil, lggr)
+	r := pipeline.NewRunner(orm, cfg, cc, ethKeyStore, nil, bridges.NewBreakers(lggr), bridges.NewResponseCache(), lggr)
 
 	spec := pipeline.Spec{DotDagSource: `
 fail_but_i_dont_care [type=fail]
@@ -510,6 +511,59 @@ a->b2;`,
 	assert.Equal(t, mustDecimal(t, "12").String(), result.Values[1].(decimal.Decimal).String())
 }
 
+func Test_PipelineRunner_ConditionalBranches(t *testing.T) {
+	cfg := cltest.NewTestGeneralConfig(t)
+	r, _ := newRunner(t, pgtest.NewSqlxDB(t), cfg)
+	lggr := logger.TestLogger(t)
+	spec := pipeline.Spec{
+		DotDagSource: `
+a [type=multiply input="$(val)" times=2]
+check [type=conditional data="$(a)" operator=">=" value="10"]
+above [type=multiply input="$(a)" times=10 index=0]
+below [type=multiply input="$(a)" times=3 when="$(small)" index=1]
+a->check->above;
+a->below;`,
+	}
+
+	t.Run("condition met", func(t *testing.T) {
+		_, trrs, err := r.ExecuteRun(context.Background(), spec, pipeline.NewVarsFrom(map[string]interface{}{"val": 6, "small": false}), lggr)
+		require.NoError(t, err)
+		require.Len(t, trrs, 4)
+
+		result := trrs.FinalResult(lggr)
+		assert.False(t, result.HasErrors())
+		assert.Equal(t, mustDecimal(t, "120").String(), result.Values[0].(decimal.Decimal).String())
+		// `below` is skipped by its `when` attribute, it has neither an output nor an error
+		assert.Nil(t, result.Values[1])
+		for _, trr := range trrs {
+			assert.Equal(t, trr.Task.DotID() == "below", trr.Skipped, trr.Task.DotID())
+		}
+	})
+
+	t.Run("condition not met", func(t *testing.T) {
+		_, trrs, err := r.ExecuteRun(context.Background(), spec, pipeline.NewVarsFrom(map[string]interface{}{"val": 2, "small": true}), lggr)
+		require.NoError(t, err)
+		require.Len(t, trrs, 4)
+
+		result := trrs.FinalResult(lggr)
+		assert.False(t, result.HasErrors())
+		assert.Nil(t, result.Values[0])
+		assert.Equal(t, mustDecimal(t, "12").String(), result.Values[1].(decimal.Decimal).String())
+		for _, trr := range trrs {
+			assert.Equal(t, trr.Task.DotID() == "above", trr.Skipped, trr.Task.DotID())
+		}
+	})
+
+	t.Run("invalid when", func(t *testing.T) {
+		_, trrs, err := r.ExecuteRun(context.Background(), spec, pipeline.NewVarsFrom(map[string]interface{}{"val": 2, "small": "maybe"}), lggr)
+		require.NoError(t, err)
+
+		result := trrs.FinalResult(lggr)
+		require.Error(t, result.FatalErrors[1])
+		assert.Contains(t, result.FatalErrors[1].Error(), "when")
+	})
+}
+
 func Test_PipelineRunner_AsyncJob_Basic(t *testing.T) {
 	db := pgtest.NewSqlxDB(t)
 
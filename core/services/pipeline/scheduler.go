@@ -26,7 +26,12 @@ func (s *scheduler) newMemoryTaskRun(task Task) *memoryTaskRun {
 		// NOTE: we could just allocate via make, then assign directly to run.inputs[i.OutputIndex()]
 		// if we're confident that indices are within range
 		for _, i := range task.Inputs() {
-			inputs = append(inputs, input{index: int32(i.OutputIndex()), result: s.results[i.ID()].Result})
+			result := s.results[i.ID()]
+			inputs = append(inputs, input{index: int32(i.OutputIndex()), result: result.Result})
+			// a task is skipped as soon as any of its inputs is on a branch that was not taken
+			if result.SkipsOutputs() {
+				run.skipped = true
+			}
 		}
 		sort.Slice(inputs, func(i, j int) bool {
 			return inputs[i].index < inputs[j].index
@@ -51,8 +56,9 @@ type scheduler struct {
 	vars         Vars
 	logger       logger.Logger
 
-	pending bool
-	exiting bool
+	pending   bool
+	exiting   bool
+	cancelled bool
 
 	taskCh   chan *memoryTaskRun
 	resultCh chan TaskRunResult
@@ -138,12 +144,14 @@ func (s *scheduler) reconstructResults() {
 			Result:     result,
 			CreatedAt:  r.CreatedAt,
 			FinishedAt: r.FinishedAt,
+			Skipped:    r.Skipped,
+			Meta:       r.Meta,
 		}
 
-		// store the result in vars
+		// store the result in vars, skipped tasks have no result
 		if result.Error != nil {
 			s.vars.Set(task.DotID(), result.Error)
-		} else {
+		} else if !r.Skipped {
 			s.vars.Set(task.DotID(), result.Value)
 		}
 
@@ -156,11 +164,28 @@ func (s *scheduler) reconstructResults() {
 }
 
 func (s *scheduler) Run() {
+	done := s.ctx.Done()
 	for s.waiting > 0 {
 		// we don't "for result in resultCh" because it would stall if the
 		// pipeline is completely empty
 
-		result := <-s.resultCh
+		// a cancelled run takes priority over any results waiting to be reported
+		select {
+		case <-done:
+			done = nil
+			s.handleCancel()
+			continue
+		default:
+		}
+
+		var result TaskRunResult
+		select {
+		case result = <-s.resultCh:
+		case <-done:
+			done = nil
+			s.handleCancel()
+			continue
+		}
 		// TODO: if for some reason the cleanup didn't succeed and we're stuck waiting for reports forever
 		// we should be able to timeout and finish shutting down
 		// See: https://app.shortcut.com/chainlinklabs/story/21225/straighten-out-and-clarify-context-usage-in-the-pipeline
@@ -186,10 +211,10 @@ func (s *scheduler) Run() {
 			continue
 		}
 
-		// store the result in vars
+		// store the result in vars, skipped tasks have no result
 		if result.Result.Error != nil {
 			s.vars.Set(result.Task.DotID(), result.Result.Error)
-		} else {
+		} else if !result.Skipped {
 			s.vars.Set(result.Task.DotID(), result.Result.Value)
 		}
 
@@ -264,6 +289,18 @@ func (s *scheduler) Run() {
 	close(s.taskCh)
 }
 
+// handleCancel is called once the scheduler context is done. Unless we're
+// already exiting due to a fail early task, the run was cancelled from outside:
+// in flight tasks are drained and nothing else gets scheduled.
+func (s *scheduler) handleCancel() {
+	if s.exiting {
+		return
+	}
+	s.exiting = true
+	s.cancelled = true
+	s.markRemaining(ErrRunCancelled)
+}
+
 func (s *scheduler) markRemaining(err error) {
 	now := time.Now()
 	for _, task := range s.pipeline.Tasks {
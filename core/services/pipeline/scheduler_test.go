@@ -167,3 +167,112 @@ func Test_Scheduler(t *testing.T) {
 
 	}
 }
+
+func Test_Scheduler_Cancel(t *testing.T) {
+	p, err := Parse(`
+	a [type=median index=0]
+	b [type=median index=1]
+	c [type=median index=2]
+	a -> c`)
+	require.NoError(t, err)
+	vars := NewVarsFrom(nil)
+	run := NewRun(Spec{}, vars)
+	s := newScheduler(p, &run, vars, logger.TestLogger(t))
+
+	go s.Run()
+
+	// receive both initial tasks, then cancel the run while they're in flight
+	var inFlight []*memoryTaskRun
+	for i := 0; i < 2; i++ {
+		select {
+		case taskRun := <-s.taskCh:
+			inFlight = append(inFlight, taskRun)
+		case <-time.After(time.Second):
+			t.Fatal("timed out waiting for task run")
+		}
+	}
+	s.cancel()
+
+	for _, taskRun := range inFlight {
+		now := time.Now()
+		s.report(context.Background(), TaskRunResult{
+			ID:         uuid.NewV4(),
+			Task:       taskRun.task,
+			Result:     Result{Value: 1},
+			FinishedAt: null.TimeFrom(now),
+			CreatedAt:  now,
+		})
+	}
+
+	select {
+	case _, ok := <-s.taskCh:
+		// c must not be scheduled once the run is cancelled
+		require.Falsef(t, ok, "scheduler has more tasks to schedule")
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for scheduler to halt")
+	}
+
+	require.True(t, s.cancelled)
+	require.True(t, s.exiting)
+	// in flight tasks keep their result, the ones that never ran are marked as cancelled
+	require.Equal(t, 1, s.results[p.ByDotID("a").ID()].Result.Value)
+	require.Equal(t, 1, s.results[p.ByDotID("b").ID()].Result.Value)
+	require.Equal(t, ErrRunCancelled, s.results[p.ByDotID("c").ID()].Result.Error)
+}
+
+func Test_Scheduler_SkipsBranches(t *testing.T) {
+	p, err := Parse(`
+	a [type=conditional]
+	b [type=median]
+	c [type=median index=0]
+	d [type=median index=1]
+	a -> b -> c
+	d`)
+	require.NoError(t, err)
+	vars := NewVarsFrom(nil)
+	run := NewRun(Spec{}, vars)
+	s := newScheduler(p, &run, vars, logger.TestLogger(t))
+
+	go s.Run()
+
+	results := map[string]TaskRunResult{
+		// the condition is false, so everything downstream of `a` is skipped
+		"a": {Result: Result{Value: false}},
+		"b": {Skipped: true},
+		"c": {Skipped: true},
+		// `d` does not depend on `a` and runs as usual
+		"d": {Result: Result{Value: 1}},
+	}
+	skipped := map[string]bool{}
+	for i := 0; i < len(results); i++ {
+		select {
+		case taskRun := <-s.taskCh:
+			dotID := taskRun.task.DotID()
+			skipped[dotID] = taskRun.skipped
+			result := results[dotID]
+			now := time.Now()
+			result.ID = uuid.NewV4()
+			result.Task = taskRun.task
+			result.CreatedAt = now
+			result.FinishedAt = null.TimeFrom(now)
+			s.report(context.Background(), result)
+		case <-time.After(time.Second):
+			t.Fatal("timed out waiting for task run")
+		}
+	}
+
+	select {
+	case _, ok := <-s.taskCh:
+		require.Falsef(t, ok, "scheduler has more tasks to schedule")
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for scheduler to halt")
+	}
+
+	require.Equal(t, map[string]bool{"a": false, "b": true, "c": true, "d": false}, skipped)
+	// skipped tasks don't produce variables
+	_, err = s.vars.Get("b")
+	require.Error(t, err)
+	val, err := s.vars.Get("d")
+	require.NoError(t, err)
+	require.Equal(t, 1, val)
+}
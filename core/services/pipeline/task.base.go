@@ -16,6 +16,7 @@ type BaseTask struct {
 	Index     int32         `mapstructure:"index" json:"-" `
 	Timeout   time.Duration `mapstructure:"timeout"`
 	FailEarly bool          `mapstructure:"failEarly"`
+	When      string        `mapstructure:"when"`
 
 	Retries    null.Uint32   `mapstructure:"retries"`
 	MinBackoff time.Duration `mapstructure:"minBackoff"`
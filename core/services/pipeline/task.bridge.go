@@ -3,9 +3,12 @@ package pipeline
 import (
 	"context"
 	"encoding/json"
+	"net/http"
 	"net/url"
 	"path"
+	"time"
 
+	"github.com/jpillora/backoff"
 	"github.com/pkg/errors"
 	"go.uber.org/multierr"
 
@@ -14,6 +17,17 @@ import (
 	"github.com/smartcontractkit/chainlink/core/services/pg"
 )
 
+//
+// BridgeTask calls an external adapter. How it is called is controlled by the
+// bridge's RequestPolicy: failed requests are retried within the task run
+// using the task's minBackoff and maxBackoff, and requests to a bridge whose
+// circuit breaker is open fail immediately.
+//
+// Responses from bridges with a CacheTTL are cached, keyed by the bridge's URL
+// and the request body. A cached response is reused by identical requests
+// until it is CacheTTL old, and up to CacheMaxStale past that if the bridge
+// fails. Whether the response came from the cache is recorded in the task
+// run's meta. Async requests are never cached.
 //
 // Return types:
 //     string
@@ -26,8 +40,10 @@ type BridgeTask struct {
 	IncludeInputAtKey string `json:"includeInputAtKey"`
 	Async             string `json:"async"`
 
-	queryer pg.Queryer
-	config  Config
+	queryer  pg.Queryer
+	config   Config
+	breakers bridges.Breakers
+	cache    bridges.ResponseCache
 }
 
 var _ Task = (*BridgeTask)(nil)
@@ -58,10 +74,11 @@ func (t *BridgeTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inp
 		return Result{Error: err}, runInfo
 	}
 
-	url, err := t.getBridgeURLFromName(name)
+	bt, err := t.getBridgeFromName(name)
 	if err != nil {
 		return Result{Error: err}, runInfo
 	}
+	url := URLParam(bt.URL)
 
 	var metaMap MapParam
 
@@ -92,10 +109,6 @@ func (t *BridgeTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inp
 		requestData["responseURL"] = responseURL.String()
 	}
 
-	// URL is "safe" because it comes from the node's own database
-	// Some node operators may run external adapters on their own hardware
-	allowUnrestrictedNetworkAccess := BoolParam(true)
-
 	requestDataJSON, err := json.Marshal(requestData)
 	if err != nil {
 		return Result{Error: err}, runInfo
@@ -105,9 +118,32 @@ func (t *BridgeTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inp
 		"url", url.String(),
 	)
 
-	responseBytes, statusCode, headers, elapsed, err := makeHTTPRequest(ctx, lggr, "POST", URLParam(url), requestData, allowUnrestrictedNetworkAccess, t.config)
+	cacheable := t.Async != "true" && bt.CachesResponses()
+	if cacheable {
+		if cached, ok := t.cache.Get(bt, requestDataJSON); ok {
+			lggr.Debugw("Bridge task: using cached response",
+				"answer", string(cached),
+				"url", url.String(),
+				"dotID", t.DotID(),
+			)
+			return Result{Value: string(cached)}, RunInfo{Meta: bridgeCacheMeta(bridges.CacheHit)}
+		}
+		runInfo.Meta = bridgeCacheMeta(bridges.CacheMiss)
+	}
+
+	responseBytes, statusCode, headers, elapsed, err := t.sendRequest(ctx, lggr, bt, requestData)
 	if err != nil {
-		return Result{Error: err}, RunInfo{IsRetryable: isRetryableHTTPError(statusCode, err)}
+		if cacheable {
+			if stale, ok := t.cache.GetStale(bt, requestDataJSON); ok {
+				lggr.Warnw("Bridge task: request failed, using stale cached response",
+					"err", err,
+					"url", url.String(),
+					"dotID", t.DotID(),
+				)
+				return Result{Value: string(stale)}, RunInfo{Meta: bridgeCacheMeta(bridges.CacheStale)}
+			}
+		}
+		return Result{Error: err}, RunInfo{IsRetryable: isRetryableHTTPError(statusCode, err), Meta: runInfo.Meta}
 	}
 
 	if t.Async == "true" {
@@ -129,6 +165,9 @@ func (t *BridgeTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inp
 	// flag such as  "BinaryMode: true" which passes through raw binary as the
 	// value instead.
 	result = Result{Value: string(responseBytes)}
+	if cacheable {
+		t.cache.Set(bt, requestDataJSON, responseBytes)
+	}
 
 	promHTTPFetchTime.WithLabelValues(t.DotID()).Set(float64(elapsed))
 	promHTTPResponseBodySize.WithLabelValues(t.DotID()).Set(float64(len(responseBytes)))
@@ -141,13 +180,68 @@ func (t *BridgeTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inp
 	return result, runInfo
 }
 
-func (t BridgeTask) getBridgeURLFromName(name StringParam) (URLParam, error) {
-	var bt bridges.BridgeType
-	err := t.queryer.Get(&bt, "SELECT * FROM bridge_types WHERE name = $1", string(name))
-	if err != nil {
-		return URLParam{}, errors.Wrapf(err, "could not find bridge with name '%s'", name)
+func (t BridgeTask) getBridgeFromName(name StringParam) (bt bridges.BridgeType, err error) {
+	err = t.queryer.Get(&bt, "SELECT * FROM bridge_types WHERE name = $1", string(name))
+	return bt, errors.Wrapf(err, "could not find bridge with name '%s'", name)
+}
+
+// sendRequest calls the bridge, retrying according to its RequestPolicy
+func (t BridgeTask) sendRequest(ctx context.Context, lggr logger.Logger, bt bridges.BridgeType, requestData MapParam) (responseBytes []byte, statusCode int, headers http.Header, elapsed time.Duration, err error) {
+	// URL is "safe" because it comes from the node's own database
+	// Some node operators may run external adapters on their own hardware
+	allowUnrestrictedNetworkAccess := BoolParam(true)
+
+	timeout := t.config.DefaultHTTPTimeout().Duration()
+	if !bt.AttemptTimeout.IsZero() {
+		timeout = bt.AttemptTimeout.Duration()
 	}
-	return URLParam(bt.URL), nil
+	retryBackoff := backoff.Backoff{
+		Factor: 2,
+		Min:    t.TaskMinBackoff(),
+		Max:    t.TaskMaxBackoff(),
+	}
+
+	for attempt := uint32(0); ; attempt++ {
+		release, err := t.breakers.Acquire(ctx, bt)
+		if err != nil {
+			return nil, 0, nil, 0, errors.Wrapf(err, "bridge '%s'", bt.Name)
+		}
+		responseBytes, statusCode, headers, elapsed, err = makeHTTPRequest(ctx, lggr, "POST", URLParam(bt.URL), requestData, allowUnrestrictedNetworkAccess, timeout, t.config)
+		release(bridgeRequestOutcome(ctx, bt, statusCode, err))
+
+		if err == nil || attempt >= bt.Retries || !bt.ShouldRetry(statusCode) {
+			return responseBytes, statusCode, headers, elapsed, err
+		}
+		lggr.Debugw("Bridge task: retrying request",
+			"err", err,
+			"statusCode", statusCode,
+			"attempt", attempt+1,
+			"bridge", bt.Name,
+		)
+		select {
+		case <-ctx.Done():
+			return nil, statusCode, headers, 0, err
+		case <-time.After(retryBackoff.ForAttempt(float64(attempt))):
+		}
+	}
+}
+
+// bridgeRequestOutcome decides whether a request counts against the bridge's
+// circuit breaker. Client errors mean the bridge is up, unless the bridge is
+// configured to retry them.
+func bridgeRequestOutcome(ctx context.Context, bt bridges.BridgeType, statusCode int, err error) bridges.RequestOutcome {
+	if ctx.Err() != nil {
+		return bridges.RequestAbandoned
+	} else if err == nil {
+		return bridges.RequestSucceeded
+	} else if statusCode == 0 || statusCode >= 500 || bt.ShouldRetry(statusCode) {
+		return bridges.RequestFailed
+	}
+	return bridges.RequestSucceeded
+}
+
+func bridgeCacheMeta(status bridges.CacheStatus) map[string]interface{} {
+	return map[string]interface{}{"cache": string(status)}
 }
 
 func withRunInfo(request MapParam, meta MapParam) MapParam {
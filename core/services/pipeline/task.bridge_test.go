@@ -10,7 +10,9 @@ import (
 	"net/http/httptest"
 	"net/url"
 	"testing"
+	"time"
 
+	"github.com/lib/pq"
 	"github.com/pkg/errors"
 	uuid "github.com/satori/go.uuid"
 	"github.com/shopspring/decimal"
@@ -24,6 +26,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/core/store/models"
 	"github.com/smartcontractkit/chainlink/core/utils"
 )
 
@@ -586,6 +589,259 @@ func TestBridgeTask_ErrorIfBridgeMissing(t *testing.T) {
 
 // Sample input taken from
 // https://github.com/smartcontractkit/price-adapters#chainlink-price-request-adapters
+func TestBridgeTask_RetryOnStatusCodes(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	cfg := cltest.NewTestGeneralConfig(t)
+
+	tests := []struct {
+		name          string
+		statusCodes   []int
+		expectedCalls int32
+		expectedError bool
+	}{
+		{"retried until success", []int{http.StatusServiceUnavailable, http.StatusTooManyRequests, http.StatusOK}, 3, false},
+		{"retries exhausted", []int{http.StatusServiceUnavailable, http.StatusServiceUnavailable, http.StatusServiceUnavailable, http.StatusOK}, 3, true},
+		{"status code not retried", []int{http.StatusInternalServerError, http.StatusOK}, 1, true},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			var calls atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				call := calls.Inc()
+				w.WriteHeader(test.statusCodes[call-1])
+				_, err := w.Write([]byte(`{"data":{"result":"1"}}`))
+				require.NoError(t, err)
+			}))
+			defer server.Close()
+
+			_, bridge := cltest.MustCreateBridge(t, db, cltest.BridgeOpts{
+				URL: server.URL,
+				RequestPolicy: bridges.RequestPolicy{
+					RetryOnStatusCodes: pq.Int32Array{http.StatusTooManyRequests, http.StatusServiceUnavailable},
+					Retries:            2,
+				},
+			}, cfg)
+
+			task := pipeline.BridgeTask{
+				BaseTask:    pipeline.NewBaseTask(0, "bridge", nil, nil, 0),
+				Name:        bridge.Name.String(),
+				RequestData: ethUSDPairing,
+			}
+			task.MinBackoff = time.Millisecond
+			task.MaxBackoff = time.Millisecond
+			task.HelperSetDependencies(cfg, db, uuid.UUID{})
+
+			result, _ := task.Run(context.Background(), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+			assert.Equal(t, test.expectedCalls, calls.Load())
+			if test.expectedError {
+				require.Error(t, result.Error)
+			} else {
+				require.NoError(t, result.Error)
+				require.Equal(t, `{"data":{"result":"1"}}`, result.Value)
+			}
+		})
+	}
+}
+
+func TestBridgeTask_AttemptTimeout(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	cfg := cltest.NewTestGeneralConfig(t)
+
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Inc() == 1 {
+			select {
+			case <-r.Context().Done():
+			case <-time.After(5 * time.Second):
+			}
+			return
+		}
+		_, err := w.Write([]byte(`{"data":{"result":"1"}}`))
+		require.NoError(t, err)
+	}))
+	defer server.Close()
+
+	_, bridge := cltest.MustCreateBridge(t, db, cltest.BridgeOpts{
+		URL: server.URL,
+		RequestPolicy: bridges.RequestPolicy{
+			Retries:        1,
+			AttemptTimeout: models.Interval(50 * time.Millisecond),
+		},
+	}, cfg)
+
+	task := pipeline.BridgeTask{
+		BaseTask:    pipeline.NewBaseTask(0, "bridge", nil, nil, 0),
+		Name:        bridge.Name.String(),
+		RequestData: ethUSDPairing,
+	}
+	task.MinBackoff = time.Millisecond
+	task.MaxBackoff = time.Millisecond
+	task.HelperSetDependencies(cfg, db, uuid.UUID{})
+
+	start := time.Now()
+	result, _ := task.Run(context.Background(), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+	require.NoError(t, result.Error)
+	assert.Equal(t, int32(2), calls.Load())
+	assert.Less(t, time.Since(start), 5*time.Second)
+}
+
+func TestBridgeTask_CircuitBreaker(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	cfg := cltest.NewTestGeneralConfig(t)
+
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Inc()
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer server.Close()
+
+	_, bridge := cltest.MustCreateBridge(t, db, cltest.BridgeOpts{
+		URL: server.URL,
+		RequestPolicy: bridges.RequestPolicy{
+			BreakerFailureThreshold: 2,
+			BreakerCooldown:         models.Interval(time.Hour),
+		},
+	}, cfg)
+
+	// The breaker is shared by all the runs that call the bridge
+	breakers := bridges.NewBreakers(logger.TestLogger(t))
+	run := func() pipeline.Result {
+		task := pipeline.BridgeTask{
+			Name:        bridge.Name.String(),
+			RequestData: ethUSDPairing,
+		}
+		task.HelperSetDependencies(cfg, db, uuid.UUID{})
+		task.HelperSetBreakers(breakers)
+		result, _ := task.Run(context.Background(), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+		return result
+	}
+
+	for i := 0; i < 2; i++ {
+		result := run()
+		require.Error(t, result.Error)
+		require.NotEqual(t, bridges.ErrCircuitOpen, errors.Cause(result.Error))
+	}
+	assert.Equal(t, int32(2), calls.Load())
+	assert.Equal(t, bridges.BreakerStateOpen, breakers.Status(bridge.Name).State)
+
+	result := run()
+	require.Equal(t, bridges.ErrCircuitOpen, errors.Cause(result.Error))
+	assert.Equal(t, int32(2), calls.Load())
+}
+
+func TestBridgeTask_ResponseCache(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	cfg := cltest.NewTestGeneralConfig(t)
+
+	var calls atomic.Int32
+	var failing atomic.Bool
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := calls.Inc()
+		if failing.Load() {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		fmt.Fprintf(w, `{"data":{"result":%d}}`, n)
+	}))
+	defer server.Close()
+
+	_, bridge := cltest.MustCreateBridge(t, db, cltest.BridgeOpts{
+		URL: server.URL,
+		RequestPolicy: bridges.RequestPolicy{
+			CacheTTL:      models.Interval(100 * time.Millisecond),
+			CacheMaxStale: models.Interval(time.Hour),
+		},
+	}, cfg)
+
+	// The cache is shared by all the runs that call the bridge
+	cache := bridges.NewResponseCache()
+	run := func(requestData string) (pipeline.Result, pipeline.RunInfo) {
+		task := pipeline.BridgeTask{
+			Name:        bridge.Name.String(),
+			RequestData: requestData,
+		}
+		task.HelperSetDependencies(cfg, db, uuid.UUID{})
+		task.HelperSetCache(cache)
+		return task.Run(context.Background(), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+	}
+
+	result, runInfo := run(ethUSDPairing)
+	require.NoError(t, result.Error)
+	assert.Equal(t, `{"data":{"result":1}}`, result.Value)
+	assert.Equal(t, map[string]interface{}{"cache": "miss"}, runInfo.Meta)
+
+	result, runInfo = run(ethUSDPairing)
+	require.NoError(t, result.Error)
+	assert.Equal(t, `{"data":{"result":1}}`, result.Value)
+	assert.Equal(t, map[string]interface{}{"cache": "hit"}, runInfo.Meta)
+	assert.Equal(t, int32(1), calls.Load())
+
+	// A different request body is a different cache entry
+	result, runInfo = run(btcUSDPairing)
+	require.NoError(t, result.Error)
+	assert.Equal(t, `{"data":{"result":2}}`, result.Value)
+	assert.Equal(t, map[string]interface{}{"cache": "miss"}, runInfo.Meta)
+
+	// Once the TTL has passed the bridge is called again, and the stale
+	// response is only used because the bridge fails
+	time.Sleep(150 * time.Millisecond)
+	failing.Store(true)
+	result, runInfo = run(ethUSDPairing)
+	require.NoError(t, result.Error)
+	assert.Equal(t, `{"data":{"result":1}}`, result.Value)
+	assert.Equal(t, map[string]interface{}{"cache": "stale"}, runInfo.Meta)
+	assert.Equal(t, int32(3), calls.Load())
+
+	assert.Equal(t, 2, cache.Flush(bridge.Name))
+	result, runInfo = run(ethUSDPairing)
+	require.Error(t, result.Error)
+	assert.Equal(t, map[string]interface{}{"cache": "miss"}, runInfo.Meta)
+	assert.Equal(t, int32(4), calls.Load())
+}
+
+func TestBridgeTask_ResponseCacheDisabled(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	cfg := cltest.NewTestGeneralConfig(t)
+
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Inc()
+		fmt.Fprint(w, `{"data":{"result":1}}`)
+	}))
+	defer server.Close()
+
+	_, bridge := cltest.MustCreateBridge(t, db, cltest.BridgeOpts{URL: server.URL}, cfg)
+
+	cache := bridges.NewResponseCache()
+	for i := 0; i < 2; i++ {
+		task := pipeline.BridgeTask{
+			Name:        bridge.Name.String(),
+			RequestData: ethUSDPairing,
+		}
+		task.HelperSetDependencies(cfg, db, uuid.UUID{})
+		task.HelperSetCache(cache)
+		result, runInfo := task.Run(context.Background(), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+		require.NoError(t, result.Error)
+		assert.Nil(t, runInfo.Meta)
+	}
+	assert.Equal(t, int32(2), calls.Load())
+}
+
 func TestAdapterResponse_UnmarshalJSON_Happy(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,81 @@
+package pipeline
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+//
+// ConditionalTask decides whether the tasks depending on it run. When it
+// evaluates to false, every task downstream of it is skipped.
+//
+// Without an operator, data must resolve to a boolean. With an operator, data
+// and value are compared as decimals:
+//
+//    check [type=conditional data="$(parse)" operator=">=" value="$(threshold)"]
+//
+// Return types:
+//    bool
+//
+type ConditionalTask struct {
+	BaseTask `mapstructure:",squash"`
+	Data     string `json:"data"`
+	Operator string `json:"operator"`
+	Value    string `json:"value"`
+}
+
+var _ Task = (*ConditionalTask)(nil)
+
+func (t *ConditionalTask) Type() TaskType {
+	return TaskTypeConditional
+}
+
+func (t *ConditionalTask) Run(_ context.Context, _ logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	_, err := CheckInputs(inputs, -1, -1, 0)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "task inputs")}, runInfo
+	}
+
+	if t.Operator == "" {
+		var data BoolParam
+		err = errors.Wrap(ResolveParam(&data, From(VarExpr(t.Data, vars), NonemptyString(t.Data), Input(inputs, 0))), "data")
+		if err != nil {
+			return Result{Error: err}, runInfo
+		}
+		return Result{Value: bool(data)}, runInfo
+	}
+
+	var (
+		a DecimalParam
+		b DecimalParam
+	)
+	err = multierr.Combine(
+		errors.Wrap(ResolveParam(&a, From(VarExpr(t.Data, vars), NonemptyString(t.Data), Input(inputs, 0))), "data"),
+		errors.Wrap(ResolveParam(&b, From(VarExpr(t.Value, vars), NonemptyString(t.Value))), "value"),
+	)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+
+	cmp := a.Decimal().Cmp(b.Decimal())
+	switch t.Operator {
+	case "==":
+		return Result{Value: cmp == 0}, runInfo
+	case "!=":
+		return Result{Value: cmp != 0}, runInfo
+	case ">":
+		return Result{Value: cmp > 0}, runInfo
+	case ">=":
+		return Result{Value: cmp >= 0}, runInfo
+	case "<":
+		return Result{Value: cmp < 0}, runInfo
+	case "<=":
+		return Result{Value: cmp <= 0}, runInfo
+	default:
+		return Result{Error: errors.Wrapf(ErrBadInput, "operator: unknown operator %q", t.Operator)}, runInfo
+	}
+}
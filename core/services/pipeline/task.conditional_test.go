@@ -0,0 +1,73 @@
+package pipeline_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+)
+
+func TestConditionalTask(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		data          string
+		operator      string
+		value         string
+		inputs        []pipeline.Result
+		vars          map[string]interface{}
+		expected      bool
+		expectedError error
+	}{
+		{"input true", "", "", "", []pipeline.Result{{Value: true}}, nil, true, nil},
+		{"input false", "", "", "", []pipeline.Result{{Value: false}}, nil, false, nil},
+		{"input string", "", "", "", []pipeline.Result{{Value: "false"}}, nil, false, nil},
+		{"literal", "true", "", "", nil, nil, true, nil},
+		{"var", "$(foo)", "", "", nil, map[string]interface{}{"foo": true}, true, nil},
+		{"not a bool", "$(foo)", "", "", nil, map[string]interface{}{"foo": "bar"}, false, pipeline.ErrBadInput},
+		{"missing data", "", "", "", nil, nil, false, pipeline.ErrParameterEmpty},
+		{"errored input", "", "", "", []pipeline.Result{{Error: errors.New("uh oh")}}, nil, false, pipeline.ErrTooManyErrors},
+
+		{">= above threshold", "", ">=", "100", []pipeline.Result{{Value: "100.5"}}, nil, true, nil},
+		{">= at threshold", "", ">=", "100", []pipeline.Result{{Value: 100}}, nil, true, nil},
+		{">= below threshold", "", ">=", "100", []pipeline.Result{{Value: 99.9}}, nil, false, nil},
+		{"> at threshold", "", ">", "100", []pipeline.Result{{Value: 100}}, nil, false, nil},
+		{"< vars", "$(a)", "<", "$(b)", nil, map[string]interface{}{"a": 1, "b": "2"}, true, nil},
+		{"<= vars", "$(a)", "<=", "$(b)", nil, map[string]interface{}{"a": 3, "b": "2"}, false, nil},
+		{"==", "1.0", "==", "1", nil, nil, true, nil},
+		{"!=", "1.0", "!=", "1", nil, nil, false, nil},
+		{"missing value", "1", ">", "", nil, nil, false, pipeline.ErrParameterEmpty},
+		{"unknown operator", "1", "=>", "1", nil, nil, false, pipeline.ErrBadInput},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			task := pipeline.ConditionalTask{
+				BaseTask: pipeline.NewBaseTask(0, "task", nil, nil, 0),
+				Data:     test.data,
+				Operator: test.operator,
+				Value:    test.value,
+			}
+			result, runInfo := task.Run(context.Background(), logger.TestLogger(t), pipeline.NewVarsFrom(test.vars), test.inputs)
+			assert.False(t, runInfo.IsPending)
+			assert.False(t, runInfo.IsRetryable)
+
+			if test.expectedError != nil {
+				require.Equal(t, test.expectedError, errors.Cause(result.Error))
+				require.Nil(t, result.Value)
+			} else {
+				require.NoError(t, result.Error)
+				require.Equal(t, test.expected, result.Value)
+			}
+		})
+	}
+}
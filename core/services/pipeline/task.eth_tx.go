@@ -14,6 +14,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/null"
 	"github.com/smartcontractkit/chainlink/core/services/bulletprooftxmanager"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
 )
 
 //
@@ -30,9 +31,11 @@ type ETHTxTask struct {
 	MinConfirmations string `json:"minConfirmations"`
 	EVMChainID       string `json:"evmChainID" mapstructure:"evmChainID"`
 	Simulate         string `json:"simulate" mapstructure:"simulate"`
+	KeyPool          string `json:"keyPool" mapstructure:"keyPool"`
 
 	keyStore ETHKeyStore
 	chainSet evm.ChainSet
+	queryer  pg.Queryer
 }
 
 //go:generate mockery --name ETHKeyStore --output ./mocks/ --case=underscore
@@ -47,7 +50,7 @@ func (t *ETHTxTask) Type() TaskType {
 	return TaskTypeETHTx
 }
 
-func (t *ETHTxTask) Run(_ context.Context, lggr logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+func (t *ETHTxTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
 	chain, err := getChainByString(t.chainSet, t.EVMChainID)
 	if err != nil {
 		return Result{Error: errors.Wrapf(err, "failed to get chain by id: %v", t.EVMChainID)}, retryableRunInfo()
@@ -67,6 +70,7 @@ func (t *ETHTxTask) Run(_ context.Context, lggr logger.Logger, vars Vars, inputs
 		txMetaMap             MapParam
 		maybeMinConfirmations MaybeUint64Param
 		simulate              BoolParam
+		keyPool               StringParam
 	)
 	err = multierr.Combine(
 		errors.Wrap(ResolveParam(&fromAddrs, From(VarExpr(t.From, vars), JSONWithVarExprs(t.From, vars, false), NonemptyString(t.From), nil)), "from"),
@@ -76,6 +80,7 @@ func (t *ETHTxTask) Run(_ context.Context, lggr logger.Logger, vars Vars, inputs
 		errors.Wrap(ResolveParam(&txMetaMap, From(VarExpr(t.TxMeta, vars), JSONWithVarExprs(t.TxMeta, vars, false), MapParam{})), "txMeta"),
 		errors.Wrap(ResolveParam(&maybeMinConfirmations, From(t.MinConfirmations)), "minConfirmations"),
 		errors.Wrap(ResolveParam(&simulate, From(VarExpr(t.Simulate, vars), NonemptyString(t.Simulate), false)), "simulate"),
+		errors.Wrap(ResolveParam(&keyPool, From(VarExpr(t.KeyPool, vars), NonemptyString(t.KeyPool), "")), "keyPool"),
 	)
 	if err != nil {
 		return Result{Error: err}, runInfo
@@ -115,12 +120,42 @@ func (t *ETHTxTask) Run(_ context.Context, lggr logger.Logger, vars Vars, inputs
 	if err != nil {
 		return Result{Error: errors.Wrapf(ErrBadInput, "txMeta: %v", err)}, runInfo
 	}
+	// The job ID attributes the gas spend of the transaction to the job
+	if txMeta.JobID == 0 {
+		if jobID, err2 := vars.Get("jobSpec.databaseID"); err2 == nil {
+			if id, ok := jobID.(int32); ok {
+				txMeta.JobID = id
+			}
+		}
+	}
 
-	fromAddr, err := t.keyStore.GetRoundRobinAddress(fromAddrs...)
-	if err != nil {
-		err = errors.Wrap(err, "ETHTxTask failed to get fromAddress")
-		lggr.Error(err)
-		return Result{Error: errors.Wrapf(ErrTaskRunFailed, "while querying keystore: %v", err)}, retryableRunInfo()
+	if keyPool != "" && len(fromAddrs) > 0 {
+		return Result{Error: errors.Wrap(ErrBadInput, "from and keyPool are mutually exclusive")}, runInfo
+	}
+	// Jobs with a key pool send from it, unless the task names its own keys
+	if keyPool == "" && len(fromAddrs) == 0 && txMeta.JobID != 0 {
+		jobKeyPool, err2 := bulletprooftxmanager.JobKeyPool(t.queryer, txMeta.JobID)
+		if err2 != nil {
+			return Result{Error: errors.Wrapf(ErrTaskRunFailed, "while loading key pool: %v", err2)}, retryableRunInfo()
+		}
+		keyPool = StringParam(jobKeyPool.String)
+	}
+
+	var fromAddr common.Address
+	if keyPool != "" {
+		fromAddr, err = txManager.SelectFromAddress(ctx, string(keyPool), uint64(gasLimit))
+		if err != nil {
+			err = errors.Wrap(err, "ETHTxTask failed to select fromAddress from key pool")
+			lggr.Error(err)
+			return Result{Error: errors.Wrapf(ErrTaskRunFailed, "while selecting key: %v", err)}, retryableRunInfo()
+		}
+	} else {
+		fromAddr, err = t.keyStore.GetRoundRobinAddress(fromAddrs...)
+		if err != nil {
+			err = errors.Wrap(err, "ETHTxTask failed to get fromAddress")
+			lggr.Error(err)
+			return Result{Error: errors.Wrapf(ErrTaskRunFailed, "while querying keystore: %v", err)}, retryableRunInfo()
+		}
 	}
 
 	// NOTE: This can be easily adjusted later to allow job specs to specify the details of which strategy they would like
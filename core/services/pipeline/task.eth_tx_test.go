@@ -7,11 +7,14 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/pkg/errors"
 	clnull "github.com/smartcontractkit/chainlink/core/null"
+	"github.com/smartcontractkit/sqlx"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"gopkg.in/guregu/null.v4"
 
+	"github.com/smartcontractkit/chainlink/core/chains/evm"
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/configtest"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/evmtest"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
@@ -105,6 +108,39 @@ func TestETHTxTask(t *testing.T) {
 			},
 			nil, nil, "", pipeline.RunInfo{},
 		},
+		{
+			"happy (job ID from jobSpec)",
+			`[ "0x882969652440ccf14a5dbb9bd53eb21cb1e11e5c" ]`,
+			"0xDeaDbeefdEAdbeefdEadbEEFdeadbeEFdEaDbeeF",
+			"foobar",
+			"12345",
+			`{}`,
+			`0`,
+			pipeline.NewVarsFrom(map[string]interface{}{
+				"jobSpec": map[string]interface{}{
+					"databaseID": int32(321),
+				},
+			}),
+			nil,
+			func(config *configtest.TestGeneralConfig, keyStore *keystoremocks.Eth, txManager *bptxmmocks.TxManager) {
+				config.Overrides.GlobalEvmGasLimitDefault = null.IntFrom(999)
+				from := common.HexToAddress("0x882969652440ccf14a5dbb9bd53eb21cb1e11e5c")
+				to := common.HexToAddress("0xDeaDbeefdEAdbeefdEadbEEFdeadbeEFdEaDbeeF")
+				data := []byte("foobar")
+				gasLimit := uint64(12345)
+				txMeta := &bulletprooftxmanager.EthTxMeta{JobID: 321}
+				keyStore.On("GetRoundRobinAddress", from).Return(from, nil)
+				txManager.On("CreateEthTransaction", bulletprooftxmanager.NewTx{
+					FromAddress:    from,
+					ToAddress:      to,
+					EncodedPayload: data,
+					GasLimit:       gasLimit,
+					Meta:           txMeta,
+					Strategy:       bulletprooftxmanager.SendEveryStrategy{},
+				}).Return(bulletprooftxmanager.EthTx{}, nil)
+			},
+			nil, nil, "", pipeline.RunInfo{},
+		},
 		{
 			"happy (with vars 2)",
 			`$(fromAddrs)`,
@@ -402,7 +438,7 @@ func TestETHTxTask(t *testing.T) {
 			cc := evmtest.NewChainSet(t, evmtest.TestChainOpts{DB: db, GeneralConfig: cfg, TxManager: txManager, KeyStore: keyStore})
 
 			test.setupClientMocks(cfg, keyStore, txManager)
-			task.HelperSetDependencies(cc, keyStore)
+			task.HelperSetDependencies(cc, keyStore, db)
 
 			result, runInfo := task.Run(context.Background(), logger.TestLogger(t), test.vars, test.inputs)
 			assert.Equal(t, test.expectedRunInfo, runInfo)
@@ -423,3 +459,109 @@ func TestETHTxTask(t *testing.T) {
 		})
 	}
 }
+
+func TestETHTxTask_KeyPool(t *testing.T) {
+	t.Parallel()
+
+	from := common.HexToAddress("0x882969652440ccf14a5dbb9bd53eb21cb1e11e5c")
+	to := common.HexToAddress("0xDeaDbeefdEAdbeefdEadbEEFdeadbeEFdEaDbeeF")
+
+	setup := func(t *testing.T) (*sqlx.DB, *keystoremocks.Eth, *bptxmmocks.TxManager, evm.ChainSet) {
+		keyStore := new(keystoremocks.Eth)
+		keyStore.Test(t)
+		txManager := new(bptxmmocks.TxManager)
+		txManager.Test(t)
+		db := pgtest.NewSqlxDB(t)
+		cfg := configtest.NewTestGeneralConfig(t)
+		cc := evmtest.NewChainSet(t, evmtest.TestChainOpts{DB: db, GeneralConfig: cfg, TxManager: txManager, KeyStore: keyStore})
+		return db, keyStore, txManager, cc
+	}
+	newTask := func(keyPool string) pipeline.ETHTxTask {
+		return pipeline.ETHTxTask{
+			BaseTask:         pipeline.NewBaseTask(0, "ethtx", nil, nil, 0),
+			To:               to.Hex(),
+			Data:             "foobar",
+			GasLimit:         "12345",
+			MinConfirmations: "0",
+			KeyPool:          keyPool,
+		}
+	}
+
+	t.Run("sends from the key selected from the key pool of the task", func(t *testing.T) {
+		db, keyStore, txManager, cc := setup(t)
+		task := newTask("ocr")
+		task.HelperSetDependencies(cc, keyStore, db)
+
+		txManager.On("SelectFromAddress", mock.Anything, "ocr", uint64(12345)).Return(from, nil)
+		txManager.On("CreateEthTransaction", mock.MatchedBy(func(tx bulletprooftxmanager.NewTx) bool {
+			return tx.FromAddress == from && tx.ToAddress == to
+		})).Return(bulletprooftxmanager.EthTx{}, nil)
+
+		result, runInfo := task.Run(context.Background(), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+		require.NoError(t, result.Error)
+		assert.Equal(t, pipeline.RunInfo{}, runInfo)
+
+		keyStore.AssertExpectations(t)
+		txManager.AssertExpectations(t)
+	})
+
+	t.Run("sends from the key pool of the job", func(t *testing.T) {
+		db, keyStore, txManager, cc := setup(t)
+		task := newTask("")
+		task.HelperSetDependencies(cc, keyStore, db)
+
+		borm := bulletprooftxmanager.NewORM(db, logger.TestLogger(t), configtest.NewTestGeneralConfig(t))
+		require.NoError(t, borm.UpsertKeyPool(&bulletprooftxmanager.KeyPool{
+			Name:     "vrf",
+			Strategy: bulletprooftxmanager.KeyPoolStrategyLeastUnconfirmed,
+			Members:  []bulletprooftxmanager.KeyPoolMember{{Address: from, Weight: 1}},
+		}))
+		jb, _ := cltest.MustInsertWebhookSpec(t, db)
+		_, err := db.Exec(`UPDATE jobs SET key_pool = 'vrf' WHERE id = $1`, jb.ID)
+		require.NoError(t, err)
+
+		txManager.On("SelectFromAddress", mock.Anything, "vrf", uint64(12345)).Return(from, nil)
+		txManager.On("CreateEthTransaction", mock.MatchedBy(func(tx bulletprooftxmanager.NewTx) bool {
+			return tx.FromAddress == from && tx.Meta.JobID == jb.ID
+		})).Return(bulletprooftxmanager.EthTx{}, nil)
+
+		vars := pipeline.NewVarsFrom(map[string]interface{}{
+			"jobSpec": map[string]interface{}{
+				"databaseID": jb.ID,
+			},
+		})
+		result, _ := task.Run(context.Background(), logger.TestLogger(t), vars, nil)
+		require.NoError(t, result.Error)
+
+		keyStore.AssertExpectations(t)
+		txManager.AssertExpectations(t)
+	})
+
+	t.Run("returns a retryable error if no key of the key pool is available", func(t *testing.T) {
+		db, keyStore, txManager, cc := setup(t)
+		task := newTask("ocr")
+		task.HelperSetDependencies(cc, keyStore, db)
+
+		txManager.On("SelectFromAddress", mock.Anything, "ocr", uint64(12345)).Return(common.Address{}, bulletprooftxmanager.ErrKeyPoolNoKeyAvailable)
+
+		result, runInfo := task.Run(context.Background(), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+		require.Equal(t, pipeline.ErrTaskRunFailed, errors.Cause(result.Error))
+		assert.Contains(t, result.Error.Error(), "no key of the key pool can send the transaction")
+		assert.Equal(t, pipeline.RunInfo{IsRetryable: true}, runInfo)
+
+		txManager.AssertExpectations(t)
+	})
+
+	t.Run("rejects a key pool together with from addresses", func(t *testing.T) {
+		db, keyStore, txManager, cc := setup(t)
+		task := newTask("ocr")
+		task.From = `[ "0x882969652440ccf14a5dbb9bd53eb21cb1e11e5c" ]`
+		task.HelperSetDependencies(cc, keyStore, db)
+
+		result, _ := task.Run(context.Background(), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+		require.Equal(t, pipeline.ErrBadInput, errors.Cause(result.Error))
+
+		keyStore.AssertExpectations(t)
+		txManager.AssertExpectations(t)
+	})
+}
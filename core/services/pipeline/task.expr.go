@@ -0,0 +1,74 @@
+package pipeline
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+//
+// ExprTask evaluates an expression over the pipeline variables, e.g.
+//
+//    change [type=expr expr="(price - previous) / previous * 100" precision=4]
+//
+// See expr.go for the syntax. Division is rounded to `precision` decimal
+// places, between 0 and 78, which defaults to 16.
+//
+// Return types:
+//    decimal.Decimal
+//    string
+//    bool
+//    []byte
+//
+type ExprTask struct {
+	BaseTask  `mapstructure:",squash"`
+	Expr      string `json:"expr"`
+	Precision string `json:"precision"`
+}
+
+var _ Task = (*ExprTask)(nil)
+
+func (t *ExprTask) Type() TaskType {
+	return TaskTypeExpr
+}
+
+// Validate checks the syntax of the expression, so that errors are reported
+// when the spec is created rather than when it runs
+func (t *ExprTask) Validate() error {
+	_, err := parseExpr(t.Expr)
+	return errors.Wrap(err, "expr")
+}
+
+func (t *ExprTask) Run(_ context.Context, _ logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	_, err := CheckInputs(inputs, -1, -1, 0)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "task inputs")}, runInfo
+	}
+
+	var maybePrecision MaybeInt32Param
+	err = errors.Wrap(ResolveParam(&maybePrecision, From(VarExpr(t.Precision, vars), t.Precision)), "precision")
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+	precision, isSet := maybePrecision.Int32()
+	if !isSet {
+		// Same default as the decimal library
+		precision = int32(decimal.DivisionPrecision)
+	}
+	if precision < 0 || precision > maxExprPlaces {
+		return Result{Error: errors.Wrapf(ErrBadInput, "precision must be between 0 and %d, got %d", maxExprPlaces, precision)}, runInfo
+	}
+
+	node, err := parseExpr(t.Expr)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "expr")}, runInfo
+	}
+	val, err := node.eval(exprEnv{vars: vars, precision: precision})
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "expr")}, runInfo
+	}
+	return Result{Value: val}, runInfo
+}
@@ -0,0 +1,188 @@
+package pipeline_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+)
+
+func TestExprTask(t *testing.T) {
+	t.Parallel()
+
+	vars := map[string]interface{}{
+		"a":      10,
+		"b":      "4",
+		"f":      1.5,
+		"flag":   true,
+		"name":   "eth",
+		"raw":    []byte{0xde, 0xad},
+		"json":   map[string]interface{}{"price": "1234.5678"},
+		"items":  []interface{}{1, 2},
+		"memo":   pipeline.ObjectParam{Type: pipeline.DecimalType, DecimalValue: pipeline.DecimalParam(decimal.NewFromInt(7))},
+		"failed": errors.New("uh oh"),
+	}
+
+	tests := []struct {
+		name          string
+		expr          string
+		precision     string
+		expected      interface{}
+		expectedError error
+	}{
+		{"literal", "42", "", mustDecimal(t, "42"), nil},
+		{"arithmetic", "(a - b) / b * 100", "", mustDecimal(t, "150"), nil},
+		{"precedence", "1 + 2 * 3 - 4 % 3", "", mustDecimal(t, "6"), nil},
+		{"unary minus", "-a + -(-2)", "", mustDecimal(t, "-8"), nil},
+		{"division precision", "1 / 3", "", mustDecimal(t, "0.3333333333333333"), nil},
+		{"custom precision", "1 / 3", "2", mustDecimal(t, "0.33"), nil},
+		{"maximum precision", "2 / 3", "78", mustDecimal(t, "0."+strings.Repeat("6", 77)+"7"), nil},
+		{"float var", "f * 2", "", mustDecimal(t, "3"), nil},
+		{"keypath", "json.price * 2", "", mustDecimal(t, "2469.1356"), nil},
+		{"array index", "items.1 + 0", "", mustDecimal(t, "2"), nil},
+		{"var expr syntax", "$(json.price) > 1000", "", true, nil},
+		{"object param", "memo + 1", "", mustDecimal(t, "8"), nil},
+
+		{"less than", "b < a", "", true, nil},
+		{"greater or equal", "a >= 10", "", true, nil},
+		{"equal numbers", "b == 4.0", "", true, nil},
+		{"equal strings", "name == 'eth'", "", true, nil},
+		{"not equal", "name != \"btc\"", "", true, nil},
+		{"equal null", "null == null", "", true, nil},
+		{"logic", "flag && !(a < 5) || false", "", true, nil},
+		{"short circuit", "false && missing", "", false, nil},
+		{"ternary", "a > 5 ? 'big' : 'small'", "", "big", nil},
+
+		{"min", "min(a, b, 7)", "", mustDecimal(t, "4"), nil},
+		{"max", "max(a, b, 7)", "", mustDecimal(t, "10"), nil},
+		{"abs", "abs(b - a)", "", mustDecimal(t, "6"), nil},
+		{"round", "round(2.5)", "", mustDecimal(t, "3"), nil},
+		{"round places", "round(json.price, 2)", "", mustDecimal(t, "1234.57"), nil},
+		{"floor", "floor(-1.5)", "", mustDecimal(t, "-2"), nil},
+		{"ceil", "ceil(1.2)", "", mustDecimal(t, "2"), nil},
+		{"concat", "concat(name, '-', a, '-', flag)", "", "eth-10-true", nil},
+		{"len", "len(name) + len(raw)", "", mustDecimal(t, "5"), nil},
+		{"bytes", "bytes(name)", "", []byte("eth"), nil},
+		{"hexEncode bytes", "hexEncode(raw)", "", "0xdead", nil},
+		{"hexEncode number", "hexEncode(255)", "", "0xff", nil},
+		{"hexDecode", "hexDecode('0xbeef')", "", []byte{0xbe, 0xef}, nil},
+
+		{"division by zero", "a / (b - 4)", "", nil, pipeline.ErrBadInput},
+		{"not a number", "name * 2", "", nil, pipeline.ErrBadInput},
+		{"not a bool", "a && flag", "", nil, pipeline.ErrBadInput},
+		{"missing var", "missing + 1", "", nil, pipeline.ErrKeypathNotFound},
+		{"errored var", "failed + 1", "", nil, pipeline.ErrTooManyErrors},
+		{"hexEncode negative", "hexEncode(-1)", "", nil, pipeline.ErrBadInput},
+		{"hexDecode invalid", "hexDecode('0xzz')", "", nil, pipeline.ErrBadInput},
+		{"round places too large", "round(a, 2000000000)", "", nil, pipeline.ErrBadInput},
+		{"round places negative", "round(a, -1)", "", nil, pipeline.ErrBadInput},
+		{"precision too large", "1 / 3", "2000000000", nil, pipeline.ErrBadInput},
+		{"precision negative", "1 / 3", "-1", nil, pipeline.ErrBadInput},
+		{"empty", " ", "", nil, pipeline.ErrParameterEmpty},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			task := pipeline.ExprTask{
+				BaseTask:  pipeline.NewBaseTask(0, "task", nil, nil, 0),
+				Expr:      test.expr,
+				Precision: test.precision,
+			}
+			result, runInfo := task.Run(context.Background(), logger.TestLogger(t), pipeline.NewVarsFrom(vars), nil)
+			assert.False(t, runInfo.IsPending)
+			assert.False(t, runInfo.IsRetryable)
+
+			if test.expectedError != nil {
+				require.Equal(t, test.expectedError, errors.Cause(result.Error))
+				require.Nil(t, result.Value)
+				return
+			}
+			require.NoError(t, result.Error)
+			switch expected := test.expected.(type) {
+			case *decimal.Decimal:
+				require.Equal(t, expected.String(), result.Value.(decimal.Decimal).String())
+			default:
+				require.Equal(t, expected, result.Value)
+			}
+		})
+	}
+}
+
+func TestExprTask_RuntimeErrorPosition(t *testing.T) {
+	t.Parallel()
+
+	task := pipeline.ExprTask{
+		BaseTask: pipeline.NewBaseTask(0, "task", nil, nil, 0),
+		Expr:     "1 + a / 0",
+	}
+	result, _ := task.Run(context.Background(), logger.TestLogger(t), pipeline.NewVarsFrom(map[string]interface{}{"a": 1}), nil)
+	require.EqualError(t, result.Error, "expr: division by zero at position 7: bad input for task")
+}
+
+func TestExprTask_Validate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		expr string
+		pos  int
+		msg  string
+	}{
+		{"(a - b", 7, `expected ")", got end of expression`},
+		{"a + * b", 5, `unexpected "*"`},
+		{"a b", 3, `unexpected "b"`},
+		{"1.", 3, "expected digit after decimal point"},
+		{"12abc", 3, `unexpected character 'a' in number`},
+		{"'unterminated", 1, "unterminated string"},
+		{"a # b", 3, `unexpected character '#'`},
+		{"sqrt(4)", 1, `unknown function "sqrt"`},
+		{"abs(1, 2)", 1, "wrong number of arguments for abs: expected 1"},
+		{"min()", 1, "wrong number of arguments for min: expected at least 1"},
+		{"a ? b", 6, `expected ":", got end of expression`},
+		{"$(a", 4, `expected ")"`},
+		{"a.", 1, `invalid variable "a."`},
+		{"json.items.1", 1, `invalid variable "json.items.1"`},
+		{"é + 1", 1, `unexpected character 'é'`},
+		{strings.Repeat("(", 100) + "1" + strings.Repeat(")", 100), 65, "expression is nested too deeply (maximum 64 levels)"},
+	}
+
+	for _, test := range tests {
+		task := pipeline.ExprTask{Expr: test.expr}
+		err := task.Validate()
+		require.Error(t, err, test.expr)
+
+		var syntaxErr pipeline.ExprSyntaxError
+		require.True(t, errors.As(err, &syntaxErr), test.expr)
+		assert.Equal(t, test.pos, syntaxErr.Pos, test.expr)
+		assert.Equal(t, test.msg, syntaxErr.Msg, test.expr)
+	}
+
+	valid := pipeline.ExprTask{Expr: "round(max(a, b) / 3, 2) >= 1 ? concat('yes', a) : hexEncode(bytes('no'))"}
+	require.NoError(t, valid.Validate())
+}
+
+func TestExprTask_ValidatedOnParse(t *testing.T) {
+	t.Parallel()
+
+	_, err := pipeline.Parse(`
+	a [type=memo value="1"]
+	b [type=expr expr="a +"]
+	a -> b`)
+	require.EqualError(t, err, `UnmarshalTaskFromMap: task "b": expr: unexpected end of expression at position 4`)
+
+	p, err := pipeline.Parse(`
+	a [type=memo value="1"]
+	b [type=expr expr="$(a) + 1"]
+	a -> b`)
+	require.NoError(t, err)
+	require.Equal(t, pipeline.TaskTypeExpr, p.Tasks[1].Type())
+}
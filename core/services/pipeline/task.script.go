@@ -0,0 +1,183 @@
+package pipeline
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/dop251/goja"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+const (
+	maxScriptLength        = 64 * 1024
+	maxScriptOutputLength  = 1024 * 1024
+	maxScriptCallStackSize = 256
+	maxScriptOperations    = 500000
+	maxScriptMemory        = 256 * 1024 * 1024
+	defaultScriptTimeout   = time.Second
+	maxScriptTimeout       = 10 * time.Second
+)
+
+//
+// ScriptTask runs a JavaScript snippet in an embedded interpreter and returns
+// the value of its last statement, e.g.
+//
+//    reshape [type=script script="({price: vars.parse.last * 100, symbol: vars.jobRun.symbol})"]
+//
+// The pipeline variables are available as `vars` and the results of the
+// task's inputs as `inputs`. Both are copied into the interpreter as plain
+// JSON values, and the result is copied back out the same way, so numbers
+// become floats: return a string to keep full precision.
+//
+// Scripts have no access to the network, the filesystem or any other part of
+// the node. Math.random is seeded and the clock is frozen at the start of the
+// task, so a script always produces the same result for the same inputs.
+//
+// A script may run at most 500,000 loop iterations and function calls in
+// total (see task.script_meter.go), which makes runaway scripts fail the same
+// way on every node, however busy it is. eval and the Function constructor
+// are disabled, since the code they compile would not be counted.
+//
+// Scripts run in worker processes (see task.script_worker.go), since the
+// interpreter can't limit the memory a script allocates. A worker is killed if
+// a script uses more than 256MB, or when the task timeout expires. The timeout
+// is 1s by default and at most 10s, and the call stack depth is bounded.
+//
+// Return types:
+//    map[string]interface{}
+//    []interface{}
+//    string
+//    float64
+//    bool
+//    nil
+//
+type ScriptTask struct {
+	BaseTask `mapstructure:",squash"`
+	Script   string `json:"script"`
+
+	workers *scriptWorkerPool
+}
+
+var _ Task = (*ScriptTask)(nil)
+
+func (t *ScriptTask) Type() TaskType {
+	return TaskTypeScript
+}
+
+// Validate compiles the script, so that syntax errors are reported when the
+// spec is created rather than when it runs
+func (t *ScriptTask) Validate() error {
+	if timeout, isSet := t.TaskTimeout(); isSet && timeout > maxScriptTimeout {
+		return errors.Errorf("script: timeout must be at most %s, got %s", maxScriptTimeout, timeout)
+	}
+	_, err := compileScript(t.Script)
+	return errors.Wrap(err, "script")
+}
+
+func (t *ScriptTask) Run(ctx context.Context, _ logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	if _, err := compileScript(t.Script); err != nil {
+		return Result{Error: errors.Wrap(err, "script")}, runInfo
+	}
+
+	timeout, isSet := t.TaskTimeout()
+	if !isSet {
+		timeout = defaultScriptTimeout
+	} else if timeout > maxScriptTimeout {
+		timeout = maxScriptTimeout
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	varsJSON, inputsJSON, err := scriptGlobals(vars, inputs)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "script")}, runInfo
+	}
+
+	output, err := t.workers.run(ctx, scriptRequest{
+		Script: t.Script,
+		Vars:   varsJSON,
+		Inputs: inputsJSON,
+		Start:  time.Now(),
+	})
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "script")}, runInfo
+	}
+	return Result{Value: output}, runInfo
+}
+
+func compileScript(script string) (*goja.Program, error) {
+	if len(script) == 0 {
+		return nil, ErrParameterEmpty
+	} else if len(script) > maxScriptLength {
+		return nil, errors.Errorf("script is too long (maximum %d bytes)", maxScriptLength)
+	}
+	program, err := goja.Parse("script", script)
+	if err != nil {
+		return nil, err
+	}
+	if err = meterScript(program); err != nil {
+		return nil, err
+	}
+	return goja.CompileAST(program, true)
+}
+
+// scriptGlobals marshals the vars and inputs as plain JSON values, so that
+// scripts never get a handle on Go objects
+func scriptGlobals(vars Vars, inputs []Result) (varsJSON, inputsJSON json.RawMessage, err error) {
+	varsMap := make(map[string]JSONSerializable, len(vars.vars))
+	for key, val := range vars.vars {
+		varsMap[key] = scriptJSONValue(val)
+	}
+	inputsSlice := make([]JSONSerializable, len(inputs))
+	for i, input := range inputs {
+		if input.Error == nil {
+			inputsSlice[i] = scriptJSONValue(input.Value)
+		}
+	}
+
+	if varsJSON, err = json.Marshal(varsMap); err != nil {
+		return nil, nil, errors.Wrap(err, "could not marshal vars")
+	}
+	if inputsJSON, err = json.Marshal(inputsSlice); err != nil {
+		return nil, nil, errors.Wrap(err, "could not marshal inputs")
+	}
+	return varsJSON, inputsJSON, nil
+}
+
+func scriptJSONValue(val interface{}) JSONSerializable {
+	if _, isErr := val.(error); isErr || val == nil {
+		return JSONSerializable{}
+	}
+	// values that can't be represented as JSON are passed in as null
+	if _, err := json.Marshal(JSONSerializable{Val: val, Valid: true}); err != nil {
+		return JSONSerializable{}
+	}
+	return JSONSerializable{Val: val, Valid: true}
+}
+
+// exportScriptValue returns the result of a script as JSON, or nil if the
+// script returned null or undefined
+func exportScriptValue(vm *goja.Runtime, value goja.Value) (json.RawMessage, error) {
+	if value == nil || goja.IsUndefined(value) || goja.IsNull(value) {
+		return nil, nil
+	}
+	stringify, ok := goja.AssertFunction(vm.Get("JSON").ToObject(vm).Get("stringify"))
+	if !ok {
+		return nil, errors.New("JSON.stringify is not a function")
+	}
+	str, err := stringify(goja.Undefined(), value)
+	if err != nil {
+		return nil, errors.Wrapf(ErrBadInput, "could not marshal result: %v", err)
+	}
+	if goja.IsUndefined(str) {
+		return nil, errors.Wrapf(ErrBadInput, "result of type %s can't be represented as JSON", value.ExportType())
+	}
+	s := str.String()
+	if len(s) > maxScriptOutputLength {
+		return nil, errors.Wrapf(ErrBadInput, "result is too large (maximum %d bytes)", maxScriptOutputLength)
+	}
+	return json.RawMessage(s), nil
+}
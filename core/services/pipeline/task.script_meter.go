@@ -0,0 +1,322 @@
+package pipeline
+
+import (
+	"github.com/dop251/goja"
+	"github.com/dop251/goja/ast"
+	"github.com/dop251/goja/file"
+	"github.com/pkg/errors"
+)
+
+// scriptTickFunc is the global function that meterScript inserts calls to.
+// Scripts can't use the name themselves.
+const scriptTickFunc = "__scriptTick"
+
+// errScriptOpBudget is what the interpreter is interrupted with when a script
+// has used up its operation budget
+var errScriptOpBudget = errors.New("exceeded the operation budget")
+
+// meterScript inserts a call to scriptTickFunc at the start of every loop
+// iteration and every function call. Without these, a script runs at most
+// once through each of its statements, so counting the calls bounds the work
+// a script can do regardless of the load on the node. Native functions, such
+// as String.prototype.repeat, are bounded by the memory limit instead.
+//
+// Node types that meterScript does not know about are rejected, so that an
+// upgrade of the interpreter can't add a way around the budget.
+func meterScript(program *ast.Program) error {
+	var m scriptMeter
+	m.statements(program.Body)
+	return m.err
+}
+
+// setScriptOpBudget defines scriptTickFunc, which interrupts the script once
+// it has been called more than budget times. It also removes eval and the
+// Function constructor, since code compiled by a running script is not
+// metered.
+func setScriptOpBudget(vm *goja.Runtime, budget uint64) error {
+	var ops uint64
+	tick := vm.ToValue(func(goja.FunctionCall) goja.Value {
+		ops++
+		if ops > budget {
+			vm.Interrupt(errScriptOpBudget)
+		}
+		return goja.Undefined()
+	})
+	err := vm.GlobalObject().DefineDataProperty(scriptTickFunc, tick, goja.FLAG_FALSE, goja.FLAG_FALSE, goja.FLAG_FALSE)
+	if err != nil {
+		return err
+	}
+
+	disabled := vm.ToValue(func(goja.FunctionCall) goja.Value {
+		panic(vm.NewTypeError("code generation from strings is not allowed"))
+	})
+	functionPrototype := vm.Get("Function").ToObject(vm).Get("prototype").ToObject(vm)
+	if err = functionPrototype.Set("constructor", disabled); err != nil {
+		return err
+	}
+	for _, name := range []string{"eval", "Function"} {
+		if err = vm.Set(name, disabled); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type scriptMeter struct {
+	err error
+}
+
+func (m *scriptMeter) tick(idx file.Idx) ast.Statement {
+	return &ast.ExpressionStatement{Expression: &ast.CallExpression{
+		Callee:           &ast.Identifier{Name: scriptTickFunc, Idx: idx},
+		LeftParenthesis:  idx,
+		RightParenthesis: idx,
+	}}
+}
+
+// loopBody returns the body of a loop with a tick in front of it
+func (m *scriptMeter) loopBody(body ast.Statement) ast.Statement {
+	m.statement(body)
+	return &ast.BlockStatement{
+		LeftBrace:  body.Idx0(),
+		List:       []ast.Statement{m.tick(body.Idx0()), body},
+		RightBrace: body.Idx1(),
+	}
+}
+
+func (m *scriptMeter) function(fn *ast.FunctionLiteral) {
+	if fn.Name != nil {
+		m.identifier(fn.Name)
+	}
+	m.parameters(fn.ParameterList)
+	m.statements(fn.Body.List)
+	fn.Body.List = append([]ast.Statement{m.tick(fn.Body.LeftBrace)}, fn.Body.List...)
+}
+
+func (m *scriptMeter) arrowFunction(fn *ast.ArrowFunctionLiteral) {
+	m.parameters(fn.ParameterList)
+	switch body := fn.Body.(type) {
+	case *ast.BlockStatement:
+		m.statements(body.List)
+		body.List = append([]ast.Statement{m.tick(body.LeftBrace)}, body.List...)
+	case *ast.ExpressionBody:
+		m.expression(body.Expression)
+		fn.Body = &ast.BlockStatement{
+			LeftBrace: body.Idx0(),
+			List: []ast.Statement{
+				m.tick(body.Idx0()),
+				&ast.ReturnStatement{Return: body.Idx0(), Argument: body.Expression},
+			},
+			RightBrace: body.Idx1(),
+		}
+	default:
+		m.err = errors.Errorf("unsupported function body %T", body)
+	}
+}
+
+func (m *scriptMeter) statements(list []ast.Statement) {
+	for _, stmt := range list {
+		m.statement(stmt)
+	}
+}
+
+func (m *scriptMeter) statement(stmt ast.Statement) {
+	if stmt == nil || m.err != nil {
+		return
+	}
+	switch stmt := stmt.(type) {
+	case *ast.BlockStatement:
+		m.statements(stmt.List)
+	case *ast.CaseStatement:
+		m.expression(stmt.Test)
+		m.statements(stmt.Consequent)
+	case *ast.CatchStatement:
+		m.expression(stmt.Parameter)
+		m.statement(stmt.Body)
+	case *ast.DoWhileStatement:
+		m.expression(stmt.Test)
+		stmt.Body = m.loopBody(stmt.Body)
+	case *ast.ExpressionStatement:
+		m.expression(stmt.Expression)
+	case *ast.ForInStatement:
+		m.forInto(stmt.Into)
+		m.expression(stmt.Source)
+		stmt.Body = m.loopBody(stmt.Body)
+	case *ast.ForOfStatement:
+		m.forInto(stmt.Into)
+		m.expression(stmt.Source)
+		stmt.Body = m.loopBody(stmt.Body)
+	case *ast.ForStatement:
+		m.forInitializer(stmt.Initializer)
+		m.expression(stmt.Test)
+		m.expression(stmt.Update)
+		stmt.Body = m.loopBody(stmt.Body)
+	case *ast.IfStatement:
+		m.expression(stmt.Test)
+		m.statement(stmt.Consequent)
+		m.statement(stmt.Alternate)
+	case *ast.LabelledStatement:
+		m.statement(stmt.Statement)
+	case *ast.ReturnStatement:
+		m.expression(stmt.Argument)
+	case *ast.SwitchStatement:
+		m.expression(stmt.Discriminant)
+		for _, c := range stmt.Body {
+			m.statement(c)
+		}
+	case *ast.ThrowStatement:
+		m.expression(stmt.Argument)
+	case *ast.TryStatement:
+		m.statement(stmt.Body)
+		if stmt.Catch != nil {
+			m.statement(stmt.Catch)
+		}
+		if stmt.Finally != nil {
+			m.statement(stmt.Finally)
+		}
+	case *ast.VariableStatement:
+		m.bindings(stmt.List)
+	case *ast.LexicalDeclaration:
+		m.bindings(stmt.List)
+	case *ast.WhileStatement:
+		m.expression(stmt.Test)
+		stmt.Body = m.loopBody(stmt.Body)
+	case *ast.WithStatement:
+		m.expression(stmt.Object)
+		m.statement(stmt.Body)
+	case *ast.FunctionDeclaration:
+		m.function(stmt.Function)
+	case *ast.BadStatement, *ast.BranchStatement, *ast.DebuggerStatement, *ast.EmptyStatement:
+	default:
+		m.err = errors.Errorf("unsupported statement %T", stmt)
+	}
+}
+
+func (m *scriptMeter) expressions(list []ast.Expression) {
+	for _, expr := range list {
+		m.expression(expr)
+	}
+}
+
+func (m *scriptMeter) expression(expr ast.Expression) {
+	if expr == nil || m.err != nil {
+		return
+	}
+	switch expr := expr.(type) {
+	case *ast.ArrayLiteral:
+		m.expressions(expr.Value)
+	case *ast.ArrayPattern:
+		m.expressions(expr.Elements)
+		m.expression(expr.Rest)
+	case *ast.AssignExpression:
+		m.expression(expr.Left)
+		m.expression(expr.Right)
+	case *ast.BinaryExpression:
+		m.expression(expr.Left)
+		m.expression(expr.Right)
+	case *ast.Binding:
+		m.binding(expr)
+	case *ast.BracketExpression:
+		m.expression(expr.Left)
+		m.expression(expr.Member)
+	case *ast.CallExpression:
+		m.expression(expr.Callee)
+		m.expressions(expr.ArgumentList)
+	case *ast.ConditionalExpression:
+		m.expression(expr.Test)
+		m.expression(expr.Consequent)
+		m.expression(expr.Alternate)
+	case *ast.DotExpression:
+		m.expression(expr.Left)
+		m.identifier(&expr.Identifier)
+	case *ast.FunctionLiteral:
+		m.function(expr)
+	case *ast.ArrowFunctionLiteral:
+		m.arrowFunction(expr)
+	case *ast.Identifier:
+		m.identifier(expr)
+	case *ast.NewExpression:
+		m.expression(expr.Callee)
+		m.expressions(expr.ArgumentList)
+	case *ast.ObjectLiteral:
+		for _, prop := range expr.Value {
+			m.expression(prop)
+		}
+	case *ast.ObjectPattern:
+		for _, prop := range expr.Properties {
+			m.expression(prop)
+		}
+		m.expression(expr.Rest)
+	case *ast.PropertyShort:
+		m.identifier(&expr.Name)
+		m.expression(expr.Initializer)
+	case *ast.PropertyKeyed:
+		m.expression(expr.Key)
+		m.expression(expr.Value)
+	case *ast.SpreadElement:
+		m.expression(expr.Expression)
+	case *ast.SequenceExpression:
+		m.expressions(expr.Sequence)
+	case *ast.TemplateLiteral:
+		m.expression(expr.Tag)
+		m.expressions(expr.Expressions)
+	case *ast.UnaryExpression:
+		m.expression(expr.Operand)
+	case *ast.BadExpression, *ast.BooleanLiteral, *ast.MetaProperty, *ast.NullLiteral,
+		*ast.NumberLiteral, *ast.RegExpLiteral, *ast.StringLiteral, *ast.ThisExpression:
+	default:
+		m.err = errors.Errorf("unsupported expression %T", expr)
+	}
+}
+
+func (m *scriptMeter) parameters(params *ast.ParameterList) {
+	if params == nil {
+		return
+	}
+	m.bindings(params.List)
+	m.expression(params.Rest)
+}
+
+func (m *scriptMeter) bindings(list []*ast.Binding) {
+	for _, b := range list {
+		m.binding(b)
+	}
+}
+
+func (m *scriptMeter) binding(b *ast.Binding) {
+	m.expression(b.Target)
+	m.expression(b.Initializer)
+}
+
+func (m *scriptMeter) forInitializer(init ast.ForLoopInitializer) {
+	switch init := init.(type) {
+	case nil:
+	case *ast.ForLoopInitializerExpression:
+		m.expression(init.Expression)
+	case *ast.ForLoopInitializerVarDeclList:
+		m.bindings(init.List)
+	case *ast.ForLoopInitializerLexicalDecl:
+		m.bindings(init.LexicalDeclaration.List)
+	default:
+		m.err = errors.Errorf("unsupported loop initializer %T", init)
+	}
+}
+
+func (m *scriptMeter) forInto(into ast.ForInto) {
+	switch into := into.(type) {
+	case *ast.ForIntoVar:
+		m.binding(into.Binding)
+	case *ast.ForDeclaration:
+		m.expression(into.Target)
+	case *ast.ForIntoExpression:
+		m.expression(into.Expression)
+	default:
+		m.err = errors.Errorf("unsupported loop variable %T", into)
+	}
+}
+
+func (m *scriptMeter) identifier(id *ast.Identifier) {
+	if id.Name == scriptTickFunc && m.err == nil {
+		m.err = errors.Errorf("%s is reserved", scriptTickFunc)
+	}
+}
@@ -0,0 +1,216 @@
+package pipeline_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+)
+
+func TestScriptTask(t *testing.T) {
+	t.Parallel()
+
+	vars := map[string]interface{}{
+		"parse":  map[string]interface{}{"last": 1.5, "symbol": "ETH"},
+		"price":  decimal.RequireFromString("1234.5678"),
+		"raw":    []byte{0xde, 0xad},
+		"failed": errors.New("uh oh"),
+	}
+
+	tests := []struct {
+		name          string
+		script        string
+		inputs        []pipeline.Result
+		expected      interface{}
+		expectedError error
+	}{
+		{"object", "({price: vars.parse.last * 100, symbol: vars.parse.symbol.toLowerCase()})", nil, map[string]interface{}{"price": float64(150), "symbol": "eth"}, nil},
+		{"array", "[1, 'two', true, null]", nil, []interface{}{float64(1), "two", true, nil}, nil},
+		{"statements", "let total = 0; for (const x of [1, 2, 3]) { total += x }; total", nil, float64(6), nil},
+		{"decimal var", "vars.price", nil, "1234.5678", nil},
+		{"bytes var", "vars.raw", nil, "dead", nil},
+		{"errored var", "vars.failed", nil, nil, nil},
+		{"inputs", "inputs[0].a + inputs[1]", []pipeline.Result{{Value: map[string]interface{}{"a": 1}}, {Value: 2}}, float64(3), nil},
+		{"undefined", "undefined", nil, nil, nil},
+		{"deterministic random", "Math.random() === Math.random()", nil, false, nil},
+		{"no require", "typeof require", nil, "undefined", nil},
+		{"thrown error", "throw new Error('boom')", nil, nil, pipeline.ErrBadInput},
+		{"reference error", "fetch('https://example.com')", nil, nil, pipeline.ErrBadInput},
+		{"function result", "(function() {})", nil, nil, pipeline.ErrBadInput},
+		{"stack overflow", "function f() { return f() }; f()", nil, nil, pipeline.ErrBadInput},
+		{"infinite loop", "while (true) {}", nil, nil, pipeline.ErrBadInput},
+		{"eval", "eval('1 + 1')", nil, nil, pipeline.ErrBadInput},
+		{"function constructor", "(() => {}).constructor('return 1')()", nil, nil, pipeline.ErrBadInput},
+		{"metered callbacks", "[1, 2, 3].map(x => x * 2)", nil, []interface{}{float64(2), float64(4), float64(6)}, nil},
+		{"output too large", "'x'.repeat(2 * 1024 * 1024)", nil, nil, pipeline.ErrBadInput},
+		{"empty", "", nil, nil, pipeline.ErrParameterEmpty},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			task := pipeline.ScriptTask{
+				BaseTask: pipeline.NewBaseTask(0, "task", nil, nil, 0),
+				Script:   test.script,
+			}
+			result, runInfo := task.Run(context.Background(), logger.TestLogger(t), pipeline.NewVarsFrom(vars), test.inputs)
+			assert.False(t, runInfo.IsPending)
+			assert.False(t, runInfo.IsRetryable)
+
+			if test.expectedError != nil {
+				require.Equal(t, test.expectedError, errors.Cause(result.Error), result.Error)
+				require.Nil(t, result.Value)
+				return
+			}
+			require.NoError(t, result.Error)
+			require.Equal(t, test.expected, result.Value)
+		})
+	}
+}
+
+func TestScriptTask_Timeout(t *testing.T) {
+	t.Parallel()
+
+	task := pipeline.ScriptTask{
+		BaseTask: pipeline.NewBaseTask(0, "task", nil, nil, 0),
+		Script:   "while (true) {}",
+	}
+	task.Timeout = 10 * time.Millisecond
+
+	ctx, cancel := context.WithTimeout(context.Background(), task.Timeout)
+	defer cancel()
+	start := time.Now()
+	result, _ := task.Run(ctx, logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+	require.Equal(t, pipeline.ErrTimeout, errors.Cause(result.Error))
+	require.Less(t, time.Since(start), time.Second)
+}
+
+func TestScriptTask_OpBudget(t *testing.T) {
+	t.Parallel()
+
+	for _, script := range []string{
+		"while (true) {}",
+		"for (;;) {}",
+		"do {} while (true)",
+		"const o = {a: 1, b: 2}; while (true) { for (const k in o) {} }",
+		"new Array(1e6).fill(0).map(x => x)",
+		"function f(n) { return n === 0 ? 0 : f(n - 1) }; for (let i = 0; i < 1e5; i++) { f(20) }",
+	} {
+		task := pipeline.ScriptTask{
+			BaseTask: pipeline.NewBaseTask(0, "task", nil, nil, 0),
+			Script:   script,
+		}
+		task.Timeout = 10 * time.Second
+
+		start := time.Now()
+		result, _ := task.Run(context.Background(), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+		require.Equal(t, pipeline.ErrBadInput, errors.Cause(result.Error), script)
+		require.Contains(t, result.Error.Error(), "exceeded the operation budget of 500000", script)
+		// the budget, not the clock, stopped the script
+		require.Less(t, time.Since(start), 5*time.Second, script)
+	}
+}
+
+func TestScriptTask_Workers(t *testing.T) {
+	t.Setenv("SCRIPT_TASK_TEST_SECRET", "hunter2")
+
+	workers := pipeline.NewScriptWorkerPool()
+	defer pipeline.CloseScriptWorkerPool(workers)
+
+	task := pipeline.ScriptTask{
+		BaseTask: pipeline.NewBaseTask(0, "task", nil, nil, 0),
+		Script:   "inputs[0] * 2",
+	}
+	task.HelperSetDependencies(workers)
+
+	var pids []int
+	for i := 0; i < 3; i++ {
+		result, _ := task.Run(context.Background(), logger.TestLogger(t), pipeline.NewVarsFrom(nil), []pipeline.Result{{Value: i}})
+		require.NoError(t, result.Error)
+		require.Equal(t, float64(2*i), result.Value)
+
+		pids = append(pids, pipeline.ScriptWorkerPoolIdlePIDs(workers)...)
+	}
+	// the same worker served every run
+	require.Len(t, pids, 3)
+	require.Equal(t, pids[0], pids[1])
+	require.Equal(t, pids[0], pids[2])
+
+	// a worker that was killed is replaced
+	task.Script = "while (true) {}"
+	task.Timeout = 10 * time.Millisecond
+	result, _ := task.Run(context.Background(), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+	require.Equal(t, pipeline.ErrTimeout, errors.Cause(result.Error))
+	require.Empty(t, pipeline.ScriptWorkerPoolIdlePIDs(workers))
+
+	task.Script = "inputs[0] * 2"
+	task.Timeout = 0
+	result, _ = task.Run(context.Background(), logger.TestLogger(t), pipeline.NewVarsFrom(nil), []pipeline.Result{{Value: 21}})
+	require.NoError(t, result.Error)
+	require.Equal(t, float64(42), result.Value)
+	newPIDs := pipeline.ScriptWorkerPoolIdlePIDs(workers)
+	require.Len(t, newPIDs, 1)
+	require.NotEqual(t, pids[0], newPIDs[0])
+
+	// workers don't inherit the node's environment
+	environ, err := os.ReadFile(fmt.Sprintf("/proc/%d/environ", newPIDs[0]))
+	if err == nil {
+		require.NotContains(t, string(environ), "hunter2")
+		require.Contains(t, string(environ), "CL_SCRIPT_TASK_WORKER=1")
+	}
+}
+
+func TestScriptTask_MemoryLimit(t *testing.T) {
+	t.Parallel()
+
+	for _, script := range []string{
+		"const a = []; while (true) { a.push(new Array(1e7).fill(0)) }",
+		"'x'.repeat(1e10)",
+	} {
+		task := pipeline.ScriptTask{
+			BaseTask: pipeline.NewBaseTask(0, "task", nil, nil, 0),
+			Script:   script,
+		}
+		task.Timeout = 10 * time.Second
+
+		result, _ := task.Run(context.Background(), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+		require.Equal(t, pipeline.ErrBadInput, errors.Cause(result.Error), result.Error)
+		require.Contains(t, result.Error.Error(), "exceeded the memory limit")
+	}
+}
+
+func TestScriptTask_ValidatedOnParse(t *testing.T) {
+	t.Parallel()
+
+	_, err := pipeline.Parse(`
+	a [type=memo value="1"]
+	b [type=script script="({foo: )"]
+	a -> b`)
+	require.Error(t, err)
+	require.True(t, strings.HasPrefix(err.Error(), `UnmarshalTaskFromMap: task "b": script: `), err.Error())
+	require.Contains(t, err.Error(), "Line 1:8")
+
+	_, err = pipeline.Parse(`b [type=script script="__scriptTick = null"]`)
+	require.EqualError(t, err, `UnmarshalTaskFromMap: task "b": script: __scriptTick is reserved`)
+
+	_, err = pipeline.Parse(`b [type=script script="` + strings.Repeat(" ", 64*1024+1) + `"]`)
+	require.EqualError(t, err, `UnmarshalTaskFromMap: task "b": script: script is too long (maximum 65536 bytes)`)
+
+	_, err = pipeline.Parse(`b [type=script script="vars.foo" timeout="1m"]`)
+	require.EqualError(t, err, `UnmarshalTaskFromMap: task "b": script: timeout must be at most 10s, got 1m0s`)
+
+	_, err = pipeline.Parse(`b [type=script script="vars.foo"]`)
+	require.NoError(t, err)
+}
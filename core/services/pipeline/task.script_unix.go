@@ -0,0 +1,49 @@
+//go:build !windows
+// +build !windows
+
+package pipeline
+
+import (
+	"bufio"
+	"bytes"
+	"io/ioutil"
+	"runtime"
+	"strconv"
+	"strings"
+	"syscall"
+)
+
+// limitScriptMemory caps the data segment of the script worker at the size it
+// already has plus the budget, so that the kernel refuses allocations beyond
+// it. Only the soft limit is set, so that the worker can raise it again for the
+// next script.
+func limitScriptMemory(budget uint64) error {
+	var limit syscall.Rlimit
+	if err := syscall.Getrlimit(syscall.RLIMIT_DATA, &limit); err != nil {
+		return err
+	}
+	limit.Cur = dataSegmentSize() + budget
+	if limit.Cur > limit.Max {
+		limit.Cur = limit.Max
+	}
+	return syscall.Setrlimit(syscall.RLIMIT_DATA, &limit)
+}
+
+// dataSegmentSize returns the size of the data segment as reported by Linux,
+// falling back to the memory obtained by the Go runtime elsewhere
+func dataSegmentSize() uint64 {
+	if status, err := ioutil.ReadFile("/proc/self/status"); err == nil {
+		scanner := bufio.NewScanner(bytes.NewReader(status))
+		for scanner.Scan() {
+			fields := strings.Fields(scanner.Text())
+			if len(fields) == 3 && fields[0] == "VmData:" && fields[2] == "kB" {
+				if kb, err := strconv.ParseUint(fields[1], 10, 64); err == nil {
+					return kb * 1024
+				}
+			}
+		}
+	}
+	var stats runtime.MemStats
+	runtime.ReadMemStats(&stats)
+	return stats.Sys
+}
@@ -0,0 +1,14 @@
+//go:build windows
+// +build windows
+
+package pipeline
+
+import "github.com/pkg/errors"
+
+func limitScriptMemory(budget uint64) error {
+	return errors.New("script tasks are not supported on Windows")
+}
+
+func dataSegmentSize() uint64 {
+	return 0
+}
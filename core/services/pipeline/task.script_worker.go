@@ -0,0 +1,357 @@
+package pipeline
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/rand"
+	"os"
+	"os/exec"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dop251/goja"
+	"github.com/pkg/errors"
+)
+
+// Script tasks are evaluated by copies of the running binary, started with
+// scriptWorkerEnv set. A worker reads scriptRequests from stdin and writes a
+// scriptResponse to stdout for each of them until stdin is closed. Running
+// scripts out of process means that a script which allocates without bound
+// is killed by the kernel, rather than taking the node down with it.
+const scriptWorkerEnv = "CL_SCRIPT_TASK_WORKER"
+
+// maxIdleScriptWorkers is the number of workers a scriptWorkerPool keeps
+// around between runs
+const maxIdleScriptWorkers = 4
+
+// scriptWorkerEnvAllowList names the variables of the node's environment that
+// are passed on to workers. Scripts are untrusted, so by default they see none
+// of them: the environment holds the database URL and keystore passwords.
+var scriptWorkerEnvAllowList []string
+
+func init() {
+	if os.Getenv(scriptWorkerEnv) != "1" {
+		return
+	}
+	serveScriptWorker(os.Stdin, os.Stdout)
+	os.Exit(0)
+}
+
+type scriptRequest struct {
+	Script  string          `json:"script"`
+	Vars    json.RawMessage `json:"vars"`
+	Inputs  json.RawMessage `json:"inputs"`
+	Start   time.Time       `json:"start"`
+	Timeout time.Duration   `json:"timeout"`
+}
+
+type scriptErrorKind string
+
+const (
+	scriptErrorKindTimeout  scriptErrorKind = "timeout"
+	scriptErrorKindBadInput scriptErrorKind = "badInput"
+)
+
+type scriptResponse struct {
+	Value     json.RawMessage `json:"value,omitempty"`
+	Error     string          `json:"error,omitempty"`
+	ErrorKind scriptErrorKind `json:"errorKind,omitempty"`
+	// Retire is set by a worker that has grown too large to be reused. It
+	// exits after sending the response.
+	Retire bool `json:"retire,omitempty"`
+}
+
+// scriptWorkerPool keeps idle workers around, so that script tasks don't
+// start a process for every run. A nil *scriptWorkerPool is valid: it starts
+// a worker for each run and stops it afterwards.
+type scriptWorkerPool struct {
+	mu     sync.Mutex
+	idle   []*scriptWorker
+	closed bool
+}
+
+func newScriptWorkerPool() *scriptWorkerPool {
+	return &scriptWorkerPool{}
+}
+
+// get returns an idle worker, or starts a new one if there are none
+func (p *scriptWorkerPool) get() (w *scriptWorker, reused bool, err error) {
+	if p != nil {
+		p.mu.Lock()
+		if n := len(p.idle); n > 0 {
+			w = p.idle[n-1]
+			p.idle = p.idle[:n-1]
+		}
+		p.mu.Unlock()
+	}
+	if w != nil {
+		return w, true, nil
+	}
+	w, err = startScriptWorker()
+	return w, false, err
+}
+
+// put returns a worker to the pool, or stops it if the pool is full
+func (p *scriptWorkerPool) put(w *scriptWorker) {
+	if p != nil {
+		p.mu.Lock()
+		if !p.closed && len(p.idle) < maxIdleScriptWorkers {
+			p.idle = append(p.idle, w)
+			w = nil
+		}
+		p.mu.Unlock()
+	}
+	if w != nil {
+		w.close()
+	}
+}
+
+// close stops the idle workers. Workers that are running a script are stopped
+// when they are returned.
+func (p *scriptWorkerPool) close() {
+	if p == nil {
+		return
+	}
+	p.mu.Lock()
+	idle := p.idle
+	p.idle, p.closed = nil, true
+	p.mu.Unlock()
+	for _, w := range idle {
+		w.close()
+	}
+}
+
+// run evaluates the script in a worker. The worker is killed when ctx is
+// done.
+func (p *scriptWorkerPool) run(ctx context.Context, req scriptRequest) (interface{}, error) {
+	if deadline, ok := ctx.Deadline(); ok {
+		req.Timeout = time.Until(deadline)
+	}
+	reqJSON, err := json.Marshal(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not marshal request")
+	}
+
+	w, reused, err := p.get()
+	if err != nil {
+		return nil, err
+	}
+	resp, err := w.run(ctx, reqJSON)
+	if reused && errors.Is(err, errScriptWorkerExited) {
+		// the idle worker has died in the meantime, try a fresh one
+		if w, err = startScriptWorker(); err != nil {
+			return nil, err
+		}
+		resp, err = w.run(ctx, reqJSON)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if resp.Retire {
+		w.close()
+	} else {
+		p.put(w)
+	}
+
+	switch {
+	case resp.ErrorKind == scriptErrorKindTimeout:
+		return nil, errors.Wrap(ErrTimeout, resp.Error)
+	case resp.ErrorKind == scriptErrorKindBadInput:
+		return nil, errors.Wrap(ErrBadInput, resp.Error)
+	case resp.Error != "":
+		return nil, errors.New(resp.Error)
+	case len(resp.Value) == 0:
+		return nil, nil
+	}
+	var output interface{}
+	if err = json.Unmarshal(resp.Value, &output); err != nil {
+		return nil, errors.Wrap(err, "could not unmarshal result")
+	}
+	return output, nil
+}
+
+type scriptWorker struct {
+	cmd    *exec.Cmd
+	stdin  io.WriteCloser
+	stdout *json.Decoder
+	stderr bytes.Buffer
+}
+
+// errScriptWorkerExited is returned when a request can't be sent because the
+// worker has already exited
+var errScriptWorkerExited = errors.New("worker exited")
+
+func startScriptWorker() (*scriptWorker, error) {
+	exe, err := os.Executable()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not find executable")
+	}
+	w := &scriptWorker{cmd: exec.Command(exe)}
+	w.cmd.Env = []string{scriptWorkerEnv + "=1"}
+	for _, name := range scriptWorkerEnvAllowList {
+		if val, ok := os.LookupEnv(name); ok {
+			w.cmd.Env = append(w.cmd.Env, name+"="+val)
+		}
+	}
+	w.cmd.Stderr = &w.stderr
+	if w.stdin, err = w.cmd.StdinPipe(); err != nil {
+		return nil, errors.Wrap(err, "could not start worker")
+	}
+	stdout, err := w.cmd.StdoutPipe()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not start worker")
+	}
+	w.stdout = json.NewDecoder(stdout)
+	if err = w.cmd.Start(); err != nil {
+		return nil, errors.Wrap(err, "could not start worker")
+	}
+	return w, nil
+}
+
+// run sends a request to the worker and waits for the response. If it
+// returns an error, the worker has been stopped.
+func (w *scriptWorker) run(ctx context.Context, reqJSON []byte) (resp scriptResponse, err error) {
+	done := make(chan struct{})
+	killed := make(chan bool, 1)
+	go func() {
+		select {
+		case <-ctx.Done():
+			_ = w.cmd.Process.Kill()
+			killed <- true
+		case <-done:
+			killed <- false
+		}
+	}()
+
+	var sent bool
+	if _, err = w.stdin.Write(append(reqJSON, '\n')); err == nil {
+		sent = true
+		err = w.stdout.Decode(&resp)
+	}
+	close(done)
+	if <-killed {
+		w.wait()
+		return resp, errors.Wrap(ErrTimeout, "execution interrupted")
+	}
+	if err != nil {
+		w.close()
+		if !sent {
+			return resp, errors.Wrap(errScriptWorkerExited, err.Error())
+		}
+		if strings.Contains(w.stderr.String(), "out of memory") {
+			return resp, errors.Wrapf(ErrBadInput, "exceeded the memory limit of %d bytes", maxScriptMemory)
+		}
+		return resp, errors.Wrap(err, "worker failed")
+	}
+	return resp, nil
+}
+
+// close stops the worker by closing its stdin
+func (w *scriptWorker) close() {
+	_ = w.stdin.Close()
+	w.wait()
+}
+
+func (w *scriptWorker) wait() {
+	// The exit status is of no interest: a worker that failed has said so on
+	// stderr
+	_ = w.cmd.Wait()
+}
+
+// serveScriptWorker evaluates requests until r is closed, or until the worker
+// has grown by more than the memory budget of a single script
+func serveScriptWorker(r io.Reader, w io.Writer) {
+	dec, enc := json.NewDecoder(r), json.NewEncoder(w)
+	startSize := dataSegmentSize()
+	for {
+		var req scriptRequest
+		var resp scriptResponse
+		if err := dec.Decode(&req); err == io.EOF {
+			return
+		} else if err != nil {
+			resp.Error, resp.Retire = errors.Wrap(err, "could not unmarshal request").Error(), true
+		} else {
+			resp = evalScript(req)
+			resp.Retire = dataSegmentSize() > startSize+maxScriptMemory
+		}
+		// There is nobody to report a failed write to: the parent sees the
+		// truncated response
+		if err := enc.Encode(resp); err != nil || resp.Retire {
+			return
+		}
+	}
+}
+
+func evalScript(req scriptRequest) (resp scriptResponse) {
+	if err := limitScriptMemory(maxScriptMemory); err != nil {
+		resp.Error = errors.Wrap(err, "could not limit memory").Error()
+		return resp
+	}
+	program, err := compileScript(req.Script)
+	if err != nil {
+		resp.Error = err.Error()
+		return resp
+	}
+
+	vm := goja.New()
+	vm.SetMaxCallStackSize(maxScriptCallStackSize)
+	vm.SetRandSource(rand.New(rand.NewSource(0)).Float64) //nolint:gosec
+	vm.SetTimeSource(func() time.Time { return req.Start })
+	if err = setScriptOpBudget(vm, maxScriptOperations); err != nil {
+		resp.Error = err.Error()
+		return resp
+	}
+
+	if err = setScriptGlobals(vm, req.Vars, req.Inputs); err != nil {
+		resp.Error = err.Error()
+		return resp
+	}
+
+	timer := time.AfterFunc(req.Timeout, func() { vm.Interrupt(ErrTimeout) })
+	defer timer.Stop()
+
+	value, err := vm.RunProgram(program)
+	if err != nil {
+		var interrupted *goja.InterruptedError
+		if errors.As(err, &interrupted) && interrupted.Value() == errScriptOpBudget {
+			resp.Error = fmt.Sprintf("%s of %d", errScriptOpBudget, maxScriptOperations)
+			resp.ErrorKind = scriptErrorKindBadInput
+		} else if errors.As(err, &interrupted) {
+			resp.Error, resp.ErrorKind = "execution interrupted", scriptErrorKindTimeout
+		} else {
+			resp.Error, resp.ErrorKind = err.Error(), scriptErrorKindBadInput
+		}
+		return resp
+	}
+
+	resp.Value, err = exportScriptValue(vm, value)
+	if err != nil {
+		resp.Error = strings.TrimSuffix(err.Error(), ": "+ErrBadInput.Error())
+		if errors.Cause(err) == ErrBadInput {
+			resp.ErrorKind = scriptErrorKindBadInput
+		}
+	}
+	return resp
+}
+
+// setScriptGlobals parses the vars and inputs into the interpreter
+func setScriptGlobals(vm *goja.Runtime, vars, inputs json.RawMessage) error {
+	parse, ok := goja.AssertFunction(vm.Get("JSON").ToObject(vm).Get("parse"))
+	if !ok {
+		return errors.New("JSON.parse is not a function")
+	}
+	for name, val := range map[string]json.RawMessage{"vars": vars, "inputs": inputs} {
+		parsed, err := parse(goja.Undefined(), vm.ToValue(string(val)))
+		if err != nil {
+			return errors.Wrapf(err, "could not parse %s", name)
+		}
+		if err = vm.Set(name, parsed); err != nil {
+			return err
+		}
+	}
+	return nil
+}
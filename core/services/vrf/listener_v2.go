@@ -23,6 +23,7 @@ import (
 	httypes "github.com/smartcontractkit/chainlink/core/services/headtracker/types"
 	"github.com/smartcontractkit/chainlink/core/services/job"
 	"github.com/smartcontractkit/chainlink/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
 	"github.com/smartcontractkit/chainlink/core/services/log"
 	"github.com/smartcontractkit/chainlink/core/services/pg"
 	"github.com/smartcontractkit/chainlink/core/services/pipeline"
@@ -167,6 +168,24 @@ func (lsn *listenerV2) getAndRemoveConfirmedLogsBySub(latestHead uint64) map[uin
 	return toProcess
 }
 
+// requeueRequests returns requests taken by getAndRemoveConfirmedLogsBySub
+// that could not be processed
+func (lsn *listenerV2) requeueRequests(reqsBySub map[uint64][]pendingRequest) {
+	lsn.reqsMu.Lock()
+	defer lsn.reqsMu.Unlock()
+	for _, reqs := range reqsBySub {
+		lsn.reqs = append(lsn.reqs, reqs...)
+	}
+}
+
+// selectFromKeyPool selects the key of the job's key pool that sends the next
+// fulfillment
+func (lsn *listenerV2) selectFromKeyPool() (common.Address, error) {
+	ctx, cancel := utils.ContextFromChan(lsn.chStop)
+	defer cancel()
+	return lsn.txm.SelectFromAddress(ctx, lsn.job.KeyPool.String, lsn.cfg.EvmGasLimitDefault())
+}
+
 // Remove all entries 10000 blocks or older
 // to avoid a memory leak.
 func (lsn *listenerV2) pruneConfirmedRequestCounts() {
@@ -209,6 +228,16 @@ func (lsn *listenerV2) processPendingVRFRequests() {
 	fromAddress := keys[0].Address
 	if lsn.job.VRFSpec.FromAddress != nil {
 		fromAddress = *lsn.job.VRFSpec.FromAddress
+	} else if lsn.job.KeyPool.Valid && len(confirmed) > 0 {
+		// Selecting from the key pool queries the balances of its keys, so
+		// only do so when there are requests to fulfill
+		addr, err := lsn.selectFromKeyPool()
+		if err != nil {
+			lsn.l.Errorw("Unable to select a sending key from the key pool, requeuing requests", "keyPool", lsn.job.KeyPool.String, "err", err)
+			lsn.requeueRequests(confirmed)
+			return
+		}
+		fromAddress = ethkey.EIP55AddressFromAddress(addr)
 	}
 	maxGasPrice := lsn.cfg.KeySpecificMaxGasPriceWei(fromAddress.Address())
 	// TODO: also probably want to order these by request time so we service oldest first
@@ -366,6 +395,7 @@ func (lsn *listenerV2) processRequestsPerSub(
 					RequestID: common.BytesToHash(vrfRequest.RequestId.Bytes()),
 					MaxLink:   maxLink.String(),
 					SubID:     vrfRequest.SubId,
+					JobID:     lsn.job.ID,
 				},
 				MinConfirmations: null.Uint32From(uint32(lsn.cfg.MinRequiredOutgoingConfirmations())),
 				Strategy:         bulletprooftxmanager.NewSendEveryStrategy(false), // We already simd
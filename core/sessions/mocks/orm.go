@@ -123,13 +123,13 @@ func (_m *ORM) DeleteAuthToken(user *sessions.User) error {
 	return r0
 }
 
-// DeleteUser provides a mock function with given fields:
-func (_m *ORM) DeleteUser() error {
-	ret := _m.Called()
+// DeleteUser provides a mock function with given fields: email
+func (_m *ORM) DeleteUser(email string) error {
+	ret := _m.Called(email)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func() error); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(email)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -174,20 +174,41 @@ func (_m *ORM) FindExternalInitiator(eia *auth.Token) (*bridges.ExternalInitiato
 	return r0, r1
 }
 
-// FindUser provides a mock function with given fields:
-func (_m *ORM) FindUser() (sessions.User, error) {
-	ret := _m.Called()
+// FindUser provides a mock function with given fields: email
+func (_m *ORM) FindUser(email string) (sessions.User, error) {
+	ret := _m.Called(email)
 
 	var r0 sessions.User
-	if rf, ok := ret.Get(0).(func() sessions.User); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(string) sessions.User); ok {
+		r0 = rf(email)
 	} else {
 		r0 = ret.Get(0).(sessions.User)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(email)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindUserByAPIToken provides a mock function with given fields: accessKey
+func (_m *ORM) FindUserByAPIToken(accessKey string) (sessions.User, error) {
+	ret := _m.Called(accessKey)
+
+	var r0 sessions.User
+	if rf, ok := ret.Get(0).(func(string) sessions.User); ok {
+		r0 = rf(accessKey)
+	} else {
+		r0 = ret.Get(0).(sessions.User)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(accessKey)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -218,6 +239,29 @@ func (_m *ORM) GetUserWebAuthn(email string) ([]sessions.WebAuthn, error) {
 	return r0, r1
 }
 
+// ListUsers provides a mock function with given fields:
+func (_m *ORM) ListUsers() ([]sessions.User, error) {
+	ret := _m.Called()
+
+	var r0 []sessions.User
+	if rf, ok := ret.Get(0).(func() []sessions.User); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]sessions.User)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // SaveWebAuthn provides a mock function with given fields: token
 func (_m *ORM) SaveWebAuthn(token *sessions.WebAuthn) error {
 	ret := _m.Called(token)
@@ -282,3 +326,24 @@ func (_m *ORM) SetPassword(user *sessions.User, newPassword string) error {
 
 	return r0
 }
+
+// UpdateRole provides a mock function with given fields: email, role
+func (_m *ORM) UpdateRole(email string, role sessions.UserRole) (sessions.User, error) {
+	ret := _m.Called(email, role)
+
+	var r0 sessions.User
+	if rf, ok := ret.Get(0).(func(string, sessions.UserRole) sessions.User); ok {
+		r0 = rf(email, role)
+	} else {
+		r0 = ret.Get(0).(sessions.User)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, sessions.UserRole) error); ok {
+		r1 = rf(email, role)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
@@ -20,13 +20,16 @@ import (
 //go:generate mockery --name ORM --output ./mocks/ --case=underscore
 
 type ORM interface {
-	FindUser() (User, error)
+	FindUser(email string) (User, error)
+	FindUserByAPIToken(accessKey string) (User, error)
+	ListUsers() ([]User, error)
 	AuthorizedUserWithSession(sessionID string) (User, error)
-	DeleteUser() error
+	DeleteUser(email string) error
 	DeleteUserSession(sessionID string) error
 	CreateSession(sr SessionRequest) (string, error)
 	ClearNonCurrentSessions(sessionID string) error
 	CreateUser(user *User) error
+	UpdateRole(email string, role UserRole) (User, error)
 	SetAuthToken(user *User, token *auth.Token) error
 	CreateAndSetAuthToken(user *User) (*auth.Token, error)
 	DeleteAuthToken(user *User) error
@@ -50,53 +53,92 @@ func NewORM(db *sqlx.DB, sessionDuration time.Duration, lggr logger.Logger) ORM
 	return &orm{db, sessionDuration, lggr.Named("SessionsORM")}
 }
 
-// FindUser will return the one API user, or an error.
-func (o *orm) FindUser() (User, error) {
-	return o.findUser()
+// FindUser will return the user with the given email, or an error.
+func (o *orm) FindUser(email string) (user User, err error) {
+	sql := "SELECT * FROM users WHERE lower(email) = lower($1)"
+	err = o.db.Get(&user, sql, email)
+	return
 }
 
-func (o *orm) findUser() (user User, err error) {
-	sql := "SELECT * FROM users ORDER BY created_at desc LIMIT 1"
-	err = o.db.Get(&user, sql)
+// FindUserByAPIToken will return the user with the given API token access
+// key, or an error. The token's secret must still be checked by the caller.
+func (o *orm) FindUserByAPIToken(accessKey string) (user User, err error) {
+	if accessKey == "" {
+		return user, sql.ErrNoRows
+	}
+	sql := "SELECT * FROM users WHERE token_key = $1"
+	err = o.db.Get(&user, sql, accessKey)
 	return
 }
 
-// AuthorizedUserWithSession will return the one API user if the Session ID exists
-// and hasn't expired, and update session's LastUsed field.
-func (o *orm) AuthorizedUserWithSession(sessionID string) (User, error) {
+// ListUsers returns all users, oldest first.
+func (o *orm) ListUsers() (users []User, err error) {
+	sql := "SELECT * FROM users ORDER BY created_at, email"
+	err = o.db.Select(&users, sql)
+	return
+}
+
+// AuthorizedUserWithSession will return the user the Session ID belongs to if
+// it exists and hasn't expired, and update session's LastUsed field.
+func (o *orm) AuthorizedUserWithSession(sessionID string) (user User, err error) {
 	if len(sessionID) == 0 {
 		return User{}, errors.New("Session ID cannot be empty")
 	}
 
-	result, err := o.db.Exec("UPDATE sessions SET last_used = now() WHERE id = $1 AND last_used + $2 >= now()", sessionID, o.sessionDuration)
-	if err != nil {
-		return User{}, err
-	}
-	rowsAffected, err := result.RowsAffected()
+	var email string
+	err = o.db.Get(&email, "UPDATE sessions SET last_used = now() WHERE id = $1 AND last_used + $2 >= now() RETURNING email", sessionID, o.sessionDuration)
 	if err != nil {
 		return User{}, err
 	}
-	if rowsAffected == 0 {
-		return User{}, sql.ErrNoRows
-	}
-	return o.FindUser()
+	return o.FindUser(email)
 }
 
-// DeleteUser will delete the API User in the db.
-func (o *orm) DeleteUser() error {
+// DeleteUser will delete the user with the given email, along with their
+// sessions and MFA tokens.
+func (o *orm) DeleteUser(email string) error {
 	ctx, cancel := pg.DefaultQueryCtx()
 	defer cancel()
 	return pg.SqlxTransaction(ctx, o.db, o.lggr, func(tx pg.Queryer) error {
-		if _, err := tx.Exec("DELETE FROM users"); err != nil {
+		if _, err := tx.Exec("DELETE FROM web_authns WHERE lower(email) = lower($1)", email); err != nil {
+			return err
+		}
+		if _, err := tx.Exec("DELETE FROM sessions WHERE lower(email) = lower($1)", email); err != nil {
+			return err
+		}
+		result, err := tx.Exec("DELETE FROM users WHERE lower(email) = lower($1)", email)
+		if err != nil {
+			return err
+		}
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
 			return err
 		}
+		if rowsAffected == 0 {
+			return sql.ErrNoRows
+		}
+		return nil
+	})
+}
 
-		_, err := tx.Exec("DELETE FROM sessions")
+// UpdateRole changes the role of the user with the given email. The user's
+// sessions are cleared so that the new role takes effect immediately.
+func (o *orm) UpdateRole(email string, role UserRole) (user User, err error) {
+	if _, err = GetUserRole(string(role)); err != nil {
+		return user, err
+	}
+	ctx, cancel := pg.DefaultQueryCtx()
+	defer cancel()
+	err = pg.SqlxTransaction(ctx, o.db, o.lggr, func(tx pg.Queryer) error {
+		if err = tx.Get(&user, "UPDATE users SET role = $1, updated_at = now() WHERE lower(email) = lower($2) RETURNING *", role, email); err != nil {
+			return err
+		}
+		_, err = tx.Exec("DELETE FROM sessions WHERE email = $1", user.Email)
 		return err
 	})
+	return user, err
 }
 
-// DeleteUserSession will erase the session ID for the sole API User.
+// DeleteUserSession will erase the session ID.
 func (o *orm) DeleteUserSession(sessionID string) error {
 	_, err := o.db.Exec("DELETE FROM sessions WHERE id = $1", sessionID)
 	return err
@@ -121,8 +163,11 @@ func (o *orm) GetUserWebAuthn(email string) ([]WebAuthn, error) {
 // the hashed API User password in the db. Also will check WebAuthn if it's
 // enabled for that user.
 func (o *orm) CreateSession(sr SessionRequest) (string, error) {
-	user, err := o.FindUser()
+	user, err := o.FindUser(sr.Email)
 	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", errors.New("Invalid email")
+		}
 		return "", err
 	}
 	lggr := o.lggr.With("user", user.Email)
@@ -130,7 +175,7 @@ func (o *orm) CreateSession(sr SessionRequest) (string, error) {
 
 	// Do email and password check first to prevent extra database look up
 	// for MFA tokens leaking if an account has MFA tokens or not.
-	if !constantTimeEmailCompare(sr.Email, user.Email) {
+	if !constantTimeEmailCompare(strings.ToLower(sr.Email), strings.ToLower(user.Email)) {
 		return "", errors.New("Invalid email")
 	}
 
@@ -149,9 +194,7 @@ func (o *orm) CreateSession(sr SessionRequest) (string, error) {
 	// No webauthn tokens registered for the current user, so normal authentication is now complete
 	if len(uwas) == 0 {
 		lggr.Infof("No MFA for user. Creating Session")
-		session := NewSession()
-		_, err = o.db.Exec("INSERT INTO sessions (id, last_used, created_at) VALUES ($1, now(), now())", session.ID)
-		return session.ID, err
+		return o.insertSession(user)
 	}
 
 	// Next check if this session request includes the required WebAuthn challenge data
@@ -187,8 +230,12 @@ func (o *orm) CreateSession(sr SessionRequest) (string, error) {
 
 	lggr.Infof("User passed MFA authentication and login will proceed")
 	// This is a success so we can create the sessions
+	return o.insertSession(user)
+}
+
+func (o *orm) insertSession(user User) (string, error) {
 	session := NewSession()
-	_, err = o.db.Exec("INSERT INTO sessions (id, last_used, created_at) VALUES ($1, now(), now())", session.ID)
+	_, err := o.db.Exec("INSERT INTO sessions (id, email, last_used, created_at) VALUES ($1, $2, now(), now())", session.ID, user.Email)
 	return session.ID, err
 }
 
@@ -203,16 +250,20 @@ func constantTimeEmailCompare(left, right string) bool {
 	return subtle.ConstantTimeCompare(leftBytes, rightBytes) == 1
 }
 
-// ClearNonCurrentSessions removes all sessions but the id passed in.
+// ClearNonCurrentSessions removes all sessions of the session's user but the
+// id passed in.
 func (o *orm) ClearNonCurrentSessions(sessionID string) error {
-	_, err := o.db.Exec("DELETE FROM sessions where id != $1", sessionID)
+	_, err := o.db.Exec("DELETE FROM sessions WHERE id != $1 AND email = (SELECT email FROM sessions WHERE id = $1)", sessionID)
 	return err
 }
 
 // Creates creates the user.
 func (o *orm) CreateUser(user *User) error {
-	sql := "INSERT INTO users (email, hashed_password, created_at, updated_at) VALUES ($1, $2, now(), now()) RETURNING *"
-	return o.db.Get(user, sql, user.Email, user.HashedPassword)
+	if _, err := GetUserRole(string(user.Role)); err != nil {
+		return err
+	}
+	sql := "INSERT INTO users (email, hashed_password, role, created_at, updated_at) VALUES ($1, $2, $3, now(), now()) RETURNING *"
+	return o.db.Get(user, sql, user.Email, user.HashedPassword, user.Role)
 }
 
 // SetAuthToken updates the user to use the given Authentication Token.
@@ -37,10 +37,77 @@ func TestORM_FindUser(t *testing.T) {
 	_, err := db.Exec("UPDATE users SET created_at = now() - interval '1 day' WHERE email = $1", user2.Email)
 	require.NoError(t, err)
 
-	actual, err := orm.FindUser()
+	actual, err := orm.FindUser(user1.Email)
 	require.NoError(t, err)
 	assert.Equal(t, user1.Email, actual.Email)
 	assert.Equal(t, user1.HashedPassword, actual.HashedPassword)
+	assert.Equal(t, sessions.UserRoleAdmin, actual.Role)
+
+	actual, err = orm.FindUser("TEST2@email2.net")
+	require.NoError(t, err)
+	assert.Equal(t, user2.Email, actual.Email)
+
+	_, err = orm.FindUser("nobody@email.net")
+	require.Error(t, err)
+}
+
+func TestORM_ListUsers(t *testing.T) {
+	t.Parallel()
+
+	_, orm := setupORM(t)
+	user := cltest.MustNewUserWithRole(t, "viewer@email.net", "password", sessions.UserRoleView)
+	require.NoError(t, orm.CreateUser(&user))
+
+	users, err := orm.ListUsers()
+	require.NoError(t, err)
+	require.Len(t, users, 2)
+	assert.Equal(t, cltest.APIEmail, users[0].Email)
+	assert.Equal(t, sessions.UserRoleAdmin, users[0].Role)
+	assert.Equal(t, user.Email, users[1].Email)
+	assert.Equal(t, sessions.UserRoleView, users[1].Role)
+}
+
+func TestORM_FindUserByAPIToken(t *testing.T) {
+	t.Parallel()
+
+	_, orm := setupORM(t)
+	user := cltest.MustRandomUser(t)
+	require.NoError(t, orm.CreateUser(&user))
+	token, err := orm.CreateAndSetAuthToken(&user)
+	require.NoError(t, err)
+
+	actual, err := orm.FindUserByAPIToken(token.AccessKey)
+	require.NoError(t, err)
+	assert.Equal(t, user.Email, actual.Email)
+
+	_, err = orm.FindUserByAPIToken("bogus")
+	require.Error(t, err)
+	_, err = orm.FindUserByAPIToken("")
+	require.Error(t, err)
+}
+
+func TestORM_UpdateRole(t *testing.T) {
+	t.Parallel()
+
+	db, orm := setupORM(t)
+	user := cltest.MustNewUserWithRole(t, "runner@email.net", "password", sessions.UserRoleRun)
+	require.NoError(t, orm.CreateUser(&user))
+	sessionID, err := orm.CreateSession(sessions.SessionRequest{Email: user.Email, Password: "password"})
+	require.NoError(t, err)
+
+	updated, err := orm.UpdateRole(user.Email, sessions.UserRoleEdit)
+	require.NoError(t, err)
+	assert.Equal(t, sessions.UserRoleEdit, updated.Role)
+
+	// Sessions are cleared so that the role change takes effect
+	var count int
+	require.NoError(t, db.Get(&count, "SELECT count(*) FROM sessions WHERE id = $1", sessionID))
+	assert.Zero(t, count)
+
+	_, err = orm.UpdateRole(user.Email, "superuser")
+	require.Error(t, err)
+	_, err = orm.UpdateRole("nobody@email.net", sessions.UserRoleView)
+	require.Error(t, err)
 }
 
 func TestORM_AuthorizedUserWithSession(t *testing.T) {
@@ -69,7 +136,7 @@ func TestORM_AuthorizedUserWithSession(t *testing.T) {
 
 			prevSession := cltest.NewSession("correctID")
 			prevSession.LastUsed = time.Now().Add(-cltest.MustParseDuration(t, "2m"))
-			_, err := db.Exec("INSERT INTO sessions (id, last_used, created_at) VALUES ($1, $2, now())", prevSession.ID, prevSession.LastUsed)
+			_, err := db.Exec("INSERT INTO sessions (id, email, last_used, created_at) VALUES ($1, $2, $3, now())", prevSession.ID, user.Email, prevSession.LastUsed)
 			require.NoError(t, err)
 
 			expectedTime := utils.ISO8601UTC(time.Now())
@@ -90,15 +157,23 @@ func TestORM_AuthorizedUserWithSession(t *testing.T) {
 
 func TestORM_DeleteUser(t *testing.T) {
 	t.Parallel()
-	_, orm := setupORM(t)
+	db, orm := setupORM(t)
 
-	_, err := orm.FindUser()
+	_, err := orm.FindUser(cltest.APIEmail)
+	require.NoError(t, err)
+	sessionID, err := orm.CreateSession(sessions.SessionRequest{Email: cltest.APIEmail, Password: cltest.Password})
 	require.NoError(t, err)
 
-	err = orm.DeleteUser()
+	err = orm.DeleteUser(cltest.APIEmail)
 	require.NoError(t, err)
 
-	_, err = orm.FindUser()
+	_, err = orm.FindUser(cltest.APIEmail)
+	require.Error(t, err)
+	var count int
+	require.NoError(t, db.Get(&count, "SELECT count(*) FROM sessions WHERE id = $1", sessionID))
+	assert.Zero(t, count)
+
+	err = orm.DeleteUser(cltest.APIEmail)
 	require.Error(t, err)
 }
 
@@ -108,13 +183,13 @@ func TestORM_DeleteUserSession(t *testing.T) {
 	db, orm := setupORM(t)
 
 	session := sessions.NewSession()
-	_, err := db.Exec("INSERT INTO sessions (id, last_used, created_at) VALUES ($1, now(), now())", session.ID)
+	_, err := db.Exec("INSERT INTO sessions (id, email, last_used, created_at) VALUES ($1, $2, now(), now())", session.ID, cltest.APIEmail)
 	require.NoError(t, err)
 
 	err = orm.DeleteUserSession(session.ID)
 	require.NoError(t, err)
 
-	_, err = orm.FindUser()
+	_, err = orm.FindUser(cltest.APIEmail)
 	require.NoError(t, err)
 
 	sessions, err := orm.Sessions(0, 10)
@@ -172,7 +247,7 @@ func TestOrm_GenerateAuthToken(t *testing.T) {
 	token, err := orm.CreateAndSetAuthToken(&initial)
 	require.NoError(t, err)
 
-	dbUser, err := orm.FindUser()
+	dbUser, err := orm.FindUser(initial.Email)
 	require.NoError(t, err)
 
 	hashedSecret, err := auth.HashedSecret(token, dbUser.TokenSalt.String)
@@ -184,3 +259,25 @@ func TestOrm_GenerateAuthToken(t *testing.T) {
 	assert.Equal(t, dbUser.TokenKey.String, token.AccessKey)
 	assert.Equal(t, dbUser.TokenHashedSecret.String, hashedSecret)
 }
+
+func TestORM_ClearNonCurrentSessions(t *testing.T) {
+	t.Parallel()
+
+	db, orm := setupORM(t)
+	other := cltest.MustRandomUser(t)
+	require.NoError(t, orm.CreateUser(&other))
+
+	current, err := orm.CreateSession(sessions.SessionRequest{Email: cltest.APIEmail, Password: cltest.Password})
+	require.NoError(t, err)
+	previous, err := orm.CreateSession(sessions.SessionRequest{Email: cltest.APIEmail, Password: cltest.Password})
+	require.NoError(t, err)
+	otherUsers, err := orm.CreateSession(sessions.SessionRequest{Email: other.Email, Password: cltest.Password})
+	require.NoError(t, err)
+
+	require.NoError(t, orm.ClearNonCurrentSessions(current))
+
+	var ids []string
+	require.NoError(t, db.Select(&ids, "SELECT id FROM sessions"))
+	assert.ElementsMatch(t, []string{current, otherUsers}, ids)
+	assert.NotContains(t, ids, previous)
+}
@@ -4,6 +4,7 @@ import (
 	"crypto/subtle"
 	"fmt"
 	"regexp"
+	"strings"
 	"time"
 
 	"github.com/gin-gonic/gin"
@@ -23,6 +24,59 @@ type User struct {
 	TokenSalt         null.String
 	TokenHashedSecret null.String
 	UpdatedAt         time.Time
+	Role              UserRole
+}
+
+// UserRole is the role of a User, which determines what it is allowed to do.
+// Each role includes the permissions of the roles below it.
+type UserRole string
+
+const (
+	// UserRoleView can read everything, except secrets
+	UserRoleView UserRole = "view"
+	// UserRoleRun can additionally run jobs and operate the node, e.g. by
+	// cancelling runs or replaying blocks
+	UserRoleRun UserRole = "run"
+	// UserRoleEdit can additionally create, update and delete jobs, bridges
+	// and external initiators
+	UserRoleEdit UserRole = "edit"
+	// UserRoleAdmin can additionally manage keys, chains, nodes, the node's
+	// configuration and other users
+	UserRoleAdmin UserRole = "admin"
+)
+
+var userRoleRanks = map[UserRole]int{
+	UserRoleView:  0,
+	UserRoleRun:   1,
+	UserRoleEdit:  2,
+	UserRoleAdmin: 3,
+}
+
+// GetUserRole parses a UserRole from its name
+func GetUserRole(role string) (UserRole, error) {
+	r := UserRole(strings.ToLower(strings.TrimSpace(role)))
+	if _, ok := userRoleRanks[r]; !ok {
+		return "", errors.Errorf("invalid user role %q, must be one of: admin, edit, run, view", role)
+	}
+	return r, nil
+}
+
+// Includes returns true if the role has all the permissions of the other
+// role
+func (r UserRole) Includes(other UserRole) bool {
+	rank, ok := userRoleRanks[r]
+	if !ok {
+		return false
+	}
+	otherRank, ok := userRoleRanks[other]
+	if !ok {
+		return false
+	}
+	return rank >= otherRank
+}
+
+func (r UserRole) String() string {
+	return string(r)
 }
 
 // https://davidcel.is/posts/stop-validating-email-addresses-with-regex/
@@ -33,8 +87,9 @@ const (
 	MaxBcryptPasswordLength = 50
 )
 
-// NewUser creates a new user by hashing the passed plainPwd with bcrypt.
-func NewUser(email, plainPwd string) (User, error) {
+// NewUser creates a new user with the given role by hashing the passed
+// plainPwd with bcrypt.
+func NewUser(email, plainPwd string, role UserRole) (User, error) {
 	if len(email) == 0 {
 		return User{}, errors.New("Must enter an email")
 	}
@@ -47,6 +102,10 @@ func NewUser(email, plainPwd string) (User, error) {
 		return User{}, fmt.Errorf("must enter a password with 8 - %v characters", MaxBcryptPasswordLength)
 	}
 
+	if _, err := GetUserRole(string(role)); err != nil {
+		return User{}, err
+	}
+
 	pwd, err := utils.HashPassword(plainPwd)
 	if err != nil {
 		return User{}, err
@@ -55,6 +114,7 @@ func NewUser(email, plainPwd string) (User, error) {
 	return User{
 		Email:          email,
 		HashedPassword: pwd,
+		Role:           role,
 	}, nil
 }
 
@@ -69,9 +129,11 @@ type SessionRequest struct {
 	RequestContext *gin.Context
 }
 
-// Session holds the unique id for the authenticated session.
+// Session holds the unique id for the authenticated session, and the email
+// of the User it belongs to.
 type Session struct {
 	ID        string    `json:"id"`
+	Email     string    `json:"email"`
 	LastUsed  time.Time `json:"lastUsed"`
 	CreatedAt time.Time `json:"createdAt"`
 }
@@ -30,12 +30,13 @@ func TestNewUser(t *testing.T) {
 
 	for _, test := range tests {
 		t.Run(test.email, func(t *testing.T) {
-			user, err := sessions.NewUser(test.email, test.pwd)
+			user, err := sessions.NewUser(test.email, test.pwd, sessions.UserRoleView)
 			if test.wantError {
 				assert.Error(t, err)
 			} else {
 				assert.NoError(t, err)
 				assert.Equal(t, test.email, user.Email)
+				assert.Equal(t, sessions.UserRoleView, user.Role)
 				assert.NotEmpty(t, user.HashedPassword)
 				newHash, _ := utils.HashPassword(test.pwd)
 				assert.NotEqual(t, newHash, user.HashedPassword, "Salt should prevent equality")
@@ -44,6 +45,33 @@ func TestNewUser(t *testing.T) {
 	}
 }
 
+func TestNewUser_InvalidRole(t *testing.T) {
+	t.Parallel()
+
+	_, err := sessions.NewUser("good@email.com", "goodpassword", "superuser")
+	assert.EqualError(t, err, `invalid user role "superuser", must be one of: admin, edit, run, view`)
+}
+
+func TestUserRole(t *testing.T) {
+	t.Parallel()
+
+	role, err := sessions.GetUserRole(" Run ")
+	require.NoError(t, err)
+	assert.Equal(t, sessions.UserRoleRun, role)
+
+	_, err = sessions.GetUserRole("")
+	assert.Error(t, err)
+
+	assert.True(t, sessions.UserRoleAdmin.Includes(sessions.UserRoleAdmin))
+	assert.True(t, sessions.UserRoleAdmin.Includes(sessions.UserRoleView))
+	assert.True(t, sessions.UserRoleEdit.Includes(sessions.UserRoleRun))
+	assert.True(t, sessions.UserRoleRun.Includes(sessions.UserRoleView))
+	assert.False(t, sessions.UserRoleView.Includes(sessions.UserRoleRun))
+	assert.False(t, sessions.UserRoleRun.Includes(sessions.UserRoleEdit))
+	assert.False(t, sessions.UserRoleEdit.Includes(sessions.UserRoleAdmin))
+	assert.False(t, sessions.UserRole("").Includes(sessions.UserRoleView))
+}
+
 func TestUserGenerateAuthToken(t *testing.T) {
 	var user sessions.User
 	token, err := user.GenerateAuthToken()
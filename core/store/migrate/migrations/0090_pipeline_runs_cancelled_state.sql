@@ -0,0 +1,51 @@
+-- +goose Up
+-- Values added with ALTER TYPE ... ADD VALUE can't be used inside the same transaction, so recreate the type instead
+ALTER TABLE pipeline_runs DROP CONSTRAINT pipeline_runs_check;
+DROP INDEX pipeline_runs_suspended;
+ALTER TABLE pipeline_runs ALTER COLUMN state DROP DEFAULT;
+
+ALTER TYPE pipeline_runs_state RENAME TO pipeline_runs_state_old;
+CREATE TYPE pipeline_runs_state AS ENUM (
+    'running',
+    'suspended',
+    'errored',
+    'completed',
+    'cancelled'
+);
+ALTER TABLE pipeline_runs ALTER COLUMN state TYPE pipeline_runs_state USING state::text::pipeline_runs_state;
+DROP TYPE pipeline_runs_state_old;
+
+ALTER TABLE pipeline_runs ALTER COLUMN state SET DEFAULT 'completed';
+CREATE INDEX pipeline_runs_suspended ON pipeline_runs (id) WHERE state = 'suspended';
+-- A cancelled run may have been stopped before it produced any outputs or errors
+ALTER TABLE pipeline_runs ADD CONSTRAINT pipeline_runs_check CHECK (
+    ((state IN ('completed', 'errored')) AND (finished_at IS NOT NULL) AND (num_nulls(outputs, fatal_errors) = 0))
+        OR
+    ((state IN ('running', 'suspended')) AND num_nulls(finished_at, outputs, fatal_errors) = 3)
+        OR
+    ((state = 'cancelled') AND (finished_at IS NOT NULL))
+);
+
+-- +goose Down
+DELETE FROM pipeline_runs WHERE state = 'cancelled';
+ALTER TABLE pipeline_runs DROP CONSTRAINT pipeline_runs_check;
+DROP INDEX pipeline_runs_suspended;
+ALTER TABLE pipeline_runs ALTER COLUMN state DROP DEFAULT;
+
+ALTER TYPE pipeline_runs_state RENAME TO pipeline_runs_state_old;
+CREATE TYPE pipeline_runs_state AS ENUM (
+    'running',
+    'suspended',
+    'errored',
+    'completed'
+);
+ALTER TABLE pipeline_runs ALTER COLUMN state TYPE pipeline_runs_state USING state::text::pipeline_runs_state;
+DROP TYPE pipeline_runs_state_old;
+
+ALTER TABLE pipeline_runs ALTER COLUMN state SET DEFAULT 'completed';
+CREATE INDEX pipeline_runs_suspended ON pipeline_runs (id) WHERE state = 'suspended';
+ALTER TABLE pipeline_runs ADD CONSTRAINT pipeline_runs_check CHECK (
+    ((state IN ('completed', 'errored')) AND (finished_at IS NOT NULL) AND (num_nulls(outputs, fatal_errors) = 0))
+        OR
+    ((state IN ('running', 'suspended')) AND num_nulls(finished_at, outputs, fatal_errors) = 3)
+);
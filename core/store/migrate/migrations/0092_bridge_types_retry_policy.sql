@@ -0,0 +1,17 @@
+-- +goose Up
+ALTER TABLE bridge_types
+    ADD COLUMN retry_on_status_codes integer[] NOT NULL DEFAULT '{}',
+    ADD COLUMN retries integer NOT NULL DEFAULT 0 CHECK (retries >= 0),
+    ADD COLUMN attempt_timeout bigint NOT NULL DEFAULT 0 CHECK (attempt_timeout >= 0),
+    ADD COLUMN max_concurrency integer NOT NULL DEFAULT 0 CHECK (max_concurrency >= 0),
+    ADD COLUMN breaker_failure_threshold integer NOT NULL DEFAULT 0 CHECK (breaker_failure_threshold >= 0),
+    ADD COLUMN breaker_cooldown bigint NOT NULL DEFAULT 0 CHECK (breaker_cooldown >= 0);
+
+-- +goose Down
+ALTER TABLE bridge_types
+    DROP COLUMN retry_on_status_codes,
+    DROP COLUMN retries,
+    DROP COLUMN attempt_timeout,
+    DROP COLUMN max_concurrency,
+    DROP COLUMN breaker_failure_threshold,
+    DROP COLUMN breaker_cooldown;
@@ -0,0 +1,13 @@
+-- +goose Up
+ALTER TABLE bridge_types
+    ADD COLUMN cache_ttl bigint NOT NULL DEFAULT 0 CHECK (cache_ttl >= 0),
+    ADD COLUMN cache_max_stale bigint NOT NULL DEFAULT 0 CHECK (cache_max_stale >= 0);
+
+ALTER TABLE pipeline_task_runs ADD COLUMN meta jsonb;
+
+-- +goose Down
+ALTER TABLE bridge_types
+    DROP COLUMN cache_ttl,
+    DROP COLUMN cache_max_stale;
+
+ALTER TABLE pipeline_task_runs DROP COLUMN meta;
@@ -0,0 +1,24 @@
+-- +goose Up
+CREATE TYPE user_roles AS ENUM ('admin', 'edit', 'run', 'view');
+
+-- Existing users were all-powerful, so keep them that way
+ALTER TABLE users ADD COLUMN role user_roles NOT NULL DEFAULT 'view';
+UPDATE users SET role = 'admin';
+
+-- Sessions belong to the user that created them. Sessions created before
+-- there were multiple users belong to the one API user.
+ALTER TABLE sessions ADD COLUMN email text REFERENCES users (email) ON DELETE CASCADE;
+UPDATE sessions SET email = (SELECT email FROM users ORDER BY created_at DESC LIMIT 1);
+DELETE FROM sessions WHERE email IS NULL;
+ALTER TABLE sessions ALTER COLUMN email SET NOT NULL;
+CREATE INDEX idx_sessions_email ON sessions (email);
+
+CREATE UNIQUE INDEX idx_unique_users_token_key ON users (token_key) WHERE token_key IS NOT NULL AND token_key != '';
+CREATE UNIQUE INDEX idx_unique_users_lower_email ON users (lower(email));
+
+-- +goose Down
+DROP INDEX idx_unique_users_lower_email;
+DROP INDEX idx_unique_users_token_key;
+ALTER TABLE sessions DROP COLUMN email;
+ALTER TABLE users DROP COLUMN role;
+DROP TYPE user_roles;
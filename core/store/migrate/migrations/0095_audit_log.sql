@@ -0,0 +1,35 @@
+-- +goose Up
+CREATE TYPE audit_auth_methods AS ENUM ('session', 'token', 'cli');
+
+CREATE TABLE audit_log_entries (
+    id BIGSERIAL PRIMARY KEY,
+    actor text NOT NULL,
+    auth_method audit_auth_methods NOT NULL,
+    action text NOT NULL,
+    target text NOT NULL DEFAULT '',
+    diff jsonb,
+    created_at timestamptz NOT NULL
+);
+
+CREATE INDEX idx_audit_log_entries_created_at ON audit_log_entries (created_at);
+CREATE INDEX idx_audit_log_entries_actor ON audit_log_entries (actor);
+CREATE INDEX idx_audit_log_entries_action ON audit_log_entries (action);
+
+-- The audit log is append-only
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION public.forbid_audit_log_entries_changes() RETURNS trigger
+    LANGUAGE plpgsql
+    AS $$
+        BEGIN
+        RAISE EXCEPTION 'audit log entries cannot be updated or deleted';
+        END
+        $$;
+-- +goose StatementEnd
+
+CREATE TRIGGER forbid_audit_log_entries_changes BEFORE UPDATE OR DELETE ON audit_log_entries FOR EACH ROW EXECUTE PROCEDURE public.forbid_audit_log_entries_changes();
+
+-- +goose Down
+DROP TRIGGER forbid_audit_log_entries_changes ON audit_log_entries;
+DROP FUNCTION forbid_audit_log_entries_changes();
+DROP TABLE audit_log_entries;
+DROP TYPE audit_auth_methods;
@@ -0,0 +1,27 @@
+-- +goose Up
+ALTER TABLE eth_receipts
+    ADD COLUMN gas_used bigint,
+    ADD COLUMN effective_gas_price numeric(78,0);
+
+-- gasUsed is stored as a hex quantity, e.g. "0x5208"
+UPDATE eth_receipts SET gas_used = ('x' || lpad(substr(receipt->>'gasUsed', 3), 16, '0'))::bit(64)::bigint
+WHERE receipt->>'gasUsed' LIKE '0x%';
+
+CREATE INDEX idx_eth_receipts_created_at ON eth_receipts (created_at);
+CREATE INDEX idx_eth_txes_meta_job_id ON eth_txes ((meta->>'JobID')) WHERE meta IS NOT NULL;
+
+ALTER TABLE jobs
+    ADD COLUMN gas_spend_budget_wei numeric(78,0) CHECK (gas_spend_budget_wei >= 0),
+    ADD COLUMN gas_spend_budget_window bigint CHECK (gas_spend_budget_window >= 0);
+
+-- +goose Down
+ALTER TABLE jobs
+    DROP COLUMN gas_spend_budget_wei,
+    DROP COLUMN gas_spend_budget_window;
+
+DROP INDEX idx_eth_txes_meta_job_id;
+DROP INDEX idx_eth_receipts_created_at;
+
+ALTER TABLE eth_receipts
+    DROP COLUMN gas_used,
+    DROP COLUMN effective_gas_price;
@@ -0,0 +1,24 @@
+-- +goose Up
+CREATE TABLE eth_key_pools (
+    name text PRIMARY KEY CHECK (name <> ''),
+    strategy text NOT NULL CHECK (strategy IN ('round_robin', 'least_unconfirmed', 'highest_balance', 'weighted')),
+    created_at timestamp with time zone NOT NULL,
+    updated_at timestamp with time zone NOT NULL
+);
+
+CREATE TABLE eth_key_pool_members (
+    pool_name text NOT NULL REFERENCES eth_key_pools (name) ON DELETE CASCADE,
+    address bytea NOT NULL CHECK (octet_length(address) = 20),
+    weight integer NOT NULL DEFAULT 1 CHECK (weight > 0),
+    PRIMARY KEY (pool_name, address)
+);
+
+ALTER TABLE jobs ADD COLUMN key_pool text REFERENCES eth_key_pools (name);
+
+CREATE INDEX idx_jobs_key_pool ON jobs (key_pool) WHERE key_pool IS NOT NULL;
+
+-- +goose Down
+ALTER TABLE jobs DROP COLUMN key_pool;
+
+DROP TABLE eth_key_pool_members;
+DROP TABLE eth_key_pools;
@@ -0,0 +1,9 @@
+-- +goose Up
+ALTER TABLE jobs ADD COLUMN generate_access_lists boolean;
+ALTER TABLE eth_txes ADD COLUMN generate_access_list boolean;
+ALTER TABLE eth_tx_attempts ADD COLUMN access_list_gas_saved bigint CHECK (access_list_gas_saved > 0);
+
+-- +goose Down
+ALTER TABLE eth_tx_attempts DROP COLUMN access_list_gas_saved;
+ALTER TABLE eth_txes DROP COLUMN generate_access_list;
+ALTER TABLE jobs DROP COLUMN generate_access_lists;
@@ -0,0 +1,7 @@
+-- +goose Up
+ALTER TABLE jobs ADD COLUMN private_relay boolean;
+ALTER TABLE eth_txes ADD COLUMN private_relay boolean;
+
+-- +goose Down
+ALTER TABLE eth_txes DROP COLUMN private_relay;
+ALTER TABLE jobs DROP COLUMN private_relay;
@@ -0,0 +1,50 @@
+-- +goose Up
+CREATE TABLE event_trigger_specs (
+    id SERIAL PRIMARY KEY,
+    contract_address bytea NOT NULL,
+    event_abi text NOT NULL,
+    indexed_filters jsonb,
+    min_incoming_confirmations bigint,
+    evm_chain_id numeric(78,0) REFERENCES evm_chains (id),
+    created_at timestamp with time zone NOT NULL,
+    updated_at timestamp with time zone NOT NULL,
+    CONSTRAINT chk_contract_address_length CHECK ((octet_length(contract_address) = 20))
+);
+ALTER TABLE jobs
+    ADD COLUMN event_trigger_spec_id integer,
+    ADD CONSTRAINT jobs_event_trigger_spec_id_fkey
+        FOREIGN KEY (event_trigger_spec_id)
+            REFERENCES event_trigger_specs(id)
+            ON DELETE CASCADE,
+    DROP CONSTRAINT chk_only_one_spec,
+    ADD CONSTRAINT chk_only_one_spec CHECK (
+            num_nonnulls(
+                    offchainreporting_oracle_spec_id,
+                    offchainreporting2_oracle_spec_id,
+                    direct_request_spec_id,
+                    flux_monitor_spec_id,
+                    keeper_spec_id,
+                    cron_spec_id,
+                    vrf_spec_id,
+                    webhook_spec_id,
+                    event_trigger_spec_id
+                ) = 1
+        );
+CREATE UNIQUE INDEX idx_jobs_unique_event_trigger_spec_id ON jobs USING btree (event_trigger_spec_id);
+
+-- +goose Down
+ALTER TABLE jobs DROP CONSTRAINT chk_only_one_spec,
+    ADD CONSTRAINT chk_only_one_spec CHECK (
+            num_nonnulls(
+                    offchainreporting_oracle_spec_id,
+                    offchainreporting2_oracle_spec_id,
+                    direct_request_spec_id,
+                    flux_monitor_spec_id,
+                    keeper_spec_id,
+                    cron_spec_id,
+                    vrf_spec_id,
+                    webhook_spec_id
+                ) = 1
+        );
+ALTER TABLE jobs DROP COLUMN event_trigger_spec_id;
+DROP TABLE event_trigger_specs;
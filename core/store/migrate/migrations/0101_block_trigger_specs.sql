@@ -0,0 +1,51 @@
+-- +goose Up
+CREATE TABLE block_trigger_specs (
+    id SERIAL PRIMARY KEY,
+    block_interval bigint NOT NULL,
+    confirmations bigint NOT NULL DEFAULT 0,
+    run_missed_blocks boolean NOT NULL DEFAULT FALSE,
+    evm_chain_id numeric(78,0) REFERENCES evm_chains (id),
+    created_at timestamp with time zone NOT NULL,
+    updated_at timestamp with time zone NOT NULL,
+    CONSTRAINT chk_block_interval_positive CHECK (block_interval > 0)
+);
+ALTER TABLE jobs
+    ADD COLUMN block_trigger_spec_id integer,
+    ADD CONSTRAINT jobs_block_trigger_spec_id_fkey
+        FOREIGN KEY (block_trigger_spec_id)
+            REFERENCES block_trigger_specs(id)
+            ON DELETE CASCADE,
+    DROP CONSTRAINT chk_only_one_spec,
+    ADD CONSTRAINT chk_only_one_spec CHECK (
+            num_nonnulls(
+                    offchainreporting_oracle_spec_id,
+                    offchainreporting2_oracle_spec_id,
+                    direct_request_spec_id,
+                    flux_monitor_spec_id,
+                    keeper_spec_id,
+                    cron_spec_id,
+                    vrf_spec_id,
+                    webhook_spec_id,
+                    event_trigger_spec_id,
+                    block_trigger_spec_id
+                ) = 1
+        );
+CREATE UNIQUE INDEX idx_jobs_unique_block_trigger_spec_id ON jobs USING btree (block_trigger_spec_id);
+
+-- +goose Down
+ALTER TABLE jobs DROP CONSTRAINT chk_only_one_spec,
+    ADD CONSTRAINT chk_only_one_spec CHECK (
+            num_nonnulls(
+                    offchainreporting_oracle_spec_id,
+                    offchainreporting2_oracle_spec_id,
+                    direct_request_spec_id,
+                    flux_monitor_spec_id,
+                    keeper_spec_id,
+                    cron_spec_id,
+                    vrf_spec_id,
+                    webhook_spec_id,
+                    event_trigger_spec_id
+                ) = 1
+        );
+ALTER TABLE jobs DROP COLUMN block_trigger_spec_id;
+DROP TABLE block_trigger_specs;
@@ -0,0 +1,13 @@
+-- +goose Up
+ALTER TABLE cron_specs
+    ADD COLUMN concurrency_policy text NOT NULL DEFAULT 'allow',
+    ADD COLUMN catch_up_window bigint NOT NULL DEFAULT 0,
+    ADD COLUMN jitter bigint NOT NULL DEFAULT 0,
+    ADD COLUMN timezone text NOT NULL DEFAULT '';
+
+-- +goose Down
+ALTER TABLE cron_specs
+    DROP COLUMN concurrency_policy,
+    DROP COLUMN catch_up_window,
+    DROP COLUMN jitter,
+    DROP COLUMN timezone;
@@ -0,0 +1,9 @@
+-- +goose Up
+ALTER TABLE jobs
+    ADD COLUMN paused_at timestamp with time zone,
+    ADD COLUMN paused_at_block bigint;
+
+-- +goose Down
+ALTER TABLE jobs
+    DROP COLUMN paused_at,
+    DROP COLUMN paused_at_block;
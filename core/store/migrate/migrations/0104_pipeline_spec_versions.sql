@@ -0,0 +1,15 @@
+-- +goose Up
+ALTER TABLE pipeline_specs ADD COLUMN version integer NOT NULL DEFAULT 1;
+-- Links every version of a job's pipeline spec to the job. jobs.pipeline_spec_id
+-- remains the current version.
+CREATE TABLE job_pipeline_specs (
+    pipeline_spec_id integer PRIMARY KEY REFERENCES pipeline_specs (id) ON DELETE CASCADE DEFERRABLE,
+    job_id integer NOT NULL REFERENCES jobs (id) ON DELETE CASCADE DEFERRABLE
+);
+CREATE INDEX idx_job_pipeline_specs_job_id ON job_pipeline_specs USING btree (job_id);
+INSERT INTO job_pipeline_specs (pipeline_spec_id, job_id) SELECT pipeline_spec_id, id FROM jobs;
+
+-- +goose Down
+DELETE FROM pipeline_specs WHERE id IN (SELECT pipeline_spec_id FROM job_pipeline_specs) AND id NOT IN (SELECT pipeline_spec_id FROM jobs);
+DROP TABLE job_pipeline_specs;
+ALTER TABLE pipeline_specs DROP COLUMN version;
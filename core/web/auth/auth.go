@@ -8,6 +8,7 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/pkg/errors"
 
+	"github.com/smartcontractkit/chainlink/core/audit"
 	"github.com/smartcontractkit/chainlink/core/auth"
 	"github.com/smartcontractkit/chainlink/core/bridges"
 	clsessions "github.com/smartcontractkit/chainlink/core/sessions"
@@ -32,6 +33,10 @@ const (
 
 	// SessionExternalInitiatorKey is the External Initiator key in the session map
 	SessionExternalInitiatorKey = "external_initiator"
+
+	// SessionAuthMethodKey is the key in the session map for how the User
+	// authenticated
+	SessionAuthMethodKey = "auth_method"
 )
 
 // Authenticator defines the interface to authenticate requests against a
@@ -39,7 +44,7 @@ const (
 type Authenticator interface {
 	AuthorizedUserWithSession(sessionID string) (clsessions.User, error)
 	FindExternalInitiator(eia *auth.Token) (*bridges.ExternalInitiator, error)
-	FindUser() (clsessions.User, error)
+	FindUserByAPIToken(accessKey string) (clsessions.User, error)
 }
 
 // authMethod defines a method which can be used to authenticate a request. This
@@ -63,6 +68,7 @@ func AuthenticateBySession(c *gin.Context, authr Authenticator) error {
 	}
 
 	c.Set(SessionUserKey, &user)
+	c.Set(SessionAuthMethodKey, audit.AuthMethodSession)
 
 	return nil
 }
@@ -78,7 +84,7 @@ func AuthenticateByToken(c *gin.Context, authr Authenticator) error {
 		Secret:    c.GetHeader(APISecret),
 	}
 
-	user, err := authr.FindUser()
+	user, err := authr.FindUserByAPIToken(token.AccessKey)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return auth.ErrorAuthFailed
@@ -96,6 +102,7 @@ func AuthenticateByToken(c *gin.Context, authr Authenticator) error {
 	}
 
 	c.Set(SessionUserKey, &user)
+	c.Set(SessionAuthMethodKey, audit.AuthMethodToken)
 
 	return nil
 }
@@ -157,6 +164,48 @@ func Authenticate(store Authenticator, methods ...authMethod) gin.HandlerFunc {
 	}
 }
 
+// RequiresRunRole extends the handler with a check that the authenticated
+// user has at least the run role. Authenticated external initiators are also
+// allowed, as they may only trigger runs.
+func RequiresRunRole(handler gin.HandlerFunc) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if _, ok := GetAuthenticatedExternalInitiator(c); ok {
+			handler(c)
+			return
+		}
+		requiresRole(clsessions.UserRoleRun, handler)(c)
+	}
+}
+
+// RequiresEditRole extends the handler with a check that the authenticated
+// user has at least the edit role.
+func RequiresEditRole(handler gin.HandlerFunc) gin.HandlerFunc {
+	return requiresRole(clsessions.UserRoleEdit, handler)
+}
+
+// RequiresAdminRole extends the handler with a check that the authenticated
+// user has the admin role.
+func RequiresAdminRole(handler gin.HandlerFunc) gin.HandlerFunc {
+	return requiresRole(clsessions.UserRoleAdmin, handler)
+}
+
+func requiresRole(role clsessions.UserRole, handler gin.HandlerFunc) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		user, ok := GetAuthenticatedUser(c)
+		if !ok {
+			c.Abort()
+			jsonAPIError(c, http.StatusUnauthorized, auth.ErrorAuthFailed)
+			return
+		}
+		if !user.Role.Includes(role) {
+			c.Abort()
+			jsonAPIError(c, http.StatusForbidden, errors.Errorf("Forbidden: the %s role is required", role))
+			return
+		}
+		handler(c)
+	}
+}
+
 // GetAuthenticatedUser extracts the authentication user from the context.
 func GetAuthenticatedUser(c *gin.Context) (*clsessions.User, bool) {
 	obj, ok := c.Get(SessionUserKey)
@@ -169,6 +218,20 @@ func GetAuthenticatedUser(c *gin.Context) (*clsessions.User, bool) {
 	return user, ok
 }
 
+// GetAuditActor returns the authenticated user as the actor for audit log
+// entries.
+func GetAuditActor(c *gin.Context) audit.Actor {
+	var actor audit.Actor
+	if user, ok := GetAuthenticatedUser(c); ok {
+		actor.Name = user.Email
+	}
+	if method, ok := c.Get(SessionAuthMethodKey); ok {
+		actor.AuthMethod, _ = method.(audit.AuthMethod)
+	}
+
+	return actor
+}
+
 // GetAuthenticatedExternalInitiator extracts the external initiator from the
 // context.
 func GetAuthenticatedExternalInitiator(c *gin.Context) (*bridges.ExternalInitiator, bool) {
@@ -11,6 +11,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/smartcontractkit/chainlink/core/auth"
+	"github.com/smartcontractkit/chainlink/core/bridges"
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/core/sessions"
 	webauth "github.com/smartcontractkit/chainlink/core/web/auth"
@@ -33,7 +34,7 @@ type userFindFailer struct {
 	err error
 }
 
-func (u userFindFailer) FindUser() (sessions.User, error) {
+func (u userFindFailer) FindUserByAPIToken(string) (sessions.User, error) {
 	return sessions.User{}, u.err
 }
 
@@ -42,7 +43,7 @@ type userFindSuccesser struct {
 	user sessions.User
 }
 
-func (u userFindSuccesser) FindUser() (sessions.User, error) {
+func (u userFindSuccesser) FindUserByAPIToken(string) (sessions.User, error) {
 	return u.user, nil
 }
 
@@ -164,3 +165,65 @@ func TestRequireAuth_Error(t *testing.T) {
 	assert.False(t, called)
 	assert.Equal(t, http.StatusText(http.StatusUnauthorized), http.StatusText(w.Code))
 }
+
+func TestRequiresRole(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		role       sessions.UserRole
+		wantStatus map[string]int
+	}{
+		{sessions.UserRoleView, map[string]int{"/view": http.StatusOK, "/run": http.StatusForbidden, "/edit": http.StatusForbidden, "/admin": http.StatusForbidden}},
+		{sessions.UserRoleRun, map[string]int{"/view": http.StatusOK, "/run": http.StatusOK, "/edit": http.StatusForbidden, "/admin": http.StatusForbidden}},
+		{sessions.UserRoleEdit, map[string]int{"/view": http.StatusOK, "/run": http.StatusOK, "/edit": http.StatusOK, "/admin": http.StatusForbidden}},
+		{sessions.UserRoleAdmin, map[string]int{"/view": http.StatusOK, "/run": http.StatusOK, "/edit": http.StatusOK, "/admin": http.StatusOK}},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(string(test.role), func(t *testing.T) {
+			user := cltest.MustNewUserWithRole(t, "role@chainlink.test", cltest.Password, test.role)
+			ok := func(c *gin.Context) { c.String(http.StatusOK, "") }
+
+			router := gin.New()
+			router.Use(func(c *gin.Context) { c.Set(webauth.SessionUserKey, &user) })
+			router.GET("/view", ok)
+			router.GET("/run", webauth.RequiresRunRole(ok))
+			router.GET("/edit", webauth.RequiresEditRole(ok))
+			router.GET("/admin", webauth.RequiresAdminRole(ok))
+
+			for path, status := range test.wantStatus {
+				w := httptest.NewRecorder()
+				req, _ := http.NewRequest("GET", path, nil)
+				router.ServeHTTP(w, req)
+				assert.Equal(t, http.StatusText(status), http.StatusText(w.Code), path)
+			}
+		})
+	}
+}
+
+func TestRequiresRunRole_ExternalInitiator(t *testing.T) {
+	t.Parallel()
+
+	called := false
+	router := gin.New()
+	router.Use(func(c *gin.Context) { c.Set(webauth.SessionExternalInitiatorKey, &bridges.ExternalInitiator{}) })
+	router.GET("/", webauth.RequiresRunRole(func(c *gin.Context) {
+		called = true
+		c.String(http.StatusOK, "")
+	}))
+	router.GET("/edit", webauth.RequiresEditRole(func(c *gin.Context) {
+		c.String(http.StatusOK, "")
+	}))
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/", nil)
+	router.ServeHTTP(w, req)
+	assert.True(t, called)
+	assert.Equal(t, http.StatusText(http.StatusOK), http.StatusText(w.Code))
+
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("GET", "/edit", nil)
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusText(http.StatusUnauthorized), http.StatusText(w.Code))
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/gin-gonic/contrib/sessions"
 	"github.com/gin-gonic/gin"
 
+	"github.com/smartcontractkit/chainlink/core/audit"
 	clsessions "github.com/smartcontractkit/chainlink/core/sessions"
 )
 
@@ -58,3 +59,14 @@ func GetGQLAuthenticatedSession(ctx context.Context) (*GQLSession, bool) {
 
 	return session, ok
 }
+
+// GetGQLAuditActor returns the actor to record in the audit log for the
+// authenticated session in the context.
+func GetGQLAuditActor(ctx context.Context) audit.Actor {
+	actor := audit.Actor{AuthMethod: audit.AuthMethodSession}
+	if session, ok := GetGQLAuthenticatedSession(ctx); ok && session.User != nil {
+		actor.Name = session.User.Email
+	}
+
+	return actor
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/jackc/pgconn"
 
 	"github.com/smartcontractkit/chainlink/core/assets"
+	"github.com/smartcontractkit/chainlink/core/audit"
 	"github.com/smartcontractkit/chainlink/core/bridges"
 	"github.com/smartcontractkit/chainlink/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/core/store/models"
@@ -49,6 +50,9 @@ func ValidateBridgeType(bt *bridges.BridgeTypeRequest, orm bridges.ORM) error {
 		bt.MinimumContractPayment.Cmp(assets.NewLinkFromJuels(0)) < 0 {
 		fe.Add("MinimumContractPayment must be positive")
 	}
+	if err := bt.RequestPolicy.Validate(); err != nil {
+		fe.Merge(err)
+	}
 	return fe.CoerceEmptyToNil()
 }
 
@@ -95,6 +99,7 @@ func (btc *BridgeTypesController) Create(c *gin.Context) {
 		return
 	default:
 		resource := presenters.NewBridgeResource(*bt)
+		recordAudit(btc.App, c, audit.ActionBridgeCreated, bt.Name.String(), audit.NewDiff(nil, resource))
 		resource.IncomingToken = bta.IncomingToken
 
 		jsonAPIResponse(c, resource, "bridge")
@@ -166,10 +171,12 @@ func (btc *BridgeTypesController) Update(c *gin.Context) {
 		jsonAPIError(c, http.StatusBadRequest, err)
 		return
 	}
+	before := presenters.NewBridgeResource(bt)
 	if err := orm.UpdateBridgeType(&bt, btr); err != nil {
 		jsonAPIError(c, http.StatusInternalServerError, err)
 		return
 	}
+	recordAudit(btc.App, c, audit.ActionBridgeUpdated, bt.Name.String(), audit.NewDiff(before, presenters.NewBridgeResource(bt)))
 
 	jsonAPIResponse(c, presenters.NewBridgeResource(bt), "bridge")
 }
@@ -207,6 +214,39 @@ func (btc *BridgeTypesController) Destroy(c *gin.Context) {
 		jsonAPIError(c, http.StatusInternalServerError, fmt.Errorf("failed to delete bridge: %+v", err))
 		return
 	}
+	recordAudit(btc.App, c, audit.ActionBridgeDeleted, bt.Name.String(), nil)
 
 	jsonAPIResponse(c, presenters.NewBridgeResource(bt), "bridge")
 }
+
+// FlushCache drops the responses cached for a specific Bridge.
+// Example:
+// "DELETE <application>/bridge_types/:BridgeName/cache"
+func (btc *BridgeTypesController) FlushCache(c *gin.Context) {
+	taskType, err := bridges.NewTaskType(c.Param("BridgeName"))
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	bt, err := btc.App.BridgeORM().FindBridge(taskType)
+	if errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("bridge not found"))
+		return
+	}
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, fmt.Errorf("error searching for bridge: %+v", err))
+		return
+	}
+
+	flushed := btc.App.BridgeCache().Flush(bt.Name)
+	jsonAPIResponse(c, presenters.NewBridgeCacheFlushResource(bt.Name.String(), flushed), "bridgeCacheFlush")
+}
+
+// FlushAllCaches drops the responses cached for all bridges.
+// Example:
+// "DELETE <application>/bridge_cache"
+func (btc *BridgeTypesController) FlushAllCaches(c *gin.Context) {
+	flushed := btc.App.BridgeCache().FlushAll()
+	jsonAPIResponse(c, presenters.NewBridgeCacheFlushResource("", flushed), "bridgeCacheFlush")
+}
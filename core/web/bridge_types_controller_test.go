@@ -4,9 +4,12 @@ import (
 	"bytes"
 	"net/http"
 	"testing"
+	"time"
 
+	"github.com/lib/pq"
 	"github.com/manyminds/api2go/jsonapi"
 	"github.com/smartcontractkit/chainlink/core/assets"
+	"github.com/smartcontractkit/chainlink/core/audit"
 	"github.com/smartcontractkit/chainlink/core/bridges"
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
@@ -87,6 +90,17 @@ func TestValidateBridgeType(t *testing.T) {
 			},
 			models.NewJSONAPIErrorsWith("MinimumContractPayment must be positive"),
 		},
+		{
+			"invalid retry status code",
+			bridges.BridgeTypeRequest{
+				Name: "adapterwithretries",
+				URL:  cltest.WebURL(t, "http://chainlink_cmc-adapter_1:8080"),
+				RequestPolicy: bridges.RequestPolicy{
+					RetryOnStatusCodes: pq.Int32Array{503, 204},
+				},
+			},
+			models.NewJSONAPIErrorsWith("retryOnStatusCodes: 204 is not an HTTP error status code"),
+		},
 		{
 			"existing core adapter (no longer fails since core adapters no longer exist)",
 			bridges.BridgeTypeRequest{
@@ -237,6 +251,14 @@ func TestBridgeTypesController_Create_Success(t *testing.T) {
 	assert.Equal(t, "https://example.com/randomNumber", bt.URL.String())
 	assert.Equal(t, assets.NewLinkFromJuels(100), bt.MinimumContractPayment)
 	assert.NotEmpty(t, bt.OutgoingToken)
+
+	entries, count, err := app.AuditLogger().Entries(audit.Filter{Action: audit.ActionBridgeCreated}, 0, 10)
+	require.NoError(t, err)
+	require.Equal(t, 1, count)
+	assert.Equal(t, cltest.APIEmail, entries[0].Actor)
+	assert.Equal(t, audit.AuthMethodSession, entries[0].AuthMethod)
+	assert.Equal(t, "randomnumber", entries[0].Target)
+	assert.Equal(t, audit.Redacted, entries[0].Diff["outgoingToken"].New)
 }
 
 func TestBridgeTypesController_Update_Success(t *testing.T) {
@@ -292,6 +314,47 @@ func TestBridgeController_Show(t *testing.T) {
 	assert.Equal(t, http.StatusNotFound, resp.StatusCode, "Response should be 404")
 }
 
+func TestBridgeTypesController_FlushCache(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplication(t)
+	require.NoError(t, app.Start())
+	client := app.NewHTTPClient()
+
+	bt := &bridges.BridgeType{
+		Name: bridges.MustNewTaskType("cachedbridge"),
+		URL:  cltest.WebURL(t, "https://testing.com/bridges"),
+		RequestPolicy: bridges.RequestPolicy{
+			CacheTTL: models.Interval(time.Hour),
+		},
+	}
+	require.NoError(t, app.BridgeORM().CreateBridgeType(bt))
+	app.BridgeCache().Set(*bt, []byte(`{"data":{"coin":"ETH"}}`), []byte(`{"data":{"result":1}}`))
+	app.BridgeCache().Set(*bt, []byte(`{"data":{"coin":"BTC"}}`), []byte(`{"data":{"result":2}}`))
+
+	resp, cleanup := client.Delete("/v2/bridge_types/" + bt.Name.String() + "/cache")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	var resource presenters.BridgeCacheFlushResource
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &resource))
+	assert.Equal(t, bt.Name.String(), resource.ID)
+	assert.Equal(t, 2, resource.FlushedResponses)
+	_, ok := app.BridgeCache().GetStale(*bt, []byte(`{"data":{"coin":"ETH"}}`))
+	assert.False(t, ok)
+
+	app.BridgeCache().Set(*bt, []byte(`{"data":{"coin":"ETH"}}`), []byte(`{"data":{"result":1}}`))
+	resp, cleanup = client.Delete("/v2/bridge_cache")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &resource))
+	assert.Equal(t, 1, resource.FlushedResponses)
+
+	resp, cleanup = client.Delete("/v2/bridge_types/nosuchbridge/cache")
+	t.Cleanup(cleanup)
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
+
 func TestBridgeTypesController_Create_AdapterExistsError(t *testing.T) {
 	t.Parallel()
 
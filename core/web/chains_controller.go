@@ -5,6 +5,7 @@ import (
 	"net/http"
 
 	"github.com/pkg/errors"
+	"github.com/smartcontractkit/chainlink/core/audit"
 	"github.com/smartcontractkit/chainlink/core/chains/evm/types"
 	"github.com/smartcontractkit/chainlink/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/core/utils"
@@ -69,6 +70,7 @@ func (cc *ChainsController) Create(c *gin.Context) {
 		jsonAPIError(c, http.StatusBadRequest, err)
 		return
 	}
+	recordAudit(cc.App, c, audit.ActionChainCreated, chain.ID.String(), audit.NewDiff(nil, presenters.NewChainResource(chain)))
 
 	jsonAPIResponseWithStatus(c, presenters.NewChainResource(chain), "chain", http.StatusCreated)
 }
@@ -92,6 +94,15 @@ func (cc *ChainsController) Update(c *gin.Context) {
 		return
 	}
 
+	before, err := cc.App.EVMORM().Chain(id)
+	if errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, err)
+		return
+	} else if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
 	chain, err := cc.App.GetChainSet().Configure(id.ToInt(), request.Enabled, request.Config)
 
 	if errors.Is(err, sql.ErrNoRows) {
@@ -101,6 +112,7 @@ func (cc *ChainsController) Update(c *gin.Context) {
 		jsonAPIError(c, http.StatusBadRequest, err)
 		return
 	}
+	recordAudit(cc.App, c, audit.ActionChainUpdated, chain.ID.String(), audit.NewDiff(presenters.NewChainResource(before), presenters.NewChainResource(chain)))
 
 	jsonAPIResponse(c, presenters.NewChainResource(chain), "chain")
 }
@@ -119,6 +131,7 @@ func (cc *ChainsController) Delete(c *gin.Context) {
 		jsonAPIError(c, http.StatusInternalServerError, err)
 		return
 	}
+	recordAudit(cc.App, c, audit.ActionChainDeleted, id.String(), nil)
 
 	jsonAPIResponseWithStatus(c, nil, "chain", http.StatusNoContent)
 }
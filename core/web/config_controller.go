@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"net/http"
 
+	"github.com/smartcontractkit/chainlink/core/audit"
 	"github.com/smartcontractkit/chainlink/core/config"
 	"github.com/smartcontractkit/chainlink/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/core/utils"
@@ -75,10 +76,14 @@ func (cc *ConfigController) Patch(c *gin.Context) {
 		return
 	}
 
+	before := chain.Config().EvmGasPriceDefault().String()
 	if err := chain.Config().SetEvmGasPriceDefault(request.EvmGasPriceDefault.ToInt()); err != nil {
 		jsonAPIError(c, http.StatusInternalServerError, fmt.Errorf("failed to set gas price default: %+v", err))
 		return
 	}
+	recordAudit(cc.App, c, audit.ActionConfigUpdated, chain.ID().String(), audit.Diff{
+		"ethGasPriceDefault": {Old: before, New: request.EvmGasPriceDefault.String()},
+	})
 	response := &ConfigPatchResponse{
 		EvmGasPriceDefault: Change{
 			From: chain.Config().EvmGasPriceDefault().String(),
@@ -7,6 +7,7 @@ import (
 
 	"github.com/gin-gonic/gin"
 
+	"github.com/smartcontractkit/chainlink/core/audit"
 	"github.com/smartcontractkit/chainlink/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/core/services/keystore"
 	"github.com/smartcontractkit/chainlink/core/web/presenters"
@@ -43,6 +44,7 @@ func (ctrl *CSAKeysController) Create(c *gin.Context) {
 		jsonAPIError(c, http.StatusInternalServerError, err)
 		return
 	}
+	recordAudit(ctrl.App, c, audit.ActionKeyCreated, audit.KeyTarget("csa", key.ID()), nil)
 	jsonAPIResponse(c, presenters.NewCSAKeyResource(key), "csaKeys")
 }
 
@@ -62,6 +64,7 @@ func (ctrl *CSAKeysController) Import(c *gin.Context) {
 		return
 	}
 
+	recordAudit(ctrl.App, c, audit.ActionKeyImported, audit.KeyTarget("csa", key.ID()), nil)
 	jsonAPIResponse(c, presenters.NewCSAKeyResource(key), "csaKey")
 }
 
@@ -77,5 +80,6 @@ func (ctrl *CSAKeysController) Export(c *gin.Context) {
 		jsonAPIError(c, http.StatusInternalServerError, err)
 		return
 	}
+	recordAudit(ctrl.App, c, audit.ActionKeyExported, audit.KeyTarget("csa", keyID), nil)
 	c.Data(http.StatusOK, MediaType, bytes)
 }
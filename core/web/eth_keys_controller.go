@@ -9,6 +9,7 @@ import (
 	"strconv"
 
 	"github.com/smartcontractkit/chainlink/core/assets"
+	"github.com/smartcontractkit/chainlink/core/audit"
 	"github.com/smartcontractkit/chainlink/core/chains/evm"
 	"github.com/smartcontractkit/chainlink/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
@@ -116,6 +117,12 @@ func (ekc *ETHKeysController) Create(c *gin.Context) {
 		}
 	}
 
+	created := map[string]interface{}{"evmChainID": chain.ID().String()}
+	if maxGasPriceGWei > 0 {
+		created["maxGasPriceWei"] = assets.GWei(maxGasPriceGWei)
+	}
+	recordAudit(ekc.App, c, audit.ActionKeyCreated, audit.KeyTarget("eth", key.Address.Hex()), audit.NewDiff(nil, created))
+
 	state, err := ethKeyStore.GetState(key.ID())
 	if err != nil {
 		jsonAPIError(c, http.StatusInternalServerError, err)
@@ -164,12 +171,20 @@ func (ekc *ETHKeysController) Update(c *gin.Context) {
 		return
 	}
 
+	var before *big.Int
+	if chain, cerr := ekc.App.GetChainSet().Get(state.EVMChainID.ToInt()); cerr == nil {
+		before = chain.Config().KeySpecificMaxGasPriceWei(key.Address.Address())
+	}
 	maxGasPriceWei := assets.GWei(maxGasPriceGWei)
 	updateMaxGasPrice := evm.UpdateKeySpecificMaxGasPrice(key.Address.Address(), maxGasPriceWei)
 	if err = ekc.App.GetChainSet().UpdateConfig((*big.Int)(&state.EVMChainID), updateMaxGasPrice); err != nil {
 		jsonAPIError(c, http.StatusInternalServerError, err)
 		return
 	}
+	recordAudit(ekc.App, c, audit.ActionKeyUpdated, audit.KeyTarget("eth", key.Address.Hex()), audit.NewDiff(
+		map[string]interface{}{"maxGasPriceWei": before},
+		map[string]interface{}{"maxGasPriceWei": maxGasPriceWei},
+	))
 
 	r, err := presenters.NewETHKeyResource(key, state,
 		ekc.setEthBalance(c.Request.Context(), state),
@@ -222,6 +237,7 @@ func (ekc *ETHKeysController) Delete(c *gin.Context) {
 		jsonAPIError(c, http.StatusInternalServerError, err)
 		return
 	}
+	recordAudit(ekc.App, c, audit.ActionKeyDeleted, audit.KeyTarget("eth", key.Address.Hex()), nil)
 
 	r, err := presenters.NewETHKeyResource(key, state,
 		ekc.setEthBalance(c.Request.Context(), state),
@@ -263,6 +279,9 @@ func (ekc *ETHKeysController) Import(c *gin.Context) {
 		jsonAPIError(c, http.StatusInternalServerError, err)
 		return
 	}
+	recordAudit(ekc.App, c, audit.ActionKeyImported, audit.KeyTarget("eth", key.Address.Hex()), audit.NewDiff(nil, map[string]interface{}{
+		"evmChainID": chain.ID().String(),
+	}))
 
 	state, err := ethKeyStore.GetState(key.ID())
 	if err != nil {
@@ -293,9 +312,49 @@ func (ekc *ETHKeysController) Export(c *gin.Context) {
 		jsonAPIError(c, http.StatusInternalServerError, err)
 		return
 	}
+	recordAudit(ekc.App, c, audit.ActionKeyExported, audit.KeyTarget("eth", address), nil)
 	c.Data(http.StatusOK, MediaType, bytes)
 }
 
+// NonceStatus checks the nonces of the node's Ethereum keys against the chain
+// and returns any gaps in them, without repairing anything. Only the keys on
+// the chain given by the evmChainID query parameter are checked, if given.
+// Example:
+//  "<application>/keys/eth/nonce_status"
+func (ekc *ETHKeysController) NonceStatus(c *gin.Context) {
+	var chains []evm.Chain
+	if chainIDstr := c.Query("evmChainID"); chainIDstr != "" {
+		chain, err := getChain(ekc.App.GetChainSet(), chainIDstr)
+		switch err {
+		case ErrInvalidChainID, ErrMissingChainID:
+			jsonAPIError(c, http.StatusUnprocessableEntity, err)
+			return
+		case nil:
+			break
+		default:
+			jsonAPIError(c, http.StatusInternalServerError, err)
+			return
+		}
+		chains = append(chains, chain)
+	} else {
+		chains = ekc.App.GetChainSet().Chains()
+	}
+
+	resources := []presenters.NonceStatusResource{}
+	for _, chain := range chains {
+		statuses, err := chain.TxManager().NonceStatus(c.Request.Context())
+		if err != nil {
+			jsonAPIError(c, http.StatusInternalServerError, errors.Wrapf(err, "failed to check nonces on chain %s", chain.ID()))
+			return
+		}
+		for _, status := range statuses {
+			resources = append(resources, presenters.NewNonceStatusResource(status))
+		}
+	}
+
+	jsonAPIResponse(c, resources, "nonceStatuses")
+}
+
 // setEthBalance is a custom functional option for NewEthKeyResource which
 // queries the EthClient for the ETH balance at the address and sets it on the
 // resource.
@@ -194,3 +194,43 @@ func TestETHKeysController_UpdateSuccess(t *testing.T) {
 
 	require.Equal(t, assets.GWei(777), chain.Config().KeySpecificMaxGasPriceWei(key.Address.Address()))
 }
+
+func TestETHKeysController_NonceStatus(t *testing.T) {
+	t.Parallel()
+
+	ethClient, _, assertMocksCalled := cltest.NewEthMocksWithStartupAssertions(t)
+	defer assertMocksCalled()
+	cfg := cltest.NewTestGeneralConfig(t)
+	cfg.Overrides.GlobalEvmNonceAutoSync = null.BoolFrom(false)
+	cfg.Overrides.GlobalBalanceMonitorEnabled = null.BoolFrom(false)
+	app := cltest.NewApplicationWithConfig(t, cfg, ethClient)
+
+	_, address := cltest.MustInsertRandomKey(t, app.KeyStore.Eth(), int64(2))
+
+	ethClient.On("NonceAt", mock.Anything, address, (*big.Int)(nil)).Return(uint64(5), nil).Once()
+	ethClient.On("PendingNonceAt", mock.Anything, address).Return(uint64(6), nil).Once()
+
+	require.NoError(t, app.Start())
+
+	client := app.NewHTTPClient()
+	resp, cleanup := client.Get("/v2/keys/eth/nonce_status")
+	defer cleanup()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var statuses []webpresenters.NonceStatusResource
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &statuses))
+	require.Len(t, statuses, 1)
+
+	status := statuses[0]
+	assert.Equal(t, address.Hex(), status.Address)
+	assert.Equal(t, cltest.FixtureChainID.String(), status.EVMChainID.String())
+	assert.Equal(t, int64(2), status.NextNonce)
+	assert.Equal(t, uint64(5), status.LatestNonce)
+	assert.Equal(t, uint64(6), status.PendingNonce)
+	assert.Len(t, status.Gaps, 0)
+	assert.True(t, status.ChainAhead)
+
+	resp, cleanup = client.Get("/v2/keys/eth/nonce_status?evmChainID=foo")
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusUnprocessableEntity)
+}
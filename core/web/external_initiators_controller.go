@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"regexp"
 
+	"github.com/smartcontractkit/chainlink/core/audit"
 	"github.com/smartcontractkit/chainlink/core/auth"
 	"github.com/smartcontractkit/chainlink/core/bridges"
 	"github.com/smartcontractkit/chainlink/core/services/chainlink"
@@ -83,6 +84,7 @@ func (eic *ExternalInitiatorsController) Create(c *gin.Context) {
 		jsonAPIError(c, http.StatusInternalServerError, err)
 		return
 	}
+	recordAudit(eic.App, c, audit.ActionExternalInitiatorCreated, ei.Name, audit.NewDiff(nil, eir))
 
 	resp := presenters.NewExternalInitiatorAuthentication(*ei, *eia)
 	jsonAPIResponseWithStatus(c, resp, "external initiator authentication", http.StatusCreated)
@@ -100,6 +102,7 @@ func (eic *ExternalInitiatorsController) Destroy(c *gin.Context) {
 		jsonAPIError(c, http.StatusInternalServerError, err)
 		return
 	}
+	recordAudit(eic.App, c, audit.ActionExternalInitiatorDeleted, exi.Name, nil)
 
 	jsonAPIResponseWithStatus(c, nil, "external initiator", http.StatusNoContent)
 }
@@ -7,6 +7,7 @@ import (
 	"strconv"
 
 	"github.com/gin-gonic/gin"
+	"github.com/smartcontractkit/chainlink/core/audit"
 	"github.com/smartcontractkit/chainlink/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/core/services/feeds"
 	"github.com/smartcontractkit/chainlink/core/utils/crypto"
@@ -62,6 +63,7 @@ func (fmc *FeedsManagerController) Create(c *gin.Context) {
 		jsonAPIError(c, http.StatusInternalServerError, err)
 		return
 	}
+	recordAudit(fmc.App, c, audit.ActionFeedsManagerCreated, ms.Name, audit.NewDiff(nil, presenters.NewFeedsManagerResource(*ms)))
 
 	jsonAPIResponseWithStatus(c,
 		presenters.NewFeedsManagerResource(*ms),
@@ -146,6 +148,17 @@ func (fmc *FeedsManagerController) Update(c *gin.Context) {
 
 	feedsService := fmc.App.GetFeedsService()
 
+	before, err := feedsService.GetManager(id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			jsonAPIError(c, http.StatusNotFound, errors.New("feeds Manager not found"))
+			return
+		}
+
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
 	err = feedsService.UpdateFeedsManager(c.Request.Context(), *mgr)
 	if err != nil {
 		jsonAPIError(c, http.StatusBadRequest, err)
@@ -157,6 +170,7 @@ func (fmc *FeedsManagerController) Update(c *gin.Context) {
 		jsonAPIError(c, http.StatusInternalServerError, err)
 		return
 	}
+	recordAudit(fmc.App, c, audit.ActionFeedsManagerUpdated, mgr.Name, audit.NewDiff(presenters.NewFeedsManagerResource(*before), presenters.NewFeedsManagerResource(*mgr)))
 
 	jsonAPIResponseWithStatus(c,
 		presenters.NewFeedsManagerResource(*mgr),
@@ -8,7 +8,11 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/manyminds/api2go/jsonapi"
 	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/audit"
+	"github.com/smartcontractkit/chainlink/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/core/store/models"
+	webauth "github.com/smartcontractkit/chainlink/core/web/auth"
 )
 
 // jsonAPIError adds an error to the gin context and sets
@@ -68,3 +72,9 @@ func jsonAPIResponseWithStatus(c *gin.Context, resource interface{}, name string
 func jsonAPIResponse(c *gin.Context, resource interface{}, name string) {
 	jsonAPIResponseWithStatus(c, resource, name, http.StatusOK)
 }
+
+// recordAudit appends an entry to the audit log for a privileged action that
+// the authenticated user has just taken.
+func recordAudit(app chainlink.Application, c *gin.Context, action audit.Action, target string, diff audit.Diff) {
+	app.AuditLogger().Record(webauth.GetAuditActor(c), action, target, diff)
+}
@@ -7,6 +7,7 @@ import (
 	"strconv"
 
 	"github.com/gin-gonic/gin"
+	"github.com/smartcontractkit/chainlink/core/audit"
 	"github.com/smartcontractkit/chainlink/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/core/web/presenters"
 )
@@ -87,6 +88,8 @@ func (jpc *JobProposalsController) Approve(c *gin.Context) {
 		return
 	}
 
+	recordAudit(jpc.App, c, audit.ActionJobProposalApproved, strconv.FormatInt(id, 10), nil)
+
 	jsonAPIResponseWithStatus(c,
 		presenters.NewJobProposalResource(*jp),
 		"job_proposals",
@@ -128,6 +131,8 @@ func (jpc *JobProposalsController) Reject(c *gin.Context) {
 		return
 	}
 
+	recordAudit(jpc.App, c, audit.ActionJobProposalRejected, strconv.FormatInt(id, 10), nil)
+
 	jsonAPIResponseWithStatus(c,
 		presenters.NewJobProposalResource(*jp),
 		"job_proposals",
@@ -171,6 +176,8 @@ func (jpc *JobProposalsController) Cancel(c *gin.Context) {
 		return
 	}
 
+	recordAudit(jpc.App, c, audit.ActionJobProposalCancelled, strconv.FormatInt(id, 10), nil)
+
 	jsonAPIResponseWithStatus(c,
 		presenters.NewJobProposalResource(*jp),
 		"job_proposals",
@@ -222,6 +229,8 @@ func (jpc *JobProposalsController) UpdateSpec(c *gin.Context) {
 		return
 	}
 
+	recordAudit(jpc.App, c, audit.ActionJobProposalSpecUpdated, strconv.FormatInt(id, 10), audit.NewDiff(nil, map[string]interface{}{"spec": request.Spec}))
+
 	jsonAPIResponseWithStatus(c,
 		presenters.NewJobProposalResource(*jp),
 		"job_proposals",
@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"net/http"
+	"strconv"
 	"time"
 
 	"github.com/smartcontractkit/chainlink/core/services/offchainreporting2"
@@ -12,17 +13,22 @@ import (
 	"github.com/pkg/errors"
 	uuid "github.com/satori/go.uuid"
 
+	"github.com/smartcontractkit/chainlink/core/audit"
+	"github.com/smartcontractkit/chainlink/core/services/blocktrigger"
 	"github.com/smartcontractkit/chainlink/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/core/services/cron"
 	"github.com/smartcontractkit/chainlink/core/services/directrequest"
+	"github.com/smartcontractkit/chainlink/core/services/eventtrigger"
 	"github.com/smartcontractkit/chainlink/core/services/fluxmonitorv2"
 	"github.com/smartcontractkit/chainlink/core/services/job"
 	"github.com/smartcontractkit/chainlink/core/services/keeper"
 	"github.com/smartcontractkit/chainlink/core/services/keystore"
 	"github.com/smartcontractkit/chainlink/core/services/offchainreporting"
 	"github.com/smartcontractkit/chainlink/core/services/pg"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/core/services/vrf"
 	"github.com/smartcontractkit/chainlink/core/services/webhook"
+	"github.com/smartcontractkit/chainlink/core/utils/stringutils"
 	"github.com/smartcontractkit/chainlink/core/web/presenters"
 )
 
@@ -87,6 +93,11 @@ type CreateJobRequest struct {
 	TOML string `json:"toml"`
 }
 
+// UpdateJobRequest represents a request to update the pipeline of a job (V2).
+type UpdateJobRequest struct {
+	TOML string `json:"toml"`
+}
+
 // Create validates, saves and starts a new job.
 // Example:
 // "POST <application>/jobs"
@@ -97,61 +108,196 @@ func (jc *JobsController) Create(c *gin.Context) {
 		return
 	}
 
-	jobType, err := job.ValidateSpec(request.TOML)
+	jb, status, err := jc.validatedJob(request.TOML)
 	if err != nil {
-		jsonAPIError(c, http.StatusUnprocessableEntity, errors.Wrap(err, "failed to parse TOML"))
+		jsonAPIError(c, status, err)
 		return
 	}
 
-	var jb job.Job
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
+	defer cancel()
+	err = jc.App.AddJobV2(ctx, &jb)
+	if err != nil {
+		if errors.Cause(err) == job.ErrNoSuchKeyBundle || errors.As(err, &keystore.KeyNotFoundError{}) || errors.Cause(err) == job.ErrNoSuchTransmitterAddress {
+			jsonAPIError(c, http.StatusBadRequest, err)
+			return
+		}
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	recordAudit(jc.App, c, audit.ActionJobCreated, strconv.FormatInt(int64(jb.ID), 10), audit.NewDiff(nil, map[string]interface{}{
+		"type":          jb.Type,
+		"name":          jb.Name.ValueOrZero(),
+		"externalJobID": jb.ExternalJobID,
+		"toml":          request.TOML,
+	}))
+
+	jsonAPIResponse(c, presenters.NewJobResource(jb), jb.Type.String())
+}
+
+// validatedJob parses and validates the TOML spec of a job, returning the
+// HTTP status to respond with if it is invalid.
+func (jc *JobsController) validatedJob(toml string) (jb job.Job, status int, err error) {
+	jobType, err := job.ValidateSpec(toml)
+	if err != nil {
+		return jb, http.StatusUnprocessableEntity, errors.Wrap(err, "failed to parse TOML")
+	}
+
 	config := jc.App.GetConfig()
 	switch jobType {
 	case job.OffchainReporting:
-		jb, err = offchainreporting.ValidatedOracleSpecToml(jc.App.GetChainSet(), request.TOML)
+		jb, err = offchainreporting.ValidatedOracleSpecToml(jc.App.GetChainSet(), toml)
 		if !config.Dev() && !config.FeatureOffchainReporting() {
-			jsonAPIError(c, http.StatusNotImplemented, errors.New("The Offchain Reporting feature is disabled by configuration"))
-			return
+			return jb, http.StatusNotImplemented, errors.New("The Offchain Reporting feature is disabled by configuration")
 		}
 	case job.OffchainReporting2:
-		jb, err = offchainreporting2.ValidatedOracleSpecToml(jc.App.GetChainSet(), request.TOML)
+		jb, err = offchainreporting2.ValidatedOracleSpecToml(jc.App.GetChainSet(), toml)
 		if !config.Dev() && !config.FeatureOffchainReporting2() {
-			jsonAPIError(c, http.StatusNotImplemented, errors.New("The Offchain Reporting 2 feature is disabled by configuration"))
-			return
+			return jb, http.StatusNotImplemented, errors.New("The Offchain Reporting 2 feature is disabled by configuration")
 		}
 	case job.DirectRequest:
-		jb, err = directrequest.ValidatedDirectRequestSpec(request.TOML)
+		jb, err = directrequest.ValidatedDirectRequestSpec(toml)
 	case job.FluxMonitor:
-		jb, err = fluxmonitorv2.ValidatedFluxMonitorSpec(jc.App.GetConfig(), request.TOML)
+		jb, err = fluxmonitorv2.ValidatedFluxMonitorSpec(jc.App.GetConfig(), toml)
 	case job.Keeper:
-		jb, err = keeper.ValidatedKeeperSpec(request.TOML)
+		jb, err = keeper.ValidatedKeeperSpec(toml)
 	case job.Cron:
-		jb, err = cron.ValidatedCronSpec(request.TOML)
+		jb, err = cron.ValidatedCronSpec(toml)
 	case job.VRF:
-		jb, err = vrf.ValidatedVRFSpec(request.TOML)
+		jb, err = vrf.ValidatedVRFSpec(toml)
+	case job.EventTrigger:
+		jb, err = eventtrigger.ValidatedEventTriggerSpec(toml)
+	case job.BlockTrigger:
+		jb, err = blocktrigger.ValidatedBlockTriggerSpec(toml)
 	case job.Webhook:
-		jb, err = webhook.ValidatedWebhookSpec(request.TOML, jc.App.GetExternalInitiatorManager())
+		jb, err = webhook.ValidatedWebhookSpec(toml, jc.App.GetExternalInitiatorManager())
 	default:
-		jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("unknown job type: %s", jobType))
+		return jb, http.StatusUnprocessableEntity, errors.Errorf("unknown job type: %s", jobType)
+	}
+	if err != nil {
+		return jb, http.StatusBadRequest, err
+	}
+	return jb, http.StatusOK, nil
+}
+
+// Update stores the pipeline of the given TOML spec as a new version of the
+// job, and restarts the job with it. The type and external job ID of the job
+// cannot be changed.
+// Example:
+// "PUT <application>/jobs/:ID"
+func (jc *JobsController) Update(c *gin.Context) {
+	j := job.Job{}
+	err := j.SetID(c.Param("ID"))
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
 		return
 	}
+	request := UpdateJobRequest{}
+	if err = c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	if _, err = jc.App.JobORM().FindJobTx(j.ID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			jsonAPIError(c, http.StatusNotFound, errors.New("job not found"))
+		} else {
+			jsonAPIError(c, http.StatusInternalServerError, err)
+		}
+		return
+	}
+
+	jb, status, err := jc.validatedJob(request.TOML)
 	if err != nil {
-		jsonAPIError(c, http.StatusBadRequest, err)
+		jsonAPIError(c, status, err)
 		return
 	}
+	jb.ID = j.ID
 
 	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
 	defer cancel()
-	err = jc.App.AddJobV2(ctx, &jb)
+	err = jc.App.UpdateJobV2(ctx, &jb)
+	if errors.Is(err, job.ErrJobTypeChanged) || errors.Is(err, job.ErrExternalJobIDChanged) || errors.Is(err, job.ErrJobSettingChanged) || errors.Is(err, pipeline.ErrNoSuchBridge) {
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	} else if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	recordAudit(jc.App, c, audit.ActionJobUpdated, strconv.FormatInt(int64(jb.ID), 10), audit.NewDiff(nil, map[string]interface{}{
+		"version": jb.PipelineSpec.Version,
+		"toml":    request.TOML,
+	}))
+
+	jsonAPIResponse(c, presenters.NewJobResource(jb), jb.Type.String())
+}
+
+// Versions lists every version of the job's pipeline, latest first.
+// Example:
+// "GET <application>/jobs/:ID/versions"
+func (jc *JobsController) Versions(c *gin.Context) {
+	j := job.Job{}
+	err := j.SetID(c.Param("ID"))
 	if err != nil {
-		if errors.Cause(err) == job.ErrNoSuchKeyBundle || errors.As(err, &keystore.KeyNotFoundError{}) || errors.Cause(err) == job.ErrNoSuchTransmitterAddress {
-			jsonAPIError(c, http.StatusBadRequest, err)
-			return
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	jb, err := jc.App.JobORM().FindJobTx(j.ID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			jsonAPIError(c, http.StatusNotFound, errors.New("job not found"))
+		} else {
+			jsonAPIError(c, http.StatusInternalServerError, err)
 		}
+		return
+	}
+
+	specs, err := jc.App.JobORM().FindJobVersions(jb.ID)
+	if err != nil {
 		jsonAPIError(c, http.StatusInternalServerError, err)
 		return
 	}
 
-	jsonAPIResponse(c, presenters.NewJobResource(jb), jb.Type.String())
+	jsonAPIResponse(c, presenters.NewJobVersionResources(jb, specs), "jobVersions")
+}
+
+// Rollback makes a previous version of the job's pipeline current again, and
+// restarts the job with it.
+// Example:
+// "POST <application>/jobs/:ID/versions/:version/rollback"
+func (jc *JobsController) Rollback(c *gin.Context) {
+	j := job.Job{}
+	err := j.SetID(c.Param("ID"))
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	version, err := stringutils.ToInt32(c.Param("version"))
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.Wrap(err, "invalid version"))
+		return
+	}
+	if _, err = jc.App.JobORM().FindJobTx(j.ID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			jsonAPIError(c, http.StatusNotFound, errors.New("job not found"))
+		} else {
+			jsonAPIError(c, http.StatusInternalServerError, err)
+		}
+		return
+	}
+
+	err = jc.App.RollbackJob(c.Request.Context(), j.ID, version)
+	if errors.Is(err, job.ErrNoSuchJobVersion) {
+		jsonAPIError(c, http.StatusNotFound, err)
+		return
+	} else if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	recordAudit(jc.App, c, audit.ActionJobRolledBack, strconv.FormatInt(int64(j.ID), 10), audit.NewDiff(nil, map[string]interface{}{
+		"version": version,
+	}))
+
+	jc.renderJob(c, j.ID)
 }
 
 // Delete hard deletes a job spec.
@@ -177,6 +323,95 @@ func (jc *JobsController) Delete(c *gin.Context) {
 
 		return
 	}
+	recordAudit(jc.App, c, audit.ActionJobDeleted, strconv.FormatInt(int64(j.ID), 10), nil)
 
 	jsonAPIResponseWithStatus(c, nil, "job", http.StatusNoContent)
 }
+
+// Pause stops the services of a job without deleting it.
+// Example:
+// "POST <application>/jobs/:ID/pause"
+func (jc *JobsController) Pause(c *gin.Context) {
+	j := job.Job{}
+	err := j.SetID(c.Param("ID"))
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	if _, err = jc.App.JobORM().FindJobTx(j.ID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			jsonAPIError(c, http.StatusNotFound, errors.New("job not found"))
+		} else {
+			jsonAPIError(c, http.StatusInternalServerError, err)
+		}
+		return
+	}
+
+	err = jc.App.PauseJob(c.Request.Context(), j.ID)
+	if errors.Is(err, job.ErrJobPaused) {
+		jsonAPIError(c, http.StatusConflict, err)
+		return
+	} else if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	recordAudit(jc.App, c, audit.ActionJobPaused, strconv.FormatInt(int64(j.ID), 10), nil)
+
+	jc.renderJob(c, j.ID)
+}
+
+// Resume starts the services of a paused job again. With ?backfill=true, logs
+// that were missed while the job was paused are replayed to it.
+// Example:
+// "POST <application>/jobs/:ID/resume"
+func (jc *JobsController) Resume(c *gin.Context) {
+	j := job.Job{}
+	err := j.SetID(c.Param("ID"))
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	var backfill bool
+	if c.Query("backfill") != "" {
+		backfill, err = strconv.ParseBool(c.Query("backfill"))
+		if err != nil {
+			jsonAPIError(c, http.StatusUnprocessableEntity, errors.Wrap(err, "invalid backfill"))
+			return
+		}
+	}
+	if _, err = jc.App.JobORM().FindJobTx(j.ID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			jsonAPIError(c, http.StatusNotFound, errors.New("job not found"))
+		} else {
+			jsonAPIError(c, http.StatusInternalServerError, err)
+		}
+		return
+	}
+
+	err = jc.App.ResumeJob(c.Request.Context(), j.ID, backfill)
+	if errors.Is(err, job.ErrJobNotPaused) {
+		jsonAPIError(c, http.StatusConflict, err)
+		return
+	} else if errors.Is(err, job.ErrJobNotBackfillable) {
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	} else if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	recordAudit(jc.App, c, audit.ActionJobResumed, strconv.FormatInt(int64(j.ID), 10), audit.NewDiff(nil, map[string]interface{}{
+		"backfill": backfill,
+	}))
+
+	jc.renderJob(c, j.ID)
+}
+
+func (jc *JobsController) renderJob(c *gin.Context, id int32) {
+	jb, err := jc.App.JobORM().FindJobTx(id)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewJobResource(jb), "jobs")
+}
@@ -9,6 +9,7 @@ import (
 	"io/ioutil"
 	"net/http"
 	"strconv"
+	"strings"
 	"sync"
 	"testing"
 	"time"
@@ -445,6 +446,122 @@ func TestJobsController_Show_NonExistentID(t *testing.T) {
 	cltest.AssertServerResponse(t, response, http.StatusNotFound)
 }
 
+func TestJobsController_PauseResume(t *testing.T) {
+	app, client, _, _, _, jobID2 := setupJobSpecsControllerTestsWithJobs(t)
+	path := "/v2/jobs/" + fmt.Sprintf("%v", jobID2)
+
+	response, cleanup := client.Post(path+"/pause", nil)
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusOK)
+
+	resource := presenters.JobResource{}
+	err := web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &resource)
+	require.NoError(t, err)
+	assert.True(t, resource.PausedAt.Valid)
+
+	jb, err := app.JobORM().FindJob(context.Background(), jobID2)
+	require.NoError(t, err)
+	assert.True(t, jb.Paused())
+
+	response, cleanup = client.Post(path+"/pause", nil)
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusConflict)
+
+	response, cleanup = client.Post(path+"/resume", nil)
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusOK)
+
+	resource = presenters.JobResource{}
+	err = web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &resource)
+	require.NoError(t, err)
+	assert.False(t, resource.PausedAt.Valid)
+
+	response, cleanup = client.Post(path+"/resume", nil)
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusConflict)
+}
+
+func TestJobsController_PauseResume_NonExistentID(t *testing.T) {
+	_, client, _, _, _, _ := setupJobSpecsControllerTestsWithJobs(t)
+
+	response, cleanup := client.Post("/v2/jobs/999999999/pause", nil)
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusNotFound)
+
+	response, cleanup = client.Post("/v2/jobs/999999999/resume", nil)
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusNotFound)
+}
+
+func TestJobsController_UpdateAndRollback(t *testing.T) {
+	_, client, _, _, _, jobID2 := setupJobSpecsControllerTestsWithJobs(t)
+	path := "/v2/jobs/" + fmt.Sprintf("%v", jobID2)
+
+	body, err := json.Marshal(web.UpdateJobRequest{
+		TOML: strings.Replace(string(cltest.MustReadFile(t, "../testdata/tomlspecs/direct-request-spec.toml")), "times=100", "times=1000", 1),
+	})
+	require.NoError(t, err)
+	response, cleanup := client.Put(path, bytes.NewReader(body))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusOK)
+
+	resource := presenters.JobResource{}
+	err = web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &resource)
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), resource.PipelineSpec.Version)
+	assert.Contains(t, resource.PipelineSpec.DotDAGSource, "times=1000")
+
+	response, cleanup = client.Get(path + "/versions")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusOK)
+
+	var versions []presenters.JobVersionResource
+	err = web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &versions)
+	require.NoError(t, err)
+	require.Len(t, versions, 2)
+	assert.Equal(t, int32(2), versions[0].Version)
+	assert.True(t, versions[0].Current)
+	assert.False(t, versions[1].Current)
+
+	response, cleanup = client.Post(path+"/versions/1/rollback", nil)
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusOK)
+
+	resource = presenters.JobResource{}
+	err = web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &resource)
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), resource.PipelineSpec.Version)
+	assert.Contains(t, resource.PipelineSpec.DotDAGSource, "times=100;")
+
+	response, cleanup = client.Post(path+"/versions/42/rollback", nil)
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusNotFound)
+}
+
+func TestJobsController_Update_JobTypeChanged(t *testing.T) {
+	_, client, _, _, _, jobID2 := setupJobSpecsControllerTestsWithJobs(t)
+
+	body, err := json.Marshal(web.UpdateJobRequest{
+		TOML: string(cltest.MustReadFile(t, "../testdata/tomlspecs/cron-spec.toml")),
+	})
+	require.NoError(t, err)
+	response, cleanup := client.Put("/v2/jobs/"+fmt.Sprintf("%v", jobID2), bytes.NewReader(body))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusBadRequest)
+}
+
+func TestJobsController_Update_NonExistentID(t *testing.T) {
+	_, client, _, _, _, _ := setupJobSpecsControllerTestsWithJobs(t)
+
+	body, err := json.Marshal(web.UpdateJobRequest{
+		TOML: string(cltest.MustReadFile(t, "../testdata/tomlspecs/direct-request-spec.toml")),
+	})
+	require.NoError(t, err)
+	response, cleanup := client.Put("/v2/jobs/999999999", bytes.NewReader(body))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusNotFound)
+}
+
 func runOCRJobSpecAssertions(t *testing.T, ocrJobSpecFromFileDB job.Job, ocrJobSpecFromServer presenters.JobResource) {
 	ocrJobSpecFromFile := ocrJobSpecFromFileDB.OffchainreportingOracleSpec
 	assert.Equal(t, ocrJobSpecFromFile.ContractAddress, ocrJobSpecFromServer.OffChainReportingSpec.ContractAddress)
@@ -0,0 +1,131 @@
+package web
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/audit"
+	"github.com/smartcontractkit/chainlink/core/services/bulletprooftxmanager"
+	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+// KeyPoolsController manages the named pools of sending keys that jobs send
+// their transactions from
+type KeyPoolsController struct {
+	App chainlink.Application
+}
+
+// KeyPoolRequest creates or replaces a key pool
+type KeyPoolRequest struct {
+	Name     string                  `json:"name"`
+	Strategy string                  `json:"strategy"`
+	Keys     []presenters.KeyPoolKey `json:"keys"`
+}
+
+// Index lists the key pools
+// Example:
+//  "GET <application>/key_pools"
+func (kpc *KeyPoolsController) Index(c *gin.Context) {
+	pools, err := kpc.App.BPTXMORM().FindKeyPools()
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	jsonAPIResponse(c, presenters.NewKeyPoolResources(pools), "keyPools")
+}
+
+// Show returns the key pool with the given name
+// Example:
+//  "GET <application>/key_pools/:name"
+func (kpc *KeyPoolsController) Show(c *gin.Context) {
+	pool, err := kpc.App.BPTXMORM().FindKeyPool(c.Param("name"))
+	if errors.Is(err, bulletprooftxmanager.ErrKeyPoolNotFound) {
+		jsonAPIError(c, http.StatusNotFound, err)
+		return
+	} else if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	jsonAPIResponse(c, presenters.NewKeyPoolResource(pool), "keyPools")
+}
+
+// Create creates a key pool, or replaces the strategy and keys of the key
+// pool with the same name. Weights default to 1.
+// Example:
+//  "POST <application>/key_pools"
+func (kpc *KeyPoolsController) Create(c *gin.Context) {
+	var request KeyPoolRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	strategy, err := bulletprooftxmanager.ParseKeyPoolStrategy(request.Strategy)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	ethKeyStore := kpc.App.GetKeyStore().Eth()
+	pool := bulletprooftxmanager.KeyPool{Name: request.Name, Strategy: strategy}
+	for _, key := range request.Keys {
+		if _, err = ethKeyStore.Get(key.Address.Hex()); err != nil {
+			jsonAPIError(c, http.StatusUnprocessableEntity, errors.Wrapf(err, "key %s", key.Address.Hex()))
+			return
+		}
+		weight := key.Weight
+		if weight == 0 {
+			weight = 1
+		}
+		pool.Members = append(pool.Members, bulletprooftxmanager.KeyPoolMember{Address: key.Address, Weight: weight})
+	}
+	if err = pool.Validate(); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	orm := kpc.App.BPTXMORM()
+	before, err := orm.FindKeyPool(pool.Name)
+	exists := err == nil
+	if err != nil && !errors.Is(err, bulletprooftxmanager.ErrKeyPoolNotFound) {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	if err = orm.UpsertKeyPool(&pool); err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	resource := presenters.NewKeyPoolResource(pool)
+	if exists {
+		recordAudit(kpc.App, c, audit.ActionKeyPoolUpdated, pool.Name, audit.NewDiff(presenters.NewKeyPoolResource(before), resource))
+		jsonAPIResponse(c, resource, "keyPools")
+		return
+	}
+	recordAudit(kpc.App, c, audit.ActionKeyPoolCreated, pool.Name, audit.NewDiff(nil, resource))
+	jsonAPIResponseWithStatus(c, resource, "keyPools", http.StatusCreated)
+}
+
+// Delete deletes a key pool that no job sends from
+// Example:
+//  "DELETE <application>/key_pools/:name"
+func (kpc *KeyPoolsController) Delete(c *gin.Context) {
+	name := c.Param("name")
+	err := kpc.App.BPTXMORM().DeleteKeyPool(name)
+	switch {
+	case errors.Is(err, bulletprooftxmanager.ErrKeyPoolNotFound):
+		jsonAPIError(c, http.StatusNotFound, err)
+		return
+	case errors.Is(err, bulletprooftxmanager.ErrKeyPoolInUse):
+		jsonAPIError(c, http.StatusConflict, err)
+		return
+	case err != nil:
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	recordAudit(kpc.App, c, audit.ActionKeyPoolDeleted, name, nil)
+
+	jsonAPIResponseWithStatus(c, nil, "keyPools", http.StatusNoContent)
+}
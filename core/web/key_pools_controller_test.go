@@ -0,0 +1,114 @@
+package web_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/services/bulletprooftxmanager"
+	"github.com/smartcontractkit/chainlink/core/web"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+func TestKeyPoolsController_CRUD(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start())
+	client := app.NewHTTPClient()
+
+	_, addr1 := cltest.MustInsertRandomKey(t, app.KeyStore.Eth())
+	_, addr2 := cltest.MustInsertRandomKey(t, app.KeyStore.Eth())
+
+	post := func(t *testing.T, request web.KeyPoolRequest, status int) presenters.KeyPoolResource {
+		body, err := json.Marshal(request)
+		require.NoError(t, err)
+		resp, cleanup := client.Post("/v2/key_pools", bytes.NewReader(body))
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, status)
+
+		var resource presenters.KeyPoolResource
+		if status < http.StatusBadRequest {
+			require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &resource))
+		}
+		return resource
+	}
+
+	resource := post(t, web.KeyPoolRequest{
+		Name:     "vrf",
+		Strategy: "weighted",
+		Keys:     []presenters.KeyPoolKey{{Address: addr1, Weight: 3}, {Address: addr2}},
+	}, http.StatusCreated)
+	assert.Equal(t, "vrf", resource.ID)
+	assert.Equal(t, bulletprooftxmanager.KeyPoolStrategyWeighted, resource.Strategy)
+	require.Len(t, resource.Keys, 2)
+	weights := map[string]int32{}
+	for _, key := range resource.Keys {
+		weights[key.Address.Hex()] = key.Weight
+	}
+	assert.Equal(t, map[string]int32{addr1.Hex(): 3, addr2.Hex(): 1}, weights)
+
+	resource = post(t, web.KeyPoolRequest{
+		Name:     "vrf",
+		Strategy: "least_unconfirmed",
+		Keys:     []presenters.KeyPoolKey{{Address: addr2}},
+	}, http.StatusOK)
+	assert.Equal(t, bulletprooftxmanager.KeyPoolStrategyLeastUnconfirmed, resource.Strategy)
+	require.Len(t, resource.Keys, 1)
+	assert.Equal(t, addr2, resource.Keys[0].Address)
+
+	post(t, web.KeyPoolRequest{Name: "ocr", Strategy: "fastest", Keys: []presenters.KeyPoolKey{{Address: addr1}}}, http.StatusUnprocessableEntity)
+	post(t, web.KeyPoolRequest{Name: "ocr", Strategy: "round_robin", Keys: []presenters.KeyPoolKey{{Address: cltest.NewAddress()}}}, http.StatusUnprocessableEntity)
+	post(t, web.KeyPoolRequest{Name: "ocr", Strategy: "round_robin"}, http.StatusUnprocessableEntity)
+
+	resp, cleanup := client.Get("/v2/key_pools")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+	var resources []presenters.KeyPoolResource
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &resources))
+	require.Len(t, resources, 1)
+	assert.Equal(t, "vrf", resources[0].ID)
+
+	resp, cleanup = client.Get("/v2/key_pools/vrf")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	resp, cleanup = client.Delete("/v2/key_pools/vrf")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusNoContent)
+
+	resp, cleanup = client.Get("/v2/key_pools/vrf")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusNotFound)
+
+	resp, cleanup = client.Delete("/v2/key_pools/vrf")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusNotFound)
+}
+
+func TestKeyPoolsController_Delete_InUse(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start())
+	client := app.NewHTTPClient()
+
+	_, addr := cltest.MustInsertRandomKey(t, app.KeyStore.Eth())
+	require.NoError(t, app.BPTXMORM().UpsertKeyPool(&bulletprooftxmanager.KeyPool{
+		Name:     "ocr",
+		Strategy: bulletprooftxmanager.KeyPoolStrategyRoundRobin,
+		Members:  []bulletprooftxmanager.KeyPoolMember{{Address: addr, Weight: 1}},
+	}))
+	jb, _ := cltest.MustInsertWebhookSpec(t, app.GetSqlxDB())
+	_, err := app.GetSqlxDB().Exec(`UPDATE jobs SET key_pool = 'ocr' WHERE id = $1`, jb.ID)
+	require.NoError(t, err)
+
+	resp, cleanup := client.Delete("/v2/key_pools/ocr")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusConflict)
+}
@@ -0,0 +1,28 @@
+package loader
+
+import (
+	"context"
+
+	"github.com/graph-gophers/dataloader"
+
+	"github.com/smartcontractkit/chainlink/core/bridges"
+	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+)
+
+type bridgeBreakerStatusBatcher struct {
+	app chainlink.Application
+}
+
+// loadByNames fetches the live state of the circuit breaker of each bridge.
+// Bridges that have not been called yet have a closed breaker.
+func (b *bridgeBreakerStatusBatcher) loadByNames(_ context.Context, keys dataloader.Keys) []*dataloader.Result {
+	breakers := b.app.BridgeBreakers()
+
+	results := make([]*dataloader.Result, len(keys))
+	for ix, key := range keys {
+		status := breakers.Status(bridges.TaskType(key.String()))
+		results[ix] = &dataloader.Result{Data: status, Error: nil}
+	}
+
+	return results
+}
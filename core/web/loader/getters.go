@@ -7,7 +7,9 @@ import (
 	"github.com/pkg/errors"
 	"go.uber.org/multierr"
 
+	"github.com/smartcontractkit/chainlink/core/bridges"
 	"github.com/smartcontractkit/chainlink/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/core/services/eth"
 	"github.com/smartcontractkit/chainlink/core/services/feeds"
 	"github.com/smartcontractkit/chainlink/core/services/job"
 	"github.com/smartcontractkit/chainlink/core/services/pipeline"
@@ -50,6 +52,44 @@ func GetNodesByChainID(ctx context.Context, id string) ([]types.Node, error) {
 	return nodes, nil
 }
 
+// GetNodeStatusesByChainID fetches the live status of the primary nodes for a
+// chain.
+func GetNodeStatusesByChainID(ctx context.Context, id string) ([]eth.NodeStatus, error) {
+	ldr := For(ctx)
+
+	thunk := ldr.NodeStatusesByChainIDLoader.Load(ctx, dataloader.StringKey(id))
+	result, err := thunk()
+	if err != nil {
+		return nil, err
+	}
+
+	statuses, ok := result.([]eth.NodeStatus)
+	if !ok {
+		return nil, errors.New("invalid type")
+	}
+
+	return statuses, nil
+}
+
+// GetBreakerStatusByBridgeName fetches the live state of a bridge's circuit
+// breaker.
+func GetBreakerStatusByBridgeName(ctx context.Context, name string) (*bridges.BreakerStatus, error) {
+	ldr := For(ctx)
+
+	thunk := ldr.BreakerStatusesByBridgeLoader.Load(ctx, dataloader.StringKey(name))
+	result, err := thunk()
+	if err != nil {
+		return nil, err
+	}
+
+	status, ok := result.(bridges.BreakerStatus)
+	if !ok {
+		return nil, errors.New("invalid type")
+	}
+
+	return &status, nil
+}
+
 // GetFeedsManagerByID fetches the feed manager by ID.
 func GetFeedsManagerByID(ctx context.Context, id string) (*feeds.FeedsManager, error) {
 	ldr := For(ctx)
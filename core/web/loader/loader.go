@@ -15,30 +15,36 @@ type Dataloader struct {
 	app chainlink.Application
 
 	NodesByChainIDLoader          *dataloader.Loader
+	NodeStatusesByChainIDLoader   *dataloader.Loader
 	ChainsByIDLoader              *dataloader.Loader
 	FeedsManagersByIDLoader       *dataloader.Loader
 	JobRunsByIDLoader             *dataloader.Loader
 	JobsByPipelineSpecIDLoader    *dataloader.Loader
 	JobProposalsByManagerIDLoader *dataloader.Loader
+	BreakerStatusesByBridgeLoader *dataloader.Loader
 }
 
 func New(app chainlink.Application) *Dataloader {
 	nodes := &nodeBatcher{app: app}
+	nodeStatuses := &nodeStatusBatcher{app: app}
 	chains := &chainBatcher{app: app}
 	mgrs := &feedsBatcher{app: app}
 	jobRuns := &jobRunBatcher{app: app}
 	jps := &jobProposalBatcher{app: app}
 	jbs := &jobBatcher{app: app}
+	breakerStatuses := &bridgeBreakerStatusBatcher{app: app}
 
 	return &Dataloader{
 		app: app,
 
 		NodesByChainIDLoader:          dataloader.NewBatchedLoader(nodes.loadByChainIDs),
+		NodeStatusesByChainIDLoader:   dataloader.NewBatchedLoader(nodeStatuses.loadByChainIDs),
 		ChainsByIDLoader:              dataloader.NewBatchedLoader(chains.loadByIDs),
 		FeedsManagersByIDLoader:       dataloader.NewBatchedLoader(mgrs.loadByIDs),
 		JobRunsByIDLoader:             dataloader.NewBatchedLoader(jobRuns.loadByIDs),
 		JobsByPipelineSpecIDLoader:    dataloader.NewBatchedLoader(jbs.loadByPipelineSpecIDs),
 		JobProposalsByManagerIDLoader: dataloader.NewBatchedLoader(jps.loadByManagersIDs),
+		BreakerStatusesByBridgeLoader: dataloader.NewBatchedLoader(breakerStatuses.loadByNames),
 	}
 }
 
@@ -7,6 +7,7 @@ import (
 
 	"github.com/smartcontractkit/chainlink/core/chains/evm/types"
 	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/services/eth"
 	"github.com/smartcontractkit/chainlink/core/utils"
 )
 
@@ -57,3 +58,27 @@ func (b *nodeBatcher) loadByChainIDs(ctx context.Context, keys dataloader.Keys)
 
 	return results
 }
+
+type nodeStatusBatcher struct {
+	app chainlink.Application
+}
+
+// loadByChainIDs fetches the live status of the primary nodes of each running
+// chain. Chains that are not running have no statuses.
+func (b *nodeStatusBatcher) loadByChainIDs(_ context.Context, keys dataloader.Keys) []*dataloader.Result {
+	results := make([]*dataloader.Result, len(keys))
+	for ix, key := range keys {
+		statuses := []eth.NodeStatus{}
+
+		id := utils.Big{}
+		if err := id.UnmarshalText([]byte(key.String())); err == nil {
+			if chain, err := b.app.GetChainSet().Get(id.ToInt()); err == nil {
+				statuses = chain.Client().NodeStatuses()
+			}
+		}
+
+		results[ix] = &dataloader.Result{Data: statuses, Error: nil}
+	}
+
+	return results
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/gin-gonic/gin"
 	"go.uber.org/zap/zapcore"
 
+	"github.com/smartcontractkit/chainlink/core/audit"
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/core/web/presenters"
@@ -119,6 +120,18 @@ func (cc *LogController) Patch(c *gin.Context) {
 		}
 	}
 
+	changed := map[string]interface{}{}
+	if request.Level != "" {
+		changed["level"] = request.Level
+	}
+	if request.SqlEnabled != nil {
+		changed["sqlEnabled"] = *request.SqlEnabled
+	}
+	if len(request.ServiceLogLevel) > 0 {
+		changed["serviceLogLevel"] = request.ServiceLogLevel
+	}
+	recordAudit(cc.App, c, audit.ActionLogConfigUpdated, "log", audit.NewDiff(nil, changed))
+
 	response := &presenters.ServiceLogConfigResource{
 		JAID: presenters.JAID{
 			ID: "log",
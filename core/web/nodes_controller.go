@@ -4,8 +4,10 @@ import (
 	"net/http"
 	"strconv"
 
+	"github.com/smartcontractkit/chainlink/core/audit"
 	"github.com/smartcontractkit/chainlink/core/chains/evm/types"
 	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/services/eth"
 	"github.com/smartcontractkit/chainlink/core/utils"
 	"github.com/smartcontractkit/chainlink/core/web/presenters"
 
@@ -37,9 +39,18 @@ func (nc *NodesController) Index(c *gin.Context, size, page, offset int) {
 		nodes, count, err = nc.App.EVMORM().NodesForChain(chainID, offset, size)
 	}
 
+	statuses := make(map[string]map[string]eth.NodeStatus)
 	var resources []presenters.NodeResource
 	for _, node := range nodes {
-		resources = append(resources, presenters.NewNodeResource(node))
+		cid := node.EVMChainID.String()
+		if _, exists := statuses[cid]; !exists {
+			statuses[cid] = nc.nodeStatuses(node.EVMChainID)
+		}
+		var status *eth.NodeStatus
+		if s, exists := statuses[cid][node.Name]; exists {
+			status = &s
+		}
+		resources = append(resources, presenters.NewNodeResource(node, status))
 	}
 
 	paginatedResponse(c, "node", size, page, resources, count, err)
@@ -59,8 +70,23 @@ func (nc *NodesController) Create(c *gin.Context) {
 		jsonAPIError(c, http.StatusBadRequest, err)
 		return
 	}
+	recordAudit(nc.App, c, audit.ActionNodeCreated, strconv.FormatInt(int64(node.ID), 10), audit.NewDiff(nil, request))
+
+	jsonAPIResponse(c, presenters.NewNodeResource(node, nil), "node")
+}
 
-	jsonAPIResponse(c, presenters.NewNodeResource(node), "node")
+// nodeStatuses returns the live status of the primary nodes on the given
+// chain keyed by node name, or nil if the chain is not running
+func (nc *NodesController) nodeStatuses(chainID utils.Big) map[string]eth.NodeStatus {
+	chain, err := nc.App.GetChainSet().Get(chainID.ToInt())
+	if err != nil {
+		return nil
+	}
+	statuses := make(map[string]eth.NodeStatus)
+	for _, s := range chain.Client().NodeStatuses() {
+		statuses[s.Name] = s
+	}
+	return statuses
 }
 
 func (nc *NodesController) Delete(c *gin.Context) {
@@ -76,6 +102,7 @@ func (nc *NodesController) Delete(c *gin.Context) {
 		jsonAPIError(c, http.StatusInternalServerError, err)
 		return
 	}
+	recordAudit(nc.App, c, audit.ActionNodeDeleted, strconv.FormatInt(id, 10), nil)
 
 	jsonAPIResponseWithStatus(c, nil, "node", http.StatusNoContent)
 }
@@ -4,6 +4,7 @@ import (
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+	"github.com/smartcontractkit/chainlink/core/audit"
 	"github.com/smartcontractkit/chainlink/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/core/web/presenters"
 )
@@ -34,6 +35,7 @@ func (ocr2kc *OCR2KeysController) Create(c *gin.Context) {
 		jsonAPIError(c, http.StatusInternalServerError, err)
 		return
 	}
+	recordAudit(ocr2kc.App, c, audit.ActionKeyCreated, audit.KeyTarget("ocr2", key.ID()), nil)
 	jsonAPIResponse(c, presenters.NewOCR2KeysBundleResource(key), "offChainReportingKeyBundle")
 }
 
@@ -52,5 +54,6 @@ func (ocr2kc *OCR2KeysController) Delete(c *gin.Context) {
 		jsonAPIError(c, http.StatusInternalServerError, err)
 		return
 	}
+	recordAudit(ocr2kc.App, c, audit.ActionKeyDeleted, audit.KeyTarget("ocr2", key.ID()), nil)
 	jsonAPIResponse(c, presenters.NewOCR2KeysBundleResource(key), "offChainReportingKeyBundle")
 }
@@ -5,6 +5,7 @@ import (
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+	"github.com/smartcontractkit/chainlink/core/audit"
 	"github.com/smartcontractkit/chainlink/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/core/web/presenters"
 )
@@ -35,6 +36,7 @@ func (ocrkc *OCRKeysController) Create(c *gin.Context) {
 		jsonAPIError(c, http.StatusInternalServerError, err)
 		return
 	}
+	recordAudit(ocrkc.App, c, audit.ActionKeyCreated, audit.KeyTarget("ocr", key.ID()), nil)
 	jsonAPIResponse(c, presenters.NewOCRKeysBundleResource(key), "offChainReportingKeyBundle")
 }
 
@@ -54,6 +56,7 @@ func (ocrkc *OCRKeysController) Delete(c *gin.Context) {
 		jsonAPIError(c, http.StatusInternalServerError, err)
 		return
 	}
+	recordAudit(ocrkc.App, c, audit.ActionKeyDeleted, audit.KeyTarget("ocr", key.ID()), nil)
 	jsonAPIResponse(c, presenters.NewOCRKeysBundleResource(key), "offChainReportingKeyBundle")
 }
 
@@ -75,6 +78,7 @@ func (ocrkc *OCRKeysController) Import(c *gin.Context) {
 		return
 	}
 
+	recordAudit(ocrkc.App, c, audit.ActionKeyImported, audit.KeyTarget("ocr", encryptedOCRKeyBundle.ID()), nil)
 	jsonAPIResponse(c, encryptedOCRKeyBundle, "offChainReportingKeyBundle")
 }
 
@@ -92,5 +96,6 @@ func (ocrkc *OCRKeysController) Export(c *gin.Context) {
 		return
 	}
 
+	recordAudit(ocrkc.App, c, audit.ActionKeyExported, audit.KeyTarget("ocr", stringID), nil)
 	c.Data(http.StatusOK, MediaType, bytes)
 }
@@ -6,6 +6,7 @@ import (
 
 	"github.com/gin-gonic/gin"
 
+	"github.com/smartcontractkit/chainlink/core/audit"
 	"github.com/smartcontractkit/chainlink/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/p2pkey"
 	"github.com/smartcontractkit/chainlink/core/web/presenters"
@@ -37,6 +38,7 @@ func (p2pkc *P2PKeysController) Create(c *gin.Context) {
 		jsonAPIError(c, http.StatusInternalServerError, err)
 		return
 	}
+	recordAudit(p2pkc.App, c, audit.ActionKeyCreated, audit.KeyTarget("p2p", key.ID()), nil)
 	jsonAPIResponse(c, presenters.NewP2PKeyResource(key), "p2pKey")
 }
 
@@ -60,6 +62,7 @@ func (p2pkc *P2PKeysController) Delete(c *gin.Context) {
 		jsonAPIError(c, http.StatusInternalServerError, err)
 		return
 	}
+	recordAudit(p2pkc.App, c, audit.ActionKeyDeleted, audit.KeyTarget("p2p", key.ID()), nil)
 	jsonAPIResponse(c, presenters.NewP2PKeyResource(key), "p2pKey")
 }
 
@@ -81,6 +84,7 @@ func (p2pkc *P2PKeysController) Import(c *gin.Context) {
 		return
 	}
 
+	recordAudit(p2pkc.App, c, audit.ActionKeyImported, audit.KeyTarget("p2p", key.ID()), nil)
 	jsonAPIResponse(c, presenters.NewP2PKeyResource(key), "p2pKey")
 }
 
@@ -103,5 +107,6 @@ func (p2pkc *P2PKeysController) Export(c *gin.Context) {
 		return
 	}
 
+	recordAudit(p2pkc.App, c, audit.ActionKeyExported, audit.KeyTarget("p2p", keyID.String()), nil)
 	c.Data(http.StatusOK, MediaType, bytes)
 }
@@ -246,6 +246,36 @@ func TestPipelineRunsController_ShowRun_InvalidID(t *testing.T) {
 	cltest.AssertServerResponse(t, response, http.StatusUnprocessableEntity)
 }
 
+func TestPipelineRunsController_Cancel_FinishedRun(t *testing.T) {
+	client, _, runIDs := setupPipelineRunsControllerTests(t)
+
+	response, cleanup := client.Post("/v2/pipeline/runs/"+fmt.Sprintf("%v", runIDs[0])+"/cancel", nil)
+	defer cleanup()
+	cltest.AssertServerResponse(t, response, http.StatusConflict)
+}
+
+func TestPipelineRunsController_Cancel_NotFound(t *testing.T) {
+	t.Parallel()
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start())
+	client := app.NewHTTPClient()
+
+	response, cleanup := client.Post("/v2/pipeline/runs/1/cancel", nil)
+	defer cleanup()
+	cltest.AssertServerResponse(t, response, http.StatusNotFound)
+}
+
+func TestPipelineRunsController_Cancel_InvalidID(t *testing.T) {
+	t.Parallel()
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start())
+	client := app.NewHTTPClient()
+
+	response, cleanup := client.Post("/v2/pipeline/runs/invalid-run-ID/cancel", nil)
+	defer cleanup()
+	cltest.AssertServerResponse(t, response, http.StatusUnprocessableEntity)
+}
+
 func setupPipelineRunsControllerTests(t *testing.T) (cltest.HTTPClientCleaner, int32, []int64) {
 	t.Parallel()
 	ethClient, _, assertMocksCalled := cltest.NewEthMocksWithStartupAssertions(t)
@@ -17,7 +17,8 @@ type BridgeResource struct {
 	IncomingToken          string       `json:"incomingToken,omitempty"`
 	OutgoingToken          string       `json:"outgoingToken"`
 	MinimumContractPayment *assets.Link `json:"minimumContractPayment"`
-	CreatedAt              time.Time    `json:"createdAt"`
+	bridges.RequestPolicy
+	CreatedAt time.Time `json:"createdAt"`
 }
 
 // GetName implements the api2go EntityNamer interface
@@ -35,6 +36,28 @@ func NewBridgeResource(b bridges.BridgeType) *BridgeResource {
 		Confirmations:          b.Confirmations,
 		OutgoingToken:          b.OutgoingToken,
 		MinimumContractPayment: b.MinimumContractPayment,
+		RequestPolicy:          b.RequestPolicy,
 		CreatedAt:              b.CreatedAt,
 	}
 }
+
+// BridgeCacheFlushResource represents the result of flushing the responses
+// cached for a bridge. The ID is the bridge's name, or empty when the cache
+// was flushed for all bridges.
+type BridgeCacheFlushResource struct {
+	JAID
+	FlushedResponses int `json:"flushedResponses"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r BridgeCacheFlushResource) GetName() string {
+	return "bridgeCacheFlushes"
+}
+
+// NewBridgeCacheFlushResource constructs a new BridgeCacheFlushResource
+func NewBridgeCacheFlushResource(name string, flushed int) *BridgeCacheFlushResource {
+	return &BridgeCacheFlushResource{
+		JAID:             NewJAID(name),
+		FlushedResponses: flushed,
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/lib/pq"
 	"github.com/manyminds/api2go/jsonapi"
 	"github.com/smartcontractkit/chainlink/core/assets"
 	"github.com/smartcontractkit/chainlink/core/bridges"
@@ -26,7 +27,15 @@ func TestBridgeResource(t *testing.T) {
 		Confirmations:          1,
 		OutgoingToken:          "vjNL7X8Ea6GFJoa6PBsvK2ECzNK3b8IZ",
 		MinimumContractPayment: assets.NewLinkFromJuels(1),
-		CreatedAt:              timestamp,
+		RequestPolicy: bridges.RequestPolicy{
+			RetryOnStatusCodes:      pq.Int32Array{429, 503},
+			Retries:                 2,
+			AttemptTimeout:          models.Interval(5 * time.Second),
+			MaxConcurrency:          10,
+			BreakerFailureThreshold: 5,
+			CacheTTL:                models.Interval(10 * time.Second),
+		},
+		CreatedAt: timestamp,
 	}
 
 	r := NewBridgeResource(bridge)
@@ -45,6 +54,14 @@ func TestBridgeResource(t *testing.T) {
 			"confirmations":1,
 			"outgoingToken":"vjNL7X8Ea6GFJoa6PBsvK2ECzNK3b8IZ",
 			"minimumContractPayment":"1",
+			"retryOnStatusCodes":[429,503],
+			"retries":2,
+			"attemptTimeout":"5s",
+			"maxConcurrency":10,
+			"breakerFailureThreshold":5,
+			"breakerCooldown":"0s",
+			"cacheTTL":"10s",
+			"cacheMaxStale":"0s",
 			"createdAt":"2000-01-01T00:00:00Z"
 		}
 	}
@@ -70,6 +87,14 @@ func TestBridgeResource(t *testing.T) {
 			"incomingToken": "cd+OfGXy3UHEDAlD0y27F6/rJE14X1UI",
 			"outgoingToken":"vjNL7X8Ea6GFJoa6PBsvK2ECzNK3b8IZ",
 			"minimumContractPayment":"1",
+			"retryOnStatusCodes":[429,503],
+			"retries":2,
+			"attemptTimeout":"5s",
+			"maxConcurrency":10,
+			"breakerFailureThreshold":5,
+			"breakerCooldown":"0s",
+			"cacheTTL":"10s",
+			"cacheMaxStale":"0s",
 			"createdAt":"2000-01-01T00:00:00Z"
 		}
 	}
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/smartcontractkit/chainlink/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/core/services/eth"
 	"github.com/smartcontractkit/chainlink/core/utils"
 	"gopkg.in/guregu/null.v4"
 )
@@ -37,6 +38,9 @@ type NodeResource struct {
 	EVMChainID utils.Big   `json:"evmChainID"`
 	WSURL      null.String `json:"wsURL"`
 	HTTPURL    null.String `json:"httpURL"`
+	State      string      `json:"state"`
+	LastHead   null.Int    `json:"lastHead"`
+	ErrorCount uint64      `json:"errorCount"`
 	CreatedAt  time.Time   `json:"createdAt"`
 	UpdatedAt  time.Time   `json:"updatedAt"`
 }
@@ -46,14 +50,29 @@ func (r NodeResource) GetName() string {
 	return "node"
 }
 
-func NewNodeResource(node types.Node) NodeResource {
-	return NodeResource{
+// NodeStateUnknown is reported for nodes that are not running as a primary,
+// e.g. send-only nodes or nodes belonging to a disabled chain
+const NodeStateUnknown = "Unknown"
+
+// NewNodeResource creates a NodeResource. The live status may be nil if the
+// node is not running as a primary.
+func NewNodeResource(node types.Node, status *eth.NodeStatus) NodeResource {
+	r := NodeResource{
 		JAID:       NewJAIDInt32(node.ID),
 		Name:       node.Name,
 		EVMChainID: node.EVMChainID,
 		WSURL:      node.WSURL,
 		HTTPURL:    node.HTTPURL,
+		State:      NodeStateUnknown,
 		CreatedAt:  node.CreatedAt,
 		UpdatedAt:  node.UpdatedAt,
 	}
+	if status != nil {
+		r.State = status.State.String()
+		if status.LatestBlockNumber >= 0 {
+			r.LastHead = null.IntFrom(status.LatestBlockNumber)
+		}
+		r.ErrorCount = status.Errors
+	}
+	return r
 }
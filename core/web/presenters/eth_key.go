@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/smartcontractkit/chainlink/core/assets"
+	"github.com/smartcontractkit/chainlink/core/services/bulletprooftxmanager"
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
 	"github.com/smartcontractkit/chainlink/core/utils"
 )
@@ -83,3 +84,43 @@ func SetETHKeyMaxGasPriceWei(maxGasPriceWei utils.Big) NewETHKeyOption {
 		return nil
 	}
 }
+
+// NonceStatusResource represents the result of checking the nonces of an ETH
+// key against the chain
+type NonceStatusResource struct {
+	JAID
+	EVMChainID   utils.Big `json:"evmChainID"`
+	Address      string    `json:"address"`
+	NextNonce    int64     `json:"nextNonce"`
+	LatestNonce  uint64    `json:"latestNonce"`
+	PendingNonce uint64    `json:"pendingNonce"`
+	InFlight     int64     `json:"inFlight"`
+	Gaps         []int64   `json:"gaps"`
+	ChainAhead   bool      `json:"chainAhead"`
+	CheckedAt    time.Time `json:"checkedAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r NonceStatusResource) GetName() string {
+	return "nonceStatuses"
+}
+
+// NewNonceStatusResource constructs a new NonceStatusResource
+func NewNonceStatusResource(s bulletprooftxmanager.NonceStatus) NonceStatusResource {
+	gaps := s.Gaps
+	if gaps == nil {
+		gaps = []int64{}
+	}
+	return NonceStatusResource{
+		JAID:         NewJAID(s.Address.Hex()),
+		EVMChainID:   s.EVMChainID,
+		Address:      s.Address.Hex(),
+		NextNonce:    s.NextNonce,
+		LatestNonce:  s.LatestNonce,
+		PendingNonce: s.PendingNonce,
+		InFlight:     s.InFlight,
+		Gaps:         gaps,
+		ChainAhead:   s.ChainAhead,
+		CheckedAt:    s.CheckedAt,
+	}
+}
@@ -33,6 +33,8 @@ const (
 	CronJobSpec              JobSpecType = "cron"
 	VRFJobSpec               JobSpecType = "vrf"
 	WebhookJobSpec           JobSpecType = "webhook"
+	EventTriggerJobSpec      JobSpecType = "eventtrigger"
+	BlockTriggerJobSpec      JobSpecType = "blocktrigger"
 )
 
 // DirectRequestSpec defines the spec details of a DirectRequest Job
@@ -211,6 +213,7 @@ func NewOffChainReporting2Spec(spec *job.OffchainReporting2OracleSpec) *OffChain
 type PipelineSpec struct {
 	ID           int32  `json:"id"`
 	JobID        int32  `json:"jobID"`
+	Version      int32  `json:"version"`
 	DotDAGSource string `json:"dotDagSource"`
 }
 
@@ -219,6 +222,7 @@ func NewPipelineSpec(spec *pipeline.Spec) PipelineSpec {
 	return PipelineSpec{
 		ID:           spec.ID,
 		JobID:        spec.JobID,
+		Version:      spec.Version,
 		DotDAGSource: spec.DotDagSource,
 	}
 }
@@ -259,17 +263,73 @@ func NewWebhookSpec(spec *job.WebhookSpec) *WebhookSpec {
 
 // CronSpec defines the spec details of a Cron Job
 type CronSpec struct {
-	CronSchedule string    `json:"schedule" tom:"schedule"`
-	CreatedAt    time.Time `json:"createdAt"`
-	UpdatedAt    time.Time `json:"updatedAt"`
+	CronSchedule      string                    `json:"schedule" tom:"schedule"`
+	ConcurrencyPolicy job.CronConcurrencyPolicy `json:"concurrencyPolicy"`
+	CatchUpWindow     string                    `json:"catchUpWindow"`
+	Jitter            string                    `json:"jitter"`
+	Timezone          string                    `json:"timezone"`
+	CreatedAt         time.Time                 `json:"createdAt"`
+	UpdatedAt         time.Time                 `json:"updatedAt"`
 }
 
 // NewCronSpec generates a new CronSpec from a job.CronSpec
 func NewCronSpec(spec *job.CronSpec) *CronSpec {
 	return &CronSpec{
-		CronSchedule: spec.CronSchedule,
-		CreatedAt:    spec.CreatedAt,
-		UpdatedAt:    spec.UpdatedAt,
+		CronSchedule:      spec.CronSchedule,
+		ConcurrencyPolicy: spec.ConcurrencyPolicy,
+		CatchUpWindow:     spec.CatchUpWindow.Duration().String(),
+		Jitter:            spec.Jitter.Duration().String(),
+		Timezone:          spec.Timezone,
+		CreatedAt:         spec.CreatedAt,
+		UpdatedAt:         spec.UpdatedAt,
+	}
+}
+
+// EventTriggerSpec defines the spec details of an EventTrigger Job
+type EventTriggerSpec struct {
+	ContractAddress          ethkey.EIP55Address     `json:"contractAddress"`
+	EventABI                 string                  `json:"eventABI"`
+	IndexedFilters           job.EventTriggerFilters `json:"indexedFilters"`
+	MinIncomingConfirmations clnull.Uint32           `json:"minIncomingConfirmations"`
+	CreatedAt                time.Time               `json:"createdAt"`
+	UpdatedAt                time.Time               `json:"updatedAt"`
+	EVMChainID               *utils.Big              `json:"evmChainID"`
+}
+
+// NewEventTriggerSpec generates a new EventTriggerSpec from a
+// job.EventTriggerSpec
+func NewEventTriggerSpec(spec *job.EventTriggerSpec) *EventTriggerSpec {
+	return &EventTriggerSpec{
+		ContractAddress:          spec.ContractAddress,
+		EventABI:                 spec.EventABI,
+		IndexedFilters:           spec.IndexedFilters,
+		MinIncomingConfirmations: spec.MinIncomingConfirmations,
+		CreatedAt:                spec.CreatedAt,
+		UpdatedAt:                spec.UpdatedAt,
+		EVMChainID:               spec.EVMChainID,
+	}
+}
+
+// BlockTriggerSpec defines the spec details of a BlockTrigger Job
+type BlockTriggerSpec struct {
+	BlockInterval   uint32     `json:"blockInterval"`
+	Confirmations   uint32     `json:"confirmations"`
+	RunMissedBlocks bool       `json:"runMissedBlocks"`
+	CreatedAt       time.Time  `json:"createdAt"`
+	UpdatedAt       time.Time  `json:"updatedAt"`
+	EVMChainID      *utils.Big `json:"evmChainID"`
+}
+
+// NewBlockTriggerSpec generates a new BlockTriggerSpec from a
+// job.BlockTriggerSpec
+func NewBlockTriggerSpec(spec *job.BlockTriggerSpec) *BlockTriggerSpec {
+	return &BlockTriggerSpec{
+		BlockInterval:   spec.BlockInterval,
+		Confirmations:   spec.Confirmations,
+		RunMissedBlocks: spec.RunMissedBlocks,
+		CreatedAt:       spec.CreatedAt,
+		UpdatedAt:       spec.UpdatedAt,
+		EVMChainID:      spec.EVMChainID,
 	}
 }
 
@@ -324,6 +384,7 @@ type JobResource struct {
 	SchemaVersion          uint32                  `json:"schemaVersion"`
 	MaxTaskDuration        models.Interval         `json:"maxTaskDuration"`
 	ExternalJobID          uuid.UUID               `json:"externalJobID"`
+	PausedAt               null.Time               `json:"pausedAt"`
 	DirectRequestSpec      *DirectRequestSpec      `json:"directRequestSpec"`
 	FluxMonitorSpec        *FluxMonitorSpec        `json:"fluxMonitorSpec"`
 	CronSpec               *CronSpec               `json:"cronSpec"`
@@ -332,6 +393,8 @@ type JobResource struct {
 	KeeperSpec             *KeeperSpec             `json:"keeperSpec"`
 	VRFSpec                *VRFSpec                `json:"vrfSpec"`
 	WebhookSpec            *WebhookSpec            `json:"webhookSpec"`
+	EventTriggerSpec       *EventTriggerSpec       `json:"eventTriggerSpec"`
+	BlockTriggerSpec       *BlockTriggerSpec       `json:"blockTriggerSpec"`
 	PipelineSpec           PipelineSpec            `json:"pipelineSpec"`
 	Errors                 []JobError              `json:"errors"`
 }
@@ -346,6 +409,7 @@ func NewJobResource(j job.Job) *JobResource {
 		MaxTaskDuration: j.MaxTaskDuration,
 		PipelineSpec:    NewPipelineSpec(j.PipelineSpec),
 		ExternalJobID:   j.ExternalJobID,
+		PausedAt:        j.PausedAt,
 	}
 
 	switch j.Type {
@@ -365,6 +429,10 @@ func NewJobResource(j job.Job) *JobResource {
 		resource.VRFSpec = NewVRFSpec(j.VRFSpec)
 	case job.Webhook:
 		resource.WebhookSpec = NewWebhookSpec(j.WebhookSpec)
+	case job.EventTrigger:
+		resource.EventTriggerSpec = NewEventTriggerSpec(j.EventTriggerSpec)
+	case job.BlockTrigger:
+		resource.BlockTriggerSpec = NewBlockTriggerSpec(j.BlockTriggerSpec)
 	}
 
 	jes := []JobError{}
@@ -380,3 +448,42 @@ func NewJobResource(j job.Job) *JobResource {
 func (r JobResource) GetName() string {
 	return "jobs"
 }
+
+// JobVersionResource represents a version of the pipeline of a job
+type JobVersionResource struct {
+	JAID
+	JobID           int32           `json:"jobID"`
+	Version         int32           `json:"version"`
+	Current         bool            `json:"current"`
+	DotDAGSource    string          `json:"dotDagSource"`
+	MaxTaskDuration models.Interval `json:"maxTaskDuration"`
+	CreatedAt       time.Time       `json:"createdAt"`
+}
+
+// NewJobVersionResource initializes a new JSONAPI job version resource
+func NewJobVersionResource(j job.Job, spec pipeline.Spec) *JobVersionResource {
+	return &JobVersionResource{
+		JAID:            NewJAIDInt32(spec.ID),
+		JobID:           j.ID,
+		Version:         spec.Version,
+		Current:         spec.ID == j.PipelineSpecID,
+		DotDAGSource:    spec.DotDagSource,
+		MaxTaskDuration: spec.MaxTaskDuration,
+		CreatedAt:       spec.CreatedAt,
+	}
+}
+
+// NewJobVersionResources initializes a slice of JSONAPI job version resources
+func NewJobVersionResources(j job.Job, specs []pipeline.Spec) []JobVersionResource {
+	rs := []JobVersionResource{}
+	for _, spec := range specs {
+		rs = append(rs, *NewJobVersionResource(j, spec))
+	}
+
+	return rs
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r JobVersionResource) GetName() string {
+	return "jobVersions"
+}
@@ -14,6 +14,7 @@ import (
 	"gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/chainlink/core/assets"
+	clnull "github.com/smartcontractkit/chainlink/core/null"
 	"github.com/smartcontractkit/chainlink/core/services/job"
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
 	"github.com/smartcontractkit/chainlink/core/services/pipeline"
@@ -76,8 +77,10 @@ func TestJob(t *testing.T) {
 						"type": "directrequest",
 						"maxTaskDuration": "1m0s",
 					    "externalJobID":"0eec7e1d-d0d2-476c-a1a8-72dfb6633f46",
+					    "pausedAt": null,
 						"pipelineSpec": {
 							"id": 1,
+							"version": 0,
 							"dotDagSource": "ds1 [type=http method=GET url=\"https://pricesource1.com\"",
 							"jobID": 0
 						},
@@ -98,6 +101,8 @@ func TestJob(t *testing.T) {
                         "cronSpec": null,
                         "vrfSpec": null,
 						"webhookSpec": null,
+						"eventTriggerSpec": null,
+						"blockTriggerSpec": null,
 						"errors": []
 					}
 				}
@@ -140,8 +145,10 @@ func TestJob(t *testing.T) {
 						"type": "fluxmonitor",
 						"maxTaskDuration": "1m0s",
 					    "externalJobID":"0eec7e1d-d0d2-476c-a1a8-72dfb6633f46",
+					    "pausedAt": null,
 						"pipelineSpec": {
 							"id": 1,
+							"version": 0,
 							"dotDagSource": "ds1 [type=http method=GET url=\"https://pricesource1.com\"",
 							"jobID": 0
 						},
@@ -168,6 +175,8 @@ func TestJob(t *testing.T) {
                         "cronSpec": null,
                         "vrfSpec": null,
 						"webhookSpec": null,
+						"eventTriggerSpec": null,
+						"blockTriggerSpec": null,
 						"errors": []
 					}
 				}
@@ -216,8 +225,10 @@ func TestJob(t *testing.T) {
 						"type": "offchainreporting",
 						"maxTaskDuration": "1m0s",
 					  "externalJobID":"0eec7e1d-d0d2-476c-a1a8-72dfb6633f46",
+					  "pausedAt": null,
 						"pipelineSpec": {
 							"id": 1,
+							"version": 0,
 							"dotDagSource": "ds1 [type=http method=GET url=\"https://pricesource1.com\"",
 							"jobID": 0
 						},
@@ -246,6 +257,8 @@ func TestJob(t *testing.T) {
                         "cronSpec": null,
                         "vrfSpec": null,
 						"webhookSpec": null,
+						"eventTriggerSpec": null,
+						"blockTriggerSpec": null,
 						"errors": []
 					}
 				}
@@ -283,8 +296,10 @@ func TestJob(t *testing.T) {
 						"type": "keeper",
 						"maxTaskDuration": "1m0s",
 					    "externalJobID":"0eec7e1d-d0d2-476c-a1a8-72dfb6633f46",
+					    "pausedAt": null,
 						"pipelineSpec": {
 							"id": 1,
+							"version": 0,
 							"dotDagSource": "",
 							"jobID": 0
 						},
@@ -303,6 +318,8 @@ func TestJob(t *testing.T) {
 						"offChainReporting2OracleSpec": null,
                         "cronSpec": null,
                         "vrfSpec": null,
+						"eventTriggerSpec": null,
+						"blockTriggerSpec": null,
 						"errors": []
 					}
 				}
@@ -313,9 +330,13 @@ func TestJob(t *testing.T) {
 			job: job.Job{
 				ID: 1,
 				CronSpec: &job.CronSpec{
-					CronSchedule: cronSchedule,
-					CreatedAt:    timestamp,
-					UpdatedAt:    timestamp,
+					CronSchedule:      cronSchedule,
+					ConcurrencyPolicy: job.CronConcurrencyForbid,
+					CatchUpWindow:     models.Interval(1 * time.Hour),
+					Jitter:            models.Interval(30 * time.Second),
+					Timezone:          "Europe/London",
+					CreatedAt:         timestamp,
+					UpdatedAt:         timestamp,
 				},
 				ExternalJobID: uuid.FromStringOrNil("0EEC7E1D-D0D2-476C-A1A8-72DFB6633F46"),
 				PipelineSpec: &pipeline.Spec{
@@ -338,13 +359,19 @@ func TestJob(t *testing.T) {
                         "type": "cron",
                         "maxTaskDuration": "1m0s",
 					    "externalJobID":"0eec7e1d-d0d2-476c-a1a8-72dfb6633f46",
+					    "pausedAt": null,
                         "pipelineSpec": {
                             "id": 1,
+                            "version": 0,
                             "dotDagSource": "",
 														"jobID": 0
                         },
                         "cronSpec": {
                             "schedule": "%s",
+                            "concurrencyPolicy": "forbid",
+                            "catchUpWindow": "1h0m0s",
+                            "jitter": "30s",
+                            "timezone": "Europe/London",
                             "createdAt":"2000-01-01T00:00:00Z",
                             "updatedAt":"2000-01-01T00:00:00Z"
                         },
@@ -355,6 +382,8 @@ func TestJob(t *testing.T) {
 						"offChainReporting2OracleSpec": null,
 						"vrfSpec": null,
                         "webhookSpec": null,
+                        "eventTriggerSpec": null,
+                        "blockTriggerSpec": null,
                         "errors": []
                     }
                 }
@@ -389,8 +418,10 @@ func TestJob(t *testing.T) {
 						"type": "webhook",
 						"maxTaskDuration": "1m0s",
 					    "externalJobID":"0eec7e1d-d0d2-476c-a1a8-72dfb6633f46",
+					    "pausedAt": null,
 						"pipelineSpec": {
 							"id": 1,
+							"version": 0,
 							"dotDagSource": "",
 							"jobID": 0
 						},
@@ -405,6 +436,134 @@ func TestJob(t *testing.T) {
 						"offChainReportingOracleSpec": null,
 						"offChainReporting2OracleSpec": null,
                         "vrfSpec": null,
+						"eventTriggerSpec": null,
+						"blockTriggerSpec": null,
+						"errors": []
+					}
+				}
+			}`,
+		},
+		{
+			name: "event trigger spec",
+			job: job.Job{
+				ID: 1,
+				EventTriggerSpec: &job.EventTriggerSpec{
+					ContractAddress:          contractAddress,
+					EventABI:                 "Transfer(address indexed from, address indexed to, uint256 value)",
+					IndexedFilters:           job.EventTriggerFilters{"to": {"0x27548a32b9aD5D64c5945EaE9Da5337bc3169D15"}},
+					MinIncomingConfirmations: clnull.NewUint32(3, true),
+					CreatedAt:                timestamp,
+					UpdatedAt:                timestamp,
+					EVMChainID:               evmChainID,
+				},
+				ExternalJobID: uuid.FromStringOrNil("0eec7e1d-d0d2-476c-a1a8-72dfb6633f46"),
+				PipelineSpec: &pipeline.Spec{
+					ID:           1,
+					DotDagSource: "",
+				},
+				Type:            job.Type("eventtrigger"),
+				SchemaVersion:   1,
+				Name:            null.StringFrom("test"),
+				MaxTaskDuration: models.Interval(1 * time.Minute),
+			},
+			want: fmt.Sprintf(`
+			{
+				"data":{
+					"type":"jobs",
+					"id":"1",
+					"attributes":{
+						"name": "test",
+						"schemaVersion": 1,
+						"type": "eventtrigger",
+						"maxTaskDuration": "1m0s",
+						"externalJobID":"0eec7e1d-d0d2-476c-a1a8-72dfb6633f46",
+						"pausedAt": null,
+						"pipelineSpec": {
+							"id": 1,
+							"version": 0,
+							"dotDagSource": "",
+							"jobID": 0
+						},
+						"eventTriggerSpec": {
+							"contractAddress": "%s",
+							"eventABI": "Transfer(address indexed from, address indexed to, uint256 value)",
+							"indexedFilters": {"to": ["0x27548a32b9aD5D64c5945EaE9Da5337bc3169D15"]},
+							"minIncomingConfirmations": 3,
+							"createdAt":"2000-01-01T00:00:00Z",
+							"updatedAt":"2000-01-01T00:00:00Z",
+							"evmChainID": "42"
+						},
+						"fluxMonitorSpec": null,
+						"directRequestSpec": null,
+						"keeperSpec": null,
+						"cronSpec": null,
+						"offChainReportingOracleSpec": null,
+						"offChainReporting2OracleSpec": null,
+						"vrfSpec": null,
+						"webhookSpec": null,
+						"blockTriggerSpec": null,
+						"errors": []
+					}
+				}
+			}`, contractAddress),
+		},
+		{
+			name: "block trigger spec",
+			job: job.Job{
+				ID: 1,
+				BlockTriggerSpec: &job.BlockTriggerSpec{
+					BlockInterval:   10,
+					Confirmations:   3,
+					RunMissedBlocks: true,
+					CreatedAt:       timestamp,
+					UpdatedAt:       timestamp,
+					EVMChainID:      evmChainID,
+				},
+				ExternalJobID: uuid.FromStringOrNil("0eec7e1d-d0d2-476c-a1a8-72dfb6633f46"),
+				PipelineSpec: &pipeline.Spec{
+					ID:           1,
+					DotDagSource: "",
+				},
+				Type:            job.Type("blocktrigger"),
+				SchemaVersion:   1,
+				Name:            null.StringFrom("test"),
+				MaxTaskDuration: models.Interval(1 * time.Minute),
+			},
+			want: `
+			{
+				"data":{
+					"type":"jobs",
+					"id":"1",
+					"attributes":{
+						"name": "test",
+						"schemaVersion": 1,
+						"type": "blocktrigger",
+						"maxTaskDuration": "1m0s",
+						"externalJobID":"0eec7e1d-d0d2-476c-a1a8-72dfb6633f46",
+						"pausedAt": null,
+						"pipelineSpec": {
+							"id": 1,
+							"version": 0,
+							"dotDagSource": "",
+							"jobID": 0
+						},
+						"blockTriggerSpec": {
+							"blockInterval": 10,
+							"confirmations": 3,
+							"runMissedBlocks": true,
+							"createdAt":"2000-01-01T00:00:00Z",
+							"updatedAt":"2000-01-01T00:00:00Z",
+							"evmChainID": "42"
+						},
+						"fluxMonitorSpec": null,
+						"directRequestSpec": null,
+						"keeperSpec": null,
+						"cronSpec": null,
+						"offChainReportingOracleSpec": null,
+						"offChainReporting2OracleSpec": null,
+						"vrfSpec": null,
+						"webhookSpec": null,
+						"eventTriggerSpec": null,
 						"errors": []
 					}
 				}
@@ -452,8 +611,10 @@ func TestJob(t *testing.T) {
 						"type": "keeper",
 						"maxTaskDuration": "1m0s",
 					    "externalJobID":"0eec7e1d-d0d2-476c-a1a8-72dfb6633f46",
+					    "pausedAt": null,
 						"pipelineSpec": {
 							"id": 1,
+							"version": 0,
 							"dotDagSource": "",
 							"jobID": 0
 						},
@@ -471,6 +632,8 @@ func TestJob(t *testing.T) {
 						"offChainReportingOracleSpec": null,
 						"offChainReporting2OracleSpec": null,
 						"vrfSpec": null,
+						"eventTriggerSpec": null,
+						"blockTriggerSpec": null,
 						"errors": [{
 							"id": 200,
 							"description": "some error",
@@ -0,0 +1,53 @@
+package presenters
+
+import (
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/smartcontractkit/chainlink/core/services/bulletprooftxmanager"
+)
+
+// KeyPoolResource represents a named pool of sending keys
+type KeyPoolResource struct {
+	JAID
+	Strategy  bulletprooftxmanager.KeyPoolStrategy `json:"strategy"`
+	Keys      []KeyPoolKey                         `json:"keys"`
+	CreatedAt time.Time                            `json:"createdAt"`
+	UpdatedAt time.Time                            `json:"updatedAt"`
+}
+
+// KeyPoolKey is a key of a key pool
+type KeyPoolKey struct {
+	Address common.Address `json:"address"`
+	Weight  int32          `json:"weight"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r KeyPoolResource) GetName() string {
+	return "keyPools"
+}
+
+// NewKeyPoolResource constructs a new KeyPoolResource
+func NewKeyPoolResource(pool bulletprooftxmanager.KeyPool) KeyPoolResource {
+	keys := []KeyPoolKey{}
+	for _, m := range pool.Members {
+		keys = append(keys, KeyPoolKey{Address: m.Address, Weight: m.Weight})
+	}
+	return KeyPoolResource{
+		JAID:      NewJAID(pool.Name),
+		Strategy:  pool.Strategy,
+		Keys:      keys,
+		CreatedAt: pool.CreatedAt,
+		UpdatedAt: pool.UpdatedAt,
+	}
+}
+
+// NewKeyPoolResources constructs a list of KeyPoolResources
+func NewKeyPoolResources(pools []bulletprooftxmanager.KeyPool) []KeyPoolResource {
+	rs := []KeyPoolResource{}
+	for _, pool := range pools {
+		rs = append(rs, NewKeyPoolResource(pool))
+	}
+	return rs
+}
@@ -21,6 +21,7 @@ type PipelineRunResource struct {
 	CreatedAt    time.Time                 `json:"createdAt"`
 	FinishedAt   time.Time                 `json:"finishedAt"`
 	PipelineSpec PipelineSpec              `json:"pipelineSpec"`
+	State        pipeline.RunStatus        `json:"state"`
 }
 
 // GetName implements the api2go EntityNamer interface
@@ -53,6 +54,7 @@ func NewPipelineRunResource(pr pipeline.Run, lggr logger.Logger) PipelineRunReso
 		CreatedAt:    pr.CreatedAt,
 		FinishedAt:   pr.FinishedAt.ValueOrZero(),
 		PipelineSpec: NewPipelineSpec(&pr.PipelineSpec),
+		State:        pr.State,
 	}
 }
 
@@ -64,6 +66,10 @@ type PipelineTaskRunResource struct {
 	Output     *string           `json:"output"`
 	Error      *string           `json:"error"`
 	DotID      string            `json:"dotId"`
+	Skipped    bool              `json:"skipped"`
+	// Meta records extra information about the task run, e.g. whether a
+	// bridge response came from the cache
+	Meta pipeline.JSONSerializable `json:"meta"`
 }
 
 // GetName implements the api2go EntityNamer interface
@@ -89,6 +95,8 @@ func NewPipelineTaskRunResource(tr pipeline.TaskRun) PipelineTaskRunResource {
 		Output:     output,
 		Error:      error,
 		DotID:      tr.GetDotID(),
+		Skipped:    tr.Skipped,
+		Meta:       tr.Meta,
 	}
 }
 
@@ -10,6 +10,7 @@ import (
 type UserResource struct {
 	JAID
 	Email     string    `json:"email"`
+	Role      string    `json:"role"`
 	CreatedAt time.Time `json:"createdAt"`
 }
 
@@ -25,6 +26,17 @@ func NewUserResource(u sessions.User) *UserResource {
 	return &UserResource{
 		JAID:      NewJAID(u.Email),
 		Email:     u.Email,
+		Role:      string(u.Role),
 		CreatedAt: u.CreatedAt,
 	}
 }
+
+// NewUserResources constructs a slice of UserResources.
+func NewUserResources(users []sessions.User) []UserResource {
+	rs := []UserResource{}
+	for _, u := range users {
+		rs = append(rs, *NewUserResource(u))
+	}
+
+	return rs
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
 
+	"github.com/smartcontractkit/chainlink/core/audit"
 	"github.com/smartcontractkit/chainlink/core/auth"
 	"github.com/smartcontractkit/chainlink/core/utils"
 	webauth "github.com/smartcontractkit/chainlink/core/web/auth"
@@ -56,7 +57,8 @@ func TestResolver_CreateAPIToken(t *testing.T) {
 
 				session.User.HashedPassword = pwd
 
-				f.Mocks.sessionsORM.On("FindUser").Return(*session.User, nil)
+				f.Mocks.sessionsORM.On("FindUser", session.User.Email).Return(*session.User, nil)
+				f.expectAuditRecord(audit.ActionAPITokenCreated, session.User.Email)
 				f.Mocks.sessionsORM.On("CreateAndSetAuthToken", session.User).Return(&auth.Token{
 					Secret:    "new-secret",
 					AccessKey: "new-access-key",
@@ -85,7 +87,7 @@ func TestResolver_CreateAPIToken(t *testing.T) {
 
 				session.User.HashedPassword = "wrong-password"
 
-				f.Mocks.sessionsORM.On("FindUser").Return(*session.User, nil)
+				f.Mocks.sessionsORM.On("FindUser", session.User.Email).Return(*session.User, nil)
 				f.App.On("SessionORM").Return(f.Mocks.sessionsORM)
 			},
 			query:     mutation,
@@ -114,7 +116,7 @@ func TestResolver_CreateAPIToken(t *testing.T) {
 
 				session.User.HashedPassword = pwd
 
-				f.Mocks.sessionsORM.On("FindUser").Return(*session.User, gError)
+				f.Mocks.sessionsORM.On("FindUser", session.User.Email).Return(*session.User, gError)
 				f.App.On("SessionORM").Return(f.Mocks.sessionsORM)
 			},
 			query:     mutation,
@@ -142,7 +144,7 @@ func TestResolver_CreateAPIToken(t *testing.T) {
 
 				session.User.HashedPassword = pwd
 
-				f.Mocks.sessionsORM.On("FindUser").Return(*session.User, nil)
+				f.Mocks.sessionsORM.On("FindUser", session.User.Email).Return(*session.User, nil)
 				f.Mocks.sessionsORM.On("CreateAndSetAuthToken", session.User).Return(nil, gError)
 				f.App.On("SessionORM").Return(f.Mocks.sessionsORM)
 			},
@@ -208,8 +210,9 @@ func TestResolver_DeleteAPIToken(t *testing.T) {
 				err = session.User.TokenKey.UnmarshalText([]byte("new-access-key"))
 				require.NoError(t, err)
 
-				f.Mocks.sessionsORM.On("FindUser").Return(*session.User, nil)
+				f.Mocks.sessionsORM.On("FindUser", session.User.Email).Return(*session.User, nil)
 				f.Mocks.sessionsORM.On("DeleteAuthToken", session.User).Return(nil)
+				f.expectAuditRecord(audit.ActionAPITokenDeleted, session.User.Email)
 				f.App.On("SessionORM").Return(f.Mocks.sessionsORM)
 			},
 			query:     mutation,
@@ -233,7 +236,7 @@ func TestResolver_DeleteAPIToken(t *testing.T) {
 
 				session.User.HashedPassword = "wrong-password"
 
-				f.Mocks.sessionsORM.On("FindUser").Return(*session.User, nil)
+				f.Mocks.sessionsORM.On("FindUser", session.User.Email).Return(*session.User, nil)
 				f.App.On("SessionORM").Return(f.Mocks.sessionsORM)
 			},
 			query:     mutation,
@@ -262,7 +265,7 @@ func TestResolver_DeleteAPIToken(t *testing.T) {
 
 				session.User.HashedPassword = pwd
 
-				f.Mocks.sessionsORM.On("FindUser").Return(*session.User, gError)
+				f.Mocks.sessionsORM.On("FindUser", session.User.Email).Return(*session.User, gError)
 				f.App.On("SessionORM").Return(f.Mocks.sessionsORM)
 			},
 			query:     mutation,
@@ -290,7 +293,7 @@ func TestResolver_DeleteAPIToken(t *testing.T) {
 
 				session.User.HashedPassword = pwd
 
-				f.Mocks.sessionsORM.On("FindUser").Return(*session.User, nil)
+				f.Mocks.sessionsORM.On("FindUser", session.User.Email).Return(*session.User, nil)
 				f.Mocks.sessionsORM.On("DeleteAuthToken", session.User).Return(gError)
 				f.App.On("SessionORM").Return(f.Mocks.sessionsORM)
 			},
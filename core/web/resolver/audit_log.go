@@ -0,0 +1,157 @@
+package resolver
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	"github.com/graph-gophers/graphql-go"
+
+	"github.com/smartcontractkit/chainlink/core/audit"
+)
+
+// AuditLogAuthMethod is the GQL enum of audit.AuthMethod
+type AuditLogAuthMethod string
+
+const (
+	AuditLogAuthMethodSession AuditLogAuthMethod = "SESSION"
+	AuditLogAuthMethodToken   AuditLogAuthMethod = "TOKEN"
+	AuditLogAuthMethodCLI     AuditLogAuthMethod = "CLI"
+)
+
+// ToAuditLogAuthMethod converts an audit.AuthMethod to the GQL enum
+func ToAuditLogAuthMethod(method audit.AuthMethod) AuditLogAuthMethod {
+	return AuditLogAuthMethod(strings.ToUpper(string(method)))
+}
+
+// FromAuditLogAuthMethod converts the GQL enum to an audit.AuthMethod
+func FromAuditLogAuthMethod(method AuditLogAuthMethod) (audit.AuthMethod, error) {
+	return audit.GetAuthMethod(strings.ToLower(string(method)))
+}
+
+// AuditLogFilterInput narrows down the audit log entries that are listed
+type AuditLogFilterInput struct {
+	Actor      *string
+	AuthMethod *AuditLogAuthMethod
+	Action     *string
+	Target     *string
+	Since      *graphql.Time
+	Until      *graphql.Time
+}
+
+// toFilter converts the input into an audit.Filter. A nil input matches every
+// entry.
+func (in *AuditLogFilterInput) toFilter() (audit.Filter, error) {
+	var filter audit.Filter
+	if in == nil {
+		return filter, nil
+	}
+
+	if in.Actor != nil {
+		filter.Actor = *in.Actor
+	}
+	if in.AuthMethod != nil {
+		method, err := FromAuditLogAuthMethod(*in.AuthMethod)
+		if err != nil {
+			return filter, err
+		}
+		filter.AuthMethod = method
+	}
+	if in.Action != nil {
+		filter.Action = audit.Action(*in.Action)
+	}
+	if in.Target != nil {
+		filter.Target = *in.Target
+	}
+	if in.Since != nil {
+		filter.Since = &in.Since.Time
+	}
+	if in.Until != nil {
+		filter.Until = &in.Until.Time
+	}
+
+	return filter, nil
+}
+
+// AuditLogEntryResolver resolves the AuditLogEntry type
+type AuditLogEntryResolver struct {
+	entry audit.Entry
+}
+
+func NewAuditLogEntry(entry audit.Entry) *AuditLogEntryResolver {
+	return &AuditLogEntryResolver{entry: entry}
+}
+
+func NewAuditLogEntries(entries []audit.Entry) []*AuditLogEntryResolver {
+	var resolvers []*AuditLogEntryResolver
+	for _, e := range entries {
+		resolvers = append(resolvers, NewAuditLogEntry(e))
+	}
+
+	return resolvers
+}
+
+// ID resolves the entry's unique identifier
+func (r *AuditLogEntryResolver) ID() graphql.ID {
+	return graphql.ID(strconv.FormatInt(r.entry.ID, 10))
+}
+
+// Actor resolves the email or OS user who took the action
+func (r *AuditLogEntryResolver) Actor() string {
+	return r.entry.Actor
+}
+
+// AuthMethod resolves how the actor authenticated
+func (r *AuditLogEntryResolver) AuthMethod() AuditLogAuthMethod {
+	return ToAuditLogAuthMethod(r.entry.AuthMethod)
+}
+
+// Action resolves the action that was taken
+func (r *AuditLogEntryResolver) Action() string {
+	return string(r.entry.Action)
+}
+
+// Target resolves what the action was taken on
+func (r *AuditLogEntryResolver) Target() string {
+	return r.entry.Target
+}
+
+// Diff resolves the JSON encoded changes made by the action
+func (r *AuditLogEntryResolver) Diff() (*string, error) {
+	if r.entry.Diff == nil {
+		return nil, nil
+	}
+
+	b, err := json.Marshal(r.entry.Diff)
+	if err != nil {
+		return nil, err
+	}
+	diff := string(b)
+
+	return &diff, nil
+}
+
+// CreatedAt resolves when the action was taken
+func (r *AuditLogEntryResolver) CreatedAt() graphql.Time {
+	return graphql.Time{Time: r.entry.CreatedAt}
+}
+
+// AuditLogEntriesPayloadResolver resolves a page of audit log entries
+type AuditLogEntriesPayloadResolver struct {
+	entries []audit.Entry
+	total   int32
+}
+
+func NewAuditLogEntriesPayload(entries []audit.Entry, total int32) *AuditLogEntriesPayloadResolver {
+	return &AuditLogEntriesPayloadResolver{entries: entries, total: total}
+}
+
+// Results returns the audit log entries.
+func (r *AuditLogEntriesPayloadResolver) Results() []*AuditLogEntryResolver {
+	return NewAuditLogEntries(r.entries)
+}
+
+// Metadata returns the pagination metadata.
+func (r *AuditLogEntriesPayloadResolver) Metadata() *PaginationMetadataResolver {
+	return NewPaginationMetadata(r.total)
+}
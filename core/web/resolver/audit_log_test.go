@@ -0,0 +1,124 @@
+package resolver
+
+import (
+	"testing"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/core/audit"
+	"github.com/smartcontractkit/chainlink/core/sessions"
+)
+
+func TestResolver_AuditLogEntries(t *testing.T) {
+	t.Parallel()
+
+	query := `
+		query GetAuditLogEntries($offset: Int, $limit: Int, $filter: AuditLogFilter) {
+			auditLogEntries(offset: $offset, limit: $limit, filter: $filter) {
+				results {
+					id
+					actor
+					authMethod
+					action
+					target
+					diff
+					createdAt
+				}
+				metadata {
+					total
+				}
+			}
+		}`
+
+	since := time.Date(2020, 12, 1, 0, 0, 0, 0, time.UTC)
+	filterVariables := map[string]interface{}{
+		"offset": 10,
+		"limit":  5,
+		"filter": map[string]interface{}{
+			"actor":      "admin@chain.link",
+			"authMethod": "TOKEN",
+			"action":     "bridge.updated",
+			"since":      since.Format(time.RFC3339),
+		},
+	}
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: query}, "auditLogEntries"),
+		forbiddenTestCase(GQLTestCase{query: query}, sessions.UserRoleEdit, sessions.UserRoleAdmin, "auditLogEntries"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.Mocks.auditLogger.On("Entries", audit.Filter{}, 0, 50).Return([]audit.Entry{
+					{
+						ID:         2,
+						Actor:      "admin@chain.link",
+						AuthMethod: audit.AuthMethodSession,
+						Action:     audit.ActionBridgeUpdated,
+						Target:     "bridge1",
+						Diff:       audit.Diff{"confirmations": {Old: 1, New: 2}},
+						CreatedAt:  f.Timestamp(),
+					},
+					{
+						ID:         1,
+						Actor:      "root@node",
+						AuthMethod: audit.AuthMethodCLI,
+						Action:     audit.ActionUserDeleted,
+						Target:     "oncall@chain.link",
+						CreatedAt:  f.Timestamp(),
+					},
+				}, 2, nil)
+			},
+			query: query,
+			result: `
+				{
+					"auditLogEntries": {
+						"results": [{
+							"id": "2",
+							"actor": "admin@chain.link",
+							"authMethod": "SESSION",
+							"action": "bridge.updated",
+							"target": "bridge1",
+							"diff": "{\"confirmations\":{\"old\":1,\"new\":2}}",
+							"createdAt": "2021-01-01T00:00:00Z"
+						}, {
+							"id": "1",
+							"actor": "root@node",
+							"authMethod": "CLI",
+							"action": "user.deleted",
+							"target": "oncall@chain.link",
+							"diff": null,
+							"createdAt": "2021-01-01T00:00:00Z"
+						}],
+						"metadata": {
+							"total": 2
+						}
+					}
+				}`,
+		},
+		{
+			name:          "with filter and paging",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.Mocks.auditLogger.On("Entries", audit.Filter{
+					Actor:      "admin@chain.link",
+					AuthMethod: audit.AuthMethodToken,
+					Action:     audit.ActionBridgeUpdated,
+					Since:      &since,
+				}, 10, 5).Return([]audit.Entry{}, 11, nil)
+			},
+			query:     query,
+			variables: filterVariables,
+			result: `
+				{
+					"auditLogEntries": {
+						"results": [],
+						"metadata": {
+							"total": 11
+						}
+					}
+				}`,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
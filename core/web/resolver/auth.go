@@ -2,11 +2,14 @@ package resolver
 
 import (
 	"context"
+	"fmt"
 
+	"github.com/smartcontractkit/chainlink/core/sessions"
 	"github.com/smartcontractkit/chainlink/core/web/auth"
 )
 
-// Authenticates the user from the session cookie.
+// Authenticates the user from the session cookie. Every authenticated user
+// has at least the view role.
 func authenticateUser(ctx context.Context) error {
 	_, ok := auth.GetGQLAuthenticatedSession(ctx)
 	if !ok {
@@ -16,6 +19,36 @@ func authenticateUser(ctx context.Context) error {
 	return nil
 }
 
+// Authenticates the user from the session cookie and checks that they have
+// the run role.
+func authenticateUserCanRun(ctx context.Context) error {
+	return authenticateUserWithRole(ctx, sessions.UserRoleRun)
+}
+
+// Authenticates the user from the session cookie and checks that they have
+// the edit role.
+func authenticateUserCanEdit(ctx context.Context) error {
+	return authenticateUserWithRole(ctx, sessions.UserRoleEdit)
+}
+
+// Authenticates the user from the session cookie and checks that they have
+// the admin role.
+func authenticateUserIsAdmin(ctx context.Context) error {
+	return authenticateUserWithRole(ctx, sessions.UserRoleAdmin)
+}
+
+func authenticateUserWithRole(ctx context.Context, role sessions.UserRole) error {
+	session, ok := auth.GetGQLAuthenticatedSession(ctx)
+	if !ok {
+		return unauthorizedError{}
+	}
+	if !session.User.Role.Includes(role) {
+		return forbiddenError{role: role}
+	}
+
+	return nil
+}
+
 type unauthorizedError struct{}
 
 func (e unauthorizedError) Error() string {
@@ -27,3 +60,17 @@ func (e unauthorizedError) Extensions() map[string]interface{} {
 		"code": "UNAUTHORIZED",
 	}
 }
+
+type forbiddenError struct {
+	role sessions.UserRole
+}
+
+func (e forbiddenError) Error() string {
+	return fmt.Sprintf("Forbidden: the %s role is required", e.role)
+}
+
+func (e forbiddenError) Extensions() map[string]interface{} {
+	return map[string]interface{}{
+		"code": "FORBIDDEN",
+	}
+}
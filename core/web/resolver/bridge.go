@@ -1,9 +1,12 @@
 package resolver
 
 import (
+	"context"
+
 	"github.com/graph-gophers/graphql-go"
 
 	"github.com/smartcontractkit/chainlink/core/bridges"
+	"github.com/smartcontractkit/chainlink/core/web/loader"
 )
 
 // BridgeResolver resolves the Bridge type.
@@ -54,11 +57,124 @@ func (r *BridgeResolver) MinimumContractPayment() string {
 	return r.bridge.MinimumContractPayment.String()
 }
 
+// RetryOnStatusCodes resolves the error status codes the bridge's requests
+// are retried on.
+func (r *BridgeResolver) RetryOnStatusCodes() []int32 {
+	codes := make([]int32, len(r.bridge.RetryOnStatusCodes))
+	copy(codes, r.bridge.RetryOnStatusCodes)
+
+	return codes
+}
+
+// Retries resolves the number of times a failed request to the bridge is
+// retried.
+func (r *BridgeResolver) Retries() int32 {
+	return int32(r.bridge.Retries)
+}
+
+// AttemptTimeout resolves the timeout of each request to the bridge.
+func (r *BridgeResolver) AttemptTimeout() string {
+	return r.bridge.AttemptTimeout.Duration().String()
+}
+
+// MaxConcurrency resolves the maximum number of requests in flight to the
+// bridge.
+func (r *BridgeResolver) MaxConcurrency() int32 {
+	return int32(r.bridge.MaxConcurrency)
+}
+
+// BreakerFailureThreshold resolves the number of consecutive failures that
+// open the bridge's circuit breaker.
+func (r *BridgeResolver) BreakerFailureThreshold() int32 {
+	return int32(r.bridge.BreakerFailureThreshold)
+}
+
+// BreakerCooldown resolves how long the bridge's circuit breaker stays open.
+func (r *BridgeResolver) BreakerCooldown() string {
+	return r.bridge.BreakerCooldown.Duration().String()
+}
+
+// CacheTTL resolves how long the bridge's responses are cached.
+func (r *BridgeResolver) CacheTTL() string {
+	return r.bridge.CacheTTL.Duration().String()
+}
+
+// CacheMaxStale resolves how long past its TTL a cached response may be used
+// when the bridge fails.
+func (r *BridgeResolver) CacheMaxStale() string {
+	return r.bridge.CacheMaxStale.Duration().String()
+}
+
+// CircuitBreaker resolves the live state of the bridge's circuit breaker.
+func (r *BridgeResolver) CircuitBreaker(ctx context.Context) (*BridgeCircuitBreakerResolver, error) {
+	status, err := loader.GetBreakerStatusByBridgeName(ctx, r.bridge.Name.String())
+	if err != nil {
+		return nil, err
+	}
+
+	return NewBridgeCircuitBreaker(*status), nil
+}
+
 // CreatedAt resolves the bridge's created at field.
 func (r *BridgeResolver) CreatedAt() graphql.Time {
 	return graphql.Time{Time: r.bridge.CreatedAt}
 }
 
+type BridgeCircuitBreakerState string
+
+const (
+	BridgeCircuitBreakerStateClosed   BridgeCircuitBreakerState = "CLOSED"
+	BridgeCircuitBreakerStateOpen     BridgeCircuitBreakerState = "OPEN"
+	BridgeCircuitBreakerStateHalfOpen BridgeCircuitBreakerState = "HALF_OPEN"
+)
+
+func NewBridgeCircuitBreakerState(state bridges.BreakerState) BridgeCircuitBreakerState {
+	switch state {
+	case bridges.BreakerStateOpen:
+		return BridgeCircuitBreakerStateOpen
+	case bridges.BreakerStateHalfOpen:
+		return BridgeCircuitBreakerStateHalfOpen
+	default:
+		return BridgeCircuitBreakerStateClosed
+	}
+}
+
+// BridgeCircuitBreakerResolver resolves the BridgeCircuitBreaker type.
+type BridgeCircuitBreakerResolver struct {
+	status bridges.BreakerStatus
+}
+
+func NewBridgeCircuitBreaker(status bridges.BreakerStatus) *BridgeCircuitBreakerResolver {
+	return &BridgeCircuitBreakerResolver{status: status}
+}
+
+// State resolves the circuit breaker's state.
+func (r *BridgeCircuitBreakerResolver) State() BridgeCircuitBreakerState {
+	return NewBridgeCircuitBreakerState(r.status.State)
+}
+
+// ConsecutiveFailures resolves the number of requests to the bridge that have
+// failed in a row.
+func (r *BridgeCircuitBreakerResolver) ConsecutiveFailures() int32 {
+	return int32(r.status.ConsecutiveFailures)
+}
+
+// OpenedAt resolves when the circuit breaker last opened. It is null while
+// the breaker is closed.
+func (r *BridgeCircuitBreakerResolver) OpenedAt() *graphql.Time {
+	if r.status.OpenedAt == nil {
+		return nil
+	}
+
+	return &graphql.Time{Time: *r.status.OpenedAt}
+}
+
+// RequestsInFlight resolves the number of requests currently in flight to the
+// bridge.
+func (r *BridgeCircuitBreakerResolver) RequestsInFlight() int32 {
+	return int32(r.status.InFlight)
+}
+
 // BridgePayloadResolver resolves a single bridge response
 type BridgePayloadResolver struct {
 	bridge bridges.BridgeType
@@ -257,3 +373,37 @@ func (r *DeleteBridgeInvalidNameErrorResolver) Message() string {
 func (r *DeleteBridgeInvalidNameErrorResolver) Code() ErrorCode {
 	return ErrorCodeUnprocessable
 }
+
+// -- FlushBridgeCache mutation --
+
+type FlushBridgeCachePayloadResolver struct {
+	flushed int
+	NotFoundErrorUnionType
+}
+
+func NewFlushBridgeCachePayload(flushed int, err error) *FlushBridgeCachePayloadResolver {
+	e := NotFoundErrorUnionType{err: err, message: "bridge not found"}
+
+	return &FlushBridgeCachePayloadResolver{flushed: flushed, NotFoundErrorUnionType: e}
+}
+
+func (r *FlushBridgeCachePayloadResolver) ToFlushBridgeCacheSuccess() (*FlushBridgeCacheSuccessResolver, bool) {
+	if r.err == nil {
+		return NewFlushBridgeCacheSuccess(r.flushed), true
+	}
+
+	return nil, false
+}
+
+type FlushBridgeCacheSuccessResolver struct {
+	flushed int
+}
+
+func NewFlushBridgeCacheSuccess(flushed int) *FlushBridgeCacheSuccessResolver {
+	return &FlushBridgeCacheSuccessResolver{flushed: flushed}
+}
+
+// FlushedResponses resolves the number of cached responses that were dropped.
+func (r *FlushBridgeCacheSuccessResolver) FlushedResponses() int32 {
+	return int32(r.flushed)
+}
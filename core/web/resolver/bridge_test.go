@@ -5,13 +5,17 @@ import (
 	"encoding/json"
 	"net/url"
 	"testing"
+	"time"
 
+	"github.com/lib/pq"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
 	"github.com/smartcontractkit/chainlink/core/assets"
+	"github.com/smartcontractkit/chainlink/core/audit"
 	"github.com/smartcontractkit/chainlink/core/bridges"
+	clsessions "github.com/smartcontractkit/chainlink/core/sessions"
 	"github.com/smartcontractkit/chainlink/core/store/models"
 )
 
@@ -97,6 +101,20 @@ func Test_Bridge(t *testing.T) {
 						confirmations
 						outgoingToken
 						minimumContractPayment
+						retryOnStatusCodes
+						retries
+						attemptTimeout
+						maxConcurrency
+						breakerFailureThreshold
+						breakerCooldown
+						cacheTTL
+						cacheMaxStale
+						circuitBreaker {
+							state
+							consecutiveFailures
+							openedAt
+							requestsInFlight
+						}
 						createdAt
 					}
 					... on NotFoundError {
@@ -126,6 +144,71 @@ func Test_Bridge(t *testing.T) {
 					MinimumContractPayment: assets.NewLinkFromJuels(1),
 					CreatedAt:              f.Timestamp(),
 				}, nil)
+				f.App.On("BridgeBreakers").Return(f.Mocks.breakers)
+				f.Mocks.breakers.On("Status", name).Return(bridges.BreakerStatus{
+					Name:  name,
+					State: bridges.BreakerStateClosed,
+				})
+			},
+			query: query,
+			result: `{
+				"bridge": {
+					"id": "bridge1",
+					"name": "bridge1",
+					"url": "https://external.adapter",
+					"confirmations": 1,
+					"outgoingToken": "outgoingToken",
+					"minimumContractPayment": "1",
+					"retryOnStatusCodes": [],
+					"retries": 0,
+					"attemptTimeout": "0s",
+					"maxConcurrency": 0,
+					"breakerFailureThreshold": 0,
+					"breakerCooldown": "0s",
+					"cacheTTL": "0s",
+					"cacheMaxStale": "0s",
+					"circuitBreaker": {
+						"state": "CLOSED",
+						"consecutiveFailures": 0,
+						"openedAt": null,
+						"requestsInFlight": 0
+					},
+					"createdAt": "2021-01-01T00:00:00Z"
+				}
+			}`,
+		},
+		{
+			name:          "success with request policy",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.App.On("BridgeORM").Return(f.Mocks.bridgeORM)
+				f.Mocks.bridgeORM.On("FindBridge", name).Return(bridges.BridgeType{
+					Name:                   name,
+					URL:                    models.WebURL(*bridgeURL),
+					Confirmations:          uint32(1),
+					OutgoingToken:          "outgoingToken",
+					MinimumContractPayment: assets.NewLinkFromJuels(1),
+					RequestPolicy: bridges.RequestPolicy{
+						RetryOnStatusCodes:      pq.Int32Array{429, 503},
+						Retries:                 2,
+						AttemptTimeout:          models.Interval(5 * time.Second),
+						MaxConcurrency:          10,
+						BreakerFailureThreshold: 5,
+						BreakerCooldown:         models.Interval(time.Minute),
+						CacheTTL:                models.Interval(5 * time.Second),
+						CacheMaxStale:           models.Interval(time.Minute),
+					},
+					CreatedAt: f.Timestamp(),
+				}, nil)
+				openedAt := f.Timestamp()
+				f.App.On("BridgeBreakers").Return(f.Mocks.breakers)
+				f.Mocks.breakers.On("Status", name).Return(bridges.BreakerStatus{
+					Name:                name,
+					State:               bridges.BreakerStateOpen,
+					ConsecutiveFailures: 5,
+					OpenedAt:            &openedAt,
+					InFlight:            1,
+				})
 			},
 			query: query,
 			result: `{
@@ -136,6 +219,20 @@ func Test_Bridge(t *testing.T) {
 					"confirmations": 1,
 					"outgoingToken": "outgoingToken",
 					"minimumContractPayment": "1",
+					"retryOnStatusCodes": [429, 503],
+					"retries": 2,
+					"attemptTimeout": "5s",
+					"maxConcurrency": 10,
+					"breakerFailureThreshold": 5,
+					"breakerCooldown": "1m0s",
+					"cacheTTL": "5s",
+					"cacheMaxStale": "1m0s",
+					"circuitBreaker": {
+						"state": "OPEN",
+						"consecutiveFailures": 5,
+						"openedAt": "2021-01-01T00:00:00Z",
+						"requestsInFlight": 1
+					},
 					"createdAt": "2021-01-01T00:00:00Z"
 				}
 			}`,
@@ -195,6 +292,7 @@ func Test_CreateBridge(t *testing.T) {
 
 	testCases := []GQLTestCase{
 		unauthorizedTestCase(GQLTestCase{query: mutation, variables: variables}, "createBridge"),
+		forbiddenTestCase(GQLTestCase{query: mutation, variables: variables}, clsessions.UserRoleRun, clsessions.UserRoleEdit, "createBridge"),
 		{
 			name:          "success",
 			authenticated: true,
@@ -214,6 +312,7 @@ func Test_CreateBridge(t *testing.T) {
 						}
 					}).
 					Return(nil)
+				f.expectAuditRecord(audit.ActionBridgeCreated, "bridge1")
 			},
 			query:     mutation,
 			variables: variables,
@@ -283,6 +382,7 @@ func Test_UpdateBridge(t *testing.T) {
 
 	testCases := []GQLTestCase{
 		unauthorizedTestCase(GQLTestCase{query: mutation, variables: variables}, "updateBridge"),
+		forbiddenTestCase(GQLTestCase{query: mutation, variables: variables}, clsessions.UserRoleRun, clsessions.UserRoleEdit, "updateBridge"),
 		{
 			name:          "success",
 			authenticated: true,
@@ -320,6 +420,7 @@ func Test_UpdateBridge(t *testing.T) {
 						}
 					}).
 					Return(nil)
+				f.expectAuditRecord(audit.ActionBridgeUpdated, "bridge-updated")
 			},
 			query:     mutation,
 			variables: variables,
@@ -337,6 +438,86 @@ func Test_UpdateBridge(t *testing.T) {
 				}
 			}`,
 		},
+		{
+			name:          "success with request policy",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				// Fields that are omitted from the input keep their current value
+				bridge := bridges.BridgeType{
+					Name:                   name,
+					URL:                    models.WebURL(*bridgeURL),
+					Confirmations:          uint32(1),
+					OutgoingToken:          "outgoingToken",
+					MinimumContractPayment: assets.NewLinkFromJuels(1),
+					RequestPolicy: bridges.RequestPolicy{
+						Retries:                 1,
+						MaxConcurrency:          5,
+						BreakerFailureThreshold: 3,
+					},
+					CreatedAt: f.Timestamp(),
+				}
+
+				f.App.On("BridgeORM").Return(f.Mocks.bridgeORM)
+				f.Mocks.bridgeORM.On("FindBridge", name).Return(bridge, nil)
+
+				btr := &bridges.BridgeTypeRequest{
+					Name:                   bridges.TaskType("bridge-updated"),
+					URL:                    models.WebURL(*newBridgeURL),
+					Confirmations:          2,
+					MinimumContractPayment: assets.NewLinkFromJuels(2),
+					RequestPolicy: bridges.RequestPolicy{
+						RetryOnStatusCodes:      pq.Int32Array{503},
+						Retries:                 3,
+						AttemptTimeout:          models.Interval(10 * time.Second),
+						MaxConcurrency:          5,
+						BreakerFailureThreshold: 0,
+					},
+				}
+
+				f.Mocks.bridgeORM.On("UpdateBridgeType", mock.IsType(&bridges.BridgeType{}), btr).
+					Run(func(args mock.Arguments) {
+						arg := args.Get(0).(*bridges.BridgeType)
+						*arg = bridges.BridgeType{
+							Name:                   "bridge-updated",
+							URL:                    models.WebURL(*newBridgeURL),
+							Confirmations:          2,
+							OutgoingToken:          "outgoingToken",
+							MinimumContractPayment: assets.NewLinkFromJuels(2),
+							RequestPolicy:          btr.RequestPolicy,
+							CreatedAt:              f.Timestamp(),
+						}
+					}).
+					Return(nil)
+				f.expectAuditRecord(audit.ActionBridgeUpdated, "bridge-updated")
+			},
+			query: mutation,
+			variables: map[string]interface{}{
+				"id": "bridge1",
+				"input": map[string]interface{}{
+					"name":                    "bridge-updated",
+					"url":                     "https://external.adapter.new",
+					"confirmations":           2,
+					"minimumContractPayment":  "2",
+					"retryOnStatusCodes":      []interface{}{503},
+					"retries":                 3,
+					"attemptTimeout":          "10s",
+					"breakerFailureThreshold": 0,
+				},
+			},
+			result: `{
+				"updateBridge": {
+					"bridge": {
+						"id": "bridge-updated",
+						"name": "bridge-updated",
+						"url": "https://external.adapter.new",
+						"confirmations": 2,
+						"outgoingToken": "outgoingToken",
+						"minimumContractPayment": "2",
+						"createdAt": "2021-01-01T00:00:00Z"
+					}
+				}
+			}`,
+		},
 		{
 			name:          "not found",
 			authenticated: true,
@@ -404,6 +585,7 @@ func Test_DeleteBridgeMutation(t *testing.T) {
 
 	testCases := []GQLTestCase{
 		unauthorizedTestCase(GQLTestCase{query: mutation, variables: variables}, "deleteBridge"),
+		forbiddenTestCase(GQLTestCase{query: mutation, variables: variables}, clsessions.UserRoleRun, clsessions.UserRoleEdit, "deleteBridge"),
 		{
 			name:          "success",
 			authenticated: true,
@@ -418,6 +600,7 @@ func Test_DeleteBridgeMutation(t *testing.T) {
 
 				f.Mocks.bridgeORM.On("FindBridge", name).Return(bridge, nil)
 				f.Mocks.bridgeORM.On("DeleteBridgeType", &bridge).Return(nil)
+				f.expectAuditRecord(audit.ActionBridgeDeleted, name.String())
 				f.Mocks.jobORM.On("FindJobIDsWithBridge", name.String()).Return([]int32{}, nil)
 				f.App.On("JobORM").Return(f.Mocks.jobORM)
 				f.App.On("BridgeORM").Return(f.Mocks.bridgeORM)
@@ -497,3 +680,83 @@ func Test_DeleteBridgeMutation(t *testing.T) {
 
 	RunGQLTests(t, testCases)
 }
+
+func Test_FlushBridgeCacheMutation(t *testing.T) {
+	t.Parallel()
+
+	name := bridges.TaskType("bridge1")
+
+	mutation := `
+		mutation FlushBridgeCache($id: ID) {
+			flushBridgeCache(id: $id) {
+				... on FlushBridgeCacheSuccess {
+					flushedResponses
+				}
+				... on NotFoundError {
+					message
+					code
+				}
+			}
+		}`
+
+	variables := map[string]interface{}{
+		"id": name.String(),
+	}
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: mutation, variables: variables}, "flushBridgeCache"),
+		forbiddenTestCase(GQLTestCase{query: mutation, variables: variables}, clsessions.UserRoleView, clsessions.UserRoleRun, "flushBridgeCache"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.App.On("BridgeORM").Return(f.Mocks.bridgeORM)
+				f.Mocks.bridgeORM.On("FindBridge", name).Return(bridges.BridgeType{Name: name}, nil)
+				f.App.On("BridgeCache").Return(f.Mocks.bridgeCache)
+				f.Mocks.bridgeCache.On("Flush", name).Return(3)
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+				{
+					"flushBridgeCache": {
+						"flushedResponses": 3
+					}
+				}`,
+		},
+		{
+			name:          "success for all bridges",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.App.On("BridgeCache").Return(f.Mocks.bridgeCache)
+				f.Mocks.bridgeCache.On("FlushAll").Return(7)
+			},
+			query: mutation,
+			result: `
+				{
+					"flushBridgeCache": {
+						"flushedResponses": 7
+					}
+				}`,
+		},
+		{
+			name:          "not found",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.App.On("BridgeORM").Return(f.Mocks.bridgeORM)
+				f.Mocks.bridgeORM.On("FindBridge", name).Return(bridges.BridgeType{}, sql.ErrNoRows)
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+				{
+					"flushBridgeCache": {
+						"message": "bridge not found",
+						"code": "NOT_FOUND"
+					}
+				}`,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
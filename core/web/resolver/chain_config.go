@@ -55,7 +55,9 @@ func FromChainType(ct ChainType) string {
 type GasEstimatorMode string
 
 const (
+	GasEstimatorModeArbitrum     GasEstimatorMode = "ARBITRUM"
 	GasEstimatorModeBlockHistory GasEstimatorMode = "BLOCK_HISTORY"
+	GasEstimatorModeFeeHistory   GasEstimatorMode = "FEE_HISTORY"
 	GasEstimatorModeFixedPrice   GasEstimatorMode = "FIXED_PRICE"
 	GasEstimatorModeOptimism     GasEstimatorMode = "OPTIMISM"
 	GasEstimatorModeOptimism2    GasEstimatorMode = "OPTIMISM2"
@@ -63,8 +65,12 @@ const (
 
 func ToGasEstimatorMode(s string) (GasEstimatorMode, error) {
 	switch s {
+	case "Arbitrum":
+		return GasEstimatorModeArbitrum, nil
 	case "BlockHistory":
 		return GasEstimatorModeBlockHistory, nil
+	case "FeeHistory":
+		return GasEstimatorModeFeeHistory, nil
 	case "FixedPrice":
 		return GasEstimatorModeFixedPrice, nil
 	case "Optimism":
@@ -78,8 +84,12 @@ func ToGasEstimatorMode(s string) (GasEstimatorMode, error) {
 
 func FromGasEstimatorMode(gsm GasEstimatorMode) string {
 	switch gsm {
+	case GasEstimatorModeArbitrum:
+		return "Arbitrum"
 	case GasEstimatorModeBlockHistory:
 		return "BlockHistory"
+	case GasEstimatorModeFeeHistory:
+		return "FeeHistory"
 	case GasEstimatorModeFixedPrice:
 		return "FixedPrice"
 	case GasEstimatorModeOptimism:
@@ -253,6 +263,14 @@ func (r *ChainConfigResolver) EvmGasTipCapMinimum() *string {
 	return nil
 }
 
+func (r *ChainConfigResolver) EvmGenerateAccessLists() *bool {
+	if r.cfg.EvmGenerateAccessLists.Valid {
+		return r.cfg.EvmGenerateAccessLists.Ptr()
+	}
+
+	return nil
+}
+
 func (r *ChainConfigResolver) EvmHeadTrackerHistoryDepth() *int32 {
 	if r.cfg.EvmHeadTrackerHistoryDepth.Valid {
 		val := r.cfg.EvmHeadTrackerHistoryDepth.Int64
@@ -314,6 +332,35 @@ func (r *ChainConfigResolver) EvmNonceAutoSync() *bool {
 	return nil
 }
 
+func (r *ChainConfigResolver) EvmPrivateRelayEnabled() *bool {
+	if r.cfg.EvmPrivateRelayEnabled.Valid {
+		return r.cfg.EvmPrivateRelayEnabled.Ptr()
+	}
+
+	return nil
+}
+
+func (r *ChainConfigResolver) EvmPrivateRelayFallbackBlocks() *int32 {
+	if r.cfg.EvmPrivateRelayFallbackBlocks.Valid {
+		val := r.cfg.EvmPrivateRelayFallbackBlocks.Int64
+		intVal := int32(val)
+
+		return &intVal
+	}
+
+	return nil
+}
+
+func (r *ChainConfigResolver) EvmPrivateRelayURL() *string {
+	if r.cfg.EvmPrivateRelayURL.Valid {
+		value := r.cfg.EvmPrivateRelayURL.String
+
+		return &value
+	}
+
+	return nil
+}
+
 func (r *ChainConfigResolver) EvmRPCDefaultBatchSize() *int32 {
 	if r.cfg.EvmRPCDefaultBatchSize.Valid {
 		val := r.cfg.EvmRPCDefaultBatchSize.Int64
@@ -436,12 +483,16 @@ type ChainConfigInput struct {
 	EvmGasPriceDefault                    *string
 	EvmGasTipCapDefault                   *string
 	EvmGasTipCapMinimum                   *string
+	EvmGenerateAccessLists                *bool
 	EvmHeadTrackerHistoryDepth            *int32
 	EvmHeadTrackerMaxBufferSize           *int32
 	EvmHeadTrackerSamplingInterval        *string
 	EvmLogBackfillBatchSize               *int32
 	EvmMaxGasPriceWei                     *string
 	EvmNonceAutoSync                      *bool
+	EvmPrivateRelayEnabled                *bool
+	EvmPrivateRelayFallbackBlocks         *int32
+	EvmPrivateRelayURL                    *string
 	EvmRPCDefaultBatchSize                *int32
 	FlagsContractAddress                  *string
 	GasEstimatorMode                      *GasEstimatorMode
@@ -547,6 +598,10 @@ func ToChainConfig(input ChainConfigInput) (*types.ChainCfg, map[string]string)
 		}
 	}
 
+	if input.EvmGenerateAccessLists != nil {
+		cfg.EvmGenerateAccessLists = null.BoolFrom(*input.EvmGenerateAccessLists)
+	}
+
 	if input.EvmHeadTrackerHistoryDepth != nil {
 		cfg.EvmHeadTrackerHistoryDepth = null.IntFrom(int64(*input.EvmHeadTrackerHistoryDepth))
 	}
@@ -581,6 +636,18 @@ func ToChainConfig(input ChainConfigInput) (*types.ChainCfg, map[string]string)
 		cfg.EvmNonceAutoSync = null.BoolFrom(*input.EvmNonceAutoSync)
 	}
 
+	if input.EvmPrivateRelayEnabled != nil {
+		cfg.EvmPrivateRelayEnabled = null.BoolFrom(*input.EvmPrivateRelayEnabled)
+	}
+
+	if input.EvmPrivateRelayFallbackBlocks != nil {
+		cfg.EvmPrivateRelayFallbackBlocks = null.IntFrom(int64(*input.EvmPrivateRelayFallbackBlocks))
+	}
+
+	if input.EvmPrivateRelayURL != nil {
+		cfg.EvmPrivateRelayURL = null.StringFrom(*input.EvmPrivateRelayURL)
+	}
+
 	if input.EvmRPCDefaultBatchSize != nil {
 		cfg.EvmRPCDefaultBatchSize = null.IntFrom(int64(*input.EvmRPCDefaultBatchSize))
 	}
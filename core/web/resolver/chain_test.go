@@ -12,7 +12,9 @@ import (
 	"github.com/stretchr/testify/require"
 	"gopkg.in/guregu/null.v4"
 
+	"github.com/smartcontractkit/chainlink/core/audit"
 	"github.com/smartcontractkit/chainlink/core/chains/evm/types"
+	clsessions "github.com/smartcontractkit/chainlink/core/sessions"
 	"github.com/smartcontractkit/chainlink/core/store/models"
 	"github.com/smartcontractkit/chainlink/core/utils"
 )
@@ -351,6 +353,7 @@ func TestResolver_CreateChain(t *testing.T) {
 
 	testCases := []GQLTestCase{
 		unauthorizedTestCase(GQLTestCase{query: mutation, variables: input}, "createChain"),
+		forbiddenTestCase(GQLTestCase{query: mutation, variables: input}, clsessions.UserRoleEdit, clsessions.UserRoleAdmin, "createChain"),
 		{
 			name:          "success",
 			authenticated: true,
@@ -377,6 +380,7 @@ func TestResolver_CreateChain(t *testing.T) {
 					Cfg:       cfg,
 				}, nil)
 				f.App.On("GetChainSet").Return(f.Mocks.chainSet)
+				f.expectAuditRecord(audit.ActionChainCreated, "1")
 			},
 			query:     mutation,
 			variables: input,
@@ -493,6 +497,7 @@ func TestResolver_DeleteChain(t *testing.T) {
 
 	testCases := []GQLTestCase{
 		unauthorizedTestCase(GQLTestCase{query: mutation, variables: variables}, "deleteChain"),
+		forbiddenTestCase(GQLTestCase{query: mutation, variables: variables}, clsessions.UserRoleEdit, clsessions.UserRoleAdmin, "deleteChain"),
 		{
 			name:          "success",
 			authenticated: true,
@@ -501,6 +506,7 @@ func TestResolver_DeleteChain(t *testing.T) {
 					ID: chainID,
 				}, nil)
 				f.Mocks.chainSet.On("Remove", chainID.ToInt()).Return(nil)
+				f.expectAuditRecord(audit.ActionChainDeleted, "123")
 				f.App.On("EVMORM").Return(f.Mocks.evmORM)
 				f.App.On("GetChainSet").Return(f.Mocks.chainSet)
 			},
@@ -652,6 +658,7 @@ func TestResolver_UpdateChain(t *testing.T) {
 
 	testCases := []GQLTestCase{
 		unauthorizedTestCase(GQLTestCase{query: mutation, variables: input}, "updateChain"),
+		forbiddenTestCase(GQLTestCase{query: mutation, variables: input}, clsessions.UserRoleEdit, clsessions.UserRoleAdmin, "updateChain"),
 		{
 			name:          "success",
 			authenticated: true,
@@ -671,6 +678,12 @@ func TestResolver_UpdateChain(t *testing.T) {
 					},
 				}
 
+				f.Mocks.evmORM.On("Chain", chainID).Return(types.Chain{
+					ID:        chainID,
+					Enabled:   false,
+					CreatedAt: f.Timestamp(),
+				}, nil)
+				f.App.On("EVMORM").Return(f.Mocks.evmORM)
 				f.Mocks.chainSet.On("Configure", chainID.ToInt(), true, cfg).Return(types.Chain{
 					ID:        chainID,
 					Enabled:   true,
@@ -678,6 +691,7 @@ func TestResolver_UpdateChain(t *testing.T) {
 					Cfg:       cfg,
 				}, nil)
 				f.App.On("GetChainSet").Return(f.Mocks.chainSet)
+				f.expectAuditRecord(audit.ActionChainUpdated, "1233")
 			},
 			query:     mutation,
 			variables: input,
@@ -729,23 +743,8 @@ func TestResolver_UpdateChain(t *testing.T) {
 			name:          "not found error",
 			authenticated: true,
 			before: func(f *gqlTestFramework) {
-				cfg := types.ChainCfg{
-					BlockHistoryEstimatorBlockDelay: null.IntFrom(1),
-					EthTxReaperThreshold:            &threshold,
-					GasEstimatorMode:                null.StringFrom("BlockHistory"),
-					ChainType:                       null.StringFrom("optimism"),
-					KeySpecific: map[string]types.ChainCfg{
-						"some-address": {
-							BlockHistoryEstimatorBlockDelay: null.IntFrom(0),
-							EthTxReaperThreshold:            &threshold,
-							GasEstimatorMode:                null.StringFrom("BlockHistory"),
-							ChainType:                       null.StringFrom("exchain"),
-						},
-					},
-				}
-
-				f.Mocks.chainSet.On("Configure", chainID.ToInt(), true, cfg).Return(types.Chain{}, sql.ErrNoRows)
-				f.App.On("GetChainSet").Return(f.Mocks.chainSet)
+				f.Mocks.evmORM.On("Chain", chainID).Return(types.Chain{}, sql.ErrNoRows)
+				f.App.On("EVMORM").Return(f.Mocks.evmORM)
 			},
 			query:     mutation,
 			variables: input,
@@ -776,6 +775,8 @@ func TestResolver_UpdateChain(t *testing.T) {
 					},
 				}
 
+				f.Mocks.evmORM.On("Chain", chainID).Return(types.Chain{ID: chainID}, nil)
+				f.App.On("EVMORM").Return(f.Mocks.evmORM)
 				f.Mocks.chainSet.On("Configure", chainID.ToInt(), true, cfg).Return(types.Chain{}, gError)
 				f.App.On("GetChainSet").Return(f.Mocks.chainSet)
 			},
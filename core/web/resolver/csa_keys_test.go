@@ -7,6 +7,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 
+	"github.com/smartcontractkit/chainlink/core/audit"
 	"github.com/smartcontractkit/chainlink/core/services/keystore"
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/csakey"
 )
@@ -110,6 +111,7 @@ func Test_CreateCSAKey(t *testing.T) {
 			authenticated: true,
 			before: func(f *gqlTestFramework) {
 				f.Mocks.csa.On("Create").Return(fakeKey, nil)
+				f.expectAuditRecord(audit.ActionKeyCreated, "csa:"+fakeKey.ID())
 				f.Mocks.keystore.On("CSA").Return(f.Mocks.csa)
 				f.App.On("GetKeyStore").Return(f.Mocks.keystore)
 			},
@@ -181,6 +183,7 @@ func Test_DeleteCSAKey(t *testing.T) {
 				f.App.On("GetKeyStore").Return(f.Mocks.keystore)
 				f.Mocks.keystore.On("CSA").Return(f.Mocks.csa)
 				f.Mocks.csa.On("Delete", fakeKey.ID()).Return(fakeKey, nil)
+				f.expectAuditRecord(audit.ActionKeyDeleted, "csa:"+fakeKey.ID())
 			},
 			query:     query,
 			variables: variables,
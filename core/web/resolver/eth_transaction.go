@@ -0,0 +1,198 @@
+package resolver
+
+import (
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/graph-gophers/graphql-go"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/services/bulletprooftxmanager"
+)
+
+// EthTransactionResolver resolves the EthTransaction type
+type EthTransactionResolver struct {
+	etx bulletprooftxmanager.EthTx
+}
+
+func NewEthTransaction(etx bulletprooftxmanager.EthTx) *EthTransactionResolver {
+	return &EthTransactionResolver{etx: etx}
+}
+
+// ID resolves the transaction's unique identifier
+func (r *EthTransactionResolver) ID() graphql.ID {
+	return int64GQLID(r.etx.ID)
+}
+
+// State resolves the transaction's state
+func (r *EthTransactionResolver) State() string {
+	return string(r.etx.State)
+}
+
+// From resolves the sending address
+func (r *EthTransactionResolver) From() string {
+	return r.etx.FromAddress.Hex()
+}
+
+// To resolves the destination address
+func (r *EthTransactionResolver) To() string {
+	return r.etx.ToAddress.Hex()
+}
+
+// Data resolves the hex encoded payload
+func (r *EthTransactionResolver) Data() string {
+	return hexutil.Encode(r.etx.EncodedPayload)
+}
+
+// Value resolves the value sent with the transaction
+func (r *EthTransactionResolver) Value() string {
+	return r.etx.Value.String()
+}
+
+// GasLimit resolves the transaction's gas limit
+func (r *EthTransactionResolver) GasLimit() string {
+	return strconv.FormatUint(r.etx.GasLimit, 10)
+}
+
+// Nonce resolves the transaction's nonce, which is only assigned once it is
+// broadcast
+func (r *EthTransactionResolver) Nonce() *string {
+	if r.etx.Nonce == nil {
+		return nil
+	}
+	nonce := strconv.FormatInt(*r.etx.Nonce, 10)
+
+	return &nonce
+}
+
+// Hash resolves the hash of the highest priced attempt
+func (r *EthTransactionResolver) Hash() *string {
+	if len(r.etx.EthTxAttempts) == 0 {
+		return nil
+	}
+	hash := r.etx.EthTxAttempts[0].Hash.Hex()
+
+	return &hash
+}
+
+// EVMChainID resolves the ID of the chain the transaction is sent on
+func (r *EthTransactionResolver) EVMChainID() graphql.ID {
+	return graphql.ID(r.etx.EVMChainID.String())
+}
+
+type EthTransactionNotReplaceableErrorResolver struct {
+	message string
+}
+
+func NewEthTransactionNotReplaceableError(message string) *EthTransactionNotReplaceableErrorResolver {
+	return &EthTransactionNotReplaceableErrorResolver{message: message}
+}
+
+func (r *EthTransactionNotReplaceableErrorResolver) Message() string {
+	return r.message
+}
+
+func (r *EthTransactionNotReplaceableErrorResolver) Code() ErrorCode {
+	return ErrorCodeStatusConflict
+}
+
+func toEthTransactionNotReplaceableError(err error) (*EthTransactionNotReplaceableErrorResolver, bool) {
+	if err != nil && errors.Is(err, bulletprooftxmanager.ErrEthTxNotReplaceable) {
+		return NewEthTransactionNotReplaceableError(err.Error()), true
+	}
+
+	return nil, false
+}
+
+// -- CancelEthTransaction Mutation --
+
+type CancelEthTransactionPayloadResolver struct {
+	etx *bulletprooftxmanager.EthTx
+	NotFoundErrorUnionType
+}
+
+func NewCancelEthTransactionPayload(etx *bulletprooftxmanager.EthTx, err error) *CancelEthTransactionPayloadResolver {
+	e := NotFoundErrorUnionType{err: err, message: "transaction not found"}
+
+	return &CancelEthTransactionPayloadResolver{etx: etx, NotFoundErrorUnionType: e}
+}
+
+func (r *CancelEthTransactionPayloadResolver) ToCancelEthTransactionSuccess() (*CancelEthTransactionSuccessResolver, bool) {
+	if r.etx != nil {
+		return NewCancelEthTransactionSuccess(*r.etx), true
+	}
+
+	return nil, false
+}
+
+func (r *CancelEthTransactionPayloadResolver) ToEthTransactionNotReplaceableError() (*EthTransactionNotReplaceableErrorResolver, bool) {
+	return toEthTransactionNotReplaceableError(r.err)
+}
+
+type CancelEthTransactionSuccessResolver struct {
+	etx bulletprooftxmanager.EthTx
+}
+
+func NewCancelEthTransactionSuccess(etx bulletprooftxmanager.EthTx) *CancelEthTransactionSuccessResolver {
+	return &CancelEthTransactionSuccessResolver{etx: etx}
+}
+
+func (r *CancelEthTransactionSuccessResolver) Transaction() *EthTransactionResolver {
+	return NewEthTransaction(r.etx)
+}
+
+// -- ReplaceEthTransaction Mutation --
+
+type ReplaceEthTransactionInput struct {
+	Data string
+}
+
+type ReplaceEthTransactionPayloadResolver struct {
+	etx       *bulletprooftxmanager.EthTx
+	inputErrs map[string]string
+	NotFoundErrorUnionType
+}
+
+func NewReplaceEthTransactionPayload(etx *bulletprooftxmanager.EthTx, inputErrs map[string]string, err error) *ReplaceEthTransactionPayloadResolver {
+	e := NotFoundErrorUnionType{err: err, message: "transaction not found"}
+
+	return &ReplaceEthTransactionPayloadResolver{etx: etx, inputErrs: inputErrs, NotFoundErrorUnionType: e}
+}
+
+func (r *ReplaceEthTransactionPayloadResolver) ToReplaceEthTransactionSuccess() (*ReplaceEthTransactionSuccessResolver, bool) {
+	if r.etx != nil {
+		return NewReplaceEthTransactionSuccess(*r.etx), true
+	}
+
+	return nil, false
+}
+
+func (r *ReplaceEthTransactionPayloadResolver) ToEthTransactionNotReplaceableError() (*EthTransactionNotReplaceableErrorResolver, bool) {
+	return toEthTransactionNotReplaceableError(r.err)
+}
+
+func (r *ReplaceEthTransactionPayloadResolver) ToInputErrors() (*InputErrorsResolver, bool) {
+	if r.inputErrs != nil {
+		var errs []*InputErrorResolver
+
+		for path, message := range r.inputErrs {
+			errs = append(errs, NewInputError(path, message))
+		}
+
+		return NewInputErrors(errs), true
+	}
+
+	return nil, false
+}
+
+type ReplaceEthTransactionSuccessResolver struct {
+	etx bulletprooftxmanager.EthTx
+}
+
+func NewReplaceEthTransactionSuccess(etx bulletprooftxmanager.EthTx) *ReplaceEthTransactionSuccessResolver {
+	return &ReplaceEthTransactionSuccessResolver{etx: etx}
+}
+
+func (r *ReplaceEthTransactionSuccessResolver) Transaction() *EthTransactionResolver {
+	return NewEthTransaction(r.etx)
+}
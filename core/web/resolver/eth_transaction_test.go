@@ -0,0 +1,266 @@
+package resolver
+
+import (
+	"database/sql"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/assets"
+	"github.com/smartcontractkit/chainlink/core/audit"
+	"github.com/smartcontractkit/chainlink/core/services/bulletprooftxmanager"
+	clsessions "github.com/smartcontractkit/chainlink/core/sessions"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+func newTestEthTx(state bulletprooftxmanager.EthTxState) bulletprooftxmanager.EthTx {
+	return bulletprooftxmanager.EthTx{
+		ID:             1,
+		FromAddress:    common.HexToAddress("0x5431F5F973781809D18643b87B44921b11355d81"),
+		ToAddress:      common.HexToAddress("0x0000000000000000000000000000000000000002"),
+		EncodedPayload: []byte{1, 2, 3},
+		Value:          assets.NewEthValue(1),
+		GasLimit:       21000,
+		State:          state,
+		EVMChainID:     *utils.NewBigI(42),
+	}
+}
+
+// setupEthTxMocks mocks the lookup of the eth_tx with ID 1 and of the
+// TxManager of its chain
+func setupEthTxMocks(f *gqlTestFramework, etx bulletprooftxmanager.EthTx) {
+	f.App.On("BPTXMORM").Return(f.Mocks.bptxmORM)
+	f.Mocks.bptxmORM.On("FindEthTxWithAttempts", int64(1)).Return(etx, nil)
+	f.App.On("GetChainSet").Return(f.Mocks.chainSet)
+	f.Mocks.chainSet.On("Get", big.NewInt(42)).Return(f.Mocks.chain, nil)
+	f.Mocks.chain.On("TxManager").Return(f.Mocks.txm)
+}
+
+func TestResolver_CancelEthTransaction(t *testing.T) {
+	t.Parallel()
+
+	mutation := `
+		mutation CancelEthTransaction($id: ID!) {
+			cancelEthTransaction(id: $id) {
+				... on CancelEthTransactionSuccess {
+					transaction {
+						id
+						state
+						from
+						to
+						data
+						value
+						gasLimit
+						nonce
+						hash
+						evmChainID
+					}
+				}
+				... on EthTransactionNotReplaceableError {
+					code
+					message
+				}
+				... on NotFoundError {
+					code
+					message
+				}
+			}
+		}`
+	variables := map[string]interface{}{
+		"id": "1",
+	}
+	nonce := int64(7)
+	hash := common.HexToHash("0x2c6b0bc2efa3e5ce4a3bbcbd1f3ab1e80dc1e3e2a8a6ba9d3d77bf8fa1e2a4c5")
+	notReplaceableErr := errors.Wrap(errors.Wrapf(bulletprooftxmanager.ErrEthTxNotReplaceable, "eth_tx 1 is %s", bulletprooftxmanager.EthTxConfirmed), "BulletproofTxManager#CancelEthTransaction")
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: mutation, variables: variables}, "cancelEthTransaction"),
+		forbiddenTestCase(GQLTestCase{query: mutation, variables: variables}, clsessions.UserRoleEdit, clsessions.UserRoleAdmin, "cancelEthTransaction"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				etx := newTestEthTx(bulletprooftxmanager.EthTxUnconfirmed)
+				etx.Nonce = &nonce
+				setupEthTxMocks(f, etx)
+
+				cancelled := etx
+				cancelled.ToAddress = etx.FromAddress
+				cancelled.EncodedPayload = []byte{}
+				cancelled.Value = assets.NewEthValue(0)
+				cancelled.EthTxAttempts = []bulletprooftxmanager.EthTxAttempt{{Hash: hash}}
+				f.Mocks.txm.On("CancelEthTransaction", int64(1)).Return(cancelled, nil)
+				f.expectAuditRecord(audit.ActionTxsCancelled, "1")
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+				{
+					"cancelEthTransaction": {
+						"transaction": {
+							"id": "1",
+							"state": "unconfirmed",
+							"from": "0x5431F5F973781809D18643b87B44921b11355d81",
+							"to": "0x5431F5F973781809D18643b87B44921b11355d81",
+							"data": "0x",
+							"value": "0.000000000000000000",
+							"gasLimit": "21000",
+							"nonce": "7",
+							"hash": "0x2c6b0bc2efa3e5ce4a3bbcbd1f3ab1e80dc1e3e2a8a6ba9d3d77bf8fa1e2a4c5",
+							"evmChainID": "42"
+						}
+					}
+				}`,
+		},
+		{
+			name:          "not found",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.App.On("BPTXMORM").Return(f.Mocks.bptxmORM)
+				f.Mocks.bptxmORM.On("FindEthTxWithAttempts", int64(1)).Return(bulletprooftxmanager.EthTx{}, errors.Wrap(sql.ErrNoRows, "FindEthTxWithAttempts failed"))
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+				{
+					"cancelEthTransaction": {
+						"code": "NOT_FOUND",
+						"message": "transaction not found"
+					}
+				}`,
+		},
+		{
+			name:          "not replaceable",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				setupEthTxMocks(f, newTestEthTx(bulletprooftxmanager.EthTxConfirmed))
+				f.Mocks.txm.On("CancelEthTransaction", int64(1)).Return(bulletprooftxmanager.EthTx{}, notReplaceableErr)
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+				{
+					"cancelEthTransaction": {
+						"code": "STATUS_CONFLICT",
+						"message": "BulletproofTxManager#CancelEthTransaction: eth_tx 1 is confirmed: transaction cannot be cancelled or replaced"
+					}
+				}`,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
+
+func TestResolver_ReplaceEthTransaction(t *testing.T) {
+	t.Parallel()
+
+	mutation := `
+		mutation ReplaceEthTransaction($id: ID!, $input: ReplaceEthTransactionInput!) {
+			replaceEthTransaction(id: $id, input: $input) {
+				... on ReplaceEthTransactionSuccess {
+					transaction {
+						id
+						state
+						data
+						nonce
+						hash
+					}
+				}
+				... on EthTransactionNotReplaceableError {
+					code
+					message
+				}
+				... on NotFoundError {
+					code
+					message
+				}
+				... on InputErrors {
+					errors {
+						path
+						message
+						code
+					}
+				}
+			}
+		}`
+	variables := map[string]interface{}{
+		"id": "1",
+		"input": map[string]interface{}{
+			"data": "0x0405",
+		},
+	}
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: mutation, variables: variables}, "replaceEthTransaction"),
+		forbiddenTestCase(GQLTestCase{query: mutation, variables: variables}, clsessions.UserRoleEdit, clsessions.UserRoleAdmin, "replaceEthTransaction"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				etx := newTestEthTx(bulletprooftxmanager.EthTxUnstarted)
+				setupEthTxMocks(f, etx)
+
+				replaced := etx
+				replaced.EncodedPayload = []byte{4, 5}
+				f.Mocks.txm.On("ReplaceEthTransaction", int64(1), []byte{4, 5}).Return(replaced, nil)
+				f.expectAuditRecord(audit.ActionTxsReplaced, "1")
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+				{
+					"replaceEthTransaction": {
+						"transaction": {
+							"id": "1",
+							"state": "unstarted",
+							"data": "0x0405",
+							"nonce": null,
+							"hash": null
+						}
+					}
+				}`,
+		},
+		{
+			name:          "invalid data",
+			authenticated: true,
+			query:         mutation,
+			variables: map[string]interface{}{
+				"id": "1",
+				"input": map[string]interface{}{
+					"data": "0405",
+				},
+			},
+			result: `
+				{
+					"replaceEthTransaction": {
+						"errors": [{
+							"path": "input/data",
+							"message": "invalid hex data: hex string without 0x prefix",
+							"code": "INVALID_INPUT"
+						}]
+					}
+				}`,
+		},
+		{
+			name:          "not found",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.App.On("BPTXMORM").Return(f.Mocks.bptxmORM)
+				f.Mocks.bptxmORM.On("FindEthTxWithAttempts", int64(1)).Return(bulletprooftxmanager.EthTx{}, sql.ErrNoRows)
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+				{
+					"replaceEthTransaction": {
+						"code": "NOT_FOUND",
+						"message": "transaction not found"
+					}
+				}`,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"gopkg.in/guregu/null.v4"
 
+	"github.com/smartcontractkit/chainlink/core/audit"
 	"github.com/smartcontractkit/chainlink/core/services/feeds"
 	"github.com/smartcontractkit/chainlink/core/utils/crypto"
 )
@@ -273,6 +274,7 @@ func Test_CreateFeedsManager(t *testing.T) {
 					IsOCRBootstrapPeer:        false,
 					OCRBootstrapPeerMultiaddr: null.StringFromPtr(nil),
 				}).Return(mgrID, nil)
+				f.expectAuditRecord(audit.ActionFeedsManagerCreated, name)
 				f.Mocks.feedsSvc.On("GetManager", mgrID).Return(&feeds.FeedsManager{
 					ID:                        mgrID,
 					Name:                      name,
@@ -427,6 +429,14 @@ func Test_UpdateFeedsManager(t *testing.T) {
 			authenticated: true,
 			before: func(f *gqlTestFramework) {
 				f.App.On("GetFeedsService").Return(f.Mocks.feedsSvc)
+				f.Mocks.feedsSvc.On("GetManager", mgrID).Return(&feeds.FeedsManager{
+					ID:        mgrID,
+					Name:      "manager0",
+					URI:       uri,
+					PublicKey: *pubKey,
+					JobTypes:  []string{"fluxmonitor"},
+					CreatedAt: f.Timestamp(),
+				}, nil).Once()
 				f.Mocks.feedsSvc.On("UpdateFeedsManager", mock.Anything, feeds.FeedsManager{
 					ID:                        mgrID,
 					Name:                      name,
@@ -446,7 +456,8 @@ func Test_UpdateFeedsManager(t *testing.T) {
 					OCRBootstrapPeerMultiaddr: null.StringFromPtr(nil),
 					IsConnectionActive:        false,
 					CreatedAt:                 f.Timestamp(),
-				}, nil)
+				}, nil).Once()
+				f.expectAuditRecord(audit.ActionFeedsManagerUpdated, name)
 			},
 			query:     mutation,
 			variables: variables,
@@ -472,7 +483,6 @@ func Test_UpdateFeedsManager(t *testing.T) {
 			authenticated: true,
 			before: func(f *gqlTestFramework) {
 				f.App.On("GetFeedsService").Return(f.Mocks.feedsSvc)
-				f.Mocks.feedsSvc.On("UpdateFeedsManager", mock.Anything, mock.IsType(feeds.FeedsManager{})).Return(nil)
 				f.Mocks.feedsSvc.On("GetManager", mgrID).Return(nil, sql.ErrNoRows)
 			},
 			query:     mutation,
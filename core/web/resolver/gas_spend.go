@@ -0,0 +1,106 @@
+package resolver
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/graph-gophers/graphql-go"
+
+	"github.com/smartcontractkit/chainlink/core/services/bulletprooftxmanager"
+)
+
+// GasSpendGroupBy is the GQL enum of bulletprooftxmanager.GasSpendGroupBy
+type GasSpendGroupBy string
+
+const (
+	GasSpendGroupByChain       GasSpendGroupBy = "CHAIN"
+	GasSpendGroupByKey         GasSpendGroupBy = "KEY"
+	GasSpendGroupByJob         GasSpendGroupBy = "JOB"
+	GasSpendGroupByPipelineRun GasSpendGroupBy = "PIPELINE_RUN"
+)
+
+// FromGasSpendGroupBy converts the GQL enum to a
+// bulletprooftxmanager.GasSpendGroupBy. A nil grouping groups by chain.
+func FromGasSpendGroupBy(groupBy *GasSpendGroupBy) bulletprooftxmanager.GasSpendGroupBy {
+	if groupBy == nil {
+		return bulletprooftxmanager.GasSpendGroupByChain
+	}
+	return bulletprooftxmanager.GasSpendGroupBy(strings.ToLower(string(*groupBy)))
+}
+
+// GasSpendResolver resolves the GasSpend type
+type GasSpendResolver struct {
+	spend bulletprooftxmanager.GasSpend
+}
+
+func NewGasSpend(spend bulletprooftxmanager.GasSpend) *GasSpendResolver {
+	return &GasSpendResolver{spend: spend}
+}
+
+func NewGasSpends(spends []bulletprooftxmanager.GasSpend) []*GasSpendResolver {
+	var resolvers []*GasSpendResolver
+	for _, s := range spends {
+		resolvers = append(resolvers, NewGasSpend(s))
+	}
+
+	return resolvers
+}
+
+// EVMChainID resolves the chain the fees were paid on
+func (r *GasSpendResolver) EVMChainID() graphql.ID {
+	return graphql.ID(r.spend.EVMChainID.String())
+}
+
+// FromAddress resolves the key that paid the fees
+func (r *GasSpendResolver) FromAddress() *string {
+	if r.spend.FromAddress == nil {
+		return nil
+	}
+	addr := r.spend.FromAddress.Hex()
+
+	return &addr
+}
+
+// JobID resolves the job whose transactions paid the fees
+func (r *GasSpendResolver) JobID() *graphql.ID {
+	if !r.spend.JobID.Valid {
+		return nil
+	}
+	id := graphql.ID(strconv.FormatInt(r.spend.JobID.Int64, 10))
+
+	return &id
+}
+
+// PipelineRunID resolves the pipeline run whose transactions paid the fees
+func (r *GasSpendResolver) PipelineRunID() *graphql.ID {
+	if !r.spend.PipelineRunID.Valid {
+		return nil
+	}
+	id := graphql.ID(strconv.FormatInt(r.spend.PipelineRunID.Int64, 10))
+
+	return &id
+}
+
+// FeeWei resolves the total fee paid, in wei
+func (r *GasSpendResolver) FeeWei() string {
+	return r.spend.FeeWei.String()
+}
+
+// TransactionCount resolves the number of confirmed transactions
+func (r *GasSpendResolver) TransactionCount() int32 {
+	return int32(r.spend.TransactionCount)
+}
+
+// GasSpendPayloadResolver resolves the gas spend groups
+type GasSpendPayloadResolver struct {
+	spends []bulletprooftxmanager.GasSpend
+}
+
+func NewGasSpendPayload(spends []bulletprooftxmanager.GasSpend) *GasSpendPayloadResolver {
+	return &GasSpendPayloadResolver{spends: spends}
+}
+
+// Results returns the gas spend groups.
+func (r *GasSpendPayloadResolver) Results() []*GasSpendResolver {
+	return NewGasSpends(r.spends)
+}
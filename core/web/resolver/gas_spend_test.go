@@ -0,0 +1,151 @@
+package resolver
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	gqlerrors "github.com/graph-gophers/graphql-go/errors"
+	"github.com/pkg/errors"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/services/bulletprooftxmanager"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+func TestResolver_GasSpend(t *testing.T) {
+	t.Parallel()
+
+	query := `
+		query GetGasSpend($since: Time!, $groupBy: GasSpendGroupBy) {
+			gasSpend(since: $since, groupBy: $groupBy) {
+				results {
+					evmChainID
+					fromAddress
+					jobID
+					pipelineRunID
+					feeWei
+					transactionCount
+				}
+			}
+		}`
+
+	since := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	address := common.HexToAddress("0x5431F5F973781809D18643b87B44921b11355d81")
+	gError := errors.New("error")
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: query, variables: map[string]interface{}{"since": "2021-01-01T00:00:00Z"}}, "gasSpend"),
+		{
+			name:          "success grouped by chain",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.App.On("BPTXMORM").Return(f.Mocks.bptxmORM)
+				f.Mocks.bptxmORM.On("GasSpend", bulletprooftxmanager.GasSpendGroupByChain, since).Return([]bulletprooftxmanager.GasSpend{
+					{
+						EVMChainID:       *utils.NewBigI(42),
+						FeeWei:           *utils.NewBigI(21000000000000),
+						TransactionCount: 1,
+					},
+				}, nil)
+			},
+			query:     query,
+			variables: map[string]interface{}{"since": "2021-01-01T00:00:00Z"},
+			result: `
+				{
+					"gasSpend": {
+						"results": [{
+							"evmChainID": "42",
+							"fromAddress": null,
+							"jobID": null,
+							"pipelineRunID": null,
+							"feeWei": "21000000000000",
+							"transactionCount": 1
+						}]
+					}
+				}`,
+		},
+		{
+			name:          "success grouped by key",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.App.On("BPTXMORM").Return(f.Mocks.bptxmORM)
+				f.Mocks.bptxmORM.On("GasSpend", bulletprooftxmanager.GasSpendGroupByKey, since).Return([]bulletprooftxmanager.GasSpend{
+					{
+						EVMChainID:       *utils.NewBigI(42),
+						FromAddress:      &address,
+						FeeWei:           *utils.NewBigI(42000000000000),
+						TransactionCount: 2,
+					},
+				}, nil)
+			},
+			query:     query,
+			variables: map[string]interface{}{"since": "2021-01-01T00:00:00Z", "groupBy": "KEY"},
+			result: `
+				{
+					"gasSpend": {
+						"results": [{
+							"evmChainID": "42",
+							"fromAddress": "0x5431F5F973781809D18643b87B44921b11355d81",
+							"jobID": null,
+							"pipelineRunID": null,
+							"feeWei": "42000000000000",
+							"transactionCount": 2
+						}]
+					}
+				}`,
+		},
+		{
+			name:          "success grouped by pipeline run",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.App.On("BPTXMORM").Return(f.Mocks.bptxmORM)
+				f.Mocks.bptxmORM.On("GasSpend", bulletprooftxmanager.GasSpendGroupByPipelineRun, since).Return([]bulletprooftxmanager.GasSpend{
+					{
+						EVMChainID:       *utils.NewBigI(42),
+						JobID:            null.IntFrom(1),
+						PipelineRunID:    null.IntFrom(200),
+						FeeWei:           *utils.NewBigI(21000000000000),
+						TransactionCount: 1,
+					},
+				}, nil)
+			},
+			query:     query,
+			variables: map[string]interface{}{"since": "2021-01-01T00:00:00Z", "groupBy": "PIPELINE_RUN"},
+			result: `
+				{
+					"gasSpend": {
+						"results": [{
+							"evmChainID": "42",
+							"fromAddress": null,
+							"jobID": "1",
+							"pipelineRunID": "200",
+							"feeWei": "21000000000000",
+							"transactionCount": 1
+						}]
+					}
+				}`,
+		},
+		{
+			name:          "generic error",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.App.On("BPTXMORM").Return(f.Mocks.bptxmORM)
+				f.Mocks.bptxmORM.On("GasSpend", bulletprooftxmanager.GasSpendGroupByChain, since).Return(nil, gError)
+			},
+			query:     query,
+			variables: map[string]interface{}{"since": "2021-01-01T00:00:00Z"},
+			result:    `null`,
+			errors: []*gqlerrors.QueryError{
+				{
+					Extensions:    nil,
+					ResolverError: gError,
+					Path:          []interface{}{"gasSpend"},
+					Message:       gError.Error(),
+				},
+			},
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
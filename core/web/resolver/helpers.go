@@ -85,6 +85,9 @@ func ValidateBridgeType(bt *bridges.BridgeTypeRequest) error {
 
 		return errors.New("MinimumContractPayment must be positive")
 	}
+	if err := bt.RequestPolicy.Validate(); err != nil {
+		return err
+	}
 
 	return nil
 }
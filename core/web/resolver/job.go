@@ -4,9 +4,11 @@ import (
 	"context"
 
 	"github.com/graph-gophers/graphql-go"
+	"github.com/pkg/errors"
 
 	"github.com/smartcontractkit/chainlink/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/core/services/job"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/core/web/loader"
 )
 
@@ -57,6 +59,27 @@ func (r *JobResolver) MaxTaskDuration() string {
 	return r.j.MaxTaskDuration.Duration().String()
 }
 
+// GasSpendBudgetWei resolves the job's gas spend budget.
+func (r *JobResolver) GasSpendBudgetWei() *string {
+	if r.j.GasSpendBudgetWei == nil {
+		return nil
+	}
+	budget := r.j.GasSpendBudgetWei.ToInt().String()
+
+	return &budget
+}
+
+// GasSpendBudgetWindow resolves the rolling window of the job's gas spend
+// budget.
+func (r *JobResolver) GasSpendBudgetWindow() *string {
+	if r.j.GasSpendBudgetWei == nil {
+		return nil
+	}
+	window := r.j.GasSpendBudgetWindow.Duration().String()
+
+	return &window
+}
+
 // Name resolves the job's name.
 func (r *JobResolver) Name() string {
 	return r.j.Name.ValueOrZero()
@@ -70,6 +93,35 @@ func (r *JobResolver) ObservationSource() string {
 	return r.j.PipelineSpec.DotDagSource
 }
 
+// Version resolves the current version of the job's pipeline.
+func (r *JobResolver) Version() int32 {
+	return r.j.PipelineSpec.Version
+}
+
+// Versions resolves every version of the job's pipeline, latest first.
+func (r *JobResolver) Versions() ([]*JobVersionResolver, error) {
+	specs, err := r.app.JobORM().FindJobVersions(r.j.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewJobVersions(r.j, specs), nil
+}
+
+// Paused resolves whether the job is paused.
+func (r *JobResolver) Paused() bool {
+	return r.j.Paused()
+}
+
+// PausedAt resolves when the job was paused.
+func (r *JobResolver) PausedAt() *graphql.Time {
+	if !r.j.PausedAt.Valid {
+		return nil
+	}
+
+	return &graphql.Time{Time: r.j.PausedAt.Time}
+}
+
 // SchemaVersion resolves the job's schema version.
 func (r *JobResolver) SchemaVersion() int32 {
 	return int32(r.j.SchemaVersion)
@@ -242,3 +294,270 @@ func NewDeleteJobSuccess(app chainlink.Application, job *job.Job) *DeleteJobSucc
 func (r *DeleteJobSuccessResolver) Job() *JobResolver {
 	return NewJob(r.app, *r.j)
 }
+
+// -- PauseJob Mutation --
+
+type PauseJobPayloadResolver struct {
+	app chainlink.Application
+	j   *job.Job
+	err error
+	NotFoundErrorUnionType
+}
+
+func NewPauseJobPayload(app chainlink.Application, j *job.Job, err error) *PauseJobPayloadResolver {
+	e := NotFoundErrorUnionType{err: err, message: "job not found"}
+
+	return &PauseJobPayloadResolver{app: app, j: j, err: err, NotFoundErrorUnionType: e}
+}
+
+func (r *PauseJobPayloadResolver) ToPauseJobSuccess() (*PauseJobSuccessResolver, bool) {
+	if r.j == nil {
+		return nil, false
+	}
+
+	return NewPauseJobSuccess(r.app, r.j), true
+}
+
+func (r *PauseJobPayloadResolver) ToPauseJobConflictError() (*PauseJobConflictErrorResolver, bool) {
+	if r.err != nil && errors.Is(r.err, job.ErrJobPaused) {
+		return NewPauseJobConflictError(r.err.Error()), true
+	}
+
+	return nil, false
+}
+
+type PauseJobSuccessResolver struct {
+	app chainlink.Application
+	j   *job.Job
+}
+
+func NewPauseJobSuccess(app chainlink.Application, job *job.Job) *PauseJobSuccessResolver {
+	return &PauseJobSuccessResolver{app: app, j: job}
+}
+
+func (r *PauseJobSuccessResolver) Job() *JobResolver {
+	return NewJob(r.app, *r.j)
+}
+
+type PauseJobConflictErrorResolver struct {
+	message string
+}
+
+func NewPauseJobConflictError(message string) *PauseJobConflictErrorResolver {
+	return &PauseJobConflictErrorResolver{message: message}
+}
+
+func (r *PauseJobConflictErrorResolver) Message() string {
+	return r.message
+}
+
+func (r *PauseJobConflictErrorResolver) Code() ErrorCode {
+	return ErrorCodeStatusConflict
+}
+
+// -- ResumeJob Mutation --
+
+type ResumeJobPayloadResolver struct {
+	app chainlink.Application
+	j   *job.Job
+	err error
+	NotFoundErrorUnionType
+}
+
+func NewResumeJobPayload(app chainlink.Application, j *job.Job, err error) *ResumeJobPayloadResolver {
+	e := NotFoundErrorUnionType{err: err, message: "job not found"}
+
+	return &ResumeJobPayloadResolver{app: app, j: j, err: err, NotFoundErrorUnionType: e}
+}
+
+func (r *ResumeJobPayloadResolver) ToResumeJobSuccess() (*ResumeJobSuccessResolver, bool) {
+	if r.j == nil {
+		return nil, false
+	}
+
+	return NewResumeJobSuccess(r.app, r.j), true
+}
+
+func (r *ResumeJobPayloadResolver) ToResumeJobConflictError() (*ResumeJobConflictErrorResolver, bool) {
+	if r.err != nil && errors.Is(r.err, job.ErrJobNotPaused) {
+		return NewResumeJobConflictError(r.err.Error()), true
+	}
+
+	return nil, false
+}
+
+func (r *ResumeJobPayloadResolver) ToInputErrors() (*InputErrorsResolver, bool) {
+	if r.err != nil && errors.Is(r.err, job.ErrJobNotBackfillable) {
+		return NewInputErrors([]*InputErrorResolver{NewInputError("backfill", r.err.Error())}), true
+	}
+
+	return nil, false
+}
+
+type ResumeJobSuccessResolver struct {
+	app chainlink.Application
+	j   *job.Job
+}
+
+func NewResumeJobSuccess(app chainlink.Application, job *job.Job) *ResumeJobSuccessResolver {
+	return &ResumeJobSuccessResolver{app: app, j: job}
+}
+
+func (r *ResumeJobSuccessResolver) Job() *JobResolver {
+	return NewJob(r.app, *r.j)
+}
+
+type ResumeJobConflictErrorResolver struct {
+	message string
+}
+
+func NewResumeJobConflictError(message string) *ResumeJobConflictErrorResolver {
+	return &ResumeJobConflictErrorResolver{message: message}
+}
+
+func (r *ResumeJobConflictErrorResolver) Message() string {
+	return r.message
+}
+
+func (r *ResumeJobConflictErrorResolver) Code() ErrorCode {
+	return ErrorCodeStatusConflict
+}
+
+// JobVersionResolver resolves the JobVersion type.
+type JobVersionResolver struct {
+	j    job.Job
+	spec pipeline.Spec
+}
+
+func NewJobVersion(j job.Job, spec pipeline.Spec) *JobVersionResolver {
+	return &JobVersionResolver{j: j, spec: spec}
+}
+
+func NewJobVersions(j job.Job, specs []pipeline.Spec) []*JobVersionResolver {
+	var resolvers []*JobVersionResolver
+	for _, spec := range specs {
+		resolvers = append(resolvers, NewJobVersion(j, spec))
+	}
+
+	return resolvers
+}
+
+// ID resolves the id of the version's pipeline spec.
+func (r *JobVersionResolver) ID() graphql.ID {
+	return int32GQLID(r.spec.ID)
+}
+
+// Version resolves the version number.
+func (r *JobVersionResolver) Version() int32 {
+	return r.spec.Version
+}
+
+// Current resolves whether this is the current version of the job.
+func (r *JobVersionResolver) Current() bool {
+	return r.spec.ID == r.j.PipelineSpecID
+}
+
+// ObservationSource resolves the version's observation source.
+func (r *JobVersionResolver) ObservationSource() string {
+	return r.spec.DotDagSource
+}
+
+// MaxTaskDuration resolves the version's max task duration.
+func (r *JobVersionResolver) MaxTaskDuration() string {
+	return r.spec.MaxTaskDuration.Duration().String()
+}
+
+// CreatedAt resolves the timestamp the version was created.
+func (r *JobVersionResolver) CreatedAt() graphql.Time {
+	return graphql.Time{Time: r.spec.CreatedAt}
+}
+
+// -- UpdateJob Mutation --
+
+type UpdateJobPayloadResolver struct {
+	app       chainlink.Application
+	j         *job.Job
+	inputErrs map[string]string
+	NotFoundErrorUnionType
+}
+
+func NewUpdateJobPayload(app chainlink.Application, j *job.Job, inputErrs map[string]string, err error) *UpdateJobPayloadResolver {
+	e := NotFoundErrorUnionType{err: err, message: "job not found"}
+
+	return &UpdateJobPayloadResolver{app: app, j: j, inputErrs: inputErrs, NotFoundErrorUnionType: e}
+}
+
+func (r *UpdateJobPayloadResolver) ToUpdateJobSuccess() (*UpdateJobSuccessResolver, bool) {
+	if r.j == nil {
+		return nil, false
+	}
+
+	return NewUpdateJobSuccess(r.app, r.j), true
+}
+
+func (r *UpdateJobPayloadResolver) ToInputErrors() (*InputErrorsResolver, bool) {
+	if r.inputErrs == nil {
+		return nil, false
+	}
+
+	var errs []*InputErrorResolver
+
+	for path, message := range r.inputErrs {
+		errs = append(errs, NewInputError(path, message))
+	}
+
+	return NewInputErrors(errs), true
+}
+
+type UpdateJobSuccessResolver struct {
+	app chainlink.Application
+	j   *job.Job
+}
+
+func NewUpdateJobSuccess(app chainlink.Application, job *job.Job) *UpdateJobSuccessResolver {
+	return &UpdateJobSuccessResolver{app: app, j: job}
+}
+
+func (r *UpdateJobSuccessResolver) Job() *JobResolver {
+	return NewJob(r.app, *r.j)
+}
+
+// -- RollbackJob Mutation --
+
+type RollbackJobPayloadResolver struct {
+	app chainlink.Application
+	j   *job.Job
+	NotFoundErrorUnionType
+}
+
+func NewRollbackJobPayload(app chainlink.Application, j *job.Job, err error) *RollbackJobPayloadResolver {
+	e := NotFoundErrorUnionType{err: err, message: "job not found"}
+	if errors.Is(err, job.ErrNoSuchJobVersion) {
+		e = NotFoundErrorUnionType{err: err, message: "job version not found", isExpectedErrorFn: func(err error) bool {
+			return errors.Is(err, job.ErrNoSuchJobVersion)
+		}}
+	}
+
+	return &RollbackJobPayloadResolver{app: app, j: j, NotFoundErrorUnionType: e}
+}
+
+func (r *RollbackJobPayloadResolver) ToRollbackJobSuccess() (*RollbackJobSuccessResolver, bool) {
+	if r.j == nil {
+		return nil, false
+	}
+
+	return NewRollbackJobSuccess(r.app, r.j), true
+}
+
+type RollbackJobSuccessResolver struct {
+	app chainlink.Application
+	j   *job.Job
+}
+
+func NewRollbackJobSuccess(app chainlink.Application, job *job.Job) *RollbackJobSuccessResolver {
+	return &RollbackJobSuccessResolver{app: app, j: job}
+}
+
+func (r *RollbackJobSuccessResolver) Job() *JobResolver {
+	return NewJob(r.app, *r.j)
+}
@@ -9,6 +9,7 @@ import (
 	uuid "github.com/satori/go.uuid"
 	"github.com/stretchr/testify/mock"
 
+	"github.com/smartcontractkit/chainlink/core/audit"
 	"github.com/smartcontractkit/chainlink/core/services/feeds"
 )
 
@@ -161,6 +162,7 @@ func TestResolver_ApproveJobProposal(t *testing.T) {
 					},
 				}, nil)
 				f.Mocks.feedsSvc.On("ApproveJobProposal", mock.Anything, jpID).Return(nil)
+				f.expectAuditRecord(audit.ActionJobProposalApproved, "1")
 				f.Mocks.feedsSvc.On("GetJobProposal", jpID).Return(&feeds.JobProposal{
 					ID:             jpID,
 					Spec:           "some-spec",
@@ -198,6 +200,7 @@ func TestResolver_ApproveJobProposal(t *testing.T) {
 			authenticated: true,
 			before: func(f *gqlTestFramework) {
 				f.Mocks.feedsSvc.On("ApproveJobProposal", mock.Anything, jpID).Return(nil)
+				f.expectAuditRecord(audit.ActionJobProposalApproved, "1")
 				f.Mocks.feedsSvc.On("GetJobProposal", jpID).Return(nil, sql.ErrNoRows)
 				f.App.On("GetFeedsService").Return(f.Mocks.feedsSvc)
 			},
@@ -277,6 +280,7 @@ func TestResolver_CancelJobProposal(t *testing.T) {
 					},
 				}, nil)
 				f.Mocks.feedsSvc.On("CancelJobProposal", mock.Anything, jpID).Return(nil)
+				f.expectAuditRecord(audit.ActionJobProposalCancelled, "1")
 				f.Mocks.feedsSvc.On("GetJobProposal", jpID).Return(&feeds.JobProposal{
 					ID:             jpID,
 					Spec:           "some-spec",
@@ -314,6 +318,7 @@ func TestResolver_CancelJobProposal(t *testing.T) {
 			authenticated: true,
 			before: func(f *gqlTestFramework) {
 				f.Mocks.feedsSvc.On("CancelJobProposal", mock.Anything, jpID).Return(nil)
+				f.expectAuditRecord(audit.ActionJobProposalCancelled, "1")
 				f.Mocks.feedsSvc.On("GetJobProposal", jpID).Return(nil, sql.ErrNoRows)
 				f.App.On("GetFeedsService").Return(f.Mocks.feedsSvc)
 			},
@@ -393,6 +398,7 @@ func TestResolver_RejectJobProposal(t *testing.T) {
 					},
 				}, nil)
 				f.Mocks.feedsSvc.On("RejectJobProposal", mock.Anything, jpID).Return(nil)
+				f.expectAuditRecord(audit.ActionJobProposalRejected, "1")
 				f.Mocks.feedsSvc.On("GetJobProposal", jpID).Return(&feeds.JobProposal{
 					ID:             jpID,
 					Spec:           "some-spec",
@@ -430,6 +436,7 @@ func TestResolver_RejectJobProposal(t *testing.T) {
 			authenticated: true,
 			before: func(f *gqlTestFramework) {
 				f.Mocks.feedsSvc.On("RejectJobProposal", mock.Anything, jpID).Return(nil)
+				f.expectAuditRecord(audit.ActionJobProposalRejected, "1")
 				f.Mocks.feedsSvc.On("GetJobProposal", jpID).Return(nil, sql.ErrNoRows)
 				f.App.On("GetFeedsService").Return(f.Mocks.feedsSvc)
 			},
@@ -512,6 +519,7 @@ func TestResolver_UpdateJobSpecProposal(t *testing.T) {
 					},
 				}, nil)
 				f.Mocks.feedsSvc.On("UpdateJobProposalSpec", mock.Anything, jpID, "").Return(nil)
+				f.expectAuditRecord(audit.ActionJobProposalSpecUpdated, "1")
 				f.Mocks.feedsSvc.On("GetJobProposal", jpID).Return(&feeds.JobProposal{
 					ID:             jpID,
 					Spec:           "some-spec",
@@ -549,6 +557,7 @@ func TestResolver_UpdateJobSpecProposal(t *testing.T) {
 			authenticated: true,
 			before: func(f *gqlTestFramework) {
 				f.Mocks.feedsSvc.On("UpdateJobProposalSpec", mock.Anything, jpID, "").Return(nil)
+				f.expectAuditRecord(audit.ActionJobProposalSpecUpdated, "1")
 				f.Mocks.feedsSvc.On("GetJobProposal", jpID).Return(nil, sql.ErrNoRows)
 				f.App.On("GetFeedsService").Return(f.Mocks.feedsSvc)
 			},
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/graph-gophers/graphql-go"
+	"github.com/pkg/errors"
 
 	"github.com/smartcontractkit/chainlink/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/core/services/pipeline"
@@ -19,6 +20,7 @@ const (
 	JobRunStatusSuspended JobRunStatus = "SUSPENDED"
 	JobRunStatusErrored   JobRunStatus = "ERRORED"
 	JobRunStatusCompleted JobRunStatus = "COMPLETED"
+	JobRunStatusCancelled JobRunStatus = "CANCELLED"
 )
 
 func NewJobRunStatus(status pipeline.RunStatus) JobRunStatus {
@@ -31,6 +33,8 @@ func NewJobRunStatus(status pipeline.RunStatus) JobRunStatus {
 		return JobRunStatusErrored
 	case pipeline.RunStatusCompleted:
 		return JobRunStatusCompleted
+	case pipeline.RunStatusCancelled:
+		return JobRunStatusCancelled
 	default:
 		return JobRunStatusUnknown
 	}
@@ -138,6 +142,11 @@ func (r *JobRunResolver) Job(ctx context.Context) (*JobResolver, error) {
 	return NewJob(r.app, *job), nil
 }
 
+// JobVersion resolves the version of the job's pipeline that the run executed.
+func (r *JobRunResolver) JobVersion() int32 {
+	return r.run.PipelineSpec.Version
+}
+
 func (r *JobRunResolver) CreatedAt() graphql.Time {
 	return graphql.Time{Time: r.run.CreatedAt}
 }
@@ -192,3 +201,62 @@ func (r *JobRunsPayloadResolver) Results() []*JobRunResolver {
 func (r *JobRunsPayloadResolver) Metadata() *PaginationMetadataResolver {
 	return NewPaginationMetadata(r.total)
 }
+
+// -- CancelJobRun Mutation --
+
+type CancelJobRunPayloadResolver struct {
+	run *pipeline.Run
+	app chainlink.Application
+	NotFoundErrorUnionType
+}
+
+func NewCancelJobRunPayload(run *pipeline.Run, app chainlink.Application, err error) *CancelJobRunPayloadResolver {
+	e := NotFoundErrorUnionType{err: err, message: "job run not found"}
+
+	return &CancelJobRunPayloadResolver{run: run, app: app, NotFoundErrorUnionType: e}
+}
+
+func (r *CancelJobRunPayloadResolver) ToCancelJobRunSuccess() (*CancelJobRunSuccessResolver, bool) {
+	if r.run != nil {
+		return NewCancelJobRunSuccess(r.run, r.app), true
+	}
+
+	return nil, false
+}
+
+func (r *CancelJobRunPayloadResolver) ToCancelJobRunConflictError() (*CancelJobRunConflictErrorResolver, bool) {
+	if r.err != nil && errors.Is(r.err, pipeline.ErrRunFinished) {
+		return NewCancelJobRunConflictError(r.err.Error()), true
+	}
+
+	return nil, false
+}
+
+type CancelJobRunSuccessResolver struct {
+	run *pipeline.Run
+	app chainlink.Application
+}
+
+func NewCancelJobRunSuccess(run *pipeline.Run, app chainlink.Application) *CancelJobRunSuccessResolver {
+	return &CancelJobRunSuccessResolver{run: run, app: app}
+}
+
+func (r *CancelJobRunSuccessResolver) JobRun() *JobRunResolver {
+	return NewJobRun(*r.run, r.app)
+}
+
+type CancelJobRunConflictErrorResolver struct {
+	message string
+}
+
+func NewCancelJobRunConflictError(message string) *CancelJobRunConflictErrorResolver {
+	return &CancelJobRunConflictErrorResolver{message: message}
+}
+
+func (r *CancelJobRunConflictErrorResolver) Message() string {
+	return r.message
+}
+
+func (r *CancelJobRunConflictErrorResolver) Code() ErrorCode {
+	return ErrorCodeStatusConflict
+}
@@ -6,11 +6,13 @@ import (
 
 	gqlerrors "github.com/graph-gophers/graphql-go/errors"
 	"github.com/pkg/errors"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/chainlink/core/services/job"
 	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+	clsessions "github.com/smartcontractkit/chainlink/core/sessions"
 	"github.com/smartcontractkit/chainlink/core/utils/stringutils"
 )
 
@@ -57,6 +59,31 @@ func TestQuery_PaginatedJobRuns(t *testing.T) {
 					}
 				}`,
 		},
+		{
+			name:          "success for view role",
+			authenticated: true,
+			role:          clsessions.UserRoleView,
+			before: func(f *gqlTestFramework) {
+				f.Mocks.jobORM.On("PipelineRuns", (*int32)(nil), PageDefaultOffset, PageDefaultLimit).Return([]pipeline.Run{
+					{
+						ID: int64(200),
+					},
+				}, 1, nil)
+				f.App.On("JobORM").Return(f.Mocks.jobORM)
+			},
+			query: query,
+			result: `
+				{
+					"jobRuns": {
+						"results": [{
+							"id": "200"
+						}],
+						"metadata": {
+							"total": 1
+						}
+					}
+				}`,
+		},
 		{
 			name:          "generic error on PipelineRuns()",
 			authenticated: true,
@@ -230,3 +257,130 @@ func TestResolver_JobRun(t *testing.T) {
 
 	RunGQLTests(t, testCases)
 }
+
+func TestResolver_CancelJobRun(t *testing.T) {
+	t.Parallel()
+
+	mutation := `
+		mutation CancelJobRun($id: ID!) {
+			cancelJobRun(id: $id) {
+				... on CancelJobRunSuccess {
+					jobRun {
+						id
+						status
+					}
+				}
+				... on CancelJobRunConflictError {
+					code
+					message
+				}
+				... on NotFoundError {
+					code
+					message
+				}
+			}
+		}`
+	variables := map[string]interface{}{
+		"id": "2",
+	}
+	gError := errors.New("error")
+	conflictErr := errors.Wrap(errors.Wrapf(pipeline.ErrRunFinished, "run is %s", pipeline.RunStatusCompleted), "CancelJobRun failed")
+	_, idError := stringutils.ToInt64("asdasads")
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: mutation, variables: variables}, "cancelJobRun"),
+		forbiddenTestCase(GQLTestCase{query: mutation, variables: variables}, clsessions.UserRoleView, clsessions.UserRoleRun, "cancelJobRun"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.App.On("CancelJobRun", mock.Anything, int64(2)).Return(nil)
+				f.Mocks.jobORM.On("FindPipelineRunByID", int64(2)).Return(pipeline.Run{
+					ID:    2,
+					State: pipeline.RunStatusCancelled,
+				}, nil)
+				f.App.On("JobORM").Return(f.Mocks.jobORM)
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+				{
+					"cancelJobRun": {
+						"jobRun": {
+							"id": "2",
+							"status": "CANCELLED"
+						}
+					}
+				}`,
+		},
+		{
+			name:          "not found",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.App.On("CancelJobRun", mock.Anything, int64(2)).Return(errors.Wrap(sql.ErrNoRows, "CancelJobRun failed"))
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+				{
+					"cancelJobRun": {
+						"code": "NOT_FOUND",
+						"message": "job run not found"
+					}
+				}`,
+		},
+		{
+			name:          "run already finished",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.App.On("CancelJobRun", mock.Anything, int64(2)).Return(conflictErr)
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+				{
+					"cancelJobRun": {
+						"code": "STATUS_CONFLICT",
+						"message": "CancelJobRun failed: run is completed: run has already finished"
+					}
+				}`,
+		},
+		{
+			name:          "generic error",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.App.On("CancelJobRun", mock.Anything, int64(2)).Return(gError)
+			},
+			query:     mutation,
+			variables: variables,
+			result:    `null`,
+			errors: []*gqlerrors.QueryError{
+				{
+					Extensions:    nil,
+					ResolverError: gError,
+					Path:          []interface{}{"cancelJobRun"},
+					Message:       gError.Error(),
+				},
+			},
+		},
+		{
+			name:          "invalid ID error",
+			authenticated: true,
+			query:         mutation,
+			variables: map[string]interface{}{
+				"id": "asdasads",
+			},
+			result: `null`,
+			errors: []*gqlerrors.QueryError{
+				{
+					Extensions:    nil,
+					ResolverError: idError,
+					Path:          []interface{}{"cancelJobRun"},
+					Message:       idError.Error(),
+				},
+			},
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
@@ -13,6 +13,8 @@ import (
 	"github.com/stretchr/testify/mock"
 	"gopkg.in/guregu/null.v4"
 
+	"github.com/smartcontractkit/chainlink/core/assets"
+	"github.com/smartcontractkit/chainlink/core/audit"
 	"github.com/smartcontractkit/chainlink/core/services/directrequest"
 	"github.com/smartcontractkit/chainlink/core/services/job"
 	"github.com/smartcontractkit/chainlink/core/services/pipeline"
@@ -131,8 +133,9 @@ func TestResolver_Jobs(t *testing.T) {
 
 func TestResolver_Job(t *testing.T) {
 	var (
-		id            = int32(1)
-		externalJobID = uuid.Must(uuid.FromString("00000000-0000-0000-0000-000000000001"))
+		id             = int32(1)
+		externalJobID  = uuid.Must(uuid.FromString("00000000-0000-0000-0000-000000000001"))
+		gasSpendBudget = assets.NewEthValue(1000000000000000000)
 
 		query = `
 			query GetJob {
@@ -142,6 +145,8 @@ func TestResolver_Job(t *testing.T) {
 						createdAt
 						externalJobID
 						maxTaskDuration
+						gasSpendBudgetWei
+						gasSpendBudgetWindow
 						name
 						schemaVersion
 						spec {
@@ -179,6 +184,8 @@ func TestResolver_Job(t *testing.T) {
 					Name:                        null.StringFrom("job1"),
 					SchemaVersion:               1,
 					MaxTaskDuration:             models.Interval(1 * time.Second),
+					GasSpendBudgetWei:           &gasSpendBudget,
+					GasSpendBudgetWindow:        models.Interval(24 * time.Hour),
 					ExternalJobID:               externalJobID,
 					CreatedAt:                   f.Timestamp(),
 					Type:                        job.OffchainReporting,
@@ -205,6 +212,8 @@ func TestResolver_Job(t *testing.T) {
 						"createdAt": "2021-01-01T00:00:00Z",
 						"externalJobID": "00000000-0000-0000-0000-000000000001",
 						"maxTaskDuration": "1s",
+						"gasSpendBudgetWei": "1000000000000000000",
+						"gasSpendBudgetWindow": "24h0m0s",
 						"name": "job1",
 						"schemaVersion": 1,
 						"spec": {
@@ -309,6 +318,7 @@ func TestResolver_CreateJob(t *testing.T) {
 			before: func(f *gqlTestFramework) {
 				f.App.On("GetConfig").Return(f.Mocks.cfg)
 				f.App.On("AddJobV2", mock.Anything, &jb).Return(nil)
+				f.expectAuditRecord(audit.ActionJobCreated, "0")
 			},
 			query:     mutation,
 			variables: variables,
@@ -417,6 +427,7 @@ func TestResolver_DeleteJob(t *testing.T) {
 				}, nil)
 				f.App.On("JobORM").Return(f.Mocks.jobORM)
 				f.App.On("DeleteJob", mock.Anything, id).Return(nil)
+				f.expectAuditRecord(audit.ActionJobDeleted, "123")
 			},
 			query:     mutation,
 			variables: variables,
@@ -517,3 +528,501 @@ func TestResolver_DeleteJob(t *testing.T) {
 
 	RunGQLTests(t, testCases)
 }
+
+func TestResolver_PauseJob(t *testing.T) {
+	t.Parallel()
+
+	id := int32(123)
+	mutation := `
+		mutation PauseJob($id: ID!) {
+			pauseJob(id: $id) {
+				... on PauseJobSuccess {
+					job {
+						id
+						paused
+						pausedAt
+					}
+				}
+				... on PauseJobConflictError {
+					code
+					message
+				}
+				... on NotFoundError {
+					code
+					message
+				}
+			}
+		}`
+	variables := map[string]interface{}{
+		"id": "123",
+	}
+
+	gError := errors.New("error")
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: mutation, variables: variables}, "pauseJob"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.Mocks.jobORM.On("FindJobTx", id).Return(job.Job{
+					ID:       id,
+					PausedAt: null.TimeFrom(f.Timestamp()),
+				}, nil)
+				f.App.On("JobORM").Return(f.Mocks.jobORM)
+				f.App.On("PauseJob", mock.Anything, id).Return(nil)
+				f.expectAuditRecord(audit.ActionJobPaused, "123")
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+				{
+					"pauseJob": {
+						"job": {
+							"id": "123",
+							"paused": true,
+							"pausedAt": "2021-01-01T00:00:00Z"
+						}
+					}
+				}
+			`,
+		},
+		{
+			name:          "not found",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.Mocks.jobORM.On("FindJobTx", id).Return(job.Job{}, sql.ErrNoRows)
+				f.App.On("JobORM").Return(f.Mocks.jobORM)
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+				{
+					"pauseJob": {
+						"code": "NOT_FOUND",
+						"message": "job not found"
+					}
+				}
+			`,
+		},
+		{
+			name:          "already paused",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.Mocks.jobORM.On("FindJobTx", id).Return(job.Job{}, nil)
+				f.App.On("JobORM").Return(f.Mocks.jobORM)
+				f.App.On("PauseJob", mock.Anything, id).Return(job.ErrJobPaused)
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+				{
+					"pauseJob": {
+						"code": "STATUS_CONFLICT",
+						"message": "job is paused"
+					}
+				}
+			`,
+		},
+		{
+			name:          "generic error on PauseJob()",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.Mocks.jobORM.On("FindJobTx", id).Return(job.Job{}, nil)
+				f.App.On("JobORM").Return(f.Mocks.jobORM)
+				f.App.On("PauseJob", mock.Anything, id).Return(gError)
+			},
+			query:     mutation,
+			variables: variables,
+			result:    `null`,
+			errors: []*gqlerrors.QueryError{
+				{
+					Extensions:    nil,
+					ResolverError: gError,
+					Path:          []interface{}{"pauseJob"},
+					Message:       gError.Error(),
+				},
+			},
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
+
+func TestResolver_ResumeJob(t *testing.T) {
+	t.Parallel()
+
+	id := int32(123)
+	mutation := `
+		mutation ResumeJob($id: ID!, $backfill: Boolean) {
+			resumeJob(id: $id, backfill: $backfill) {
+				... on ResumeJobSuccess {
+					job {
+						id
+						paused
+						pausedAt
+					}
+				}
+				... on ResumeJobConflictError {
+					code
+					message
+				}
+				... on InputErrors {
+					errors {
+						path
+						message
+						code
+					}
+				}
+				... on NotFoundError {
+					code
+					message
+				}
+			}
+		}`
+	variables := map[string]interface{}{
+		"id": "123",
+	}
+	backfillVariables := map[string]interface{}{
+		"id":       "123",
+		"backfill": true,
+	}
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: mutation, variables: variables}, "resumeJob"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.Mocks.jobORM.On("FindJobTx", id).Return(job.Job{ID: id}, nil)
+				f.App.On("JobORM").Return(f.Mocks.jobORM)
+				f.App.On("ResumeJob", mock.Anything, id, true).Return(nil)
+				f.expectAuditRecord(audit.ActionJobResumed, "123")
+			},
+			query:     mutation,
+			variables: backfillVariables,
+			result: `
+				{
+					"resumeJob": {
+						"job": {
+							"id": "123",
+							"paused": false,
+							"pausedAt": null
+						}
+					}
+				}
+			`,
+		},
+		{
+			name:          "not found",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.Mocks.jobORM.On("FindJobTx", id).Return(job.Job{}, sql.ErrNoRows)
+				f.App.On("JobORM").Return(f.Mocks.jobORM)
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+				{
+					"resumeJob": {
+						"code": "NOT_FOUND",
+						"message": "job not found"
+					}
+				}
+			`,
+		},
+		{
+			name:          "not paused",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.Mocks.jobORM.On("FindJobTx", id).Return(job.Job{}, nil)
+				f.App.On("JobORM").Return(f.Mocks.jobORM)
+				f.App.On("ResumeJob", mock.Anything, id, false).Return(job.ErrJobNotPaused)
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+				{
+					"resumeJob": {
+						"code": "STATUS_CONFLICT",
+						"message": "job is not paused"
+					}
+				}
+			`,
+		},
+		{
+			name:          "not backfillable",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.Mocks.jobORM.On("FindJobTx", id).Return(job.Job{}, nil)
+				f.App.On("JobORM").Return(f.Mocks.jobORM)
+				f.App.On("ResumeJob", mock.Anything, id, true).Return(job.ErrJobNotBackfillable)
+			},
+			query:     mutation,
+			variables: backfillVariables,
+			result: `
+				{
+					"resumeJob": {
+						"errors": [{
+							"path": "backfill",
+							"message": "job does not consume logs, or the latest head was unknown when it was paused",
+							"code": "INVALID_INPUT"
+						}]
+					}
+				}
+			`,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
+
+func TestResolver_UpdateJob(t *testing.T) {
+	t.Parallel()
+
+	id := int32(123)
+	mutation := `
+		mutation UpdateJob($id: ID!, $input: UpdateJobInput!) {
+			updateJob(id: $id, input: $input) {
+				... on UpdateJobSuccess {
+					job {
+						id
+						name
+						version
+					}
+				}
+				... on InputErrors {
+					errors {
+						path
+						message
+						code
+					}
+				}
+				... on NotFoundError {
+					code
+					message
+				}
+			}
+		}`
+	variables := map[string]interface{}{
+		"id": "123",
+		"input": map[string]interface{}{
+			"TOML": testspecs.DirectRequestSpec,
+		},
+	}
+	jb, err := directrequest.ValidatedDirectRequestSpec(testspecs.DirectRequestSpec)
+	assert.NoError(t, err)
+	jb.ID = id
+
+	d, err := json.Marshal(map[string]interface{}{
+		"updateJob": map[string]interface{}{
+			"job": map[string]interface{}{
+				"id":      "123",
+				"name":    jb.Name,
+				"version": 2,
+			},
+		},
+	})
+	assert.NoError(t, err)
+	expected := string(d)
+
+	gError := errors.New("error")
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: mutation, variables: variables}, "updateJob"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.Mocks.jobORM.On("FindJobTx", id).Return(job.Job{ID: id}, nil)
+				f.App.On("JobORM").Return(f.Mocks.jobORM)
+				f.App.On("GetConfig").Return(f.Mocks.cfg)
+				f.App.On("UpdateJobV2", mock.Anything, &jb).Return(nil).Run(func(args mock.Arguments) {
+					args.Get(1).(*job.Job).PipelineSpec = &pipeline.Spec{Version: 2}
+				})
+				f.expectAuditRecord(audit.ActionJobUpdated, "123")
+			},
+			query:     mutation,
+			variables: variables,
+			result:    expected,
+		},
+		{
+			name:          "not found",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.Mocks.jobORM.On("FindJobTx", id).Return(job.Job{}, sql.ErrNoRows)
+				f.App.On("JobORM").Return(f.Mocks.jobORM)
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+				{
+					"updateJob": {
+						"code": "NOT_FOUND",
+						"message": "job not found"
+					}
+				}
+			`,
+		},
+		{
+			name:          "job type changed",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.Mocks.jobORM.On("FindJobTx", id).Return(job.Job{ID: id}, nil)
+				f.App.On("JobORM").Return(f.Mocks.jobORM)
+				f.App.On("GetConfig").Return(f.Mocks.cfg)
+				f.App.On("UpdateJobV2", mock.Anything, &jb).Return(job.ErrJobTypeChanged)
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+				{
+					"updateJob": {
+						"errors": [{
+							"code": "INVALID_INPUT",
+							"message": "job type cannot be changed",
+							"path": "TOML spec"
+						}]
+					}
+				}`,
+		},
+		{
+			name:          "job setting changed",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.Mocks.jobORM.On("FindJobTx", id).Return(job.Job{ID: id}, nil)
+				f.App.On("JobORM").Return(f.Mocks.jobORM)
+				f.App.On("GetConfig").Return(f.Mocks.cfg)
+				f.App.On("UpdateJobV2", mock.Anything, &jb).Return(errors.Wrap(job.ErrJobSettingChanged, "KeyPool differs from the current job"))
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+				{
+					"updateJob": {
+						"errors": [{
+							"code": "INVALID_INPUT",
+							"message": "KeyPool differs from the current job: only the observationSource, name and maxTaskDuration of a job can be changed",
+							"path": "TOML spec"
+						}]
+					}
+				}`,
+		},
+		{
+			name:          "generic error when updating the job",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.Mocks.jobORM.On("FindJobTx", id).Return(job.Job{ID: id}, nil)
+				f.App.On("JobORM").Return(f.Mocks.jobORM)
+				f.App.On("GetConfig").Return(f.Mocks.cfg)
+				f.App.On("UpdateJobV2", mock.Anything, &jb).Return(gError)
+			},
+			query:     mutation,
+			variables: variables,
+			result:    `null`,
+			errors: []*gqlerrors.QueryError{
+				{
+					Extensions:    nil,
+					ResolverError: gError,
+					Path:          []interface{}{"updateJob"},
+					Message:       gError.Error(),
+				},
+			},
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
+
+func TestResolver_RollbackJob(t *testing.T) {
+	t.Parallel()
+
+	id := int32(123)
+	mutation := `
+		mutation RollbackJob($id: ID!, $version: Int!) {
+			rollbackJob(id: $id, version: $version) {
+				... on RollbackJobSuccess {
+					job {
+						id
+						version
+					}
+				}
+				... on NotFoundError {
+					code
+					message
+				}
+			}
+		}`
+	variables := map[string]interface{}{
+		"id":      "123",
+		"version": 1,
+	}
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: mutation, variables: variables}, "rollbackJob"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.Mocks.jobORM.On("FindJobTx", id).Return(job.Job{
+					ID:           id,
+					PipelineSpec: &pipeline.Spec{Version: 1},
+				}, nil)
+				f.App.On("JobORM").Return(f.Mocks.jobORM)
+				f.App.On("RollbackJob", mock.Anything, id, int32(1)).Return(nil)
+				f.expectAuditRecord(audit.ActionJobRolledBack, "123")
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+				{
+					"rollbackJob": {
+						"job": {
+							"id": "123",
+							"version": 1
+						}
+					}
+				}
+			`,
+		},
+		{
+			name:          "job not found",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.Mocks.jobORM.On("FindJobTx", id).Return(job.Job{}, sql.ErrNoRows)
+				f.App.On("JobORM").Return(f.Mocks.jobORM)
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+				{
+					"rollbackJob": {
+						"code": "NOT_FOUND",
+						"message": "job not found"
+					}
+				}
+			`,
+		},
+		{
+			name:          "version not found",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.Mocks.jobORM.On("FindJobTx", id).Return(job.Job{ID: id}, nil)
+				f.App.On("JobORM").Return(f.Mocks.jobORM)
+				f.App.On("RollbackJob", mock.Anything, id, int32(1)).Return(job.ErrNoSuchJobVersion)
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+				{
+					"rollbackJob": {
+						"code": "NOT_FOUND",
+						"message": "job version not found"
+					}
+				}
+			`,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/stretchr/testify/mock"
 	"go.uber.org/zap/zapcore"
 
+	"github.com/smartcontractkit/chainlink/core/audit"
 	"github.com/smartcontractkit/chainlink/core/logger"
 )
 
@@ -61,6 +62,7 @@ func TestResolver_SetServiceLogLevel(t *testing.T) {
 			before: func(f *gqlTestFramework) {
 				f.App.On("SetServiceLogLevel", mock.Anything, logger.HeadTracker, infoLvl).Return(nil)
 				f.App.On("SetServiceLogLevel", mock.Anything, logger.FluxMonitor, warnLvl).Return(nil)
+				f.expectAuditRecord(audit.ActionLogConfigUpdated, "log")
 			},
 			query:     mutation,
 			variables: input,
@@ -125,6 +127,7 @@ func TestResolver_SetSQLLogging(t *testing.T) {
 			authenticated: true,
 			before: func(f *gqlTestFramework) {
 				f.Mocks.cfg.On("SetLogSQL", true).Return(nil)
+				f.expectAuditRecord(audit.ActionLogConfigUpdated, "log")
 				f.App.On("GetConfig").Return(f.Mocks.cfg)
 			},
 			query:     mutation,
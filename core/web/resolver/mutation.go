@@ -5,8 +5,11 @@ import (
 	"database/sql"
 	"fmt"
 	"net/url"
+	"strconv"
+	"strings"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/graph-gophers/graphql-go"
 	"github.com/lib/pq"
 	"github.com/pkg/errors"
@@ -14,14 +17,18 @@ import (
 	"gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/chainlink/core/assets"
+	"github.com/smartcontractkit/chainlink/core/audit"
 	"github.com/smartcontractkit/chainlink/core/auth"
 	"github.com/smartcontractkit/chainlink/core/bridges"
 	"github.com/smartcontractkit/chainlink/core/chains/evm"
 	"github.com/smartcontractkit/chainlink/core/chains/evm/types"
 	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/blocktrigger"
+	"github.com/smartcontractkit/chainlink/core/services/bulletprooftxmanager"
 	"github.com/smartcontractkit/chainlink/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/core/services/cron"
 	"github.com/smartcontractkit/chainlink/core/services/directrequest"
+	"github.com/smartcontractkit/chainlink/core/services/eventtrigger"
 	"github.com/smartcontractkit/chainlink/core/services/feeds"
 	"github.com/smartcontractkit/chainlink/core/services/fluxmonitorv2"
 	"github.com/smartcontractkit/chainlink/core/services/job"
@@ -32,29 +39,99 @@ import (
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/p2pkey"
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/vrfkey"
 	"github.com/smartcontractkit/chainlink/core/services/offchainreporting"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/core/services/vrf"
 	"github.com/smartcontractkit/chainlink/core/services/webhook"
+	"github.com/smartcontractkit/chainlink/core/sessions"
 	"github.com/smartcontractkit/chainlink/core/store/models"
 	"github.com/smartcontractkit/chainlink/core/utils"
 	"github.com/smartcontractkit/chainlink/core/utils/crypto"
 	"github.com/smartcontractkit/chainlink/core/utils/stringutils"
 	webauth "github.com/smartcontractkit/chainlink/core/web/auth"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
 )
 
 type Resolver struct {
 	App chainlink.Application
 }
 
+// recordAudit appends an entry to the audit log for a privileged action that
+// the authenticated user has just taken.
+func (r *Resolver) recordAudit(ctx context.Context, action audit.Action, target string, diff audit.Diff) {
+	r.App.AuditLogger().Record(webauth.GetGQLAuditActor(ctx), action, target, diff)
+}
+
+// BridgeRequestPolicyInput holds the optional request policy fields shared by
+// the bridge inputs.
+type BridgeRequestPolicyInput struct {
+	RetryOnStatusCodes      *[]int32
+	Retries                 *int32
+	AttemptTimeout          *string
+	MaxConcurrency          *int32
+	BreakerFailureThreshold *int32
+	BreakerCooldown         *string
+	CacheTTL                *string
+	CacheMaxStale           *string
+}
+
+// apply overwrites the fields of the policy that are set in the input.
+func (in BridgeRequestPolicyInput) apply(policy bridges.RequestPolicy) (bridges.RequestPolicy, error) {
+	if in.RetryOnStatusCodes != nil {
+		policy.RetryOnStatusCodes = pq.Int32Array(*in.RetryOnStatusCodes)
+	}
+	if in.Retries != nil {
+		if *in.Retries < 0 {
+			return policy, errors.New("retries must not be negative")
+		}
+		policy.Retries = uint32(*in.Retries)
+	}
+	if in.AttemptTimeout != nil {
+		if err := policy.AttemptTimeout.UnmarshalText([]byte(*in.AttemptTimeout)); err != nil {
+			return policy, errors.Wrap(err, "attemptTimeout")
+		}
+	}
+	if in.MaxConcurrency != nil {
+		if *in.MaxConcurrency < 0 {
+			return policy, errors.New("maxConcurrency must not be negative")
+		}
+		policy.MaxConcurrency = uint32(*in.MaxConcurrency)
+	}
+	if in.BreakerFailureThreshold != nil {
+		if *in.BreakerFailureThreshold < 0 {
+			return policy, errors.New("breakerFailureThreshold must not be negative")
+		}
+		policy.BreakerFailureThreshold = uint32(*in.BreakerFailureThreshold)
+	}
+	if in.BreakerCooldown != nil {
+		if err := policy.BreakerCooldown.UnmarshalText([]byte(*in.BreakerCooldown)); err != nil {
+			return policy, errors.Wrap(err, "breakerCooldown")
+		}
+	}
+	if in.CacheTTL != nil {
+		if err := policy.CacheTTL.UnmarshalText([]byte(*in.CacheTTL)); err != nil {
+			return policy, errors.Wrap(err, "cacheTTL")
+		}
+	}
+	if in.CacheMaxStale != nil {
+		if err := policy.CacheMaxStale.UnmarshalText([]byte(*in.CacheMaxStale)); err != nil {
+			return policy, errors.Wrap(err, "cacheMaxStale")
+		}
+	}
+
+	return policy, nil
+}
+
 type createBridgeInput struct {
 	Name                   string
 	URL                    string
 	Confirmations          int32
 	MinimumContractPayment string
+	BridgeRequestPolicyInput
 }
 
 // CreateBridge creates a new bridge.
 func (r *Resolver) CreateBridge(ctx context.Context, args struct{ Input createBridgeInput }) (*CreateBridgePayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUserCanEdit(ctx); err != nil {
 		return nil, err
 	}
 
@@ -71,11 +148,17 @@ func (r *Resolver) CreateBridge(ctx context.Context, args struct{ Input createBr
 		return nil, err
 	}
 
+	policy, err := args.Input.apply(bridges.RequestPolicy{})
+	if err != nil {
+		return nil, err
+	}
+
 	btr := &bridges.BridgeTypeRequest{
 		Name:                   bridges.TaskType(args.Input.Name),
 		URL:                    webURL,
 		Confirmations:          uint32(args.Input.Confirmations),
 		MinimumContractPayment: minContractPayment,
+		RequestPolicy:          policy,
 	}
 
 	bta, bt, err := bridges.NewBridgeType(btr)
@@ -92,12 +175,13 @@ func (r *Resolver) CreateBridge(ctx context.Context, args struct{ Input createBr
 	if err := orm.CreateBridgeType(bt); err != nil {
 		return nil, err
 	}
+	r.recordAudit(ctx, audit.ActionBridgeCreated, bt.Name.String(), audit.NewDiff(nil, presenters.NewBridgeResource(*bt)))
 
 	return NewCreateBridgePayload(*bt, bta.IncomingToken), nil
 }
 
 func (r *Resolver) CreateCSAKey(ctx context.Context) (*CreateCSAKeyPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUserIsAdmin(ctx); err != nil {
 		return nil, err
 	}
 
@@ -109,6 +193,7 @@ func (r *Resolver) CreateCSAKey(ctx context.Context) (*CreateCSAKeyPayloadResolv
 
 		return nil, err
 	}
+	r.recordAudit(ctx, audit.ActionKeyCreated, audit.KeyTarget("csa", key.ID()), nil)
 
 	return NewCreateCSAKeyPayload(&key, nil), nil
 }
@@ -116,7 +201,7 @@ func (r *Resolver) CreateCSAKey(ctx context.Context) (*CreateCSAKeyPayloadResolv
 func (r *Resolver) DeleteCSAKey(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*DeleteCSAKeyPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUserIsAdmin(ctx); err != nil {
 		return nil, err
 	}
 
@@ -128,6 +213,7 @@ func (r *Resolver) DeleteCSAKey(ctx context.Context, args struct {
 
 		return nil, err
 	}
+	r.recordAudit(ctx, audit.ActionKeyDeleted, audit.KeyTarget("csa", key.ID()), nil)
 
 	return NewDeleteCSAKeyPayload(key, nil), nil
 }
@@ -144,7 +230,7 @@ type createFeedsManagerInput struct {
 func (r *Resolver) CreateFeedsManager(ctx context.Context, args struct {
 	Input *createFeedsManagerInput
 }) (*CreateFeedsManagerPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUserCanEdit(ctx); err != nil {
 		return nil, err
 	}
 
@@ -189,6 +275,7 @@ func (r *Resolver) CreateFeedsManager(ctx context.Context, args struct {
 
 		return nil, err
 	}
+	r.recordAudit(ctx, audit.ActionFeedsManagerCreated, mgr.Name, audit.NewDiff(nil, presenters.NewFeedsManagerResource(*mgr)))
 
 	return NewCreateFeedsManagerPayload(mgr, nil, nil), nil
 }
@@ -198,13 +285,14 @@ type updateBridgeInput struct {
 	URL                    string
 	Confirmations          int32
 	MinimumContractPayment string
+	BridgeRequestPolicyInput
 }
 
 func (r *Resolver) UpdateBridge(ctx context.Context, args struct {
 	ID    graphql.ID
 	Input updateBridgeInput
 }) (*UpdateBridgePayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUserCanEdit(ctx); err != nil {
 		return nil, err
 	}
 
@@ -243,14 +331,22 @@ func (r *Resolver) UpdateBridge(ctx context.Context, args struct {
 		return nil, err
 	}
 
+	// Request policy fields that are not set keep their current value
+	btr.RequestPolicy, err = args.Input.apply(bridge.RequestPolicy)
+	if err != nil {
+		return nil, err
+	}
+
 	// Update the bridge
 	if err := ValidateBridgeType(btr); err != nil {
 		return nil, err
 	}
 
+	before := presenters.NewBridgeResource(bridge)
 	if err := orm.UpdateBridgeType(&bridge, btr); err != nil {
 		return nil, err
 	}
+	r.recordAudit(ctx, audit.ActionBridgeUpdated, bridge.Name.String(), audit.NewDiff(before, presenters.NewBridgeResource(bridge)))
 
 	return NewUpdateBridgePayload(&bridge, nil), nil
 }
@@ -268,7 +364,7 @@ func (r *Resolver) UpdateFeedsManager(ctx context.Context, args struct {
 	ID    graphql.ID
 	Input *updateFeedsManagerInput
 }) (*UpdateFeedsManagerPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUserCanEdit(ctx); err != nil {
 		return nil, err
 	}
 
@@ -302,6 +398,15 @@ func (r *Resolver) UpdateFeedsManager(ctx context.Context, args struct {
 
 	feedsService := r.App.GetFeedsService()
 
+	before, err := feedsService.GetManager(id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return NewUpdateFeedsManagerPayload(nil, err, nil), nil
+		}
+
+		return nil, err
+	}
+
 	err = feedsService.UpdateFeedsManager(ctx, *mgr)
 	if err != nil {
 		return nil, err
@@ -316,11 +421,13 @@ func (r *Resolver) UpdateFeedsManager(ctx context.Context, args struct {
 		return nil, err
 	}
 
+	r.recordAudit(ctx, audit.ActionFeedsManagerUpdated, mgr.Name, audit.NewDiff(presenters.NewFeedsManagerResource(*before), presenters.NewFeedsManagerResource(*mgr)))
+
 	return NewUpdateFeedsManagerPayload(mgr, nil, nil), nil
 }
 
 func (r *Resolver) CreateOCRKeyBundle(ctx context.Context) (*CreateOCRKeyBundlePayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUserIsAdmin(ctx); err != nil {
 		return nil, err
 	}
 
@@ -328,6 +435,7 @@ func (r *Resolver) CreateOCRKeyBundle(ctx context.Context) (*CreateOCRKeyBundleP
 	if err != nil {
 		return nil, err
 	}
+	r.recordAudit(ctx, audit.ActionKeyCreated, audit.KeyTarget("ocr", key.ID()), nil)
 
 	return NewCreateOCRKeyBundlePayload(&key), nil
 }
@@ -335,7 +443,7 @@ func (r *Resolver) CreateOCRKeyBundle(ctx context.Context) (*CreateOCRKeyBundleP
 func (r *Resolver) DeleteOCRKeyBundle(ctx context.Context, args struct {
 	ID string
 }) (*DeleteOCRKeyBundlePayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUserIsAdmin(ctx); err != nil {
 		return nil, err
 	}
 
@@ -346,6 +454,7 @@ func (r *Resolver) DeleteOCRKeyBundle(ctx context.Context, args struct {
 		}
 		return nil, err
 	}
+	r.recordAudit(ctx, audit.ActionKeyDeleted, audit.KeyTarget("ocr", deletedKey.ID()), nil)
 
 	return NewDeleteOCRKeyBundlePayloadResolver(deletedKey, nil), nil
 }
@@ -353,7 +462,7 @@ func (r *Resolver) DeleteOCRKeyBundle(ctx context.Context, args struct {
 func (r *Resolver) CreateNode(ctx context.Context, args struct {
 	Input *types.NewNode
 }) (*CreateNodePayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUserIsAdmin(ctx); err != nil {
 		return nil, err
 	}
 
@@ -361,6 +470,7 @@ func (r *Resolver) CreateNode(ctx context.Context, args struct {
 	if err != nil {
 		return nil, err
 	}
+	r.recordAudit(ctx, audit.ActionNodeCreated, strconv.FormatInt(int64(node.ID), 10), audit.NewDiff(nil, *args.Input))
 
 	return NewCreateNodePayloadResolver(&node), nil
 }
@@ -368,7 +478,7 @@ func (r *Resolver) CreateNode(ctx context.Context, args struct {
 func (r *Resolver) DeleteNode(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*DeleteNodePayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUserIsAdmin(ctx); err != nil {
 		return nil, err
 	}
 
@@ -397,6 +507,7 @@ func (r *Resolver) DeleteNode(ctx context.Context, args struct {
 
 		return nil, err
 	}
+	r.recordAudit(ctx, audit.ActionNodeDeleted, strconv.FormatInt(int64(id), 10), nil)
 
 	return NewDeleteNodePayloadResolver(&node, nil), nil
 }
@@ -404,7 +515,7 @@ func (r *Resolver) DeleteNode(ctx context.Context, args struct {
 func (r *Resolver) DeleteBridge(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*DeleteBridgePayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUserCanEdit(ctx); err != nil {
 		return nil, err
 	}
 
@@ -434,12 +545,43 @@ func (r *Resolver) DeleteBridge(ctx context.Context, args struct {
 	if err = orm.DeleteBridgeType(&bt); err != nil {
 		return nil, err
 	}
+	r.recordAudit(ctx, audit.ActionBridgeDeleted, bt.Name.String(), nil)
 
 	return NewDeleteBridgePayload(&bt, nil), nil
 }
 
+// FlushBridgeCache drops the cached responses of a bridge, or of all bridges
+// when no ID is given.
+func (r *Resolver) FlushBridgeCache(ctx context.Context, args struct {
+	ID *graphql.ID
+}) (*FlushBridgeCachePayloadResolver, error) {
+	if err := authenticateUserCanRun(ctx); err != nil {
+		return nil, err
+	}
+
+	if args.ID == nil {
+		return NewFlushBridgeCachePayload(r.App.BridgeCache().FlushAll(), nil), nil
+	}
+
+	taskType, err := bridges.NewTaskType(string(*args.ID))
+	if err != nil {
+		return nil, err
+	}
+
+	bt, err := r.App.BridgeORM().FindBridge(taskType)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return NewFlushBridgeCachePayload(0, err), nil
+		}
+
+		return nil, err
+	}
+
+	return NewFlushBridgeCachePayload(r.App.BridgeCache().Flush(bt.Name), nil), nil
+}
+
 func (r *Resolver) CreateP2PKey(ctx context.Context) (*CreateP2PKeyPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUserIsAdmin(ctx); err != nil {
 		return nil, err
 	}
 
@@ -447,6 +589,7 @@ func (r *Resolver) CreateP2PKey(ctx context.Context) (*CreateP2PKeyPayloadResolv
 	if err != nil {
 		return nil, err
 	}
+	r.recordAudit(ctx, audit.ActionKeyCreated, audit.KeyTarget("p2p", key.ID()), nil)
 
 	return NewCreateP2PKeyPayload(key), nil
 }
@@ -454,7 +597,7 @@ func (r *Resolver) CreateP2PKey(ctx context.Context) (*CreateP2PKeyPayloadResolv
 func (r *Resolver) DeleteP2PKey(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*DeleteP2PKeyPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUserIsAdmin(ctx); err != nil {
 		return nil, err
 	}
 
@@ -470,12 +613,13 @@ func (r *Resolver) DeleteP2PKey(ctx context.Context, args struct {
 		}
 		return nil, err
 	}
+	r.recordAudit(ctx, audit.ActionKeyDeleted, audit.KeyTarget("p2p", key.ID()), nil)
 
 	return NewDeleteP2PKeyPayload(key, nil), nil
 }
 
 func (r *Resolver) CreateVRFKey(ctx context.Context) (*CreateVRFKeyPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUserIsAdmin(ctx); err != nil {
 		return nil, err
 	}
 
@@ -483,6 +627,7 @@ func (r *Resolver) CreateVRFKey(ctx context.Context) (*CreateVRFKeyPayloadResolv
 	if err != nil {
 		return nil, err
 	}
+	r.recordAudit(ctx, audit.ActionKeyCreated, audit.KeyTarget("vrf", key.ID()), nil)
 
 	return NewCreateVRFKeyPayloadResolver(key), nil
 }
@@ -490,7 +635,7 @@ func (r *Resolver) CreateVRFKey(ctx context.Context) (*CreateVRFKeyPayloadResolv
 func (r *Resolver) DeleteVRFKey(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*DeleteVRFKeyPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUserIsAdmin(ctx); err != nil {
 		return nil, err
 	}
 
@@ -501,6 +646,7 @@ func (r *Resolver) DeleteVRFKey(ctx context.Context, args struct {
 		}
 		return nil, err
 	}
+	r.recordAudit(ctx, audit.ActionKeyDeleted, audit.KeyTarget("vrf", key.ID()), nil)
 
 	return NewDeleteVRFKeyPayloadResolver(key, nil), nil
 }
@@ -541,6 +687,122 @@ func (r *Resolver) CancelJobProposal(ctx context.Context, args struct {
 	return NewCancelJobProposalPayload(jp, nil), nil
 }
 
+func (r *Resolver) CancelJobRun(ctx context.Context, args struct {
+	ID graphql.ID
+}) (*CancelJobRunPayloadResolver, error) {
+	if err := authenticateUserCanRun(ctx); err != nil {
+		return nil, err
+	}
+
+	id, err := stringutils.ToInt64(string(args.ID))
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.App.CancelJobRun(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) || errors.Is(err, pipeline.ErrRunFinished) {
+			return NewCancelJobRunPayload(nil, r.App, err), nil
+		}
+
+		return nil, err
+	}
+
+	run, err := r.App.JobORM().FindPipelineRunByID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewCancelJobRunPayload(&run, r.App, nil), nil
+}
+
+func (r *Resolver) CancelEthTransaction(ctx context.Context, args struct {
+	ID graphql.ID
+}) (*CancelEthTransactionPayloadResolver, error) {
+	if err := authenticateUserIsAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	etx, txm, err := r.findEthTxManager(string(args.ID))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return NewCancelEthTransactionPayload(nil, err), nil
+		}
+
+		return nil, err
+	}
+
+	cancelled, err := txm.CancelEthTransaction(etx.ID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) || errors.Is(err, bulletprooftxmanager.ErrEthTxNotReplaceable) {
+			return NewCancelEthTransactionPayload(nil, err), nil
+		}
+
+		return nil, err
+	}
+	r.recordAudit(ctx, audit.ActionTxsCancelled, string(args.ID), audit.NewDiff(etx.AuditFields(), cancelled.AuditFields()))
+
+	return NewCancelEthTransactionPayload(&cancelled, nil), nil
+}
+
+func (r *Resolver) ReplaceEthTransaction(ctx context.Context, args struct {
+	ID    graphql.ID
+	Input ReplaceEthTransactionInput
+}) (*ReplaceEthTransactionPayloadResolver, error) {
+	if err := authenticateUserIsAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	payload, err := hexutil.Decode(args.Input.Data)
+	if err != nil {
+		return NewReplaceEthTransactionPayload(nil, map[string]string{
+			"input/data": fmt.Sprintf("invalid hex data: %v", err),
+		}, nil), nil
+	}
+
+	etx, txm, err := r.findEthTxManager(string(args.ID))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return NewReplaceEthTransactionPayload(nil, nil, err), nil
+		}
+
+		return nil, err
+	}
+
+	replaced, err := txm.ReplaceEthTransaction(etx.ID, payload)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) || errors.Is(err, bulletprooftxmanager.ErrEthTxNotReplaceable) {
+			return NewReplaceEthTransactionPayload(nil, nil, err), nil
+		}
+
+		return nil, err
+	}
+	r.recordAudit(ctx, audit.ActionTxsReplaced, string(args.ID), audit.NewDiff(etx.AuditFields(), replaced.AuditFields()))
+
+	return NewReplaceEthTransactionPayload(&replaced, nil, nil), nil
+}
+
+// findEthTxManager finds a transaction by ID, along with the TxManager of
+// the chain it is sent on
+func (r *Resolver) findEthTxManager(id string) (etx bulletprooftxmanager.EthTx, txm bulletprooftxmanager.TxManager, err error) {
+	etxID, err := stringutils.ToInt64(id)
+	if err != nil {
+		return etx, nil, err
+	}
+
+	etx, err = r.App.BPTXMORM().FindEthTxWithAttempts(etxID)
+	if err != nil {
+		return etx, nil, err
+	}
+
+	chain, err := r.App.GetChainSet().Get(etx.EVMChainID.ToInt())
+	if err != nil {
+		return etx, nil, err
+	}
+
+	return etx, chain.TxManager(), nil
+}
+
 func (r *Resolver) RejectJobProposal(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*RejectJobProposalPayloadResolver, error) {
@@ -563,7 +825,7 @@ func (r *Resolver) UpdateJobProposalSpec(ctx context.Context, args struct {
 	ID    graphql.ID
 	Input *struct{ Spec string }
 }) (*UpdateJobProposalSpecPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUserCanEdit(ctx); err != nil {
 		return nil, err
 	}
 
@@ -582,6 +844,7 @@ func (r *Resolver) UpdateJobProposalSpec(ctx context.Context, args struct {
 
 		return nil, err
 	}
+	r.recordAudit(ctx, audit.ActionJobProposalSpecUpdated, strconv.FormatInt(id, 10), audit.NewDiff(nil, map[string]interface{}{"spec": args.Input.Spec}))
 
 	jp, err := r.App.GetFeedsService().GetJobProposal(id)
 	if err != nil {
@@ -601,7 +864,7 @@ type jobProposalAction struct {
 }
 
 func (r *Resolver) executeJobProposalAction(ctx context.Context, action jobProposalAction) (*feeds.JobProposal, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUserCanEdit(ctx); err != nil {
 		return nil, err
 	}
 
@@ -612,13 +875,17 @@ func (r *Resolver) executeJobProposalAction(ctx context.Context, action jobPropo
 
 	feedsSvc := r.App.GetFeedsService()
 
+	var auditAction audit.Action
 	switch action.name {
 	case approve:
 		err = feedsSvc.ApproveJobProposal(ctx, id)
+		auditAction = audit.ActionJobProposalApproved
 	case cancel:
 		err = feedsSvc.CancelJobProposal(ctx, id)
+		auditAction = audit.ActionJobProposalCancelled
 	case reject:
 		err = feedsSvc.RejectJobProposal(ctx, id)
+		auditAction = audit.ActionJobProposalRejected
 	default:
 		return nil, errors.New("invalid job proposal action")
 	}
@@ -626,6 +893,7 @@ func (r *Resolver) executeJobProposalAction(ctx context.Context, action jobPropo
 	if err != nil {
 		return nil, err
 	}
+	r.recordAudit(ctx, auditAction, strconv.FormatInt(id, 10), nil)
 
 	jp, err := r.App.GetFeedsService().GetJobProposal(id)
 	if err != nil {
@@ -638,7 +906,7 @@ func (r *Resolver) executeJobProposalAction(ctx context.Context, action jobPropo
 func (r *Resolver) SetServicesLogLevels(ctx context.Context, args struct {
 	Input struct{ Config LogLevelConfig }
 }) (*SetServicesLogLevelsPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUserIsAdmin(ctx); err != nil {
 		return nil, err
 	}
 
@@ -672,6 +940,18 @@ func (r *Resolver) SetServicesLogLevels(ctx context.Context, args struct {
 		}
 	}
 
+	changed := map[string]interface{}{}
+	if args.Input.Config.HeadTracker != nil {
+		changed[logger.HeadTracker] = FromLogLevel(*args.Input.Config.HeadTracker)
+	}
+	if args.Input.Config.FluxMonitor != nil {
+		changed[logger.FluxMonitor] = FromLogLevel(*args.Input.Config.FluxMonitor)
+	}
+	if args.Input.Config.Keeper != nil {
+		changed[logger.Keeper] = FromLogLevel(*args.Input.Config.Keeper)
+	}
+	r.recordAudit(ctx, audit.ActionLogConfigUpdated, "log", audit.NewDiff(nil, map[string]interface{}{"serviceLogLevel": changed}))
+
 	return NewSetServicesLogLevelsPayload(&args.Input.Config, nil), nil
 }
 
@@ -705,7 +985,7 @@ func (r *Resolver) UpdateUserPassword(ctx context.Context, args struct {
 		return nil, errors.New("couldn't retrieve user session")
 	}
 
-	dbUser, err := r.App.SessionORM().FindUser()
+	dbUser, err := r.App.SessionORM().FindUser(session.User.Email)
 	if err != nil {
 		return nil, err
 	}
@@ -724,18 +1004,117 @@ func (r *Resolver) UpdateUserPassword(ctx context.Context, args struct {
 	if err != nil {
 		return nil, failedPasswordUpdateError{}
 	}
+	r.recordAudit(ctx, audit.ActionUserPasswordUpdated, dbUser.Email, nil)
 
 	return NewUpdatePasswordPayload(session.User, nil), nil
 }
 
+func (r *Resolver) CreateUser(ctx context.Context, args struct {
+	Input CreateUserInput
+}) (*CreateUserPayloadResolver, error) {
+	if err := authenticateUserIsAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	role, err := FromUserRole(args.Input.Role)
+	if err != nil {
+		return NewCreateUserPayload(nil, map[string]string{"role": err.Error()}), nil
+	}
+	user, err := sessions.NewUser(args.Input.Email, args.Input.Password, role)
+	if err != nil {
+		return NewCreateUserPayload(nil, map[string]string{"input": err.Error()}), nil
+	}
+	if _, err = r.App.SessionORM().FindUser(user.Email); err == nil {
+		return NewCreateUserPayload(nil, map[string]string{"email": "user already exists"}), nil
+	}
+	if err = r.App.SessionORM().CreateUser(&user); err != nil {
+		return nil, err
+	}
+	r.recordAudit(ctx, audit.ActionUserCreated, user.Email, audit.NewDiff(nil, presenters.NewUserResource(user)))
+
+	return NewCreateUserPayload(&user, nil), nil
+}
+
+// UpdateUserRole changes the role of a user. Admins can't change their own
+// role, so that there is always at least one admin.
+func (r *Resolver) UpdateUserRole(ctx context.Context, args struct {
+	Email string
+	Input UpdateUserRoleInput
+}) (*UpdateUserRolePayloadResolver, error) {
+	if err := authenticateUserIsAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	if isCurrentUser(ctx, args.Email) {
+		return NewUpdateUserRolePayload(nil, map[string]string{"email": "you cannot change your own role"}, nil), nil
+	}
+	role, err := FromUserRole(args.Input.Role)
+	if err != nil {
+		return NewUpdateUserRolePayload(nil, map[string]string{"role": err.Error()}, nil), nil
+	}
+	before, err := r.App.SessionORM().FindUser(args.Email)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return NewUpdateUserRolePayload(nil, nil, err), nil
+		}
+
+		return nil, err
+	}
+	user, err := r.App.SessionORM().UpdateRole(args.Email, role)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return NewUpdateUserRolePayload(nil, nil, err), nil
+		}
+
+		return nil, err
+	}
+	r.recordAudit(ctx, audit.ActionUserRoleUpdated, user.Email, audit.NewDiff(presenters.NewUserResource(before), presenters.NewUserResource(user)))
+
+	return NewUpdateUserRolePayload(&user, nil, nil), nil
+}
+
+// DeleteUser deletes a user. Admins can't delete themselves, so that there
+// is always at least one admin.
+func (r *Resolver) DeleteUser(ctx context.Context, args struct {
+	Email string
+}) (*DeleteUserPayloadResolver, error) {
+	if err := authenticateUserIsAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	if isCurrentUser(ctx, args.Email) {
+		return NewDeleteUserPayload(nil, map[string]string{"email": "you cannot delete yourself"}, nil), nil
+	}
+	user, err := r.App.SessionORM().FindUser(args.Email)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return NewDeleteUserPayload(nil, nil, err), nil
+		}
+
+		return nil, err
+	}
+	if err = r.App.SessionORM().DeleteUser(user.Email); err != nil {
+		return nil, err
+	}
+	r.recordAudit(ctx, audit.ActionUserDeleted, user.Email, nil)
+
+	return NewDeleteUserPayload(&user, nil, nil), nil
+}
+
+func isCurrentUser(ctx context.Context, email string) bool {
+	session, ok := webauth.GetGQLAuthenticatedSession(ctx)
+	return ok && strings.EqualFold(session.User.Email, email)
+}
+
 func (r *Resolver) SetSQLLogging(ctx context.Context, args struct {
 	Input struct{ Enabled bool }
 }) (*SetSQLLoggingPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUserIsAdmin(ctx); err != nil {
 		return nil, err
 	}
 
 	r.App.GetConfig().SetLogSQL(args.Input.Enabled)
+	r.recordAudit(ctx, audit.ActionLogConfigUpdated, "log", audit.NewDiff(nil, map[string]interface{}{"sqlEnabled": args.Input.Enabled}))
 
 	return NewSetSQLLoggingPayload(args.Input.Enabled), nil
 }
@@ -747,7 +1126,12 @@ func (r *Resolver) CreateAPIToken(ctx context.Context, args struct {
 		return nil, err
 	}
 
-	dbUser, err := r.App.SessionORM().FindUser()
+	session, ok := webauth.GetGQLAuthenticatedSession(ctx)
+	if !ok {
+		return nil, errors.New("couldn't retrieve user session")
+	}
+
+	dbUser, err := r.App.SessionORM().FindUser(session.User.Email)
 	if err != nil {
 		return nil, err
 	}
@@ -762,6 +1146,7 @@ func (r *Resolver) CreateAPIToken(ctx context.Context, args struct {
 	if err != nil {
 		return nil, err
 	}
+	r.recordAudit(ctx, audit.ActionAPITokenCreated, dbUser.Email, nil)
 
 	return NewCreateAPITokenPayload(newToken, nil), nil
 }
@@ -773,7 +1158,12 @@ func (r *Resolver) DeleteAPIToken(ctx context.Context, args struct {
 		return nil, err
 	}
 
-	dbUser, err := r.App.SessionORM().FindUser()
+	session, ok := webauth.GetGQLAuthenticatedSession(ctx)
+	if !ok {
+		return nil, errors.New("couldn't retrieve user session")
+	}
+
+	dbUser, err := r.App.SessionORM().FindUser(session.User.Email)
 	if err != nil {
 		return nil, err
 	}
@@ -788,6 +1178,7 @@ func (r *Resolver) DeleteAPIToken(ctx context.Context, args struct {
 	if err != nil {
 		return nil, err
 	}
+	r.recordAudit(ctx, audit.ActionAPITokenDeleted, dbUser.Email, nil)
 
 	return NewDeleteAPITokenPayload(&auth.Token{
 		AccessKey: dbUser.TokenKey.String,
@@ -801,7 +1192,7 @@ func (r *Resolver) CreateChain(ctx context.Context, args struct {
 		KeySpecificConfigs []*KeySpecificChainConfigInput
 	}
 }) (*CreateChainPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUserIsAdmin(ctx); err != nil {
 		return nil, err
 	}
 
@@ -837,6 +1228,7 @@ func (r *Resolver) CreateChain(ctx context.Context, args struct {
 	if err != nil {
 		return nil, err
 	}
+	r.recordAudit(ctx, audit.ActionChainCreated, chain.ID.String(), audit.NewDiff(nil, presenters.NewChainResource(chain)))
 
 	return NewCreateChainPayload(&chain, nil), nil
 }
@@ -849,7 +1241,7 @@ func (r *Resolver) UpdateChain(ctx context.Context, args struct {
 		KeySpecificConfigs []*KeySpecificChainConfigInput
 	}
 }) (*UpdateChainPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUserIsAdmin(ctx); err != nil {
 		return nil, err
 	}
 
@@ -881,6 +1273,15 @@ func (r *Resolver) UpdateChain(ctx context.Context, args struct {
 		chainCfg.KeySpecific = sCfgs
 	}
 
+	before, err := r.App.EVMORM().Chain(id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return NewUpdateChainPayload(nil, nil, err), nil
+		}
+
+		return nil, err
+	}
+
 	chain, err := r.App.GetChainSet().Configure(id.ToInt(), args.Input.Enabled, *chainCfg)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -889,6 +1290,7 @@ func (r *Resolver) UpdateChain(ctx context.Context, args struct {
 
 		return nil, err
 	}
+	r.recordAudit(ctx, audit.ActionChainUpdated, chain.ID.String(), audit.NewDiff(presenters.NewChainResource(before), presenters.NewChainResource(chain)))
 
 	return NewUpdateChainPayload(&chain, nil, nil), nil
 }
@@ -896,7 +1298,7 @@ func (r *Resolver) UpdateChain(ctx context.Context, args struct {
 func (r *Resolver) DeleteChain(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*DeleteChainPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUserIsAdmin(ctx); err != nil {
 		return nil, err
 	}
 
@@ -919,6 +1321,7 @@ func (r *Resolver) DeleteChain(ctx context.Context, args struct {
 	if err != nil {
 		return nil, err
 	}
+	r.recordAudit(ctx, audit.ActionChainDeleted, id.String(), nil)
 
 	return NewDeleteChainPayload(&chain, nil), nil
 }
@@ -928,61 +1331,175 @@ func (r *Resolver) CreateJob(ctx context.Context, args struct {
 		TOML string
 	}
 }) (*CreateJobPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUserCanEdit(ctx); err != nil {
 		return nil, err
 	}
 
-	jbt, err := job.ValidateSpec(args.Input.TOML)
+	jb, inputErrs, err := r.validatedJob(args.Input.TOML)
 	if err != nil {
-		return NewCreateJobPayload(r.App, nil, map[string]string{
+		return nil, err
+	}
+	if inputErrs != nil {
+		return NewCreateJobPayload(r.App, nil, inputErrs), nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	err = r.App.AddJobV2(ctx, &jb)
+	if err != nil {
+		return nil, err
+	}
+	r.recordAudit(ctx, audit.ActionJobCreated, strconv.FormatInt(int64(jb.ID), 10), audit.NewDiff(nil, map[string]interface{}{
+		"type":          jb.Type,
+		"name":          jb.Name.ValueOrZero(),
+		"externalJobID": jb.ExternalJobID,
+		"toml":          args.Input.TOML,
+	}))
+
+	return NewCreateJobPayload(r.App, &jb, nil), nil
+}
+
+// validatedJob parses and validates the TOML spec of a job. A spec which
+// cannot be parsed, or is of an unknown type, is returned as input errors.
+func (r *Resolver) validatedJob(toml string) (jb job.Job, inputErrs map[string]string, err error) {
+	jbt, err := job.ValidateSpec(toml)
+	if err != nil {
+		return jb, map[string]string{
 			"TOML spec": errors.Wrap(err, "failed to parse TOML").Error(),
-		}), nil
+		}, nil
 	}
 
-	var jb job.Job
 	config := r.App.GetConfig()
 	switch jbt {
 	case job.OffchainReporting:
-		jb, err = offchainreporting.ValidatedOracleSpecToml(r.App.GetChainSet(), args.Input.TOML)
+		jb, err = offchainreporting.ValidatedOracleSpecToml(r.App.GetChainSet(), toml)
 		if !config.Dev() && !config.FeatureOffchainReporting() {
-			return nil, errors.New("The Offchain Reporting feature is disabled by configuration")
+			return jb, nil, errors.New("The Offchain Reporting feature is disabled by configuration")
 		}
 	case job.DirectRequest:
-		jb, err = directrequest.ValidatedDirectRequestSpec(args.Input.TOML)
+		jb, err = directrequest.ValidatedDirectRequestSpec(toml)
 	case job.FluxMonitor:
-		jb, err = fluxmonitorv2.ValidatedFluxMonitorSpec(config, args.Input.TOML)
+		jb, err = fluxmonitorv2.ValidatedFluxMonitorSpec(config, toml)
 	case job.Keeper:
-		jb, err = keeper.ValidatedKeeperSpec(args.Input.TOML)
+		jb, err = keeper.ValidatedKeeperSpec(toml)
 	case job.Cron:
-		jb, err = cron.ValidatedCronSpec(args.Input.TOML)
+		jb, err = cron.ValidatedCronSpec(toml)
 	case job.VRF:
-		jb, err = vrf.ValidatedVRFSpec(args.Input.TOML)
+		jb, err = vrf.ValidatedVRFSpec(toml)
+	case job.EventTrigger:
+		jb, err = eventtrigger.ValidatedEventTriggerSpec(toml)
+	case job.BlockTrigger:
+		jb, err = blocktrigger.ValidatedBlockTriggerSpec(toml)
 	case job.Webhook:
-		jb, err = webhook.ValidatedWebhookSpec(args.Input.TOML, r.App.GetExternalInitiatorManager())
+		jb, err = webhook.ValidatedWebhookSpec(toml, r.App.GetExternalInitiatorManager())
 	default:
-		return NewCreateJobPayload(r.App, nil, map[string]string{
+		return jb, map[string]string{
 			"Job Type": fmt.Sprintf("unknown job type: %s", jbt),
-		}), nil
+		}, nil
+	}
+
+	return jb, nil, err
+}
+
+func (r *Resolver) UpdateJob(ctx context.Context, args struct {
+	ID    graphql.ID
+	Input struct {
+		TOML string
+	}
+}) (*UpdateJobPayloadResolver, error) {
+	if err := authenticateUserCanEdit(ctx); err != nil {
+		return nil, err
+	}
+
+	id, err := stringutils.ToInt32(string(args.ID))
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err = r.App.JobORM().FindJobTx(id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return NewUpdateJobPayload(r.App, nil, nil, err), nil
+		}
+
+		return nil, err
 	}
+
+	jb, inputErrs, err := r.validatedJob(args.Input.TOML)
 	if err != nil {
 		return nil, err
 	}
+	if inputErrs != nil {
+		return NewUpdateJobPayload(r.App, nil, inputErrs, nil), nil
+	}
+	jb.ID = id
 
 	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
 	defer cancel()
 
-	err = r.App.AddJobV2(ctx, &jb)
+	err = r.App.UpdateJobV2(ctx, &jb)
 	if err != nil {
+		if errors.Is(err, job.ErrJobTypeChanged) || errors.Is(err, job.ErrExternalJobIDChanged) || errors.Is(err, job.ErrJobSettingChanged) {
+			return NewUpdateJobPayload(r.App, nil, map[string]string{
+				"TOML spec": err.Error(),
+			}, nil), nil
+		}
+
 		return nil, err
 	}
+	r.recordAudit(ctx, audit.ActionJobUpdated, strconv.FormatInt(int64(jb.ID), 10), audit.NewDiff(nil, map[string]interface{}{
+		"version": jb.PipelineSpec.Version,
+		"toml":    args.Input.TOML,
+	}))
 
-	return NewCreateJobPayload(r.App, &jb, nil), nil
+	return NewUpdateJobPayload(r.App, &jb, nil, nil), nil
+}
+
+func (r *Resolver) RollbackJob(ctx context.Context, args struct {
+	ID      graphql.ID
+	Version int32
+}) (*RollbackJobPayloadResolver, error) {
+	if err := authenticateUserCanEdit(ctx); err != nil {
+		return nil, err
+	}
+
+	id, err := stringutils.ToInt32(string(args.ID))
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err = r.App.JobORM().FindJobTx(id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return NewRollbackJobPayload(r.App, nil, err), nil
+		}
+
+		return nil, err
+	}
+
+	err = r.App.RollbackJob(ctx, id, args.Version)
+	if err != nil {
+		if errors.Is(err, job.ErrNoSuchJobVersion) {
+			return NewRollbackJobPayload(r.App, nil, err), nil
+		}
+
+		return nil, err
+	}
+	r.recordAudit(ctx, audit.ActionJobRolledBack, strconv.FormatInt(int64(id), 10), audit.NewDiff(nil, map[string]interface{}{
+		"version": args.Version,
+	}))
+
+	j, err := r.App.JobORM().FindJobTx(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewRollbackJobPayload(r.App, &j, nil), nil
 }
 
 func (r *Resolver) DeleteJob(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*DeleteJobPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUserCanEdit(ctx); err != nil {
 		return nil, err
 	}
 
@@ -1008,14 +1525,95 @@ func (r *Resolver) DeleteJob(ctx context.Context, args struct {
 
 		return nil, err
 	}
+	r.recordAudit(ctx, audit.ActionJobDeleted, strconv.FormatInt(int64(id), 10), nil)
 
 	return NewDeleteJobPayload(r.App, &j, nil), nil
 }
 
+func (r *Resolver) PauseJob(ctx context.Context, args struct {
+	ID graphql.ID
+}) (*PauseJobPayloadResolver, error) {
+	if err := authenticateUserCanEdit(ctx); err != nil {
+		return nil, err
+	}
+
+	id, err := stringutils.ToInt32(string(args.ID))
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err = r.App.JobORM().FindJobTx(id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return NewPauseJobPayload(r.App, nil, err), nil
+		}
+
+		return nil, err
+	}
+
+	err = r.App.PauseJob(ctx, id)
+	if err != nil {
+		if errors.Is(err, job.ErrJobPaused) {
+			return NewPauseJobPayload(r.App, nil, err), nil
+		}
+
+		return nil, err
+	}
+	r.recordAudit(ctx, audit.ActionJobPaused, strconv.FormatInt(int64(id), 10), nil)
+
+	j, err := r.App.JobORM().FindJobTx(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewPauseJobPayload(r.App, &j, nil), nil
+}
+
+func (r *Resolver) ResumeJob(ctx context.Context, args struct {
+	ID       graphql.ID
+	Backfill *bool
+}) (*ResumeJobPayloadResolver, error) {
+	if err := authenticateUserCanEdit(ctx); err != nil {
+		return nil, err
+	}
+
+	id, err := stringutils.ToInt32(string(args.ID))
+	if err != nil {
+		return nil, err
+	}
+	backfill := args.Backfill != nil && *args.Backfill
+
+	if _, err = r.App.JobORM().FindJobTx(id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return NewResumeJobPayload(r.App, nil, err), nil
+		}
+
+		return nil, err
+	}
+
+	err = r.App.ResumeJob(ctx, id, backfill)
+	if err != nil {
+		if errors.Is(err, job.ErrJobNotPaused) || errors.Is(err, job.ErrJobNotBackfillable) {
+			return NewResumeJobPayload(r.App, nil, err), nil
+		}
+
+		return nil, err
+	}
+	r.recordAudit(ctx, audit.ActionJobResumed, strconv.FormatInt(int64(id), 10), audit.NewDiff(nil, map[string]interface{}{
+		"backfill": backfill,
+	}))
+
+	j, err := r.App.JobORM().FindJobTx(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewResumeJobPayload(r.App, &j, nil), nil
+}
+
 func (r *Resolver) DismissJobError(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*DismissJobErrorPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUserCanRun(ctx); err != nil {
 		return nil, err
 	}
 
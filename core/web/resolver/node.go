@@ -6,7 +6,9 @@ import (
 	"github.com/graph-gophers/graphql-go"
 
 	"github.com/smartcontractkit/chainlink/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/core/services/eth"
 	"github.com/smartcontractkit/chainlink/core/web/loader"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
 )
 
 // NodeResolver resolves the Node type.
@@ -57,6 +59,64 @@ func (r *NodeResolver) Chain(ctx context.Context) (*ChainResolver, error) {
 	return NewChain(*chain), nil
 }
 
+// State resolves the node's live state. It is Unknown if the node is not
+// running as a primary.
+func (r *NodeResolver) State(ctx context.Context) (string, error) {
+	status, err := r.status(ctx)
+	if err != nil {
+		return "", err
+	}
+	if status == nil {
+		return presenters.NodeStateUnknown, nil
+	}
+
+	return status.State.String(), nil
+}
+
+// LastHead resolves the highest block number reported by the node.
+func (r *NodeResolver) LastHead(ctx context.Context) (*int32, error) {
+	status, err := r.status(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if status == nil || status.LatestBlockNumber < 0 {
+		return nil, nil
+	}
+	lastHead := int32(status.LatestBlockNumber)
+
+	return &lastHead, nil
+}
+
+// ErrorCount resolves the number of calls to the node that have failed due to
+// a problem with the node.
+func (r *NodeResolver) ErrorCount(ctx context.Context) (int32, error) {
+	status, err := r.status(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if status == nil {
+		return 0, nil
+	}
+
+	return int32(status.Errors), nil
+}
+
+// status fetches the node's live status, which is nil if the node is not
+// running as a primary.
+func (r *NodeResolver) status(ctx context.Context) (*eth.NodeStatus, error) {
+	statuses, err := loader.GetNodeStatusesByChainID(ctx, r.node.EVMChainID.String())
+	if err != nil {
+		return nil, err
+	}
+	for _, s := range statuses {
+		if s.Name == r.node.Name {
+			return &s, nil
+		}
+	}
+
+	return nil, nil
+}
+
 // CreatedAt resolves the node's created at field.
 func (r *NodeResolver) CreatedAt() graphql.Time {
 	return graphql.Time{Time: r.node.CreatedAt}
@@ -10,8 +10,10 @@ import (
 	"github.com/stretchr/testify/assert"
 	"gopkg.in/guregu/null.v4"
 
+	"github.com/smartcontractkit/chainlink/core/audit"
 	"github.com/smartcontractkit/chainlink/core/chains/evm"
 	"github.com/smartcontractkit/chainlink/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/core/services/eth"
 	"github.com/smartcontractkit/chainlink/core/utils"
 	"github.com/smartcontractkit/chainlink/core/utils/stringutils"
 )
@@ -169,6 +171,83 @@ func Test_NodeQuery(t *testing.T) {
 	RunGQLTests(t, testCases)
 }
 
+func Test_NodeQuery_LiveStatus(t *testing.T) {
+	t.Parallel()
+
+	query := `
+		query GetNode {
+			node(id: "200") {
+				... on Node {
+					name
+					state
+					lastHead
+					errorCount
+				}
+			}
+		}`
+
+	nodeID := int32(200)
+	chainID := *utils.NewBigI(1)
+
+	testCases := []GQLTestCase{
+		{
+			name:          "running primary",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.Mocks.evmORM.On("Node", nodeID).Return(types.Node{
+					ID:         nodeID,
+					Name:       "node-name",
+					EVMChainID: chainID,
+				}, nil)
+				f.App.On("EVMORM").Return(f.Mocks.evmORM)
+				f.Mocks.ethClient.On("NodeStatuses").Return([]eth.NodeStatus{
+					{Name: "other-node", State: eth.NodeStateDead, NodeStats: eth.NodeStats{LatestBlockNumber: -1}},
+					{Name: "node-name", State: eth.NodeStateOutOfSync, NodeStats: eth.NodeStats{LatestBlockNumber: 42, Errors: 3}},
+				})
+				f.Mocks.chain.On("Client").Return(f.Mocks.ethClient)
+				f.Mocks.chainSet.On("Get", chainID.ToInt()).Return(f.Mocks.chain, nil)
+				f.App.On("GetChainSet").Return(f.Mocks.chainSet)
+			},
+			query: query,
+			result: `
+			{
+				"node": {
+					"name": "node-name",
+					"state": "OutOfSync",
+					"lastHead": 42,
+					"errorCount": 3
+				}
+			}`,
+		},
+		{
+			name:          "chain not running",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.Mocks.evmORM.On("Node", nodeID).Return(types.Node{
+					ID:         nodeID,
+					Name:       "node-name",
+					EVMChainID: chainID,
+				}, nil)
+				f.App.On("EVMORM").Return(f.Mocks.evmORM)
+				f.Mocks.chainSet.On("Get", chainID.ToInt()).Return(nil, evm.ErrNoChains)
+				f.App.On("GetChainSet").Return(f.Mocks.chainSet)
+			},
+			query: query,
+			result: `
+			{
+				"node": {
+					"name": "node-name",
+					"state": "Unknown",
+					"lastHead": null,
+					"errorCount": 0
+				}
+			}`,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
+
 func Test_CreateNodeMutation(t *testing.T) {
 	t.Parallel()
 
@@ -223,6 +302,7 @@ func Test_CreateNodeMutation(t *testing.T) {
 					{ID: *utils.NewBigI(1), Enabled: true},
 				}, nil)
 				f.App.On("EVMORM").Return(f.Mocks.evmORM)
+				f.expectAuditRecord(audit.ActionNodeCreated, "1")
 			},
 			query:     mutation,
 			variables: input,
@@ -301,6 +381,7 @@ func Test_DeleteNodeMutation(t *testing.T) {
 			before: func(f *gqlTestFramework) {
 				f.Mocks.evmORM.On("Node", fakeID).Return(fakeNode, nil)
 				f.Mocks.evmORM.On("DeleteNode", int64(2)).Return(nil)
+				f.expectAuditRecord(audit.ActionNodeDeleted, "2")
 				f.App.On("EVMORM").Return(f.Mocks.evmORM)
 			},
 			query:     mutation,
@@ -7,6 +7,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 
+	"github.com/smartcontractkit/chainlink/core/audit"
 	"github.com/smartcontractkit/chainlink/core/services/keystore"
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ocrkey"
 )
@@ -106,6 +107,7 @@ func TestResolver_OCRCreateBundle(t *testing.T) {
 			authenticated: true,
 			before: func(f *gqlTestFramework) {
 				f.Mocks.ocr.On("Create").Return(fakeKey, nil)
+				f.expectAuditRecord(audit.ActionKeyCreated, "ocr:"+fakeKey.ID())
 				f.Mocks.keystore.On("OCR").Return(f.Mocks.ocr)
 				f.App.On("GetKeyStore").Return(f.Mocks.keystore)
 			},
@@ -164,6 +166,7 @@ func TestResolver_OCRDeleteBundle(t *testing.T) {
 			authenticated: true,
 			before: func(f *gqlTestFramework) {
 				f.Mocks.ocr.On("Delete", fakeKey.ID()).Return(fakeKey, nil)
+				f.expectAuditRecord(audit.ActionKeyDeleted, "ocr:"+fakeKey.ID())
 				f.Mocks.keystore.On("OCR").Return(f.Mocks.ocr)
 				f.App.On("GetKeyStore").Return(f.Mocks.keystore)
 			},
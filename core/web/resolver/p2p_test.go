@@ -8,6 +8,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 
+	"github.com/smartcontractkit/chainlink/core/audit"
 	"github.com/smartcontractkit/chainlink/core/services/keystore"
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/p2pkey"
 )
@@ -103,6 +104,7 @@ func TestResolver_CreateP2PKey(t *testing.T) {
 			authenticated: true,
 			before: func(f *gqlTestFramework) {
 				f.Mocks.p2p.On("Create").Return(fakeKey, nil)
+				f.expectAuditRecord(audit.ActionKeyCreated, "p2p:"+fakeKey.ID())
 				f.Mocks.keystore.On("P2P").Return(f.Mocks.p2p)
 				f.App.On("GetKeyStore").Return(f.Mocks.keystore)
 			},
@@ -164,6 +166,7 @@ func TestResolver_DeleteP2PKey(t *testing.T) {
 			authenticated: true,
 			before: func(f *gqlTestFramework) {
 				f.Mocks.p2p.On("Delete", peerID).Return(fakeKey, nil)
+				f.expectAuditRecord(audit.ActionKeyDeleted, "p2p:"+fakeKey.ID())
 				f.Mocks.keystore.On("P2P").Return(f.Mocks.p2p)
 				f.App.On("GetKeyStore").Return(f.Mocks.keystore)
 			},
@@ -18,6 +18,33 @@ import (
 	"github.com/smartcontractkit/chainlink/core/utils/stringutils"
 )
 
+// AuditLogEntries retrieves a page of the audit log, newest first. Only admins
+// can read the audit log.
+func (r *Resolver) AuditLogEntries(ctx context.Context, args struct {
+	Offset *int32
+	Limit  *int32
+	Filter *AuditLogFilterInput
+}) (*AuditLogEntriesPayloadResolver, error) {
+	if err := authenticateUserIsAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	filter, err := args.Filter.toFilter()
+	if err != nil {
+		return nil, err
+	}
+
+	offset := pageOffset(args.Offset)
+	limit := pageLimit(args.Limit)
+
+	entries, count, err := r.App.AuditLogger().Entries(filter, offset, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewAuditLogEntriesPayload(entries, int32(count)), nil
+}
+
 // Bridge retrieves a bridges by name.
 func (r *Resolver) Bridge(ctx context.Context, args struct{ ID graphql.ID }) (*BridgePayloadResolver, error) {
 	if err := authenticateUser(ctx); err != nil {
@@ -141,6 +168,24 @@ func (r *Resolver) FeedsManagers(ctx context.Context) (*FeedsManagersPayloadReso
 	return NewFeedsManagersPayload(mgrs), nil
 }
 
+// GasSpend retrieves the fees paid for the transactions confirmed since the
+// given time, grouped by chain, key, job or pipeline run.
+func (r *Resolver) GasSpend(ctx context.Context, args struct {
+	Since   graphql.Time
+	GroupBy *GasSpendGroupBy
+}) (*GasSpendPayloadResolver, error) {
+	if err := authenticateUser(ctx); err != nil {
+		return nil, err
+	}
+
+	spends, err := r.App.BPTXMORM().GasSpend(FromGasSpendGroupBy(args.GroupBy), args.Since.Time)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewGasSpendPayload(spends), nil
+}
+
 // Job retrieves a job by id.
 func (r *Resolver) Job(ctx context.Context, args struct{ ID graphql.ID }) (*JobPayloadResolver, error) {
 	if err := authenticateUser(ctx); err != nil {
@@ -255,6 +300,20 @@ func (r *Resolver) P2PKeys(ctx context.Context) (*P2PKeysPayloadResolver, error)
 	return NewP2PKeysPayload(p2pKeys), nil
 }
 
+// Users retrieves all users
+func (r *Resolver) Users(ctx context.Context) (*UsersPayloadResolver, error) {
+	if err := authenticateUserIsAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	users, err := r.App.SessionORM().ListUsers()
+	if err != nil {
+		return nil, err
+	}
+
+	return NewUsersPayload(users), nil
+}
+
 // VRFKeys fetches all VRF keys.
 func (r *Resolver) VRFKeys(ctx context.Context) (*VRFKeysPayloadResolver, error) {
 	if err := authenticateUser(ctx); err != nil {
@@ -10,11 +10,14 @@ import (
 	"github.com/graph-gophers/graphql-go/gqltesting"
 	"github.com/stretchr/testify/mock"
 
+	"github.com/smartcontractkit/chainlink/core/audit"
+	auditMocks "github.com/smartcontractkit/chainlink/core/audit/mocks"
 	bridgeORMMocks "github.com/smartcontractkit/chainlink/core/bridges/mocks"
 	evmConfigMocks "github.com/smartcontractkit/chainlink/core/chains/evm/config/mocks"
 	evmORMMocks "github.com/smartcontractkit/chainlink/core/chains/evm/mocks"
 	configMocks "github.com/smartcontractkit/chainlink/core/config/mocks"
 	coremocks "github.com/smartcontractkit/chainlink/core/internal/mocks"
+	bptxmMocks "github.com/smartcontractkit/chainlink/core/services/bulletprooftxmanager/mocks"
 	ethmocks "github.com/smartcontractkit/chainlink/core/services/eth/mocks"
 	feedsMocks "github.com/smartcontractkit/chainlink/core/services/feeds/mocks"
 	jobORMMocks "github.com/smartcontractkit/chainlink/core/services/job/mocks"
@@ -30,6 +33,8 @@ import (
 
 type mocks struct {
 	bridgeORM   *bridgeORMMocks.ORM
+	breakers    *bridgeORMMocks.Breakers
+	bridgeCache *bridgeORMMocks.ResponseCache
 	evmORM      *evmORMMocks.ORM
 	jobORM      *jobORMMocks.ORM
 	sessionsORM *sessionsMocks.ORM
@@ -47,6 +52,9 @@ type mocks struct {
 	ethClient   *ethmocks.Client
 	eIMgr       *webhookmocks.ExternalInitiatorManager
 	balM        *servicesMocks.BalanceMonitor
+	auditLogger *auditMocks.AuditLogger
+	bptxmORM    *bptxmMocks.ORM
+	txm         *bptxmMocks.TxManager
 }
 
 // gqlTestFramework is a framework wrapper containing the objects needed to run
@@ -83,6 +91,8 @@ func setupFramework(t *testing.T) *gqlTestFramework {
 	// Note - If you add a new mock make sure you assert it's expectation below.
 	m := &mocks{
 		bridgeORM:   &bridgeORMMocks.ORM{},
+		breakers:    &bridgeORMMocks.Breakers{},
+		bridgeCache: &bridgeORMMocks.ResponseCache{},
 		evmORM:      &evmORMMocks.ORM{},
 		jobORM:      &jobORMMocks.ORM{},
 		feedsSvc:    &feedsMocks.Service{},
@@ -100,13 +110,21 @@ func setupFramework(t *testing.T) *gqlTestFramework {
 		ethClient:   &ethmocks.Client{},
 		eIMgr:       &webhookmocks.ExternalInitiatorManager{},
 		balM:        &servicesMocks.BalanceMonitor{},
+		auditLogger: &auditMocks.AuditLogger{},
+		bptxmORM:    &bptxmMocks.ORM{},
+		txm:         &bptxmMocks.TxManager{},
 	}
 
+	// Privileged mutations record an entry in the audit log once they succeed
+	app.On("AuditLogger").Return(m.auditLogger).Maybe()
+
 	// Assert expectations for any mocks that we set up
 	t.Cleanup(func() {
 		mock.AssertExpectationsForObjects(t,
 			app,
 			m.bridgeORM,
+			m.breakers,
+			m.bridgeCache,
 			m.evmORM,
 			m.jobORM,
 			m.sessionsORM,
@@ -124,6 +142,9 @@ func setupFramework(t *testing.T) *gqlTestFramework {
 			m.ethClient,
 			m.eIMgr,
 			m.balM,
+			m.auditLogger,
+			m.bptxmORM,
+			m.txm,
 		)
 	})
 
@@ -149,19 +170,33 @@ func (f *gqlTestFramework) Timestamp() time.Time {
 	return time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
 }
 
-// injectAuthenticatedUser injects a session into the request context
-func (f *gqlTestFramework) injectAuthenticatedUser() {
+// injectAuthenticatedUser injects a session for a user with the role into the
+// request context. Users are admins unless another role is given.
+func (f *gqlTestFramework) injectAuthenticatedUser(role clsessions.UserRole) {
 	f.t.Helper()
 
-	user := clsessions.User{Email: "gqltester@chain.link"}
+	if role == "" {
+		role = clsessions.UserRoleAdmin
+	}
+	user := clsessions.User{Email: "gqltester@chain.link", Role: role}
 
 	f.Ctx = auth.SetGQLAuthenticatedSession(f.Ctx, user, "gqltesterSession")
 }
 
+// expectAuditRecord expects an entry to be recorded in the audit log for an
+// action taken by the authenticated test user.
+func (f *gqlTestFramework) expectAuditRecord(action audit.Action, target string) {
+	f.t.Helper()
+
+	actor := audit.Actor{Name: "gqltester@chain.link", AuthMethod: audit.AuthMethodSession}
+	f.Mocks.auditLogger.On("Record", actor, action, target, mock.Anything).Once()
+}
+
 // GQLTestCase represents a single GQL request test.
 type GQLTestCase struct {
 	name          string
 	authenticated bool
+	role          clsessions.UserRole
 	before        func(*gqlTestFramework)
 	query         string
 	variables     map[string]interface{}
@@ -183,7 +218,7 @@ func RunGQLTests(t *testing.T, testCases []GQLTestCase) {
 			)
 
 			if tc.authenticated {
-				f.injectAuthenticatedUser()
+				f.injectAuthenticatedUser(tc.role)
 			}
 
 			if tc.before != nil {
@@ -228,3 +263,30 @@ func unauthorizedTestCase(tc GQLTestCase, paths ...interface{}) GQLTestCase {
 
 	return tc
 }
+
+// forbiddenTestCase generates a forbidden test case from another test case,
+// authenticating as a user with the role, which must be below the required
+// role.
+//
+// The paths will be the query/mutation definition name
+func forbiddenTestCase(tc GQLTestCase, role, required clsessions.UserRole, paths ...interface{}) GQLTestCase {
+	err := forbiddenError{role: required}
+
+	tc.name = "forbidden for " + string(role)
+	tc.authenticated = true
+	tc.role = role
+	tc.before = nil
+	tc.result = "null"
+	tc.errors = []*gqlerrors.QueryError{
+		{
+			ResolverError: err,
+			Path:          paths,
+			Message:       err.Error(),
+			Extensions: map[string]interface{}{
+				"code": "FORBIDDEN",
+			},
+		},
+	}
+
+	return tc
+}
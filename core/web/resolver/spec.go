@@ -1,6 +1,8 @@
 package resolver
 
 import (
+	"sort"
+
 	"github.com/graph-gophers/graphql-go"
 
 	"github.com/smartcontractkit/chainlink/core/services/job"
@@ -14,6 +16,14 @@ func NewSpec(j job.Job) *SpecResolver {
 	return &SpecResolver{j: j}
 }
 
+func (r *SpecResolver) ToBlockTriggerSpec() (*BlockTriggerSpecResolver, bool) {
+	if r.j.Type != job.BlockTrigger {
+		return nil, false
+	}
+
+	return &BlockTriggerSpecResolver{spec: *r.j.BlockTriggerSpec}, true
+}
+
 func (r *SpecResolver) ToCronSpec() (*CronSpecResolver, bool) {
 	if r.j.Type != job.Cron {
 		return nil, false
@@ -30,6 +40,14 @@ func (r *SpecResolver) ToDirectRequestSpec() (*DirectRequestSpecResolver, bool)
 	return &DirectRequestSpecResolver{spec: *r.j.DirectRequestSpec}, true
 }
 
+func (r *SpecResolver) ToEventTriggerSpec() (*EventTriggerSpecResolver, bool) {
+	if r.j.Type != job.EventTrigger {
+		return nil, false
+	}
+
+	return &EventTriggerSpecResolver{spec: *r.j.EventTriggerSpec}, true
+}
+
 func (r *SpecResolver) ToFluxMonitorSpec() (*FluxMonitorSpecResolver, bool) {
 	if r.j.Type != job.FluxMonitor {
 		return nil, false
@@ -78,6 +96,41 @@ func (r *SpecResolver) ToWebhookSpec() (*WebhookSpecResolver, bool) {
 	return &WebhookSpecResolver{spec: *r.j.WebhookSpec}, true
 }
 
+type BlockTriggerSpecResolver struct {
+	spec job.BlockTriggerSpec
+}
+
+// BlockInterval resolves the spec's block interval.
+func (r *BlockTriggerSpecResolver) BlockInterval() int32 {
+	return int32(r.spec.BlockInterval)
+}
+
+// Confirmations resolves the spec's confirmations.
+func (r *BlockTriggerSpecResolver) Confirmations() int32 {
+	return int32(r.spec.Confirmations)
+}
+
+// CreatedAt resolves the spec's created at timestamp.
+func (r *BlockTriggerSpecResolver) CreatedAt() graphql.Time {
+	return graphql.Time{Time: r.spec.CreatedAt}
+}
+
+// EVMChainID resolves the spec's evm chain id.
+func (r *BlockTriggerSpecResolver) EVMChainID() *string {
+	if r.spec.EVMChainID == nil {
+		return nil
+	}
+
+	chainID := r.spec.EVMChainID.String()
+
+	return &chainID
+}
+
+// RunMissedBlocks resolves whether the spec runs missed blocks.
+func (r *BlockTriggerSpecResolver) RunMissedBlocks() bool {
+	return r.spec.RunMissedBlocks
+}
+
 type CronSpecResolver struct {
 	spec job.CronSpec
 }
@@ -86,6 +139,26 @@ func (r *CronSpecResolver) Schedule() string {
 	return r.spec.CronSchedule
 }
 
+// CatchUpWindow resolves the spec's catch up window.
+func (r *CronSpecResolver) CatchUpWindow() string {
+	return r.spec.CatchUpWindow.Duration().String()
+}
+
+// ConcurrencyPolicy resolves the spec's concurrency policy.
+func (r *CronSpecResolver) ConcurrencyPolicy() string {
+	return string(r.spec.ConcurrencyPolicy)
+}
+
+// Jitter resolves the spec's jitter.
+func (r *CronSpecResolver) Jitter() string {
+	return r.spec.Jitter.Duration().String()
+}
+
+// Timezone resolves the spec's timezone.
+func (r *CronSpecResolver) Timezone() string {
+	return r.spec.Timezone
+}
+
 // CreatedAt resolves the spec's created at timestamp.
 func (r *CronSpecResolver) CreatedAt() graphql.Time {
 	return graphql.Time{Time: r.spec.CreatedAt}
@@ -146,6 +219,79 @@ func (r *DirectRequestSpecResolver) Requesters() *[]string {
 	return &requesters
 }
 
+type EventTriggerSpecResolver struct {
+	spec job.EventTriggerSpec
+}
+
+// ContractAddress resolves the spec's contract address.
+func (r *EventTriggerSpecResolver) ContractAddress() string {
+	return r.spec.ContractAddress.String()
+}
+
+// CreatedAt resolves the spec's created at timestamp.
+func (r *EventTriggerSpecResolver) CreatedAt() graphql.Time {
+	return graphql.Time{Time: r.spec.CreatedAt}
+}
+
+// EventABI resolves the spec's event signature.
+func (r *EventTriggerSpecResolver) EventABI() string {
+	return r.spec.EventABI
+}
+
+// EVMChainID resolves the spec's evm chain id.
+func (r *EventTriggerSpecResolver) EVMChainID() *string {
+	if r.spec.EVMChainID == nil {
+		return nil
+	}
+
+	chainID := r.spec.EVMChainID.String()
+
+	return &chainID
+}
+
+// IndexedFilters resolves the spec's filters on indexed event arguments,
+// sorted by argument name.
+func (r *EventTriggerSpecResolver) IndexedFilters() []*EventTriggerFilterResolver {
+	names := make([]string, 0, len(r.spec.IndexedFilters))
+	for name := range r.spec.IndexedFilters {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	filters := make([]*EventTriggerFilterResolver, 0, len(names))
+	for _, name := range names {
+		filters = append(filters, &EventTriggerFilterResolver{name: name, values: r.spec.IndexedFilters[name]})
+	}
+
+	return filters
+}
+
+// MinIncomingConfirmations resolves the spec's min incoming confirmations.
+func (r *EventTriggerSpecResolver) MinIncomingConfirmations() *int32 {
+	if r.spec.MinIncomingConfirmations.Valid {
+		confs := int32(r.spec.MinIncomingConfirmations.Uint32)
+
+		return &confs
+	}
+
+	return nil
+}
+
+type EventTriggerFilterResolver struct {
+	name   string
+	values []string
+}
+
+// Name resolves the filtered argument's name.
+func (r *EventTriggerFilterResolver) Name() string {
+	return r.name
+}
+
+// Values resolves the values accepted for the argument.
+func (r *EventTriggerFilterResolver) Values() []string {
+	return r.values
+}
+
 type FluxMonitorSpecResolver struct {
 	spec job.FluxMonitorSpec
 }
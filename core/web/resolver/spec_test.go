@@ -21,6 +21,66 @@ import (
 // Specs are only embedded on the job and are not fetchable by it's own id, so
 // we test the spec resolvers by fetching a job by id.
 
+func TestResolver_BlockTriggerSpec(t *testing.T) {
+	var (
+		id = int32(1)
+	)
+
+	testCases := []GQLTestCase{
+		{
+			name:          "block trigger spec success",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.App.On("JobORM").Return(f.Mocks.jobORM)
+				f.Mocks.jobORM.On("FindJobTx", id).Return(job.Job{
+					Type: job.BlockTrigger,
+					BlockTriggerSpec: &job.BlockTriggerSpec{
+						BlockInterval:   10,
+						Confirmations:   3,
+						RunMissedBlocks: true,
+						CreatedAt:       f.Timestamp(),
+						EVMChainID:      utils.NewBigI(42),
+					},
+				}, nil)
+			},
+			query: `
+				query GetJob {
+					job(id: "1") {
+						... on Job {
+							spec {
+								__typename
+								... on BlockTriggerSpec {
+									blockInterval
+									confirmations
+									createdAt
+									evmChainID
+									runMissedBlocks
+								}
+							}
+						}
+					}
+				}
+			`,
+			result: `
+				{
+					"job": {
+						"spec": {
+							"__typename": "BlockTriggerSpec",
+							"blockInterval": 10,
+							"confirmations": 3,
+							"createdAt": "2021-01-01T00:00:00Z",
+							"evmChainID": "42",
+							"runMissedBlocks": true
+						}
+					}
+				}
+			`,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
+
 func TestResolver_CronSpec(t *testing.T) {
 	var (
 		id = int32(1)
@@ -35,8 +95,11 @@ func TestResolver_CronSpec(t *testing.T) {
 				f.Mocks.jobORM.On("FindJobTx", id).Return(job.Job{
 					Type: job.Cron,
 					CronSpec: &job.CronSpec{
-						CronSchedule: "CRON_TZ=UTC 0 0 1 1 *",
-						CreatedAt:    f.Timestamp(),
+						CronSchedule:      "CRON_TZ=UTC 0 0 1 1 *",
+						ConcurrencyPolicy: job.CronConcurrencyReplace,
+						CatchUpWindow:     models.Interval(time.Hour),
+						Jitter:            models.Interval(10 * time.Second),
+						CreatedAt:         f.Timestamp(),
 					},
 				}, nil)
 			},
@@ -48,6 +111,10 @@ func TestResolver_CronSpec(t *testing.T) {
 								__typename
 								... on CronSpec {
 									schedule
+									catchUpWindow
+									concurrencyPolicy
+									jitter
+									timezone
 									createdAt
 								}
 							}
@@ -61,6 +128,10 @@ func TestResolver_CronSpec(t *testing.T) {
 						"spec": {
 							"__typename": "CronSpec",
 							"schedule": "CRON_TZ=UTC 0 0 1 1 *",
+							"catchUpWindow": "1h0m0s",
+							"concurrencyPolicy": "replace",
+							"jitter": "10s",
+							"timezone": "",
 							"createdAt": "2021-01-01T00:00:00Z"
 						}
 					}
@@ -141,6 +212,80 @@ func TestResolver_DirectRequestSpec(t *testing.T) {
 	RunGQLTests(t, testCases)
 }
 
+func TestResolver_EventTriggerSpec(t *testing.T) {
+	var (
+		id = int32(1)
+	)
+	contractAddress, err := ethkey.NewEIP55Address("0x613a38AC1659769640aaE063C651F48E0250454C")
+	require.NoError(t, err)
+
+	testCases := []GQLTestCase{
+		{
+			name:          "event trigger spec success",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.App.On("JobORM").Return(f.Mocks.jobORM)
+				f.Mocks.jobORM.On("FindJobTx", id).Return(job.Job{
+					Type: job.EventTrigger,
+					EventTriggerSpec: &job.EventTriggerSpec{
+						ContractAddress: contractAddress,
+						EventABI:        "Transfer(address indexed from, address indexed to, uint256 value)",
+						IndexedFilters: job.EventTriggerFilters{
+							"to":   {"0x3cCad4715152693fE3BC4460591e3D3Fbd071b42"},
+							"from": {"0x0000000000000000000000000000000000000000"},
+						},
+						CreatedAt:                f.Timestamp(),
+						EVMChainID:               utils.NewBigI(42),
+						MinIncomingConfirmations: clnull.NewUint32(3, true),
+					},
+				}, nil)
+			},
+			query: `
+				query GetJob {
+					job(id: "1") {
+						... on Job {
+							spec {
+								__typename
+								... on EventTriggerSpec {
+									contractAddress
+									createdAt
+									eventABI
+									evmChainID
+									indexedFilters {
+										name
+										values
+									}
+									minIncomingConfirmations
+								}
+							}
+						}
+					}
+				}
+			`,
+			result: `
+				{
+					"job": {
+						"spec": {
+							"__typename": "EventTriggerSpec",
+							"contractAddress": "0x613a38AC1659769640aaE063C651F48E0250454C",
+							"createdAt": "2021-01-01T00:00:00Z",
+							"eventABI": "Transfer(address indexed from, address indexed to, uint256 value)",
+							"evmChainID": "42",
+							"indexedFilters": [
+								{"name": "from", "values": ["0x0000000000000000000000000000000000000000"]},
+								{"name": "to", "values": ["0x3cCad4715152693fE3BC4460591e3D3Fbd071b42"]}
+							],
+							"minIncomingConfirmations": 3
+						}
+					}
+				}
+			`,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
+
 func TestResolver_FluxMonitorSpec(t *testing.T) {
 	var (
 		id = int32(1)
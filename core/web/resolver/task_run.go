@@ -60,3 +60,21 @@ func (r *TaskRunResolver) FinishedAt() *graphql.Time {
 func (r *TaskRunResolver) DotID() string {
 	return r.tr.GetDotID()
 }
+
+func (r *TaskRunResolver) Skipped() bool {
+	return r.tr.Skipped
+}
+
+// Meta resolves the task run's meta as a JSON string, e.g. whether a bridge
+// response came from the cache.
+func (r *TaskRunResolver) Meta() *string {
+	if !r.tr.Meta.Valid {
+		return nil
+	}
+	val, err := r.tr.Meta.MarshalJSON()
+	if err != nil {
+		return nil
+	}
+	meta := string(val)
+	return &meta
+}
@@ -1,6 +1,8 @@
 package resolver
 
 import (
+	"strings"
+
 	"github.com/graph-gophers/graphql-go"
 
 	"github.com/smartcontractkit/chainlink/core/sessions"
@@ -18,6 +20,26 @@ func (e failedPasswordUpdateError) Error() string {
 	return "failed to update current user password"
 }
 
+// UserRole is the GQL enum of sessions.UserRole
+type UserRole string
+
+const (
+	UserRoleAdmin UserRole = "ADMIN"
+	UserRoleEdit  UserRole = "EDIT"
+	UserRoleRun   UserRole = "RUN"
+	UserRoleView  UserRole = "VIEW"
+)
+
+// ToUserRole converts a sessions.UserRole to the GQL enum
+func ToUserRole(role sessions.UserRole) UserRole {
+	return UserRole(strings.ToUpper(string(role)))
+}
+
+// FromUserRole converts the GQL enum to a sessions.UserRole
+func FromUserRole(role UserRole) (sessions.UserRole, error) {
+	return sessions.GetUserRole(string(role))
+}
+
 // UserResolver resolves the User type
 type UserResolver struct {
 	user *sessions.User
@@ -32,11 +54,38 @@ func (r *UserResolver) Email() string {
 	return r.user.Email
 }
 
+// Role resolves the user's role
+func (r *UserResolver) Role() UserRole {
+	return ToUserRole(r.user.Role)
+}
+
 // CreatedAt resolves the user's creation date
 func (r *UserResolver) CreatedAt() graphql.Time {
 	return graphql.Time{Time: r.user.CreatedAt}
 }
 
+func NewUsers(users []sessions.User) []*UserResolver {
+	var resolvers []*UserResolver
+	for i := range users {
+		resolvers = append(resolvers, NewUser(&users[i]))
+	}
+
+	return resolvers
+}
+
+// UsersPayloadResolver resolves a list of users
+type UsersPayloadResolver struct {
+	users []sessions.User
+}
+
+func NewUsersPayload(users []sessions.User) *UsersPayloadResolver {
+	return &UsersPayloadResolver{users: users}
+}
+
+func (r *UsersPayloadResolver) Results() []*UserResolver {
+	return NewUsers(r.users)
+}
+
 // -- UpdatePassword Mutation --
 
 type UpdatePasswordInput struct {
@@ -88,3 +137,125 @@ func NewUpdatePasswordSuccess(user *sessions.User) *UpdatePasswordSuccessResolve
 func (r *UpdatePasswordSuccessResolver) User() *UserResolver {
 	return NewUser(r.user)
 }
+
+func newInputErrorsResolver(inputErrs map[string]string) (*InputErrorsResolver, bool) {
+	if inputErrs == nil {
+		return nil, false
+	}
+
+	var errs []*InputErrorResolver
+	for path, message := range inputErrs {
+		errs = append(errs, NewInputError(path, message))
+	}
+
+	return NewInputErrors(errs), true
+}
+
+// -- CreateUser Mutation --
+
+type CreateUserInput struct {
+	Email    string
+	Password string
+	Role     UserRole
+}
+
+type CreateUserPayloadResolver struct {
+	user      *sessions.User
+	inputErrs map[string]string
+}
+
+func NewCreateUserPayload(user *sessions.User, inputErrs map[string]string) *CreateUserPayloadResolver {
+	return &CreateUserPayloadResolver{user: user, inputErrs: inputErrs}
+}
+
+func (r *CreateUserPayloadResolver) ToCreateUserSuccess() (*CreateUserSuccessResolver, bool) {
+	if r.user == nil {
+		return nil, false
+	}
+
+	return &CreateUserSuccessResolver{user: r.user}, true
+}
+
+func (r *CreateUserPayloadResolver) ToInputErrors() (*InputErrorsResolver, bool) {
+	return newInputErrorsResolver(r.inputErrs)
+}
+
+type CreateUserSuccessResolver struct {
+	user *sessions.User
+}
+
+func (r *CreateUserSuccessResolver) User() *UserResolver {
+	return NewUser(r.user)
+}
+
+// -- UpdateUserRole Mutation --
+
+type UpdateUserRoleInput struct {
+	Role UserRole
+}
+
+type UpdateUserRolePayloadResolver struct {
+	user      *sessions.User
+	inputErrs map[string]string
+	NotFoundErrorUnionType
+}
+
+func NewUpdateUserRolePayload(user *sessions.User, inputErrs map[string]string, err error) *UpdateUserRolePayloadResolver {
+	e := NotFoundErrorUnionType{err: err, message: "user not found"}
+
+	return &UpdateUserRolePayloadResolver{user: user, inputErrs: inputErrs, NotFoundErrorUnionType: e}
+}
+
+func (r *UpdateUserRolePayloadResolver) ToUpdateUserRoleSuccess() (*UpdateUserRoleSuccessResolver, bool) {
+	if r.user == nil {
+		return nil, false
+	}
+
+	return &UpdateUserRoleSuccessResolver{user: r.user}, true
+}
+
+func (r *UpdateUserRolePayloadResolver) ToInputErrors() (*InputErrorsResolver, bool) {
+	return newInputErrorsResolver(r.inputErrs)
+}
+
+type UpdateUserRoleSuccessResolver struct {
+	user *sessions.User
+}
+
+func (r *UpdateUserRoleSuccessResolver) User() *UserResolver {
+	return NewUser(r.user)
+}
+
+// -- DeleteUser Mutation --
+
+type DeleteUserPayloadResolver struct {
+	user      *sessions.User
+	inputErrs map[string]string
+	NotFoundErrorUnionType
+}
+
+func NewDeleteUserPayload(user *sessions.User, inputErrs map[string]string, err error) *DeleteUserPayloadResolver {
+	e := NotFoundErrorUnionType{err: err, message: "user not found"}
+
+	return &DeleteUserPayloadResolver{user: user, inputErrs: inputErrs, NotFoundErrorUnionType: e}
+}
+
+func (r *DeleteUserPayloadResolver) ToDeleteUserSuccess() (*DeleteUserSuccessResolver, bool) {
+	if r.user == nil {
+		return nil, false
+	}
+
+	return &DeleteUserSuccessResolver{user: r.user}, true
+}
+
+func (r *DeleteUserPayloadResolver) ToInputErrors() (*InputErrorsResolver, bool) {
+	return newInputErrorsResolver(r.inputErrs)
+}
+
+type DeleteUserSuccessResolver struct {
+	user *sessions.User
+}
+
+func (r *DeleteUserSuccessResolver) User() *UserResolver {
+	return NewUser(r.user)
+}
@@ -1,11 +1,15 @@
 package resolver
 
 import (
+	"database/sql"
 	"testing"
 
 	gqlerrors "github.com/graph-gophers/graphql-go/errors"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
+	"github.com/smartcontractkit/chainlink/core/audit"
+	"github.com/smartcontractkit/chainlink/core/sessions"
 	"github.com/smartcontractkit/chainlink/core/utils"
 	"github.com/smartcontractkit/chainlink/core/web/auth"
 )
@@ -53,10 +57,11 @@ func TestResolver_UpdateUserPassword(t *testing.T) {
 
 				session.User.HashedPassword = pwd
 
-				f.Mocks.sessionsORM.On("FindUser").Return(*session.User, nil)
+				f.Mocks.sessionsORM.On("FindUser", session.User.Email).Return(*session.User, nil)
 				f.Mocks.sessionsORM.On("SetPassword", session.User, "new").Return(nil)
 				f.Mocks.sessionsORM.On("ClearNonCurrentSessions", session.SessionID).Return(nil)
 				f.App.On("SessionORM").Return(f.Mocks.sessionsORM)
+				f.expectAuditRecord(audit.ActionUserPasswordUpdated, session.User.Email)
 			},
 			query:     mutation,
 			variables: variables,
@@ -79,7 +84,7 @@ func TestResolver_UpdateUserPassword(t *testing.T) {
 
 				session.User.HashedPassword = "random-string"
 
-				f.Mocks.sessionsORM.On("FindUser").Return(*session.User, nil)
+				f.Mocks.sessionsORM.On("FindUser", session.User.Email).Return(*session.User, nil)
 				f.App.On("SessionORM").Return(f.Mocks.sessionsORM)
 			},
 			query:     mutation,
@@ -108,7 +113,7 @@ func TestResolver_UpdateUserPassword(t *testing.T) {
 
 				session.User.HashedPassword = pwd
 
-				f.Mocks.sessionsORM.On("FindUser").Return(*session.User, nil)
+				f.Mocks.sessionsORM.On("FindUser", session.User.Email).Return(*session.User, nil)
 				f.Mocks.sessionsORM.On("ClearNonCurrentSessions", session.SessionID).Return(
 					clearSessionsError{},
 				)
@@ -139,7 +144,7 @@ func TestResolver_UpdateUserPassword(t *testing.T) {
 
 				session.User.HashedPassword = pwd
 
-				f.Mocks.sessionsORM.On("FindUser").Return(*session.User, nil)
+				f.Mocks.sessionsORM.On("FindUser", session.User.Email).Return(*session.User, nil)
 				f.Mocks.sessionsORM.On("ClearNonCurrentSessions", session.SessionID).Return(nil)
 				f.Mocks.sessionsORM.On("SetPassword", session.User, "new").Return(failedPasswordUpdateError{})
 				f.App.On("SessionORM").Return(f.Mocks.sessionsORM)
@@ -160,3 +165,319 @@ func TestResolver_UpdateUserPassword(t *testing.T) {
 
 	RunGQLTests(t, testCases)
 }
+
+func TestResolver_Users(t *testing.T) {
+	t.Parallel()
+
+	query := `
+		query GetUsers {
+			users {
+				results {
+					email
+					role
+				}
+			}
+		}`
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: query}, "users"),
+		forbiddenTestCase(GQLTestCase{query: query}, sessions.UserRoleEdit, sessions.UserRoleAdmin, "users"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.Mocks.sessionsORM.On("ListUsers").Return([]sessions.User{
+					{Email: "admin@chain.link", Role: sessions.UserRoleAdmin},
+					{Email: "oncall@chain.link", Role: sessions.UserRoleView},
+				}, nil)
+				f.App.On("SessionORM").Return(f.Mocks.sessionsORM)
+			},
+			query: query,
+			result: `
+				{
+					"users": {
+						"results": [
+							{"email": "admin@chain.link", "role": "ADMIN"},
+							{"email": "oncall@chain.link", "role": "VIEW"}
+						]
+					}
+				}`,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
+
+func TestResolver_CreateUser(t *testing.T) {
+	t.Parallel()
+
+	mutation := `
+		mutation CreateUser($input: CreateUserInput!) {
+			createUser(input: $input) {
+				... on CreateUserSuccess {
+					user {
+						email
+						role
+					}
+				}
+				... on InputErrors {
+					errors {
+						path
+						message
+						code
+					}
+				}
+			}
+		}`
+	variables := map[string]interface{}{
+		"input": map[string]interface{}{
+			"email":    "oncall@chain.link",
+			"password": "password123",
+			"role":     "VIEW",
+		},
+	}
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: mutation, variables: variables}, "createUser"),
+		forbiddenTestCase(GQLTestCase{query: mutation, variables: variables}, sessions.UserRoleEdit, sessions.UserRoleAdmin, "createUser"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.Mocks.sessionsORM.On("FindUser", "oncall@chain.link").Return(sessions.User{}, sql.ErrNoRows)
+				f.Mocks.sessionsORM.On("CreateUser", mock.MatchedBy(func(u *sessions.User) bool {
+					return u.Email == "oncall@chain.link" && u.Role == sessions.UserRoleView && utils.CheckPasswordHash("password123", u.HashedPassword)
+				})).Return(nil)
+				f.App.On("SessionORM").Return(f.Mocks.sessionsORM)
+				f.expectAuditRecord(audit.ActionUserCreated, "oncall@chain.link")
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+				{
+					"createUser": {
+						"user": {
+							"email": "oncall@chain.link",
+							"role": "VIEW"
+						}
+					}
+				}`,
+		},
+		{
+			name:          "user already exists",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.Mocks.sessionsORM.On("FindUser", "oncall@chain.link").Return(sessions.User{Email: "oncall@chain.link"}, nil)
+				f.App.On("SessionORM").Return(f.Mocks.sessionsORM)
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+				{
+					"createUser": {
+						"errors": [{
+							"path": "email",
+							"message": "user already exists",
+							"code": "INVALID_INPUT"
+						}]
+					}
+				}`,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
+
+func TestResolver_UpdateUserRole(t *testing.T) {
+	t.Parallel()
+
+	mutation := `
+		mutation UpdateUserRole($email: String!, $input: UpdateUserRoleInput!) {
+			updateUserRole(email: $email, input: $input) {
+				... on UpdateUserRoleSuccess {
+					user {
+						email
+						role
+					}
+				}
+				... on InputErrors {
+					errors {
+						path
+						message
+						code
+					}
+				}
+				... on NotFoundError {
+					message
+					code
+				}
+			}
+		}`
+	variables := map[string]interface{}{
+		"email": "oncall@chain.link",
+		"input": map[string]interface{}{
+			"role": "RUN",
+		},
+	}
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: mutation, variables: variables}, "updateUserRole"),
+		forbiddenTestCase(GQLTestCase{query: mutation, variables: variables}, sessions.UserRoleEdit, sessions.UserRoleAdmin, "updateUserRole"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.Mocks.sessionsORM.On("FindUser", "oncall@chain.link").Return(sessions.User{
+					Email: "oncall@chain.link",
+					Role:  sessions.UserRoleView,
+				}, nil)
+				f.Mocks.sessionsORM.On("UpdateRole", "oncall@chain.link", sessions.UserRoleRun).Return(sessions.User{
+					Email: "oncall@chain.link",
+					Role:  sessions.UserRoleRun,
+				}, nil)
+				f.App.On("SessionORM").Return(f.Mocks.sessionsORM)
+				f.expectAuditRecord(audit.ActionUserRoleUpdated, "oncall@chain.link")
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+				{
+					"updateUserRole": {
+						"user": {
+							"email": "oncall@chain.link",
+							"role": "RUN"
+						}
+					}
+				}`,
+		},
+		{
+			name:          "not found",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.Mocks.sessionsORM.On("FindUser", "oncall@chain.link").Return(sessions.User{}, sql.ErrNoRows)
+				f.App.On("SessionORM").Return(f.Mocks.sessionsORM)
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+				{
+					"updateUserRole": {
+						"message": "user not found",
+						"code": "NOT_FOUND"
+					}
+				}`,
+		},
+		{
+			name:          "cannot change own role",
+			authenticated: true,
+			query:         mutation,
+			variables: map[string]interface{}{
+				"email": "gqltester@chain.link",
+				"input": map[string]interface{}{
+					"role": "VIEW",
+				},
+			},
+			result: `
+				{
+					"updateUserRole": {
+						"errors": [{
+							"path": "email",
+							"message": "you cannot change your own role",
+							"code": "INVALID_INPUT"
+						}]
+					}
+				}`,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
+
+func TestResolver_DeleteUser(t *testing.T) {
+	t.Parallel()
+
+	mutation := `
+		mutation DeleteUser($email: String!) {
+			deleteUser(email: $email) {
+				... on DeleteUserSuccess {
+					user {
+						email
+					}
+				}
+				... on InputErrors {
+					errors {
+						path
+						message
+						code
+					}
+				}
+				... on NotFoundError {
+					message
+					code
+				}
+			}
+		}`
+	variables := map[string]interface{}{
+		"email": "oncall@chain.link",
+	}
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: mutation, variables: variables}, "deleteUser"),
+		forbiddenTestCase(GQLTestCase{query: mutation, variables: variables}, sessions.UserRoleRun, sessions.UserRoleAdmin, "deleteUser"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.Mocks.sessionsORM.On("FindUser", "oncall@chain.link").Return(sessions.User{Email: "oncall@chain.link"}, nil)
+				f.Mocks.sessionsORM.On("DeleteUser", "oncall@chain.link").Return(nil)
+				f.expectAuditRecord(audit.ActionUserDeleted, "oncall@chain.link")
+				f.App.On("SessionORM").Return(f.Mocks.sessionsORM)
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+				{
+					"deleteUser": {
+						"user": {
+							"email": "oncall@chain.link"
+						}
+					}
+				}`,
+		},
+		{
+			name:          "not found",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.Mocks.sessionsORM.On("FindUser", "oncall@chain.link").Return(sessions.User{}, sql.ErrNoRows)
+				f.App.On("SessionORM").Return(f.Mocks.sessionsORM)
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+				{
+					"deleteUser": {
+						"message": "user not found",
+						"code": "NOT_FOUND"
+					}
+				}`,
+		},
+		{
+			name:          "cannot delete yourself",
+			authenticated: true,
+			query:         mutation,
+			variables:     map[string]interface{}{"email": "GQLTester@chain.link"},
+			result: `
+				{
+					"deleteUser": {
+						"errors": [{
+							"path": "email",
+							"message": "you cannot delete yourself",
+							"code": "INVALID_INPUT"
+						}]
+					}
+				}`,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
@@ -9,8 +9,10 @@ import (
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 
+	"github.com/smartcontractkit/chainlink/core/audit"
 	"github.com/smartcontractkit/chainlink/core/services/keystore"
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/vrfkey"
+	clsessions "github.com/smartcontractkit/chainlink/core/sessions"
 )
 
 func TestResolver_GetVRFKey(t *testing.T) {
@@ -194,11 +196,13 @@ func TestResolver_CreateVRFKey(t *testing.T) {
 
 	testCases := []GQLTestCase{
 		unauthorizedTestCase(GQLTestCase{query: mutation}, "createVRFKey"),
+		forbiddenTestCase(GQLTestCase{query: mutation}, clsessions.UserRoleEdit, clsessions.UserRoleAdmin, "createVRFKey"),
 		{
 			name:          "success",
 			authenticated: true,
 			before: func(f *gqlTestFramework) {
 				f.Mocks.vrf.On("Create").Return(fakeKey, nil)
+				f.expectAuditRecord(audit.ActionKeyCreated, "vrf:"+fakeKey.ID())
 				f.Mocks.keystore.On("VRF").Return(f.Mocks.vrf)
 				f.App.On("GetKeyStore").Return(f.Mocks.keystore)
 			},
@@ -257,11 +261,13 @@ func TestResolver_DeleteVRFKey(t *testing.T) {
 
 	testCases := []GQLTestCase{
 		unauthorizedTestCase(GQLTestCase{query: mutation, variables: variables}, "deleteVRFKey"),
+		forbiddenTestCase(GQLTestCase{query: mutation, variables: variables}, clsessions.UserRoleEdit, clsessions.UserRoleAdmin, "deleteVRFKey"),
 		{
 			name:          "success",
 			authenticated: true,
 			before: func(f *gqlTestFramework) {
 				f.Mocks.vrf.On("Delete", fakeKey.PublicKey.String()).Return(fakeKey, nil)
+				f.expectAuditRecord(audit.ActionKeyDeleted, "vrf:"+fakeKey.ID())
 				f.Mocks.keystore.On("VRF").Return(f.Mocks.vrf)
 				f.App.On("GetKeyStore").Return(f.Mocks.keystore)
 			},
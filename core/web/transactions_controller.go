@@ -3,7 +3,11 @@ package web
 import (
 	"database/sql"
 	"net/http"
+	"strconv"
+	"strings"
 
+	"github.com/smartcontractkit/chainlink/core/audit"
+	"github.com/smartcontractkit/chainlink/core/services/bulletprooftxmanager"
 	"github.com/smartcontractkit/chainlink/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/core/web/presenters"
 
@@ -46,3 +50,62 @@ func (tc *TransactionsController) Show(c *gin.Context) {
 
 	jsonAPIResponse(c, presenters.NewEthTxResourceFromAttempt(*ethTxAttempt), "transaction")
 }
+
+// Cancel aborts an Ethereum transaction, given either its ID or the hash of
+// one of its attempts. Unstarted transactions are never sent, in-flight
+// transactions are replaced by a zero-value transfer to the sending address.
+// Example:
+//  "<application>/transactions/:ID/cancel"
+func (tc *TransactionsController) Cancel(c *gin.Context) {
+	etx, err := tc.findEthTx(c.Param("ID"))
+	if errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("Transaction not found"))
+		return
+	}
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	chain, err := tc.App.GetChainSet().Get(etx.EVMChainID.ToInt())
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	cancelled, err := chain.TxManager().CancelEthTransaction(etx.ID)
+	if errors.Is(err, bulletprooftxmanager.ErrEthTxNotReplaceable) {
+		jsonAPIError(c, http.StatusConflict, err)
+		return
+	}
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	recordAudit(tc.App, c, audit.ActionTxsCancelled, strconv.FormatInt(etx.ID, 10), audit.NewDiff(etx.AuditFields(), cancelled.AuditFields()))
+
+	if len(cancelled.EthTxAttempts) > 0 {
+		attempt := cancelled.EthTxAttempts[0]
+		attempt.EthTx = cancelled
+		jsonAPIResponse(c, presenters.NewEthTxResourceFromAttempt(attempt), "transaction")
+		return
+	}
+	jsonAPIResponse(c, presenters.NewEthTxResource(cancelled), "transaction")
+}
+
+// findEthTx finds an eth_tx by its ID, or by the hash of one of its attempts
+func (tc *TransactionsController) findEthTx(idOrHash string) (bulletprooftxmanager.EthTx, error) {
+	if strings.HasPrefix(idOrHash, "0x") {
+		attempt, err := tc.App.BPTXMORM().FindEthTxAttempt(common.HexToHash(idOrHash))
+		if err != nil {
+			return bulletprooftxmanager.EthTx{}, err
+		}
+		return attempt.EthTx, nil
+	}
+
+	id, err := strconv.ParseInt(idOrHash, 10, 64)
+	if err != nil {
+		return bulletprooftxmanager.EthTx{}, errors.Errorf("invalid transaction ID or hash %q", idOrHash)
+	}
+	return tc.App.BPTXMORM().FindEthTxWithAttempts(id)
+}
@@ -124,3 +124,44 @@ func TestTransactionsController_Show_NotFound(t *testing.T) {
 	t.Cleanup(cleanup)
 	cltest.AssertServerResponse(t, resp, http.StatusNotFound)
 }
+
+func TestTransactionsController_Cancel(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationWithKey(t)
+	require.NoError(t, app.Start())
+
+	borm := app.BPTXMORM()
+	client := app.NewHTTPClient()
+	_, from := cltest.MustInsertRandomKey(t, app.KeyStore.Eth(), 0)
+
+	t.Run("cancels unstarted transactions by ID", func(t *testing.T) {
+		etx := cltest.MustInsertUnstartedEthTx(t, borm, from)
+
+		resp, cleanup := client.Post(fmt.Sprintf("/v2/transactions/%d/cancel", etx.ID), nil)
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+		ptx := presenters.EthTxResource{}
+		require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &ptx))
+		assert.Equal(t, string(bulletprooftxmanager.EthTxFatalError), ptx.State)
+
+		cancelled, err := borm.FindEthTxWithAttempts(etx.ID)
+		require.NoError(t, err)
+		assert.Equal(t, bulletprooftxmanager.ErrEthTxCancelled.Error(), cancelled.Error.String)
+	})
+
+	t.Run("refuses to cancel finished transactions by hash", func(t *testing.T) {
+		etx := cltest.MustInsertConfirmedEthTxWithLegacyAttempt(t, borm, 2, 1, from)
+
+		resp, cleanup := client.Post("/v2/transactions/"+etx.EthTxAttempts[0].Hash.Hex()+"/cancel", nil)
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusConflict)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		resp, cleanup := client.Post("/v2/transactions/4242/cancel", nil)
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusNotFound)
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"net/http"
 
+	"github.com/smartcontractkit/chainlink/core/audit"
 	"github.com/smartcontractkit/chainlink/core/services/bulletprooftxmanager"
 	"github.com/smartcontractkit/chainlink/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/core/services/pg"
@@ -47,6 +48,12 @@ func (tc *TransfersController) Create(c *gin.Context) {
 		jsonAPIError(c, http.StatusBadRequest, fmt.Errorf("transaction failed: %v", err))
 		return
 	}
+	recordAudit(tc.App, c, audit.ActionTransferCreated, tr.DestinationAddress.Hex(), audit.NewDiff(nil, map[string]interface{}{
+		"ethTxID":    etx.ID,
+		"evmChainID": chain.ID().String(),
+		"from":       tr.FromAddress.Hex(),
+		"amount":     tr.Amount.String(),
+	}))
 
 	jsonAPIResponse(c, presenters.NewEthTxResource(etx), "eth_tx")
 }
@@ -1,13 +1,16 @@
 package web
 
 import (
+	"database/sql"
 	"errors"
 	"fmt"
 	"net/http"
+	"strings"
 
 	"github.com/gin-gonic/contrib/sessions"
 	"github.com/gin-gonic/gin"
 
+	"github.com/smartcontractkit/chainlink/core/audit"
 	"github.com/smartcontractkit/chainlink/core/auth"
 	"github.com/smartcontractkit/chainlink/core/services/chainlink"
 	clsession "github.com/smartcontractkit/chainlink/core/sessions"
@@ -16,11 +19,131 @@ import (
 	"github.com/smartcontractkit/chainlink/core/web/presenters"
 )
 
-// UserController manages the current Session's User User.
+// UserController manages the current Session's User, and the node's users.
 type UserController struct {
 	App chainlink.Application
 }
 
+// CreateUserRequest defines the request to create a new user.
+type CreateUserRequest struct {
+	Email    string `json:"email"`
+	Password string `json:"password"`
+	Role     string `json:"role"`
+}
+
+// UpdateRoleRequest defines the request to change a user's role.
+type UpdateRoleRequest struct {
+	Role string `json:"role"`
+}
+
+// Index lists all users.
+func (c *UserController) Index(ctx *gin.Context) {
+	users, err := c.App.SessionORM().ListUsers()
+	if err != nil {
+		jsonAPIError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(ctx, presenters.NewUserResources(users), "users")
+}
+
+// Create creates a new user with the requested role.
+func (c *UserController) Create(ctx *gin.Context) {
+	var request CreateUserRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(ctx, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	role, err := clsession.GetUserRole(request.Role)
+	if err != nil {
+		jsonAPIError(ctx, http.StatusBadRequest, err)
+		return
+	}
+	user, err := clsession.NewUser(request.Email, request.Password, role)
+	if err != nil {
+		jsonAPIError(ctx, http.StatusBadRequest, err)
+		return
+	}
+	if _, err = c.App.SessionORM().FindUser(user.Email); err == nil {
+		jsonAPIError(ctx, http.StatusConflict, fmt.Errorf("user %s already exists", user.Email))
+		return
+	}
+	if err = c.App.SessionORM().CreateUser(&user); err != nil {
+		jsonAPIError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+	recordAudit(c.App, ctx, audit.ActionUserCreated, user.Email, audit.NewDiff(nil, presenters.NewUserResource(user)))
+
+	jsonAPIResponseWithStatus(ctx, presenters.NewUserResource(user), "user", http.StatusCreated)
+}
+
+// UpdateRole changes the role of a user. Admins can't change their own role,
+// so that there is always at least one admin.
+func (c *UserController) UpdateRole(ctx *gin.Context) {
+	var request UpdateRoleRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(ctx, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	email := ctx.Param("email")
+	if c.isCurrentUser(ctx, email) {
+		jsonAPIError(ctx, http.StatusBadRequest, errors.New("you cannot change your own role"))
+		return
+	}
+	role, err := clsession.GetUserRole(request.Role)
+	if err != nil {
+		jsonAPIError(ctx, http.StatusBadRequest, err)
+		return
+	}
+	before, err := c.App.SessionORM().FindUser(email)
+	if errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(ctx, http.StatusNotFound, errors.New("user not found"))
+		return
+	} else if err != nil {
+		jsonAPIError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+	user, err := c.App.SessionORM().UpdateRole(email, role)
+	if errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(ctx, http.StatusNotFound, errors.New("user not found"))
+		return
+	} else if err != nil {
+		jsonAPIError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+	recordAudit(c.App, ctx, audit.ActionUserRoleUpdated, user.Email, audit.NewDiff(presenters.NewUserResource(before), presenters.NewUserResource(user)))
+
+	jsonAPIResponse(ctx, presenters.NewUserResource(user), "user")
+}
+
+// Delete deletes a user. Admins can't delete themselves, so that there is
+// always at least one admin.
+func (c *UserController) Delete(ctx *gin.Context) {
+	email := ctx.Param("email")
+	if c.isCurrentUser(ctx, email) {
+		jsonAPIError(ctx, http.StatusBadRequest, errors.New("you cannot delete yourself"))
+		return
+	}
+	err := c.App.SessionORM().DeleteUser(email)
+	if errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(ctx, http.StatusNotFound, errors.New("user not found"))
+		return
+	} else if err != nil {
+		jsonAPIError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+	recordAudit(c.App, ctx, audit.ActionUserDeleted, email, nil)
+
+	jsonAPIResponseWithStatus(ctx, nil, "user", http.StatusNoContent)
+}
+
+func (c *UserController) isCurrentUser(ctx *gin.Context, email string) bool {
+	user, ok := webauth.GetAuthenticatedUser(ctx)
+	return ok && strings.EqualFold(user.Email, email)
+}
+
 // UpdatePasswordRequest defines the request to set a new password for the
 // current session's User.
 type UpdatePasswordRequest struct {
@@ -36,7 +159,7 @@ func (c *UserController) UpdatePassword(ctx *gin.Context) {
 		return
 	}
 
-	user, err := c.App.SessionORM().FindUser()
+	user, err := c.getCurrentUser(ctx)
 	if err != nil {
 		jsonAPIError(ctx, http.StatusInternalServerError, fmt.Errorf("failed to obtain current user record: %+v", err))
 		return
@@ -49,6 +172,7 @@ func (c *UserController) UpdatePassword(ctx *gin.Context) {
 		jsonAPIError(ctx, http.StatusInternalServerError, err)
 		return
 	}
+	recordAudit(c.App, ctx, audit.ActionUserPasswordUpdated, user.Email, nil)
 
 	jsonAPIResponse(ctx, presenters.NewUserResource(user), "user")
 }
@@ -61,7 +185,7 @@ func (c *UserController) NewAPIToken(ctx *gin.Context) {
 		return
 	}
 
-	user, err := c.App.SessionORM().FindUser()
+	user, err := c.getCurrentUser(ctx)
 	if err != nil {
 		jsonAPIError(ctx, http.StatusInternalServerError, fmt.Errorf("failed to obtain current user record: %+v", err))
 		return
@@ -75,6 +199,7 @@ func (c *UserController) NewAPIToken(ctx *gin.Context) {
 		jsonAPIError(ctx, http.StatusInternalServerError, err)
 		return
 	}
+	recordAudit(c.App, ctx, audit.ActionAPITokenCreated, user.Email, nil)
 
 	jsonAPIResponseWithStatus(ctx, newToken, "auth_token", http.StatusCreated)
 }
@@ -87,7 +212,7 @@ func (c *UserController) DeleteAPIToken(ctx *gin.Context) {
 		return
 	}
 
-	user, err := c.App.SessionORM().FindUser()
+	user, err := c.getCurrentUser(ctx)
 	if err != nil {
 		jsonAPIError(ctx, http.StatusInternalServerError, fmt.Errorf("failed to obtain current user record: %+v", err))
 		return
@@ -100,11 +225,20 @@ func (c *UserController) DeleteAPIToken(ctx *gin.Context) {
 		jsonAPIError(ctx, http.StatusInternalServerError, err)
 		return
 	}
+	recordAudit(c.App, ctx, audit.ActionAPITokenDeleted, user.Email, nil)
 	{
 		jsonAPIResponseWithStatus(ctx, nil, "auth_token", http.StatusNoContent)
 	}
 }
 
+func (c *UserController) getCurrentUser(ctx *gin.Context) (clsession.User, error) {
+	sessionUser, ok := webauth.GetAuthenticatedUser(ctx)
+	if !ok {
+		return clsession.User{}, errors.New("unable to get current user")
+	}
+	return c.App.SessionORM().FindUser(sessionUser.Email)
+}
+
 func (c *UserController) getCurrentSessionID(ctx *gin.Context) (string, error) {
 	session := sessions.Default(ctx)
 	sessionID, ok := session.Get(webauth.SessionIDKey).(string)
@@ -6,6 +6,7 @@ import (
 
 	"github.com/gin-gonic/gin"
 
+	"github.com/smartcontractkit/chainlink/core/audit"
 	"github.com/smartcontractkit/chainlink/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/core/web/presenters"
 )
@@ -36,6 +37,7 @@ func (vrfkc *VRFKeysController) Create(c *gin.Context) {
 		jsonAPIError(c, http.StatusInternalServerError, err)
 		return
 	}
+	recordAudit(vrfkc.App, c, audit.ActionKeyCreated, audit.KeyTarget("vrf", pk.ID()), nil)
 	jsonAPIResponse(c, presenters.NewVRFKeyResource(pk, vrfkc.App.GetLogger()), "vrfKey")
 }
 
@@ -55,6 +57,7 @@ func (vrfkc *VRFKeysController) Delete(c *gin.Context) {
 		jsonAPIError(c, http.StatusInternalServerError, err)
 		return
 	}
+	recordAudit(vrfkc.App, c, audit.ActionKeyDeleted, audit.KeyTarget("vrf", key.ID()), nil)
 	jsonAPIResponse(c, presenters.NewVRFKeyResource(key, vrfkc.App.GetLogger()), "vrfKey")
 }
 
@@ -76,6 +79,7 @@ func (vrfkc *VRFKeysController) Import(c *gin.Context) {
 		return
 	}
 
+	recordAudit(vrfkc.App, c, audit.ActionKeyImported, audit.KeyTarget("vrf", key.ID()), nil)
 	jsonAPIResponse(c, presenters.NewVRFKeyResource(key, vrfkc.App.GetLogger()), "vrfKey")
 }
 
@@ -94,5 +98,6 @@ func (vrfkc *VRFKeysController) Export(c *gin.Context) {
 		return
 	}
 
+	recordAudit(vrfkc.App, c, audit.ActionKeyExported, audit.KeyTarget("vrf", keyID), nil)
 	c.Data(http.StatusOK, MediaType, bytes)
 }
@@ -11,6 +11,7 @@ import (
 
 	"github.com/smartcontractkit/chainlink/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/core/sessions"
+	webauth "github.com/smartcontractkit/chainlink/core/web/auth"
 	"github.com/smartcontractkit/chainlink/core/web/presenters"
 	sqlxTypes "github.com/smartcontractkit/sqlx/types"
 )
@@ -31,7 +32,7 @@ func NewWebAuthnController(app chainlink.Application) WebAuthnController {
 
 func (c *WebAuthnController) BeginRegistration(ctx *gin.Context) {
 	orm := c.App.SessionORM()
-	user, err := orm.FindUser()
+	user, err := c.getCurrentUser(ctx)
 	if err != nil {
 		jsonAPIError(ctx, http.StatusInternalServerError, fmt.Errorf("failed to obtain current user record: %+v", err))
 		return
@@ -59,7 +60,7 @@ func (c *WebAuthnController) BeginRegistration(ctx *gin.Context) {
 
 func (c *WebAuthnController) FinishRegistration(ctx *gin.Context) {
 	orm := c.App.SessionORM()
-	user, err := orm.FindUser()
+	user, err := c.getCurrentUser(ctx)
 	if err != nil {
 		c.App.GetLogger().Errorf("error finding user: %s", err)
 		jsonAPIError(ctx, http.StatusInternalServerError, fmt.Errorf("failed to obtain current user record: %+v", err))
@@ -107,3 +108,11 @@ func (c *WebAuthnController) addCredentialToUser(user sessions.User, credential
 	}
 	return err
 }
+
+func (c *WebAuthnController) getCurrentUser(ctx *gin.Context) (sessions.User, error) {
+	sessionUser, ok := webauth.GetAuthenticatedUser(ctx)
+	if !ok {
+		return sessions.User{}, errors.New("unable to get current user")
+	}
+	return c.App.SessionORM().FindUser(sessionUser.Email)
+}
@@ -20,6 +20,11 @@ describe('JobRunStatusIcon', () => {
     expect(getByTestId('errored')).toBeInTheDocument()
   })
 
+  it('renders the cancelled icon', () => {
+    render(<JobRunStatusIcon {...dimensions} status="CANCELLED" />)
+    expect(getByTestId('cancelled')).toBeInTheDocument()
+  })
+
   it('renders the running icon', () => {
     render(<JobRunStatusIcon {...dimensions} status="RUNNING" />)
     expect(getByTestId('running')).toBeInTheDocument()
@@ -20,6 +20,10 @@ export const JobRunStatusIcon = ({ status, width, height }: Props) => {
       )
     case 'ERRORED':
       return <ErrorIcon width={width} height={height} data-testid="errored" />
+    case 'CANCELLED':
+      return (
+        <ErrorIcon width={width} height={height} data-testid="cancelled" />
+      )
     case 'RUNNING':
       return <PendingIcon width={width} height={height} data-testid="running" />
     case 'SUSPENDED':
@@ -0,0 +1,14 @@
+import React, { FC, SVGProps } from 'react'
+
+const SkippedIcon: FC<SVGProps<SVGSVGElement>> = (props) => (
+  <svg viewBox="0 0 48 48" {...props}>
+    <circle cx={24} cy={24} r={22} fill="none" stroke="#fff" strokeWidth={4} />
+    <circle cx={24} cy={24} r={20} fill="#f1f1f1" />
+    <path
+      d="M15 17.2v13.6c0 .9 1 1.4 1.7.9l9.1-6.8c.6-.5.6-1.4 0-1.8l-9.1-6.8c-.7-.5-1.7 0-1.7.9zm11.5 0v13.6c0 .9 1 1.4 1.7.9l3.3-2.5V31c0 .6.5 1.1 1.1 1.1s1.1-.5 1.1-1.1V17c0-.6-.5-1.1-1.1-1.1s-1.1.5-1.1 1.1v1.7l-3.3-2.4c-.7-.6-1.7-.1-1.7.9z"
+      fill="#9e9e9e"
+    />
+  </svg>
+)
+
+export default SkippedIcon
@@ -19,4 +19,9 @@ describe('TaskRunStatusIcon', () => {
     render(<TaskRunStatusIcon {...dimensions} status="errored" />)
     expect(getByTestId('errored')).toBeInTheDocument()
   })
+
+  it('renders the skipped icon', () => {
+    render(<TaskRunStatusIcon {...dimensions} status="skipped" />)
+    expect(getByTestId('skipped')).toBeInTheDocument()
+  })
 })
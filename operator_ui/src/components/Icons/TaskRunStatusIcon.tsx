@@ -1,10 +1,11 @@
 import React from 'react'
 
 import ErrorIcon from './Error'
+import SkippedIcon from './Skipped'
 import SuccessIcon from './Success'
 
 interface Props {
-  status: 'completed' | 'errored'
+  status: 'completed' | 'errored' | 'skipped'
   width?: number
   height?: number
 }
@@ -17,6 +18,10 @@ export const TaskRunStatusIcon = ({ status, width, height }: Props) => {
       )
     case 'errored':
       return <ErrorIcon width={width} height={height} data-testid="errored" />
+    case 'skipped':
+      return (
+        <SkippedIcon width={width} height={height} data-testid="skipped" />
+      )
     default:
       return null
   }
@@ -25,7 +25,9 @@ const defaultKeySpecifics = '{}'
 
 const chainTypes = ['arbitrum', 'exchain', 'optimism', 'xdai']
 const gasEstimatorModes = [
+  'Arbitrum',
   'BlockHistory',
+  'FeeHistory',
   'FixedPrice',
   'Optimism',
   'Optimism2',
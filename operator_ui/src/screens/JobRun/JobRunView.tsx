@@ -23,6 +23,7 @@ export const JOB_RUN_PAYLOAD__TASK_RUNS_FIELDS = gql`
     error
     finishedAt
     output
+    skipped
     type
   }
 `
@@ -26,6 +26,9 @@ const styles = (theme: any) =>
     errored: {
       backgroundColor: theme.palette.error.light,
     },
+    cancelled: {
+      backgroundColor: theme.palette.grey[300],
+    },
     running: {
       backgroundColor: theme.palette.warning.light,
     },
@@ -54,6 +57,7 @@ export const StatusCard = withStyles(styles)(
       switch (status) {
         case 'COMPLETED':
         case 'ERRORED':
+        case 'CANCELLED':
           return finishedAt
         case 'RUNNING':
           return Date.now()
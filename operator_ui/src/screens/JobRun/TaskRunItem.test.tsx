@@ -40,4 +40,18 @@ describe('TaskRunItemCard', () => {
     expect(queryByText(run.error as string)).toBeInTheDocument()
     expect(queryByText(': result,data')).toBeInTheDocument()
   })
+
+  it('renders details of a skipped task run', async () => {
+    const run = buildTaskRun({
+      error: null,
+      skipped: true,
+    })
+
+    renderComponent({ ...run, attrs: { type: run.type } })
+
+    // The skipped icon
+    expect(queryByTestId('skipped')).toBeInTheDocument()
+    expect(queryByText(run.dotID)).toBeInTheDocument()
+    expect(queryByText('Skipped')).toBeInTheDocument()
+  })
 })
@@ -42,13 +42,16 @@ const styles = (theme: Theme) =>
 
 export interface Props
   extends WithStyles<typeof styles>,
-    Pick<JobRunPayload_TaskRunsFields, 'dotID' | 'output' | 'error' | 'type'> {
+    Pick<
+      JobRunPayload_TaskRunsFields,
+      'dotID' | 'output' | 'error' | 'type' | 'skipped'
+    > {
   attrs?: object
 }
 
 export const TaskRunItem = withStyles(styles)(
-  ({ attrs, classes, dotID, output, error, type }: Props) => {
-    const status = error ? 'errored' : 'completed'
+  ({ attrs, classes, dotID, output, error, type, skipped }: Props) => {
+    const status = error ? 'errored' : skipped ? 'skipped' : 'completed'
 
     return (
       <div className={classes.root}>
@@ -67,6 +70,12 @@ export const TaskRunItem = withStyles(styles)(
             </Typography>
           )}
 
+          {status === 'skipped' && (
+            <Typography className={classes.text} variant="body1">
+              Skipped
+            </Typography>
+          )}
+
           {attrs &&
             Object.entries(attrs).map(([key, value]) => {
               if (key === 'type') {
@@ -40,6 +40,7 @@ export function buildTaskRun(
     error: 'data: parameter is empty',
     finishedAt: minuteAgo,
     output: 'null',
+    skipped: false,
     type: 'jsonparse',
     ...overrides,
   }